@@ -19,6 +19,7 @@ import (
 
 	"github.com/cloudwego/biz-demo/gomall/app/cart/biz/dal/mysql"
 	"github.com/cloudwego/biz-demo/gomall/app/cart/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
 	cart "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
 	"github.com/cloudwego/kitex/pkg/kerrors"
 )
@@ -33,6 +34,9 @@ func NewEmptyCartService(ctx context.Context) *EmptyCartService {
 // Run create note info
 func (s *EmptyCartService) Run(req *cart.EmptyCartReq) (resp *cart.EmptyCartResp, err error) {
 	// Finish your business logic.
+	if err = authn.RequireService(s.ctx); err != nil {
+		return nil, err
+	}
 	err = model.EmptyCart(mysql.DB, s.ctx, req.GetUserId())
 	if err != nil {
 		return &cart.EmptyCartResp{}, kerrors.NewBizStatusError(50001, "empty cart error")
@@ -16,7 +16,19 @@ package service
 
 import (
 	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/common/authn/authntest"
+	cart "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
+	"github.com/cloudwego/kitex/pkg/kerrors"
 )
 
 func TestEmptyCart_Run(t *testing.T) {
 }
+
+func TestEmptyCart_RejectsUsers(t *testing.T) {
+	ctx := authntest.UserContext(t, 1)
+	_, err := NewEmptyCartService(ctx).Run(&cart.EmptyCartReq{UserId: 1})
+	if bizErr, ok := kerrors.FromBizStatusError(err); !ok || bizErr.BizStatusCode() != 40300 {
+		t.Errorf("a user emptied the cart directly: %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/cart/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/cart/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	cart "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type EraseUserDataService struct {
+	ctx context.Context
+} // NewEraseUserDataService new EraseUserDataService
+func NewEraseUserDataService(ctx context.Context) *EraseUserDataService {
+	return &EraseUserDataService{ctx: ctx}
+}
+
+// Run deletes the cart of the user, nothing in it has to be kept.
+func (s *EraseUserDataService) Run(req *cart.EraseUserDataReq) (resp *cart.EraseUserDataResp, err error) {
+	if err = authn.Require(s.ctx, authn.PermUsersErase); err != nil {
+		return nil, err
+	}
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	if err = model.EmptyCart(mysql.DB, s.ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	return &cart.EraseUserDataResp{}, nil
+}
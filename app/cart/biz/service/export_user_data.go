@@ -0,0 +1,60 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/cart/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/cart/biz/model"
+	cart "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type ExportUserDataService struct {
+	ctx context.Context
+} // NewExportUserDataService new ExportUserDataService
+func NewExportUserDataService(ctx context.Context) *ExportUserDataService {
+	return &ExportUserDataService{ctx: ctx}
+}
+
+type exportedItem struct {
+	ProductId uint32    `json:"product_id"`
+	Qty       uint32    `json:"qty"`
+	AddedAt   time.Time `json:"added_at"`
+}
+
+func (s *ExportUserDataService) Run(req *cart.ExportUserDataReq) (resp *cart.ExportUserDataResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	items, err := model.GetCartByUserId(mysql.DB, s.ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	export := struct {
+		Items []exportedItem `json:"items"`
+	}{Items: []exportedItem{}}
+	for _, item := range items {
+		export.Items = append(export.Items, exportedItem{ProductId: item.ProductId, Qty: item.Qty, AddedAt: item.CreatedAt})
+	}
+	data, err := json.Marshal(export)
+	if err != nil {
+		return nil, err
+	}
+	return &cart.ExportUserDataResp{Data: data}, nil
+}
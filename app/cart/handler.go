@@ -44,3 +44,17 @@ func (s *CartServiceImpl) EmptyCart(ctx context.Context, req *cart.EmptyCartReq)
 
 	return resp, err
 }
+
+// ExportUserData implements the CartServiceImpl interface.
+func (s *CartServiceImpl) ExportUserData(ctx context.Context, req *cart.ExportUserDataReq) (resp *cart.ExportUserDataResp, err error) {
+	resp, err = service.NewExportUserDataService(ctx).Run(req)
+
+	return resp, err
+}
+
+// EraseUserData implements the CartServiceImpl interface.
+func (s *CartServiceImpl) EraseUserData(ctx context.Context, req *cart.EraseUserDataReq) (resp *cart.EraseUserDataResp, err error) {
+	resp, err = service.NewEraseUserDataService(ctx).Run(req)
+
+	return resp, err
+}
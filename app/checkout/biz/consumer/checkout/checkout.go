@@ -0,0 +1,176 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/consumer/queue"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/service"
+	rpccheckout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/server"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/protobuf/proto"
+)
+
+// expireInterval is how often checkouts stuck in the queue are failed.
+const expireInterval = 30 * time.Second
+
+func ConsumerInit() {
+	opts := queue.OptionsFromConf()
+	cc, err := queue.Consume(context.Background(), service.CheckoutSubject, func(msg jetstream.Msg) {
+		handle(msg, opts)
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ticker := time.NewTicker(expireInterval)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				expireQueued(ctx, now.Add(-opts.Timeout))
+			}
+		}
+	}()
+
+	server.RegisterShutdownHook(func() {
+		cc.Stop()
+		ticker.Stop()
+		cancel()
+	})
+}
+
+// handle runs a queued checkout. Failures are retried with backoff; a checkout that still
+// fails after the shopper was charged is refunded before it is marked failed.
+func handle(msg jetstream.Msg, opts queue.Options) {
+	meta, err := msg.Metadata()
+	if err != nil {
+		klog.Errorf("checkout consumer: invalid message metadata: %v", err)
+		_ = msg.Term()
+		return
+	}
+	var job rpccheckout.CheckoutJob
+	if err := proto.Unmarshal(msg.Data(), &job); err != nil || job.Req == nil {
+		klog.Errorf("checkout consumer: invalid message %d: %v", meta.Sequence.Stream, err)
+		_ = msg.Term()
+		return
+	}
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.HeaderCarrier(msg.Headers()))
+	ctx, span := otel.Tracer("shop-nats-consumer").Start(ctx, "shop-checkout-consumer")
+	defer span.End()
+
+	store := model.NewCheckoutJobStore(ctx, redis.RedisClient)
+	claimed, err := store.Claim(job.CheckoutId)
+	if err != nil {
+		klog.CtxErrorf(ctx, "claim checkout %s: %v", job.CheckoutId, err)
+		_ = msg.NakWithDelay(opts.Backoff(meta.NumDelivered))
+		return
+	}
+	if !claimed {
+		// expired while queued, or finished before the ack got lost
+		_ = msg.Ack()
+		return
+	}
+
+	_, err = service.NewCheckoutService(ctx).Queued(job.CheckoutId, job.CardToken).OnStep(func(step string) {
+		if err := store.SetStep(job.CheckoutId, step); err != nil {
+			klog.CtxErrorf(ctx, "set checkout %s step: %v", job.CheckoutId, err)
+		}
+	}).Run(job.Req)
+	if err == nil {
+		if err := store.Finish(job.CheckoutId, model.CheckoutStatusSuccess, ""); err != nil {
+			klog.CtxErrorf(ctx, "save checkout %s result: %v", job.CheckoutId, err)
+			_ = msg.NakWithDelay(opts.Backoff(meta.NumDelivered))
+			return
+		}
+		_ = msg.Ack()
+		return
+	}
+
+	var charged *service.ChargedError
+	if !errors.As(err, &charged) {
+		// an earlier attempt may have charged the order before this one failed
+		progress, _, getErr := store.Get(job.CheckoutId)
+		if getErr != nil {
+			klog.CtxErrorf(ctx, "get checkout %s: %v", job.CheckoutId, getErr)
+			_ = msg.NakWithDelay(opts.Backoff(meta.NumDelivered))
+			return
+		}
+		if progress.TransactionId != "" {
+			charged = &service.ChargedError{
+				UserId:        job.Req.UserId,
+				OrderId:       progress.OrderId,
+				TransactionId: progress.TransactionId,
+				Amount:        progress.Amount,
+				Err:           err,
+			}
+		}
+	}
+	if charged != nil {
+		if !opts.LastAttempt(meta) || charged.Paid {
+			klog.CtxWarnf(ctx, "checkout %s not confirmed on attempt %d, retrying: %v", job.CheckoutId, meta.NumDelivered, err)
+			_ = msg.NakWithDelay(opts.Backoff(meta.NumDelivered))
+			return
+		}
+		if refundErr := service.RefundCharge(ctx, charged); refundErr != nil {
+			klog.CtxErrorf(ctx, "refund unconfirmed checkout %s failed, retrying: %v", job.CheckoutId, refundErr)
+			_ = msg.NakWithDelay(opts.BackoffMax)
+			return
+		}
+		err = fmt.Errorf("the payment was refunded: %w", err)
+	} else if _, invalid := kerrors.FromBizStatusError(err); !invalid && !opts.LastAttempt(meta) {
+		klog.CtxWarnf(ctx, "checkout %s failed on attempt %d, retrying: %v", job.CheckoutId, meta.NumDelivered, err)
+		_ = msg.NakWithDelay(opts.Backoff(meta.NumDelivered))
+		return
+	}
+
+	klog.CtxErrorf(ctx, "checkout %s failed: %v", job.CheckoutId, err)
+	if err := store.Finish(job.CheckoutId, model.CheckoutStatusFailed, err.Error()); err != nil {
+		klog.CtxErrorf(ctx, "save checkout %s result: %v", job.CheckoutId, err)
+		_ = msg.NakWithDelay(opts.Backoff(meta.NumDelivered))
+		return
+	}
+	_ = msg.Ack()
+}
+
+// expireQueued fails the checkouts no consumer picked up since before, so they do not stay
+// queued forever.
+func expireQueued(ctx context.Context, before time.Time) {
+	store := model.NewCheckoutJobStore(ctx, redis.RedisClient)
+	queued, err := store.ListQueued(before)
+	if err != nil {
+		klog.Errorf("list queued checkouts: %v", err)
+		return
+	}
+	for _, checkoutId := range queued {
+		if _, err := store.Expire(checkoutId, "the checkout was not processed in time"); err != nil {
+			klog.Errorf("expire checkout %s: %v", checkoutId, err)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flashsale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/consumer/queue"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/service"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/metric"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/server"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/protobuf/proto"
+)
+
+// expireInterval is how often purchases stuck in the queue are failed.
+const expireInterval = 30 * time.Second
+
+func ConsumerInit() {
+	flashSale := model.NewFlashSale(context.Background(), redis.RedisClient)
+	for _, p := range conf.GetConf().FlashSale.Products {
+		if err := flashSale.Seed(p.ProductId, p.Stock); err != nil {
+			panic(err)
+		}
+	}
+
+	opts := queue.OptionsFromConf()
+	// a work queue, so each purchase is handled by only one checkout instance
+	cc, err := queue.Consume(context.Background(), service.FlashSaleSubject, func(msg jetstream.Msg) {
+		handle(msg, opts)
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ticker := time.NewTicker(expireInterval)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				expireQueued(ctx, now.Add(-opts.Timeout))
+			}
+		}
+	}()
+
+	server.RegisterShutdownHook(func() {
+		cc.Stop()
+		ticker.Stop()
+		cancel()
+	})
+}
+
+// handle places and pays the order of a purchase. Failures are retried with backoff; a
+// purchase that still fails gives its item back, after refunding the shopper if the order
+// was already charged.
+func handle(msg jetstream.Msg, opts queue.Options) {
+	meta, err := msg.Metadata()
+	if err != nil {
+		klog.Errorf("flash sale consumer: invalid message metadata: %v", err)
+		_ = msg.Term()
+		return
+	}
+	var job checkout.FlashSaleJob
+	if err := proto.Unmarshal(msg.Data(), &job); err != nil || job.Req == nil {
+		klog.Errorf("flash sale consumer: invalid message %d: %v", meta.Sequence.Stream, err)
+		_ = msg.Term()
+		return
+	}
+	req := job.Req
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.HeaderCarrier(msg.Headers()))
+	ctx, span := otel.Tracer("shop-nats-consumer").Start(ctx, "shop-flashsale-consumer")
+	defer span.End()
+
+	flashSale := model.NewFlashSale(ctx, redis.RedisClient)
+	claimed, err := flashSale.Claim(req.ProductId, req.UserId)
+	if err != nil {
+		klog.CtxErrorf(ctx, "claim flash sale purchase: %v", err)
+		_ = msg.NakWithDelay(opts.Backoff(meta.NumDelivered))
+		return
+	}
+	if !claimed {
+		// expired while queued, or finished before the ack got lost
+		_ = msg.Ack()
+		return
+	}
+
+	productLabel := strconv.Itoa(int(req.ProductId))
+	resp, err := service.NewFlashSaleOrderService(ctx).Run(&job)
+	if err == nil {
+		if err := flashSale.Finish(req.ProductId, req.UserId, model.FlashSaleStatusSuccess, ""); err != nil {
+			klog.CtxErrorf(ctx, "set flash sale status: %v", err)
+			_ = msg.NakWithDelay(opts.Backoff(meta.NumDelivered))
+			return
+		}
+		klog.CtxInfof(ctx, "flash sale order %s paid with transaction %s", resp.OrderId, resp.TransactionId)
+		metric.FlashSaleSold.WithLabelValues(productLabel).Inc()
+		_ = msg.Ack()
+		return
+	}
+
+	var charged *service.ChargedError
+	if !errors.As(err, &charged) {
+		// an earlier attempt may have charged the order before this one failed
+		progress, statusErr := flashSale.GetStatus(req.ProductId, req.UserId)
+		if statusErr != nil {
+			klog.CtxErrorf(ctx, "get flash sale status: %v", statusErr)
+			_ = msg.NakWithDelay(opts.Backoff(meta.NumDelivered))
+			return
+		}
+		if progress.TransactionId != "" {
+			charged = &service.ChargedError{
+				UserId:        req.UserId,
+				OrderId:       progress.OrderId,
+				TransactionId: progress.TransactionId,
+				Amount:        progress.Amount,
+				Err:           err,
+			}
+		}
+	}
+	if charged != nil {
+		if !opts.LastAttempt(meta) || charged.Paid {
+			klog.CtxWarnf(ctx, "flash sale order for user %d product %d not confirmed on attempt %d, retrying: %v", req.UserId, req.ProductId, meta.NumDelivered, err)
+			_ = msg.NakWithDelay(opts.Backoff(meta.NumDelivered))
+			return
+		}
+		// the item goes back on sale only once the money went back to the shopper
+		if refundErr := service.RefundCharge(ctx, charged); refundErr != nil {
+			klog.CtxErrorf(ctx, "refund unconfirmed flash sale order %s failed, retrying: %v", charged.OrderId, refundErr)
+			_ = msg.NakWithDelay(opts.BackoffMax)
+			return
+		}
+		err = fmt.Errorf("the payment was refunded: %w", err)
+	} else if _, invalid := kerrors.FromBizStatusError(err); !invalid && !opts.LastAttempt(meta) {
+		klog.CtxWarnf(ctx, "flash sale order for user %d product %d failed on attempt %d, retrying: %v", req.UserId, req.ProductId, meta.NumDelivered, err)
+		_ = msg.NakWithDelay(opts.Backoff(meta.NumDelivered))
+		return
+	}
+
+	klog.CtxErrorf(ctx, "flash sale order for user %d product %d failed: %v", req.UserId, req.ProductId, err)
+	metric.FlashSaleRejected.WithLabelValues(productLabel, "order_failed").Inc()
+	if err := flashSale.Release(req.ProductId, req.UserId); err != nil {
+		klog.CtxErrorf(ctx, "release flash sale stock: %v", err)
+		_ = msg.NakWithDelay(opts.Backoff(meta.NumDelivered))
+		return
+	}
+	if err := flashSale.Finish(req.ProductId, req.UserId, model.FlashSaleStatusFailed, err.Error()); err != nil {
+		klog.CtxErrorf(ctx, "set flash sale status: %v", err)
+	}
+	_ = msg.Ack()
+}
+
+// expireQueued fails the purchases no consumer picked up since before, so they do not stay
+// queued forever and their items go back on sale.
+func expireQueued(ctx context.Context, before time.Time) {
+	flashSale := model.NewFlashSale(ctx, redis.RedisClient)
+	queued, err := flashSale.ListQueued(before)
+	if err != nil {
+		klog.Errorf("list queued flash sale purchases: %v", err)
+		return
+	}
+	for _, q := range queued {
+		expired, err := flashSale.Expire(q.ProductId, q.UserId, "the purchase was not processed in time")
+		if err != nil {
+			klog.Errorf("expire flash sale purchase of user %d product %d: %v", q.UserId, q.ProductId, err)
+			continue
+		}
+		if expired {
+			metric.FlashSaleRejected.WithLabelValues(strconv.Itoa(int(q.ProductId)), "expired").Inc()
+		}
+	}
+}
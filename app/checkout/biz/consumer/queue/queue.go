@@ -0,0 +1,78 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package queue consumes the jobs of the checkout work queue, see conf.Queue.
+package queue
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/mq"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// ackWait is how long a job may run before the server redelivers it, e.g. because the
+// consumer stopped while processing it.
+const ackWait = 2 * time.Minute
+
+type Options struct {
+	MaxDeliver  int
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	Timeout     time.Duration
+}
+
+func OptionsFromConf() Options {
+	q := conf.GetConf().Queue
+	return Options{
+		MaxDeliver:  q.MaxDeliver,
+		BackoffBase: time.Duration(q.BackoffBaseSeconds) * time.Second,
+		BackoffMax:  time.Duration(q.BackoffMaxSeconds) * time.Second,
+		Timeout:     time.Duration(q.TimeoutMinutes) * time.Minute,
+	}
+}
+
+// Backoff is the delay before delivery number delivered+1: BackoffBase doubled for every
+// earlier attempt, at most BackoffMax.
+func (o Options) Backoff(delivered uint64) time.Duration {
+	d := o.BackoffBase
+	for i := uint64(1); i < delivered && d < o.BackoffMax; i++ {
+		d *= 2
+	}
+	return min(d, o.BackoffMax)
+}
+
+// LastAttempt tells whether a failed job must not be retried anymore.
+func (o Options) LastAttempt(meta *jetstream.MsgMetadata) bool {
+	return int(meta.NumDelivered) >= o.MaxDeliver
+}
+
+// Consume calls handle for the jobs published on subject. The server redelivers a job until
+// it is acked or terminated, so handle decides when to give up; see LastAttempt.
+func Consume(ctx context.Context, subject string, handle jetstream.MessageHandler) (jetstream.ConsumeContext, error) {
+	consumer, err := mq.JS.CreateOrUpdateConsumer(ctx, conf.GetConf().Queue.Stream, jetstream.ConsumerConfig{
+		Durable:       strings.ReplaceAll(subject, ".", "_"),
+		FilterSubject: subject,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       ackWait,
+		MaxDeliver:    -1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return consumer.Consume(handle)
+}
@@ -0,0 +1,37 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+func TestOptions(t *testing.T) {
+	opts := Options{MaxDeliver: 3, BackoffBase: 2 * time.Second, BackoffMax: 5 * time.Second}
+	for delivered, want := range map[uint64]time.Duration{1: 2 * time.Second, 2: 4 * time.Second, 3: 5 * time.Second, 10: 5 * time.Second} {
+		if got := opts.Backoff(delivered); got != want {
+			t.Errorf("Backoff(%d) = %v, want %v", delivered, got, want)
+		}
+	}
+	if opts.LastAttempt(&jetstream.MsgMetadata{NumDelivered: 2}) {
+		t.Error("attempt 2 of 3 is not the last")
+	}
+	if !opts.LastAttempt(&jetstream.MsgMetadata{NumDelivered: 3}) {
+		t.Error("attempt 3 of 3 is the last")
+	}
+}
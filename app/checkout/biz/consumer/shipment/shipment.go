@@ -0,0 +1,78 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shipment
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/consumer/queue"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/service"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/server"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/protobuf/proto"
+)
+
+func ConsumerInit() {
+	opts := queue.OptionsFromConf()
+	cc, err := queue.Consume(context.Background(), service.ShipmentSubject, func(msg jetstream.Msg) {
+		handle(msg, opts)
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	server.RegisterShutdownHook(func() {
+		cc.Stop()
+	})
+}
+
+// handle creates the shipment of a paid order. The order is paid, so the shipment is
+// retried until shipping takes it; only a request shipping rejects is given up.
+func handle(msg jetstream.Msg, opts queue.Options) {
+	meta, err := msg.Metadata()
+	if err != nil {
+		klog.Errorf("shipment consumer: invalid message metadata: %v", err)
+		_ = msg.Term()
+		return
+	}
+	var req shipping.CreateShipmentReq
+	if err := proto.Unmarshal(msg.Data(), &req); err != nil {
+		klog.Errorf("shipment consumer: invalid message %d: %v", meta.Sequence.Stream, err)
+		_ = msg.Term()
+		return
+	}
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.HeaderCarrier(msg.Headers()))
+	ctx, span := otel.Tracer("shop-nats-consumer").Start(ctx, "shop-shipment-consumer")
+	defer span.End()
+
+	_, err = rpc.ShippingClient.CreateShipment(ctx, &req)
+	if err == nil {
+		_ = msg.Ack()
+		return
+	}
+	if _, invalid := kerrors.FromBizStatusError(err); invalid {
+		klog.CtxErrorf(ctx, "shipment of paid order %s rejected, it must be created by hand: %v", req.OrderId, err)
+		_ = msg.Term()
+		return
+	}
+	klog.CtxWarnf(ctx, "shipment of order %s failed on attempt %d, retrying: %v", req.OrderId, meta.NumDelivered, err)
+	_ = msg.NakWithDelay(opts.Backoff(meta.NumDelivered))
+}
@@ -0,0 +1,175 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	CheckoutStatusQueued     = "queued"
+	CheckoutStatusProcessing = "processing"
+	CheckoutStatusSuccess    = "success"
+	CheckoutStatusFailed     = "failed"
+
+	CheckoutStepCart    = "cart"
+	CheckoutStepOrder   = "order"
+	CheckoutStepPayment = "payment"
+	CheckoutStepConfirm = "confirm"
+
+	checkoutJobTTL = 24 * time.Hour
+)
+
+// claimCheckoutScript moves a queued or interrupted checkout to processing. It returns 0
+// when the checkout expired while queued or is already finished.
+var claimCheckoutScript = redis.NewScript(`
+local status = redis.call('HGET', KEYS[1], 'status')
+if status ~= ARGV[1] and status ~= ARGV[2] then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'status', ARGV[2])
+redis.call('ZREM', KEYS[2], ARGV[3])
+return 1
+`)
+
+// expireCheckoutScript fails a checkout that is still queued. It returns 0 when a consumer
+// claimed the checkout in the meantime.
+var expireCheckoutScript = redis.NewScript(`
+redis.call('ZREM', KEYS[2], ARGV[1])
+if redis.call('HGET', KEYS[1], 'status') ~= ARGV[2] then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'status', ARGV[3], 'reason', ARGV[4])
+return 1
+`)
+
+// CheckoutJob is the progress of a checkout. OrderId, Amount, Weight and ShippingMethod are
+// recorded once the order is placed, CartEmptied once the cart is emptied and TransactionId
+// once it is paid, so a redelivered job resumes instead of ordering or charging again.
+type CheckoutJob struct {
+	UserId         uint32  `redis:"user_id"`
+	Status         string  `redis:"status"`
+	Step           string  `redis:"step"`
+	OrderId        string  `redis:"order_id"`
+	Amount         float32 `redis:"amount"`
+	Weight         float32 `redis:"weight"`
+	ShippingMethod string  `redis:"shipping_method"`
+	CartEmptied    bool    `redis:"cart_emptied"`
+	TransactionId  string  `redis:"transaction_id"`
+	Reason         string  `redis:"reason"`
+}
+
+// CheckoutJobStore keeps the progress of asynchronous checkouts, so the frontend can poll it.
+type CheckoutJobStore struct {
+	ctx         context.Context
+	cacheClient *redis.Client
+	prefix      string
+}
+
+func NewCheckoutJobStore(ctx context.Context, cacheClient *redis.Client) CheckoutJobStore {
+	return CheckoutJobStore{ctx: ctx, cacheClient: cacheClient, prefix: "cloudwego_shop"}
+}
+
+func (s CheckoutJobStore) key(checkoutId string) string {
+	return fmt.Sprintf("%s_checkout_%s", s.prefix, checkoutId)
+}
+
+// queuedKey is a sorted set of the checkouts no consumer has claimed yet, by queue time.
+func (s CheckoutJobStore) queuedKey() string {
+	return fmt.Sprintf("%s_checkout_queued", s.prefix)
+}
+
+func (s CheckoutJobStore) Create(checkoutId string, userId uint32) error {
+	key := s.key(checkoutId)
+	_, err := s.cacheClient.TxPipelined(s.ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(s.ctx, key, CheckoutJob{UserId: userId, Status: CheckoutStatusQueued})
+		pipe.Expire(s.ctx, key, checkoutJobTTL)
+		pipe.ZAdd(s.ctx, s.queuedKey(), redis.Z{Score: float64(time.Now().Unix()), Member: checkoutId})
+		return nil
+	})
+	return err
+}
+
+// Claim marks the checkout as processing. It returns false when the checkout must not be
+// processed, because it expired while queued or is already finished.
+func (s CheckoutJobStore) Claim(checkoutId string) (bool, error) {
+	keys := []string{s.key(checkoutId), s.queuedKey()}
+	res, err := claimCheckoutScript.Run(s.ctx, s.cacheClient, keys, CheckoutStatusQueued, CheckoutStatusProcessing, checkoutId).Int()
+	return res == 1, err
+}
+
+// ListQueued returns the checkouts queued before the given time and not claimed since.
+func (s CheckoutJobStore) ListQueued(before time.Time) ([]string, error) {
+	return s.cacheClient.ZRangeByScore(s.ctx, s.queuedKey(), &redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(before.Unix(), 10),
+	}).Result()
+}
+
+// Expire fails the checkout if it is still queued.
+func (s CheckoutJobStore) Expire(checkoutId, reason string) (bool, error) {
+	keys := []string{s.key(checkoutId), s.queuedKey()}
+	res, err := expireCheckoutScript.Run(s.ctx, s.cacheClient, keys, checkoutId, CheckoutStatusQueued, CheckoutStatusFailed, reason).Int()
+	return res == 1, err
+}
+
+// SetOrder records the placed order of the checkout.
+func (s CheckoutJobStore) SetOrder(checkoutId, orderId string, amount, weight float32, method string) error {
+	return s.cacheClient.HSet(s.ctx, s.key(checkoutId),
+		"order_id", orderId, "amount", amount, "weight", weight, "shipping_method", method).Err()
+}
+
+// SetCartEmptied records that the cart of the checkout's order was emptied.
+func (s CheckoutJobStore) SetCartEmptied(checkoutId string) error {
+	return s.cacheClient.HSet(s.ctx, s.key(checkoutId), "cart_emptied", true).Err()
+}
+
+// SetCharged records the charge of the checkout.
+func (s CheckoutJobStore) SetCharged(checkoutId, transactionId string) error {
+	return s.cacheClient.HSet(s.ctx, s.key(checkoutId), "transaction_id", transactionId).Err()
+}
+
+// Finish sets the final status of the checkout. The recorded order and charge are kept.
+func (s CheckoutJobStore) Finish(checkoutId, status, reason string) error {
+	key := s.key(checkoutId)
+	_, err := s.cacheClient.TxPipelined(s.ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(s.ctx, key, "status", status, "reason", reason)
+		pipe.Expire(s.ctx, key, checkoutJobTTL)
+		return nil
+	})
+	return err
+}
+
+func (s CheckoutJobStore) SetStep(checkoutId, step string) error {
+	return s.cacheClient.HSet(s.ctx, s.key(checkoutId), "status", CheckoutStatusProcessing, "step", step).Err()
+}
+
+// Get returns the job, and false when it does not exist or has expired.
+func (s CheckoutJobStore) Get(checkoutId string) (job CheckoutJob, ok bool, err error) {
+	res := s.cacheClient.HGetAll(s.ctx, s.key(checkoutId))
+	if res.Err() != nil {
+		return job, false, res.Err()
+	}
+	if len(res.Val()) == 0 {
+		return job, false, nil
+	}
+	err = res.Scan(&job)
+	return job, err == nil, err
+}
@@ -0,0 +1,247 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	FlashSaleStatusQueued     = "queued"
+	FlashSaleStatusProcessing = "processing"
+	FlashSaleStatusSuccess    = "success"
+	FlashSaleStatusFailed     = "failed"
+
+	FlashSaleRejectNotOnSale = "not_on_sale"
+	FlashSaleRejectSoldOut   = "sold_out"
+	FlashSaleRejectDuplicate = "duplicate"
+
+	flashSaleStatusTTL = 24 * time.Hour
+)
+
+// reserveScript checks the buyer set and the stock, then takes one item and marks the
+// purchase as queued until a consumer claims it. It returns 1 on success, 0 when sold out,
+// -1 when the user has already bought the product and -2 when the product is not on sale.
+var reserveScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return -2
+end
+if redis.call('SISMEMBER', KEYS[2], ARGV[1]) == 1 then
+	return -1
+end
+if tonumber(redis.call('GET', KEYS[1])) <= 0 then
+	return 0
+end
+redis.call('DECR', KEYS[1])
+redis.call('SADD', KEYS[2], ARGV[1])
+redis.call('DEL', KEYS[3])
+redis.call('HSET', KEYS[3], 'status', ARGV[2])
+redis.call('EXPIRE', KEYS[3], ARGV[3])
+redis.call('ZADD', KEYS[4], ARGV[4], ARGV[5])
+return 1
+`)
+
+// releaseScript gives the item back, so the user can try again.
+var releaseScript = redis.NewScript(`
+if redis.call('SREM', KEYS[2], ARGV[1]) == 1 then
+	redis.call('INCR', KEYS[1])
+end
+return 1
+`)
+
+// claimScript moves a queued or interrupted purchase to processing. It returns 0 when the
+// purchase expired while queued or is already finished.
+var claimScript = redis.NewScript(`
+local status = redis.call('HGET', KEYS[1], 'status')
+if status ~= ARGV[1] and status ~= ARGV[2] then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'status', ARGV[2])
+redis.call('ZREM', KEYS[2], ARGV[3])
+return 1
+`)
+
+// expireScript fails a purchase still queued and gives its item back. It returns 0 when a
+// consumer claimed the purchase in the meantime.
+var expireScript = redis.NewScript(`
+redis.call('ZREM', KEYS[2], ARGV[1])
+if redis.call('HGET', KEYS[1], 'status') ~= ARGV[2] then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'status', ARGV[3], 'reason', ARGV[4])
+if redis.call('SREM', KEYS[4], ARGV[5]) == 1 then
+	redis.call('INCR', KEYS[3])
+end
+return 1
+`)
+
+// FlashSaleStatus is the purchase status polled by the shopper. OrderId, Amount, Weight and
+// ShippingMethod are recorded once the order is placed and TransactionId once it is paid,
+// so a redelivered purchase resumes instead of ordering or charging again.
+type FlashSaleStatus struct {
+	Status         string  `redis:"status"`
+	OrderId        string  `redis:"order_id"`
+	Amount         float32 `redis:"amount"`
+	Weight         float32 `redis:"weight"`
+	ShippingMethod string  `redis:"shipping_method"`
+	TransactionId  string  `redis:"transaction_id"`
+	Reason         string  `redis:"reason"`
+}
+
+// QueuedFlashSale identifies a purchase waiting for a consumer.
+type QueuedFlashSale struct {
+	ProductId uint32
+	UserId    uint32
+}
+
+type FlashSale struct {
+	ctx         context.Context
+	cacheClient *redis.Client
+	prefix      string
+}
+
+func NewFlashSale(ctx context.Context, cacheClient *redis.Client) FlashSale {
+	return FlashSale{ctx: ctx, cacheClient: cacheClient, prefix: "cloudwego_shop"}
+}
+
+func (f FlashSale) stockKey(productId uint32) string {
+	return fmt.Sprintf("%s_flashsale_stock_%d", f.prefix, productId)
+}
+
+func (f FlashSale) buyersKey(productId uint32) string {
+	return fmt.Sprintf("%s_flashsale_buyers_%d", f.prefix, productId)
+}
+
+func (f FlashSale) statusKey(productId, userId uint32) string {
+	return fmt.Sprintf("%s_flashsale_status_%d_%d", f.prefix, productId, userId)
+}
+
+// queuedKey is a sorted set of the purchases no consumer has claimed yet, by queue time.
+func (f FlashSale) queuedKey() string {
+	return fmt.Sprintf("%s_flashsale_queued", f.prefix)
+}
+
+func queuedMember(productId, userId uint32) string {
+	return fmt.Sprintf("%d:%d", productId, userId)
+}
+
+// Seed puts the product on sale. Existing stock is kept, so restarting does not refill it.
+func (f FlashSale) Seed(productId uint32, stock int64) error {
+	return f.cacheClient.SetNX(f.ctx, f.stockKey(productId), stock, 0).Err()
+}
+
+// Stock returns the remaining stock and whether the product is on sale at all.
+func (f FlashSale) Stock(productId uint32) (stock int64, onSale bool, err error) {
+	stock, err = f.cacheClient.Get(f.ctx, f.stockKey(productId)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return stock, true, nil
+}
+
+// Reserve takes one item for the user. reason is empty when the reservation succeeded.
+func (f FlashSale) Reserve(productId, userId uint32) (reason string, err error) {
+	keys := []string{f.stockKey(productId), f.buyersKey(productId), f.statusKey(productId, userId), f.queuedKey()}
+	res, err := reserveScript.Run(f.ctx, f.cacheClient, keys, userId, FlashSaleStatusQueued, int(flashSaleStatusTTL.Seconds()),
+		time.Now().Unix(), queuedMember(productId, userId)).Int()
+	if err != nil {
+		return "", err
+	}
+	switch res {
+	case 1:
+		return "", nil
+	case 0:
+		return FlashSaleRejectSoldOut, nil
+	case -1:
+		return FlashSaleRejectDuplicate, nil
+	default:
+		return FlashSaleRejectNotOnSale, nil
+	}
+}
+
+func (f FlashSale) Release(productId, userId uint32) error {
+	return releaseScript.Run(f.ctx, f.cacheClient, []string{f.stockKey(productId), f.buyersKey(productId)}, userId).Err()
+}
+
+// Claim marks the purchase as processing. It returns false when the purchase must not be
+// processed, because it expired while queued or is already finished.
+func (f FlashSale) Claim(productId, userId uint32) (bool, error) {
+	keys := []string{f.statusKey(productId, userId), f.queuedKey()}
+	res, err := claimScript.Run(f.ctx, f.cacheClient, keys, FlashSaleStatusQueued, FlashSaleStatusProcessing, queuedMember(productId, userId)).Int()
+	return res == 1, err
+}
+
+// ListQueued returns the purchases queued before the given time and not claimed since.
+func (f FlashSale) ListQueued(before time.Time) (queued []QueuedFlashSale, err error) {
+	members, err := f.cacheClient.ZRangeByScore(f.ctx, f.queuedKey(), &redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(before.Unix(), 10),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		var q QueuedFlashSale
+		if _, err := fmt.Sscanf(m, "%d:%d", &q.ProductId, &q.UserId); err != nil {
+			continue
+		}
+		queued = append(queued, q)
+	}
+	return queued, nil
+}
+
+// Expire fails the purchase and releases its item if it is still queued.
+func (f FlashSale) Expire(productId, userId uint32, reason string) (bool, error) {
+	keys := []string{f.statusKey(productId, userId), f.queuedKey(), f.stockKey(productId), f.buyersKey(productId)}
+	res, err := expireScript.Run(f.ctx, f.cacheClient, keys, queuedMember(productId, userId),
+		FlashSaleStatusQueued, FlashSaleStatusFailed, reason, userId).Int()
+	return res == 1, err
+}
+
+// SetOrder records the placed order of the purchase.
+func (f FlashSale) SetOrder(productId, userId uint32, orderId string, amount, weight float32, method string) error {
+	return f.cacheClient.HSet(f.ctx, f.statusKey(productId, userId),
+		"order_id", orderId, "amount", amount, "weight", weight, "shipping_method", method).Err()
+}
+
+// SetCharged records the charge of the purchase.
+func (f FlashSale) SetCharged(productId, userId uint32, transactionId string) error {
+	return f.cacheClient.HSet(f.ctx, f.statusKey(productId, userId), "transaction_id", transactionId).Err()
+}
+
+// Finish sets the final status of the purchase. The recorded order and charge are kept.
+func (f FlashSale) Finish(productId, userId uint32, status, reason string) error {
+	key := f.statusKey(productId, userId)
+	_, err := f.cacheClient.TxPipelined(f.ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(f.ctx, key, "status", status, "reason", reason)
+		pipe.Expire(f.ctx, key, flashSaleStatusTTL)
+		return nil
+	})
+	return err
+}
+
+func (f FlashSale) GetStatus(productId, userId uint32) (status FlashSaleStatus, err error) {
+	err = f.cacheClient.HGetAll(f.ctx, f.statusKey(productId, userId)).Scan(&status)
+	return
+}
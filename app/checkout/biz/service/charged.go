@@ -0,0 +1,57 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+)
+
+// ChargedError is returned when the order could not be confirmed after the shopper was
+// charged. The job must be retried, or the charge refunded before the purchase fails. Paid
+// orders only lack their shipment and are retried until it is queued, never refunded.
+type ChargedError struct {
+	UserId        uint32
+	OrderId       string
+	TransactionId string
+	Amount        float32
+	Paid          bool
+	Err           error
+}
+
+func (e *ChargedError) Error() string {
+	return fmt.Sprintf("order %s charged with transaction %s but not confirmed: %v", e.OrderId, e.TransactionId, e.Err)
+}
+
+func (e *ChargedError) Unwrap() error {
+	return e.Err
+}
+
+// RefundCharge refunds the charge of an order that could not be confirmed. It can be
+// retried, the refund key makes sure the money is returned once.
+func RefundCharge(ctx context.Context, e *ChargedError) error {
+	_, err := rpc.PaymentClient.Refund(ctx, &payment.RefundReq{
+		UserId:        e.UserId,
+		OrderId:       e.OrderId,
+		TransactionId: e.TransactionId,
+		Amount:        e.Amount,
+		Reason:        "order could not be confirmed",
+		RefundKey:     "unconfirmed-" + e.OrderId,
+	})
+	return err
+}
@@ -16,37 +16,60 @@ package service
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"math"
 	"strconv"
 
-	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/mq"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/tax"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/conf"
 	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/rpc"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
 	checkout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
-	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
 	"github.com/cloudwego/kitex/pkg/klog"
-	"github.com/nats-io/nats.go"
-	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/propagation"
-	"google.golang.org/protobuf/proto"
 )
 
 type CheckoutService struct {
-	ctx context.Context
+	ctx        context.Context
+	onStep     func(step string)
+	checkoutId string
+	cardToken  string
 } // NewCheckoutService new CheckoutService
 func NewCheckoutService(ctx context.Context) *CheckoutService {
 	return &CheckoutService{ctx: ctx}
 }
 
+// OnStep sets a callback that is called when the checkout reaches the next step.
+func (s *CheckoutService) OnStep(fn func(step string)) *CheckoutService {
+	s.onStep = fn
+	return s
+}
+
+// Queued makes Run process the queued checkout: it charges the tokenized card and resumes
+// from the progress recorded for the checkout, so a redelivered job neither places a second
+// order nor charges twice.
+func (s *CheckoutService) Queued(checkoutId, cardToken string) *CheckoutService {
+	s.checkoutId = checkoutId
+	s.cardToken = cardToken
+	return s
+}
+
+func (s *CheckoutService) step(step string) {
+	if s.onStep != nil {
+		s.onStep(step)
+	}
+}
+
 /*
 	Run
 
 1. get cart
-2. calculate cart
+2. calculate cart and tax
 3. create order
 4. empty cart
 5. pay
@@ -56,20 +79,115 @@ func NewCheckoutService(ctx context.Context) *CheckoutService {
 func (s *CheckoutService) Run(req *checkout.CheckoutReq) (resp *checkout.CheckoutResp, err error) {
 	// Finish your business logic.
 	// Idempotent
+	if err = requireVerifiedEmail(s.ctx); err != nil {
+		return nil, err
+	}
+	var (
+		store    model.CheckoutJobStore
+		progress model.CheckoutJob
+	)
+	if s.checkoutId != "" {
+		store = model.NewCheckoutJobStore(s.ctx, redis.RedisClient)
+		if progress, _, err = store.Get(s.checkoutId); err != nil {
+			return nil, err
+		}
+	}
+	if progress.OrderId == "" {
+		if progress, err = s.placeOrder(req); err != nil {
+			return nil, err
+		}
+		// recorded before anything else can fail, a redelivered job must not order again
+		if s.checkoutId != "" {
+			if err = store.SetOrder(s.checkoutId, progress.OrderId, progress.Amount, progress.Weight, progress.ShippingMethod); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if !progress.CartEmptied {
+		// empty cart
+		emptyResult, err := rpc.CartClient.EmptyCart(s.ctx, &cart.EmptyCartReq{UserId: req.UserId})
+		if err != nil {
+			return nil, fmt.Errorf("EmptyCart.err:%w", err)
+		}
+		klog.Info(emptyResult)
+		if s.checkoutId != "" {
+			if err = store.SetCartEmptied(s.checkoutId); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// charge
+	orderId := progress.OrderId
+	payReq := &payment.ChargeReq{
+		UserId:    req.UserId,
+		OrderId:   orderId,
+		Amount:    progress.Amount,
+		CardToken: s.cardToken,
+	}
+	if req.CreditCard != nil {
+		payReq.CreditCard = &payment.CreditCardInfo{
+			CreditCardNumber:          req.CreditCard.CreditCardNumber,
+			CreditCardExpirationYear:  req.CreditCard.CreditCardExpirationYear,
+			CreditCardExpirationMonth: req.CreditCard.CreditCardExpirationMonth,
+			CreditCardCvv:             req.CreditCard.CreditCardCvv,
+		}
+	}
+	s.step(model.CheckoutStepPayment)
+	paymentResult, err := rpc.PaymentClient.Charge(s.ctx, payReq)
+	if err != nil {
+		err = fmt.Errorf("Charge.err:%w", err)
+		return
+	}
+	klog.Info(paymentResult)
+	charged := &ChargedError{UserId: req.UserId, OrderId: orderId, TransactionId: paymentResult.TransactionId, Amount: progress.Amount}
+	if s.checkoutId != "" {
+		if err = store.SetCharged(s.checkoutId, paymentResult.TransactionId); err != nil {
+			charged.Err = err
+			return nil, charged
+		}
+	}
+	// change order state
+	s.step(model.CheckoutStepConfirm)
+	// the order service queues the confirmation email in the same transaction
+	_, err = rpc.OrderClient.MarkOrderPaid(s.ctx, &order.MarkOrderPaidReq{UserId: req.UserId, OrderId: orderId, TransactionId: paymentResult.TransactionId})
+	if err != nil {
+		klog.Error(err)
+		charged.Err = fmt.Errorf("MarkOrderPaid.err:%w", err)
+		return nil, charged
+	}
+	if err = queueShipment(s.ctx, req.UserId, orderId, req.Address, progress.Weight, progress.ShippingMethod); err != nil {
+		charged.Paid = true
+		charged.Err = err
+		return nil, charged
+	}
+
+	resp = &checkout.CheckoutResp{
+		OrderId:       orderId,
+		TransactionId: paymentResult.TransactionId,
+	}
+	return
+}
+
+// placeOrder places the order of the cart and returns what the order is charged and shipped
+// with. The cart is emptied by Run once the order is recorded.
+func (s *CheckoutService) placeOrder(req *checkout.CheckoutReq) (progress model.CheckoutJob, err error) {
 	// get cart
+	s.step(model.CheckoutStepCart)
 	cartResult, err := rpc.CartClient.GetCart(s.ctx, &cart.GetCartReq{UserId: req.UserId})
 	if err != nil {
 		klog.Error(err)
-		err = fmt.Errorf("GetCart.err:%v", err)
+		err = fmt.Errorf("GetCart.err:%w", err)
 		return
 	}
 	if cartResult == nil || cartResult.Cart == nil || len(cartResult.Cart.Items) == 0 {
-		err = errors.New("cart is empty")
+		err = kerrors.NewBizStatusError(40000, "cart is empty")
 		return
 	}
 	var (
-		oi    []*order.OrderItem
-		total float32
+		oi       []*order.OrderItem
+		total    float32
+		weight   float32
+		taxItems []tax.Item
 	)
 	for _, cartItem := range cartResult.Cart.Items {
 		productResp, resultErr := rpc.ProductClient.GetProduct(s.ctx, &product.GetProductReq{Id: cartItem.ProductId})
@@ -83,18 +201,35 @@ func (s *CheckoutService) Run(req *checkout.CheckoutReq) (resp *checkout.Checkou
 		}
 		p := productResp.Product
 		cost := p.Price * float32(cartItem.Quantity)
-		total += cost
+		weight += p.Weight * float32(cartItem.Quantity)
+		taxItems = append(taxItems, tax.Item{Category: p.TaxCategory, Amount: cost})
 		oi = append(oi, &order.OrderItem{
-			Item: &cart.CartItem{ProductId: cartItem.ProductId, Quantity: cartItem.Quantity},
-			Cost: cost,
+			Item:        &cart.CartItem{ProductId: cartItem.ProductId, Quantity: cartItem.Quantity},
+			Cost:        cost,
+			ProductName: p.Name,
 		})
 	}
+	// tax
+	taxResult := calculateTax(req.Address, taxItems)
+	total = taxResult.Total
+	// shipping
+	quote, err := quoteShipping(s.ctx, req.Address, weight, req.ShippingMethod)
+	if err != nil {
+		return
+	}
+	// the amount is charged and stored in cents
+	total = roundCents(total + quote.Cost)
 	// create order
 	orderReq := &order.PlaceOrderReq{
-		UserId:       req.UserId,
-		UserCurrency: "USD",
-		OrderItems:   oi,
-		Email:        req.Email,
+		UserId:           req.UserId,
+		UserCurrency:     "USD",
+		OrderItems:       oi,
+		Email:            req.Email,
+		ShippingMethod:   quote.Method,
+		ShippingCost:     quote.Cost,
+		TaxLines:         toOrderTaxLines(taxResult.Lines),
+		Tax:              taxResult.Tax,
+		PricesIncludeTax: conf.GetConf().Tax.PricesIncludeTax,
 	}
 	if req.Address != nil {
 		addr := req.Address
@@ -107,66 +242,21 @@ func (s *CheckoutService) Run(req *checkout.CheckoutReq) (resp *checkout.Checkou
 			ZipCode:       int32(zipCodeInt),
 		}
 	}
+	s.step(model.CheckoutStepOrder)
 	orderResult, err := rpc.OrderClient.PlaceOrder(s.ctx, orderReq)
 	if err != nil {
-		err = fmt.Errorf("PlaceOrder.err:%v", err)
+		err = fmt.Errorf("PlaceOrder.err:%w", err)
 		return
 	}
 	klog.Info("orderResult", orderResult)
-	// empty cart
-	emptyResult, err := rpc.CartClient.EmptyCart(s.ctx, &cart.EmptyCartReq{UserId: req.UserId})
-	if err != nil {
-		err = fmt.Errorf("EmptyCart.err:%v", err)
-		return
-	}
-	klog.Info(emptyResult)
-	// charge
-	var orderId string
-	if orderResult != nil || orderResult.Order != nil {
-		orderId = orderResult.Order.OrderId
-	}
-	payReq := &payment.ChargeReq{
-		UserId:  req.UserId,
-		OrderId: orderId,
-		Amount:  total,
-		CreditCard: &payment.CreditCardInfo{
-			CreditCardNumber:          req.CreditCard.CreditCardNumber,
-			CreditCardExpirationYear:  req.CreditCard.CreditCardExpirationYear,
-			CreditCardExpirationMonth: req.CreditCard.CreditCardExpirationMonth,
-			CreditCardCvv:             req.CreditCard.CreditCardCvv,
-		},
-	}
-	paymentResult, err := rpc.PaymentClient.Charge(s.ctx, payReq)
-	if err != nil {
-		err = fmt.Errorf("Charge.err:%v", err)
-		return
-	}
-	data, _ := proto.Marshal(&email.EmailReq{
-		From:        "from@example.com",
-		To:          req.Email,
-		ContentType: "text/plain",
-		Subject:     "You just created an order in CloudWeGo shop",
-		Content:     "You just created an order in CloudWeGo shop",
-	})
-	msg := &nats.Msg{Subject: "email", Data: data, Header: make(nats.Header)}
-
-	// otel inject
-	otel.GetTextMapPropagator().Inject(s.ctx, propagation.HeaderCarrier(msg.Header))
-
-	_ = mq.Nc.PublishMsg(msg)
-
-	klog.Info(paymentResult)
-	// change order state
-	klog.Info(orderResult)
-	_, err = rpc.OrderClient.MarkOrderPaid(s.ctx, &order.MarkOrderPaidReq{UserId: req.UserId, OrderId: orderId})
-	if err != nil {
-		klog.Error(err)
-		return
-	}
+	return model.CheckoutJob{
+		OrderId:        orderResult.Order.OrderId,
+		Amount:         total,
+		Weight:         weight,
+		ShippingMethod: quote.Method,
+	}, nil
+}
 
-	resp = &checkout.CheckoutResp{
-		OrderId:       orderId,
-		TransactionId: paymentResult.TransactionId,
-	}
-	return
+func roundCents(v float32) float32 {
+	return float32(math.Round(float64(v)*100) / 100)
 }
@@ -0,0 +1,97 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/metric"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/mq"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/rpc"
+	checkout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/protobuf/proto"
+)
+
+// FlashSaleSubject is the work queue subject winning flash sale purchases are queued on.
+const FlashSaleSubject = "flashsale.job"
+
+type FlashSaleCheckoutService struct {
+	ctx context.Context
+} // NewFlashSaleCheckoutService new FlashSaleCheckoutService
+func NewFlashSaleCheckoutService(ctx context.Context) *FlashSaleCheckoutService {
+	return &FlashSaleCheckoutService{ctx: ctx}
+}
+
+// Run reserves one item in redis and queues the order creation. The item counts as sold
+// once the order is paid, see the flash sale consumer.
+func (s *FlashSaleCheckoutService) Run(req *checkout.FlashSaleCheckoutReq) (resp *checkout.FlashSaleCheckoutResp, err error) {
+	if req.UserId == 0 || req.ProductId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id and product id are required")
+	}
+	if req.CreditCard == nil {
+		return nil, kerrors.NewBizStatusError(40000, "credit card is required")
+	}
+	if err = requireVerifiedEmail(s.ctx); err != nil {
+		return nil, err
+	}
+	productLabel := strconv.Itoa(int(req.ProductId))
+	flashSale := model.NewFlashSale(s.ctx, redis.RedisClient)
+	reason, err := flashSale.Reserve(req.ProductId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		metric.FlashSaleRejected.WithLabelValues(productLabel, reason).Inc()
+		return &checkout.FlashSaleCheckoutResp{Accepted: false, Reason: reason}, nil
+	}
+
+	// the card stays with the payment service, the job only carries a token for it
+	token, err := rpc.PaymentClient.TokenizeCard(s.ctx, &payment.TokenizeCardReq{UserId: req.UserId, CreditCard: req.CreditCard})
+	if err != nil {
+		metric.FlashSaleRejected.WithLabelValues(productLabel, "card_error").Inc()
+		s.release(flashSale, req)
+		return nil, err
+	}
+	jobReq := proto.Clone(req).(*checkout.FlashSaleCheckoutReq)
+	jobReq.CreditCard = nil
+	data, err := proto.Marshal(&checkout.FlashSaleJob{Req: jobReq, CardToken: token.CardToken})
+	if err == nil {
+		msg := &nats.Msg{Subject: FlashSaleSubject, Data: data, Header: make(nats.Header)}
+		otel.GetTextMapPropagator().Inject(s.ctx, propagation.HeaderCarrier(msg.Header))
+		_, err = mq.JS.PublishMsg(s.ctx, msg)
+	}
+	if err != nil {
+		metric.FlashSaleRejected.WithLabelValues(productLabel, "queue_error").Inc()
+		s.release(flashSale, req)
+		return nil, err
+	}
+	return &checkout.FlashSaleCheckoutResp{Accepted: true}, nil
+}
+
+// release gives the item back when the purchase could not be queued.
+func (s *FlashSaleCheckoutService) release(flashSale model.FlashSale, req *checkout.FlashSaleCheckoutReq) {
+	if _, err := flashSale.Expire(req.ProductId, req.UserId, "the purchase could not be queued"); err != nil {
+		klog.CtxErrorf(s.ctx, "release flash sale stock: %v", err)
+	}
+}
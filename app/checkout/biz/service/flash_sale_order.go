@@ -0,0 +1,146 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/tax"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
+	checkout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type FlashSaleOrderService struct {
+	ctx context.Context
+} // NewFlashSaleOrderService new FlashSaleOrderService
+func NewFlashSaleOrderService(ctx context.Context) *FlashSaleOrderService {
+	return &FlashSaleOrderService{ctx: ctx}
+}
+
+// Run creates, pays and confirms the order of a queued flash sale purchase. It resumes from
+// the progress recorded in the purchase status, so a redelivered job neither places a
+// second order nor charges twice. The cart is left untouched.
+func (s *FlashSaleOrderService) Run(job *checkout.FlashSaleJob) (resp *checkout.CheckoutResp, err error) {
+	req := job.Req
+	flashSale := model.NewFlashSale(s.ctx, redis.RedisClient)
+	progress, err := flashSale.GetStatus(req.ProductId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if progress.OrderId == "" {
+		if progress, err = s.placeOrder(req); err != nil {
+			return nil, err
+		}
+		if err = flashSale.SetOrder(req.ProductId, req.UserId, progress.OrderId, progress.Amount, progress.Weight, progress.ShippingMethod); err != nil {
+			return nil, err
+		}
+	}
+	orderId := progress.OrderId
+	paymentResult, err := rpc.PaymentClient.Charge(s.ctx, &payment.ChargeReq{
+		UserId:    req.UserId,
+		OrderId:   orderId,
+		Amount:    progress.Amount,
+		CardToken: job.CardToken,
+	})
+	if err != nil {
+		err = fmt.Errorf("Charge.err:%w", err)
+		return
+	}
+	charged := &ChargedError{UserId: req.UserId, OrderId: orderId, TransactionId: paymentResult.TransactionId, Amount: progress.Amount}
+	if err = flashSale.SetCharged(req.ProductId, req.UserId, paymentResult.TransactionId); err != nil {
+		charged.Err = err
+		return nil, charged
+	}
+	_, err = rpc.OrderClient.MarkOrderPaid(s.ctx, &order.MarkOrderPaidReq{UserId: req.UserId, OrderId: orderId, TransactionId: paymentResult.TransactionId})
+	if err != nil {
+		charged.Err = fmt.Errorf("MarkOrderPaid.err:%w", err)
+		return nil, charged
+	}
+	if err = queueShipment(s.ctx, req.UserId, orderId, req.Address, progress.Weight, progress.ShippingMethod); err != nil {
+		charged.Paid = true
+		charged.Err = err
+		return nil, charged
+	}
+
+	return &checkout.CheckoutResp{
+		OrderId:       orderId,
+		TransactionId: paymentResult.TransactionId,
+	}, nil
+}
+
+// placeOrder places the order of the purchase and returns what it is charged and shipped with.
+func (s *FlashSaleOrderService) placeOrder(req *checkout.FlashSaleCheckoutReq) (progress model.FlashSaleStatus, err error) {
+	productResp, err := rpc.ProductClient.GetProduct(s.ctx, &product.GetProductReq{Id: req.ProductId})
+	if err != nil {
+		err = fmt.Errorf("GetProduct.err:%w", err)
+		return
+	}
+	if productResp.Product == nil {
+		err = kerrors.NewBizStatusError(40004, "product not exist")
+		return
+	}
+	price := productResp.Product.Price
+	weight := productResp.Product.Weight
+	taxResult := calculateTax(req.Address, []tax.Item{{Category: productResp.Product.TaxCategory, Amount: price}})
+	quote, err := quoteShipping(s.ctx, req.Address, weight, req.ShippingMethod)
+	if err != nil {
+		return
+	}
+	orderReq := &order.PlaceOrderReq{
+		UserId:       req.UserId,
+		UserCurrency: "USD",
+		OrderItems: []*order.OrderItem{
+			{Item: &cart.CartItem{ProductId: req.ProductId, Quantity: 1}, Cost: price, ProductName: productResp.Product.Name},
+		},
+		Email:            req.Email,
+		ShippingMethod:   quote.Method,
+		ShippingCost:     quote.Cost,
+		TaxLines:         toOrderTaxLines(taxResult.Lines),
+		Tax:              taxResult.Tax,
+		PricesIncludeTax: conf.GetConf().Tax.PricesIncludeTax,
+	}
+	if req.Address != nil {
+		addr := req.Address
+		zipCodeInt, _ := strconv.Atoi(addr.ZipCode)
+		orderReq.Address = &order.Address{
+			StreetAddress: addr.StreetAddress,
+			City:          addr.City,
+			Country:       addr.Country,
+			State:         addr.State,
+			ZipCode:       int32(zipCodeInt),
+		}
+	}
+	orderResult, err := rpc.OrderClient.PlaceOrder(s.ctx, orderReq)
+	if err != nil {
+		err = fmt.Errorf("PlaceOrder.err:%w", err)
+		return
+	}
+	return model.FlashSaleStatus{
+		OrderId:        orderResult.Order.OrderId,
+		Amount:         roundCents(taxResult.Total + quote.Cost),
+		Weight:         weight,
+		ShippingMethod: quote.Method,
+	}, nil
+}
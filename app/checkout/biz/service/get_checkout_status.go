@@ -0,0 +1,52 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/model"
+	checkout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type GetCheckoutStatusService struct {
+	ctx context.Context
+} // NewGetCheckoutStatusService new GetCheckoutStatusService
+func NewGetCheckoutStatusService(ctx context.Context) *GetCheckoutStatusService {
+	return &GetCheckoutStatusService{ctx: ctx}
+}
+
+// Run returns the progress of a checkout submitted by the same user.
+func (s *GetCheckoutStatusService) Run(req *checkout.GetCheckoutStatusReq) (resp *checkout.GetCheckoutStatusResp, err error) {
+	if req.CheckoutId == "" {
+		return nil, kerrors.NewBizStatusError(40000, "checkout id is required")
+	}
+	job, ok, err := model.NewCheckoutJobStore(s.ctx, redis.RedisClient).Get(req.CheckoutId)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || job.UserId != req.UserId {
+		return nil, kerrors.NewBizStatusError(40004, "checkout not found")
+	}
+	return &checkout.GetCheckoutStatusResp{
+		Status:        job.Status,
+		Step:          job.Step,
+		OrderId:       job.OrderId,
+		TransactionId: job.TransactionId,
+		Reason:        job.Reason,
+	}, nil
+}
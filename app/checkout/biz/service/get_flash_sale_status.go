@@ -0,0 +1,56 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/model"
+	checkout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type GetFlashSaleStatusService struct {
+	ctx context.Context
+} // NewGetFlashSaleStatusService new GetFlashSaleStatusService
+func NewGetFlashSaleStatusService(ctx context.Context) *GetFlashSaleStatusService {
+	return &GetFlashSaleStatusService{ctx: ctx}
+}
+
+// Run returns the remaining stock, and the purchase status when user_id is set.
+func (s *GetFlashSaleStatusService) Run(req *checkout.GetFlashSaleStatusReq) (resp *checkout.GetFlashSaleStatusResp, err error) {
+	if req.ProductId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "product id is required")
+	}
+	flashSale := model.NewFlashSale(s.ctx, redis.RedisClient)
+	stock, onSale, err := flashSale.Stock(req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	resp = &checkout.GetFlashSaleStatusResp{OnSale: onSale, Stock: stock}
+	if req.UserId == 0 {
+		return resp, nil
+	}
+	status, err := flashSale.GetStatus(req.ProductId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	resp.Status = status.Status
+	resp.OrderId = status.OrderId
+	resp.TransactionId = status.TransactionId
+	resp.Reason = status.Reason
+	return resp, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/tax"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
+	checkout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+)
+
+type QuoteService struct {
+	ctx context.Context
+} // NewQuoteService new QuoteService
+func NewQuoteService(ctx context.Context) *QuoteService {
+	return &QuoteService{ctx: ctx}
+}
+
+// Run prices the cart, or a single flash sale product, for the address without placing an order.
+// Shipping is only quoted once the address has a country.
+func (s *QuoteService) Run(req *checkout.QuoteReq) (resp *checkout.QuoteResp, err error) {
+	items := []*cart.CartItem{{ProductId: req.ProductId, Quantity: 1}}
+	if req.ProductId == 0 {
+		cartResult, err := rpc.CartClient.GetCart(s.ctx, &cart.GetCartReq{UserId: req.UserId})
+		if err != nil {
+			return nil, fmt.Errorf("GetCart.err:%v", err)
+		}
+		items = cartResult.Cart.Items
+	}
+	var (
+		subtotal float32
+		weight   float32
+		taxItems []tax.Item
+	)
+	for _, item := range items {
+		productResp, err := rpc.ProductClient.GetProduct(s.ctx, &product.GetProductReq{Id: item.ProductId})
+		if err != nil {
+			return nil, fmt.Errorf("GetProduct.err:%v", err)
+		}
+		if productResp.Product == nil {
+			continue
+		}
+		p := productResp.Product
+		cost := p.Price * float32(item.Quantity)
+		subtotal += cost
+		weight += p.Weight * float32(item.Quantity)
+		taxItems = append(taxItems, tax.Item{Category: p.TaxCategory, Amount: cost})
+	}
+	taxResult := calculateTax(req.Address, taxItems)
+	resp = &checkout.QuoteResp{
+		Subtotal:         subtotal,
+		Tax:              taxResult.Tax,
+		PricesIncludeTax: conf.GetConf().Tax.PricesIncludeTax,
+		Total:            taxResult.Total,
+	}
+	for _, l := range taxResult.Lines {
+		resp.TaxLines = append(resp.TaxLines, &checkout.TaxLine{Name: l.Name, Rate: l.Rate, Amount: l.Amount})
+	}
+	if req.Address != nil && req.Address.Country != "" {
+		quote, err := quoteShipping(s.ctx, req.Address, weight, req.ShippingMethod)
+		if err != nil {
+			return nil, err
+		}
+		resp.ShippingMethod = quote.Method
+		resp.ShippingCost = quote.Cost
+		resp.Total += quote.Cost
+	}
+	return
+}
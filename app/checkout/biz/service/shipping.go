@@ -0,0 +1,82 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/mq"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/rpc"
+	checkout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/protobuf/proto"
+)
+
+// quoteShipping returns the quote of the selected method, or the cheapest one when none is selected.
+func quoteShipping(ctx context.Context, address *checkout.Address, weight float32, method string) (*shipping.Quote, error) {
+	if address == nil {
+		return nil, errors.New("address is required for shipping")
+	}
+	resp, err := rpc.ShippingClient.GetQuote(ctx, &shipping.GetQuoteReq{Address: toShippingAddress(address), Weight: weight})
+	if err != nil {
+		return nil, fmt.Errorf("GetQuote.err:%v", err)
+	}
+	for _, q := range resp.Quotes {
+		if method == "" || q.Method == method {
+			return q, nil
+		}
+	}
+	return nil, fmt.Errorf("shipping method %q is not available for this address", method)
+}
+
+// ShipmentSubject is the work queue subject paid orders are handed over to shipping on.
+const ShipmentSubject = "shipment.job"
+
+// queueShipment hands a paid order over to shipping. The shipment is created by the shipment
+// consumer, which retries until shipping accepts it; CreateShipment is idempotent per order.
+func queueShipment(ctx context.Context, userId uint32, orderId string, address *checkout.Address, weight float32, method string) error {
+	data, err := proto.Marshal(&shipping.CreateShipmentReq{
+		UserId:  userId,
+		OrderId: orderId,
+		Method:  method,
+		Address: toShippingAddress(address),
+		Weight:  weight,
+	})
+	if err != nil {
+		return err
+	}
+	msg := &nats.Msg{Subject: ShipmentSubject, Data: data, Header: make(nats.Header)}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(msg.Header))
+	if _, err = mq.JS.PublishMsg(ctx, msg, jetstream.WithMsgID("shipment-"+orderId)); err != nil {
+		return fmt.Errorf("queue shipment: %w", err)
+	}
+	return nil
+}
+
+func toShippingAddress(addr *checkout.Address) *shipping.Address {
+	return &shipping.Address{
+		StreetAddress: addr.StreetAddress,
+		City:          addr.City,
+		State:         addr.State,
+		Country:       addr.Country,
+		ZipCode:       addr.ZipCode,
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/mq"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/rpc"
+	checkout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/protobuf/proto"
+)
+
+// CheckoutSubject is the work queue subject checkout jobs are queued on.
+const CheckoutSubject = "checkout.job"
+
+type SubmitCheckoutService struct {
+	ctx context.Context
+} // NewSubmitCheckoutService new SubmitCheckoutService
+func NewSubmitCheckoutService(ctx context.Context) *SubmitCheckoutService {
+	return &SubmitCheckoutService{ctx: ctx}
+}
+
+// Run queues the checkout and returns its id right away. Progress can be polled with GetCheckoutStatus.
+func (s *SubmitCheckoutService) Run(req *checkout.CheckoutReq) (resp *checkout.SubmitCheckoutResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	if req.CreditCard == nil {
+		return nil, kerrors.NewBizStatusError(40000, "credit card is required")
+	}
+	if err = requireVerifiedEmail(s.ctx); err != nil {
+		return nil, err
+	}
+	// the card stays with the payment service, the job only carries a token for it
+	token, err := rpc.PaymentClient.TokenizeCard(s.ctx, &payment.TokenizeCardReq{UserId: req.UserId, CreditCard: req.CreditCard})
+	if err != nil {
+		return nil, err
+	}
+	jobReq := proto.Clone(req).(*checkout.CheckoutReq)
+	jobReq.CreditCard = nil
+	checkoutId := uuid.NewString()
+	data, err := proto.Marshal(&checkout.CheckoutJob{CheckoutId: checkoutId, Req: jobReq, CardToken: token.CardToken})
+	if err != nil {
+		return nil, err
+	}
+	store := model.NewCheckoutJobStore(s.ctx, redis.RedisClient)
+	if err = store.Create(checkoutId, req.UserId); err != nil {
+		return nil, err
+	}
+	msg := &nats.Msg{Subject: CheckoutSubject, Data: data, Header: make(nats.Header)}
+	otel.GetTextMapPropagator().Inject(s.ctx, propagation.HeaderCarrier(msg.Header))
+	if _, err = mq.JS.PublishMsg(s.ctx, msg); err != nil {
+		if _, expireErr := store.Expire(checkoutId, "the checkout could not be queued"); expireErr != nil {
+			klog.CtxErrorf(s.ctx, "expire checkout %s: %v", checkoutId, expireErr)
+		}
+		return nil, err
+	}
+	return &checkout.SubmitCheckoutResp{CheckoutId: checkoutId}, nil
+}
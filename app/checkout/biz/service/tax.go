@@ -0,0 +1,39 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/tax"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/conf"
+	checkout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+)
+
+// calculateTax computes the tax of the items for the address. Shipping is not taxed.
+func calculateTax(address *checkout.Address, items []tax.Item) tax.Result {
+	var country, state string
+	if address != nil {
+		country, state = address.Country, address.State
+	}
+	return tax.Calculate(conf.GetConf().Tax, country, state, items)
+}
+
+func toOrderTaxLines(lines []tax.Line) []*order.TaxLine {
+	var res []*order.TaxLine
+	for _, l := range lines {
+		res = append(res, &order.TaxLine{Name: l.Name, Rate: l.Rate, Amount: l.Amount})
+	}
+	return res
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/conf"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+var errEmailNotVerified = kerrors.NewBizStatusError(40300, "verify your email address before checking out")
+
+// requireVerifiedEmail refuses users whose token says their email is not verified, when
+// configured. Calls without a user token, like the checkout consumer's, are not checked.
+func requireVerifiedEmail(ctx context.Context) error {
+	if !conf.GetConf().Checkout.RequireVerifiedEmail {
+		return nil
+	}
+	p, ok := authn.FromContext(ctx)
+	if !ok || p.Claims == nil || p.Claims.EmailVerified {
+		return nil
+	}
+	return errEmailNotVerified
+}
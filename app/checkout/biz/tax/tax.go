@@ -0,0 +1,123 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tax
+
+import (
+	"math"
+	"strings"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/conf"
+)
+
+const StandardCategory = "standard"
+
+type Item struct {
+	Category string
+	Amount   float32
+}
+
+type Line struct {
+	Name   string
+	Rate   float32
+	Amount float32
+}
+
+type Result struct {
+	Lines []Line
+	Tax   float32
+	// Total is the cost of the items including tax
+	Total float32
+}
+
+// Rules returns the rules that apply to the address, in configuration order.
+func Rules(rules []conf.TaxRule, country, state string) (matched []conf.TaxRule) {
+	country = strings.ToLower(strings.TrimSpace(country))
+	state = strings.ToLower(strings.TrimSpace(state))
+	for _, r := range rules {
+		if strings.ToLower(r.Country) != country {
+			continue
+		}
+		if r.State != "" && strings.ToLower(r.State) != state {
+			continue
+		}
+		matched = append(matched, r)
+	}
+	return
+}
+
+// Rate returns the rate of the category, falling back to the standard rate.
+func Rate(r conf.TaxRule, category string) float32 {
+	if category == "" {
+		category = StandardCategory
+	}
+	if rate, ok := r.Rates[category]; ok {
+		return rate
+	}
+	return r.Rates[StandardCategory]
+}
+
+// Calculate computes the tax of the items shipped to the address. Items taxed by the same
+// rule at the same rate share one line. With inclusive pricing the tax is taken out of the
+// item amounts, otherwise it is added on top.
+func Calculate(c conf.Tax, country, state string, items []Item) (res Result) {
+	rules := Rules(c.Rules, country, state)
+	type lineKey struct {
+		rule int
+		rate float32
+	}
+	var (
+		keys     []lineKey
+		amounts  = make(map[lineKey]float64)
+		subtotal float64
+	)
+	for _, item := range items {
+		subtotal += float64(item.Amount)
+		base := float64(item.Amount)
+		if c.PricesIncludeTax {
+			var combined float64
+			for _, r := range rules {
+				combined += float64(Rate(r, item.Category))
+			}
+			base /= 1 + combined
+		}
+		for i, r := range rules {
+			rate := Rate(r, item.Category)
+			if rate == 0 {
+				continue
+			}
+			k := lineKey{rule: i, rate: rate}
+			if _, ok := amounts[k]; !ok {
+				keys = append(keys, k)
+			}
+			amounts[k] += base * float64(rate)
+		}
+	}
+	var tax float64
+	for _, k := range keys {
+		amount := round(amounts[k])
+		tax += amount
+		res.Lines = append(res.Lines, Line{Name: rules[k.rule].Name, Rate: k.rate, Amount: float32(amount)})
+	}
+	res.Tax = float32(round(tax))
+	res.Total = float32(round(subtotal))
+	if !c.PricesIncludeTax {
+		res.Total = float32(round(subtotal + tax))
+	}
+	return
+}
+
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tax
+
+import (
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/conf"
+)
+
+var testRules = []conf.TaxRule{
+	{Name: "GST", Country: "canada", Rates: map[string]float32{"standard": 0.05}},
+	{Name: "PST", Country: "canada", State: "bc", Rates: map[string]float32{"standard": 0.07, "food": 0}},
+	{Name: "Sales tax", Country: "usa", State: "ny", Rates: map[string]float32{"standard": 0.04, "clothing": 0}},
+}
+
+func TestRules(t *testing.T) {
+	if got := Rules(testRules, " Canada ", "BC"); len(got) != 2 {
+		t.Errorf("got %d rules for canada/bc, want 2", len(got))
+	}
+	if got := Rules(testRules, "canada", "on"); len(got) != 1 || got[0].Name != "GST" {
+		t.Errorf("got %v for canada/on, want only GST", got)
+	}
+	if got := Rules(testRules, "usa", "ca"); len(got) != 0 {
+		t.Errorf("got %v for usa/ca, want none", got)
+	}
+}
+
+func TestRate(t *testing.T) {
+	r := testRules[2]
+	for _, c := range []struct {
+		category string
+		want     float32
+	}{
+		{"", 0.04},
+		{"clothing", 0},
+		{"books", 0.04},
+	} {
+		if got := Rate(r, c.category); got != c.want {
+			t.Errorf("Rate(%q) = %v, want %v", c.category, got, c.want)
+		}
+	}
+}
+
+func TestCalculateExclusive(t *testing.T) {
+	res := Calculate(conf.Tax{Rules: testRules}, "canada", "bc", []Item{
+		{Category: "standard", Amount: 100},
+		{Category: "food", Amount: 20},
+	})
+	if len(res.Lines) != 2 {
+		t.Fatalf("got %d lines, want 2", len(res.Lines))
+	}
+	if res.Lines[0].Name != "GST" || res.Lines[0].Amount != 6 {
+		t.Errorf("got GST line %+v, want 6", res.Lines[0])
+	}
+	if res.Lines[1].Name != "PST" || res.Lines[1].Amount != 7 {
+		t.Errorf("got PST line %+v, want 7", res.Lines[1])
+	}
+	if res.Tax != 13 || res.Total != 133 {
+		t.Errorf("got tax %v total %v, want 13 and 133", res.Tax, res.Total)
+	}
+}
+
+func TestCalculateInclusive(t *testing.T) {
+	res := Calculate(conf.Tax{PricesIncludeTax: true, Rules: testRules}, "canada", "bc", []Item{
+		{Amount: 112},
+	})
+	if res.Tax != 12 || res.Total != 112 {
+		t.Errorf("got tax %v total %v, want 12 and 112", res.Tax, res.Total)
+	}
+}
+
+func TestCalculateExempt(t *testing.T) {
+	res := Calculate(conf.Tax{Rules: testRules}, "usa", "ny", []Item{
+		{Category: "clothing", Amount: 50},
+	})
+	if len(res.Lines) != 0 || res.Tax != 0 || res.Total != 50 {
+		t.Errorf("got %+v, want no tax", res)
+	}
+}
@@ -31,11 +31,31 @@ var (
 )
 
 type Config struct {
-	Env      string
-	Kitex    Kitex    `yaml:"kitex"`
-	MySQL    MySQL    `yaml:"mysql"`
-	Redis    Redis    `yaml:"redis"`
-	Registry Registry `yaml:"registry"`
+	Env       string
+	Kitex     Kitex     `yaml:"kitex"`
+	MySQL     MySQL     `yaml:"mysql"`
+	Redis     Redis     `yaml:"redis"`
+	Registry  Registry  `yaml:"registry"`
+	FlashSale FlashSale `yaml:"flash_sale"`
+	Tax       Tax       `yaml:"tax"`
+	Checkout  Checkout  `yaml:"checkout"`
+	Queue     Queue     `yaml:"queue"`
+}
+
+// Queue is the JetStream work queue of the asynchronous checkouts. A job is tried up to
+// MaxDeliver times with backoff, and fails if no consumer picked it up within TimeoutMinutes.
+type Queue struct {
+	Stream             string   `yaml:"stream"`
+	Subjects           []string `yaml:"subjects"`
+	MaxDeliver         int      `yaml:"max_deliver"`
+	BackoffBaseSeconds int      `yaml:"backoff_base_seconds"`
+	BackoffMaxSeconds  int      `yaml:"backoff_max_seconds"`
+	TimeoutMinutes     int      `yaml:"timeout_minutes"`
+}
+
+// Checkout with RequireVerifiedEmail refuses users whose email is not verified.
+type Checkout struct {
+	RequireVerifiedEmail bool `yaml:"require_verified_email"`
 }
 
 type MySQL struct {
@@ -49,6 +69,29 @@ type Redis struct {
 	DB       int    `yaml:"db"`
 }
 
+type FlashSale struct {
+	Products []FlashSaleProduct `yaml:"products"`
+}
+
+type FlashSaleProduct struct {
+	ProductId uint32 `yaml:"product_id"`
+	Stock     int64  `yaml:"stock"`
+}
+
+type Tax struct {
+	PricesIncludeTax bool      `yaml:"prices_include_tax"`
+	Rules            []TaxRule `yaml:"rules"`
+}
+
+// TaxRule applies to addresses in Country, and only in State when it is set.
+// Rates maps a product tax category to its rate, "standard" is used for unknown categories.
+type TaxRule struct {
+	Name    string             `yaml:"name"`
+	Country string             `yaml:"country"`
+	State   string             `yaml:"state"`
+	Rates   map[string]float32 `yaml:"rates"`
+}
+
 type Kitex struct {
 	Service         string `yaml:"service"`
 	Address         string `yaml:"address"`
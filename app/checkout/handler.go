@@ -37,3 +37,38 @@ func (s *CheckoutServiceImpl) Checkout(ctx context.Context, req *checkout.Checko
 
 	return resp, err
 }
+
+// FlashSaleCheckout implements the CheckoutServiceImpl interface.
+func (s *CheckoutServiceImpl) FlashSaleCheckout(ctx context.Context, req *checkout.FlashSaleCheckoutReq) (resp *checkout.FlashSaleCheckoutResp, err error) {
+	resp, err = service.NewFlashSaleCheckoutService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetFlashSaleStatus implements the CheckoutServiceImpl interface.
+func (s *CheckoutServiceImpl) GetFlashSaleStatus(ctx context.Context, req *checkout.GetFlashSaleStatusReq) (resp *checkout.GetFlashSaleStatusResp, err error) {
+	resp, err = service.NewGetFlashSaleStatusService(ctx).Run(req)
+
+	return resp, err
+}
+
+// SubmitCheckout implements the CheckoutServiceImpl interface.
+func (s *CheckoutServiceImpl) SubmitCheckout(ctx context.Context, req *checkout.CheckoutReq) (resp *checkout.SubmitCheckoutResp, err error) {
+	resp, err = service.NewSubmitCheckoutService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetCheckoutStatus implements the CheckoutServiceImpl interface.
+func (s *CheckoutServiceImpl) GetCheckoutStatus(ctx context.Context, req *checkout.GetCheckoutStatusReq) (resp *checkout.GetCheckoutStatusResp, err error) {
+	resp, err = service.NewGetCheckoutStatusService(ctx).Run(req)
+
+	return resp, err
+}
+
+// Quote implements the CheckoutServiceImpl interface.
+func (s *CheckoutServiceImpl) Quote(ctx context.Context, req *checkout.QuoteReq) (resp *checkout.QuoteResp, err error) {
+	resp, err = service.NewQuoteService(ctx).Run(req)
+
+	return resp, err
+}
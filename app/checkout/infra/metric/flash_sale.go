@@ -0,0 +1,36 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"github.com/cloudwego/biz-demo/gomall/common/mtl"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	FlashSaleSold = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "checkout_flash_sale_sold_total",
+		Help: "Flash sale items reserved by shoppers.",
+	}, []string{"product_id"})
+
+	FlashSaleRejected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "checkout_flash_sale_rejected_total",
+		Help: "Flash sale purchases that were rejected or failed, by reason.",
+	}, []string{"product_id", "reason"})
+)
+
+func Init() {
+	mtl.Registry.MustRegister(FlashSaleSold, FlashSaleRejected)
+}
@@ -15,11 +15,16 @@
 package mq
 
 import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/conf"
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 )
 
 var (
 	Nc  *nats.Conn
+	JS  jetstream.JetStream
 	err error
 )
 
@@ -28,4 +33,19 @@ func Init() {
 	if err != nil {
 		panic(err)
 	}
+	JS, err = jetstream.New(Nc)
+	if err != nil {
+		panic(err)
+	}
+	// jobs stay in the work queue until a consumer acks them
+	q := conf.GetConf().Queue
+	_, err = JS.CreateOrUpdateStream(context.Background(), jetstream.StreamConfig{
+		Name:      q.Stream,
+		Subjects:  q.Subjects,
+		Retention: jetstream.WorkQueuePolicy,
+		Storage:   jetstream.FileStorage,
+	})
+	if err != nil {
+		panic(err)
+	}
 }
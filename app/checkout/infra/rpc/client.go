@@ -24,19 +24,21 @@ import (
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order/orderservice"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment/paymentservice"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product/productcatalogservice"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping/shippingservice"
 	"github.com/cloudwego/kitex/client"
 )
 
 var (
-	CartClient    cartservice.Client
-	ProductClient productcatalogservice.Client
-	PaymentClient paymentservice.Client
-	OrderClient   orderservice.Client
-	once          sync.Once
-	err           error
-	registryAddr  string
-	serviceName   string
-	commonSuite   client.Option
+	CartClient     cartservice.Client
+	ProductClient  productcatalogservice.Client
+	PaymentClient  paymentservice.Client
+	OrderClient    orderservice.Client
+	ShippingClient shippingservice.Client
+	once           sync.Once
+	err            error
+	registryAddr   string
+	serviceName    string
+	commonSuite    client.Option
 )
 
 func InitClient() {
@@ -51,6 +53,7 @@ func InitClient() {
 		initProductClient()
 		initPaymentClient()
 		initOrderClient()
+		initShippingClient()
 	})
 }
 
@@ -73,3 +76,8 @@ func initOrderClient() {
 	OrderClient, err = orderservice.NewClient("order", commonSuite)
 	checkoututils.MustHandleError(err)
 }
+
+func initShippingClient() {
+	ShippingClient, err = shippingservice.NewClient("shipping", commonSuite)
+	checkoututils.MustHandleError(err)
+}
@@ -18,7 +18,10 @@ import (
 	"net"
 	"strings"
 
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/consumer"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal/redis"
 	"github.com/cloudwego/biz-demo/gomall/app/checkout/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/metric"
 	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/mq"
 	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/rpc"
 	"github.com/cloudwego/biz-demo/gomall/common/mtl"
@@ -43,8 +46,11 @@ func main() {
 	})
 	mtl.InitTracing(serviceName)
 	mtl.InitMetric(serviceName, conf.GetConf().Kitex.MetricsPort, conf.GetConf().Registry.RegistryAddress[0])
+	metric.Init()
 	rpc.InitClient()
+	redis.Init()
 	mq.Init()
+	consumer.Init()
 	opts := kitexInit()
 
 	svr := checkoutservice.NewServer(new(CheckoutServiceImpl), opts...)
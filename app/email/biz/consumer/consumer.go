@@ -14,8 +14,12 @@
 
 package consumer
 
-import "github.com/cloudwego/biz-demo/gomall/app/email/biz/consumer/email"
+import (
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/consumer/email"
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/consumer/notification"
+)
 
 func Init() {
 	email.ConsumerInit()
+	notification.ConsumerInit()
 }
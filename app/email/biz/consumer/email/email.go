@@ -4,7 +4,7 @@
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
 //
-//	http://www.apache.org/licenses/LICENSE-2.0
+//     http://www.apache.org/licenses/LICENSE-2.0
 //
 // Unless required by applicable law or agreed to in writing, software
 // distributed under the License is distributed on an "AS IS" BASIS,
@@ -16,12 +16,17 @@ package email
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/deadletter"
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/service"
+	"github.com/cloudwego/biz-demo/gomall/app/email/conf"
 	"github.com/cloudwego/biz-demo/gomall/app/email/infra/mq"
-	"github.com/cloudwego/biz-demo/gomall/app/email/infra/notify"
+	"github.com/cloudwego/kitex/pkg/kerrors"
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/cloudwego/kitex/server"
-	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
 	"google.golang.org/protobuf/proto"
@@ -29,32 +34,185 @@ import (
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
 )
 
-func ConsumerInit() {
-	// Connect to a server
-
-	tracer := otel.Tracer("shop-nats-consumer")
-	sub, err := mq.Nc.Subscribe("email", func(m *nats.Msg) {
-		var req email.EmailReq
-		err := proto.Unmarshal(m.Data, &req)
-		if err != nil {
-			klog.Error(err)
-		}
-		noopEmail := notify.NewNoopEmail()
-		_ = noopEmail.Send(&req)
-
-		// consumer otel
-		ctx := context.Background()
-		ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(m.Header))
-		_, span := tracer.Start(ctx, "shop-email-consumer")
-		defer span.End()
-		// consumer otel
+type Options struct {
+	Stream            string
+	Subject           string
+	Durable           string
+	MaxDeliver        int
+	BackoffBase       time.Duration
+	BackoffMax        time.Duration
+	DeadLetterStream  string
+	DeadLetterSubject string
+	DeadLetterMaxAge  time.Duration
+}
+
+func OptionsFromConf() Options {
+	c := conf.GetConf().Consumer
+	return Options{
+		Stream:            c.Stream,
+		Subject:           c.Subject,
+		Durable:           c.Durable,
+		MaxDeliver:        c.MaxDeliver,
+		BackoffBase:       time.Duration(c.BackoffBaseSeconds) * time.Second,
+		BackoffMax:        time.Duration(c.BackoffMaxSeconds) * time.Second,
+		DeadLetterStream:  c.DeadLetterStream,
+		DeadLetterSubject: c.DeadLetterSubject,
+		DeadLetterMaxAge:  time.Duration(c.DeadLetterMaxAgeHours) * time.Hour,
+	}
+}
+
+// Backoff is the delay before delivery number delivered+1: BackoffBase doubled for every
+// earlier attempt, at most BackoffMax.
+func (o Options) Backoff(delivered uint64) time.Duration {
+	d := o.BackoffBase
+	for i := uint64(1); i < delivered && d < o.BackoffMax; i++ {
+		d *= 2
+	}
+	return min(d, o.BackoffMax)
+}
+
+type SendFunc func(ctx context.Context, req *email.EmailReq) error
+
+// Consumer delivers the mails published on the email subject. Mails are acked once sent,
+// redelivered with backoff when sending fails, and moved to the dead letter stream when they
+// cannot be decoded, are rejected as invalid, or still fail on the last attempt. A mail is
+// acked only once it is sent or dead lettered, so the server keeps redelivering it past
+// MaxDeliver; those deliveries only move it to the dead letter stream.
+type Consumer struct {
+	js   jetstream.JetStream
+	opts Options
+	send SendFunc
+	dead *deadletter.Store
+	cc   jetstream.ConsumeContext
+}
+
+func NewConsumer(js jetstream.JetStream, opts Options, send SendFunc) *Consumer {
+	return &Consumer{
+		js:   js,
+		opts: opts,
+		send: send,
+		dead: deadletter.NewStore(js, opts.DeadLetterStream, opts.DeadLetterSubject, opts.Subject),
+	}
+}
+
+// Setup creates or updates the streams and the durable consumer.
+func (c *Consumer) Setup(ctx context.Context) error {
+	_, err := c.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:      c.opts.Stream,
+		Subjects:  []string{c.opts.Subject},
+		Retention: jetstream.WorkQueuePolicy,
+		Storage:   jetstream.FileStorage,
 	})
 	if err != nil {
+		return fmt.Errorf("create stream %s: %w", c.opts.Stream, err)
+	}
+	_, err = c.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     c.opts.DeadLetterStream,
+		Subjects: []string{c.opts.DeadLetterSubject},
+		MaxAge:   c.opts.DeadLetterMaxAge,
+		Storage:  jetstream.FileStorage,
+	})
+	if err != nil {
+		return fmt.Errorf("create stream %s: %w", c.opts.DeadLetterStream, err)
+	}
+	_, err = c.js.CreateOrUpdateConsumer(ctx, c.opts.Stream, jetstream.ConsumerConfig{
+		Durable:       c.opts.Durable,
+		FilterSubject: c.opts.Subject,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		// unlimited, the consumer stops sending after MaxDeliver attempts itself. A mail that
+		// is not acked in time, e.g. because the service stopped, is redelivered after AckWait.
+		MaxDeliver: -1,
+	})
+	if err != nil {
+		return fmt.Errorf("create consumer %s: %w", c.opts.Durable, err)
+	}
+	return nil
+}
+
+func (c *Consumer) Start(ctx context.Context) error {
+	consumer, err := c.js.Consumer(ctx, c.opts.Stream, c.opts.Durable)
+	if err != nil {
+		return err
+	}
+	c.cc, err = consumer.Consume(c.handle)
+	return err
+}
+
+func (c *Consumer) Stop() {
+	if c.cc != nil {
+		c.cc.Stop()
+	}
+}
+
+func (c *Consumer) handle(msg jetstream.Msg) {
+	meta, err := msg.Metadata()
+	if err != nil {
+		klog.Errorf("email consumer: invalid message metadata: %v", err)
+		_ = msg.Term()
+		return
+	}
+
+	// consumer otel
+	ctx := context.Background()
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(msg.Headers()))
+	ctx, span := otel.Tracer("shop-nats-consumer").Start(ctx, "shop-email-consumer")
+	defer span.End()
+	// consumer otel
+
+	if int(meta.NumDelivered) > c.opts.MaxDeliver {
+		// the last attempt ended without a result, e.g. the service stopped while sending or
+		// the dead letter could not be stored
+		c.deadLetter(ctx, msg, meta, "no result after the last attempt")
+		return
+	}
+	var req email.EmailReq
+	if err := proto.Unmarshal(msg.Data(), &req); err != nil {
+		c.deadLetter(ctx, msg, meta, "invalid message: "+err.Error())
+		return
+	}
+	err = c.send(ctx, &req)
+	if err == nil {
+		if err := msg.Ack(); err != nil {
+			klog.CtxWarnf(ctx, "email consumer: ack message %d failed: %v", meta.Sequence.Stream, err)
+		}
+		return
+	}
+	if _, invalid := kerrors.FromBizStatusError(err); invalid || int(meta.NumDelivered) >= c.opts.MaxDeliver {
+		c.deadLetter(ctx, msg, meta, err.Error())
+		return
+	}
+	klog.CtxWarnf(ctx, "email consumer: send to %s failed on attempt %d, retrying: %v", req.To, meta.NumDelivered, err)
+	_ = msg.NakWithDelay(c.opts.Backoff(meta.NumDelivered))
+}
+
+// deadLetter moves the message to the dead letter stream. The message is redelivered until
+// that succeeds.
+func (c *Consumer) deadLetter(ctx context.Context, msg jetstream.Msg, meta *jetstream.MsgMetadata, reason string) {
+	err := c.dead.Add(ctx, msg.Data(), msg.Headers(), reason, meta.NumDelivered, meta.Sequence.Stream)
+	if err != nil {
+		klog.CtxErrorf(ctx, "email consumer: dead letter message %d failed, retrying: %v", meta.Sequence.Stream, err)
+		_ = msg.NakWithDelay(c.opts.Backoff(meta.NumDelivered))
+		return
+	}
+	klog.CtxErrorf(ctx, "email consumer: message %d moved to %s: %s", meta.Sequence.Stream, c.opts.DeadLetterStream, reason)
+	_ = msg.Ack()
+}
+
+func ConsumerInit() {
+	ctx := context.Background()
+	c := NewConsumer(mq.JS, OptionsFromConf(), func(ctx context.Context, req *email.EmailReq) error {
+		_, err := service.NewSendService(ctx).Run(req)
+		return err
+	})
+	if err := c.Setup(ctx); err != nil {
+		panic(err)
+	}
+	if err := c.Start(ctx); err != nil {
 		panic(err)
 	}
 
 	server.RegisterShutdownHook(func() {
-		sub.Unsubscribe() //nolint:errcheck
+		c.Stop()
 		mq.Nc.Close()
 	})
 }
@@ -15,8 +15,268 @@
 package email
 
 import (
+	"context"
+	"errors"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/deadletter"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	natsserver "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/protobuf/proto"
 )
 
+func testOptions() Options {
+	return Options{
+		Stream:            "EMAIL",
+		Subject:           "email",
+		Durable:           "email-sender",
+		MaxDeliver:        3,
+		BackoffBase:       10 * time.Millisecond,
+		BackoffMax:        40 * time.Millisecond,
+		DeadLetterStream:  "EMAIL_DLQ",
+		DeadLetterSubject: "email.dlq",
+		DeadLetterMaxAge:  time.Hour,
+	}
+}
+
+// startJetStream runs an embedded NATS server with JetStream for the test.
+func startJetStream(t *testing.T) (*nats.Conn, jetstream.JetStream) {
+	t.Helper()
+	s, err := natsserver.NewServer(&natsserver.Options{Host: "127.0.0.1", Port: -1, JetStream: true, StoreDir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Start()
+	if !s.ReadyForConnections(5 * time.Second) {
+		t.Fatal("nats server did not start")
+	}
+	t.Cleanup(s.Shutdown)
+	nc, err := nats.Connect(s.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(nc.Close)
+	js, err := jetstream.New(nc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return nc, js
+}
+
+// recorder is a SendFunc failing the first failures calls for each recipient.
+type recorder struct {
+	mu       sync.Mutex
+	failures map[string]int
+	err      error
+	attempts map[string]int
+	sent     chan string
+}
+
+func newRecorder(failures map[string]int, err error) *recorder {
+	return &recorder{failures: failures, err: err, attempts: map[string]int{}, sent: make(chan string, 10)}
+}
+
+func (r *recorder) send(ctx context.Context, req *email.EmailReq) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.attempts[req.To]++
+	if r.attempts[req.To] <= r.failures[req.To] {
+		return r.err
+	}
+	r.sent <- req.To
+	return nil
+}
+
+func (r *recorder) attemptsOf(to string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.attempts[to]
+}
+
+func startConsumer(t *testing.T, js jetstream.JetStream, send SendFunc) {
+	t.Helper()
+	c := NewConsumer(js, testOptions(), send)
+	ctx := context.Background()
+	if err := c.Setup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(c.Stop)
+}
+
+func publish(t *testing.T, nc *nats.Conn, to string) {
+	t.Helper()
+	data, _ := proto.Marshal(&email.EmailReq{To: to, Subject: "hi"})
+	if err := nc.Publish("email", data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func waitSent(t *testing.T, r *recorder, want string) {
+	t.Helper()
+	select {
+	case to := <-r.sent:
+		if to != want {
+			t.Fatalf("sent to %s, want %s", to, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("mail to %s was not sent", want)
+	}
+}
+
+func waitDeadLetters(t *testing.T, store *deadletter.Store, n int) []deadletter.Letter {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		letters, _, err := store.List(context.Background(), 0, 10)
+		if err == nil && len(letters) == n {
+			return letters
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d dead letters (err %v), want %d", len(letters), err, n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
 func TestEmailConsumer(t *testing.T) {
+	nc, js := startJetStream(t)
+	r := newRecorder(map[string]int{"retry@example.com": 2}, errors.New("smtp unavailable"))
+	startConsumer(t, js, r.send)
+
+	publish(t, nc, "ok@example.com")
+	waitSent(t, r, "ok@example.com")
+
+	// fails twice, then goes out on the third and last attempt
+	publish(t, nc, "retry@example.com")
+	waitSent(t, r, "retry@example.com")
+	if got := r.attemptsOf("retry@example.com"); got != 3 {
+		t.Errorf("got %d attempts, want 3", got)
+	}
+
+	stream, err := js.Stream(context.Background(), "EMAIL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for stream.CachedInfo().State.Msgs != 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+		_, _ = stream.Info(context.Background())
+	}
+	if n := stream.CachedInfo().State.Msgs; n != 0 {
+		t.Errorf("got %d messages left in the work queue, want all acked", n)
+	}
+}
+
+func TestEmailConsumerDeadLetters(t *testing.T) {
+	nc, js := startJetStream(t)
+	r := newRecorder(map[string]int{"down@example.com": 100, "invalid@example.com": 100}, errors.New("smtp unavailable"))
+	startConsumer(t, js, func(ctx context.Context, req *email.EmailReq) error {
+		if req.To == "invalid@example.com" {
+			return kerrors.NewBizStatusError(40000, "unknown email template")
+		}
+		return r.send(ctx, req)
+	})
+	store := deadletter.NewStore(js, "EMAIL_DLQ", "email.dlq", "email")
+
+	publish(t, nc, "down@example.com")
+	publish(t, nc, "invalid@example.com")
+	if err := nc.Publish("email", []byte("not a protobuf message \xff")); err != nil {
+		t.Fatal(err)
+	}
+	letters := waitDeadLetters(t, store, 3)
+
+	byReason := map[string]deadletter.Letter{}
+	for _, l := range letters {
+		to := ""
+		if l.Req != nil {
+			to = l.Req.To
+		}
+		byReason[to] = l
+	}
+	if l := byReason["down@example.com"]; l.Deliveries != 3 || l.Reason != "smtp unavailable" {
+		t.Errorf("got %+v, want the mail dead after 3 deliveries", l)
+	}
+	if l := byReason["invalid@example.com"]; l.Deliveries != 1 {
+		t.Errorf("got %d deliveries of an invalid mail, want it dead on the first", l.Deliveries)
+	}
+	if _, ok := byReason[""]; !ok {
+		t.Error("want the undecodable message in the dead letters")
+	}
+
+	// the relay is back, replaying delivers the mail and removes the dead letter
+	r.mu.Lock()
+	r.failures["down@example.com"] = 0
+	r.mu.Unlock()
+	if err := store.Replay(context.Background(), byReason["down@example.com"].Seq); err != nil {
+		t.Fatal(err)
+	}
+	waitSent(t, r, "down@example.com")
+	waitDeadLetters(t, store, 2)
+	if err := store.Replay(context.Background(), byReason["down@example.com"].Seq); !errors.Is(err, jetstream.ErrMsgNotFound) {
+		t.Errorf("got %v replaying twice, want ErrMsgNotFound", err)
+	}
+}
+
+func TestEmailConsumerDeadLetterRetried(t *testing.T) {
+	nc, js := startJetStream(t)
+	r := newRecorder(map[string]int{"down@example.com": 100}, errors.New("smtp unavailable"))
+	c := NewConsumer(js, testOptions(), r.send)
+	ctx := context.Background()
+	if err := c.Setup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// the dead letter stream is unavailable when the last attempt fails
+	if err := js.DeleteStream(ctx, "EMAIL_DLQ"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(c.Stop)
+
+	publish(t, nc, "down@example.com")
+	consumer, err := js.Consumer(ctx, "EMAIL", "email-sender")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// wait for a delivery past the last attempt
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		info, err := consumer.Info(ctx)
+		if err == nil && info.Delivered.Consumer > 3 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the mail was not redelivered after the last attempt")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := c.Setup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	letters := waitDeadLetters(t, deadletter.NewStore(js, "EMAIL_DLQ", "email.dlq", "email"), 1)
+	if letters[0].Deliveries <= 3 {
+		t.Errorf("got the dead letter after %d deliveries, want it stored on a later one", letters[0].Deliveries)
+	}
+	if got := r.attemptsOf("down@example.com"); got != 3 {
+		t.Errorf("got %d attempts, want no sending after the last one", got)
+	}
+}
+
+func TestOptionsBackoff(t *testing.T) {
+	o := Options{BackoffBase: time.Second, BackoffMax: 5 * time.Second, MaxDeliver: 5}
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}
+	for i := range want {
+		if got := o.Backoff(uint64(i + 1)); got != want[i] {
+			t.Errorf("Backoff(%d) = %v, want %v", i+1, got, want[i])
+		}
+	}
 }
@@ -0,0 +1,123 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/notifier"
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/render"
+	"github.com/cloudwego/biz-demo/gomall/app/email/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/email/infra/mq"
+	"github.com/cloudwego/biz-demo/gomall/common/eventbus"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/events"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/server"
+	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/protobuf/proto"
+)
+
+// The notifications sent for the domain events. Order confirmations are receipts mailed
+// by the order service, so OrderPaid has no email here.
+
+func userRegistered(e *events.UserRegistered) notifier.Notification {
+	shop := conf.GetConf().Email.ShopName
+	return notifier.Notification{
+		UserId:   e.UserId,
+		Category: notifier.CategoryAccount,
+		Event:    "user.registered",
+		Template: "welcome",
+		Data:     map[string]any{"email": e.Email},
+		SMS:      render.Default.T("", "sms.welcome", shop),
+	}
+}
+
+func orderPaid(e *events.OrderPaid) notifier.Notification {
+	shop := conf.GetConf().Email.ShopName
+	return notifier.Notification{
+		UserId:   e.UserId,
+		Category: notifier.CategoryOrder,
+		Event:    "order.paid",
+		Data: map[string]any{
+			"order_id":       e.OrderId,
+			"total":          e.Total,
+			"currency":       e.Currency,
+			"transaction_id": e.TransactionId,
+		},
+		SMS: render.Default.T("", "sms.order_paid", shop, e.OrderId),
+	}
+}
+
+func paymentRefunded(e *events.PaymentRefunded) notifier.Notification {
+	shop := conf.GetConf().Email.ShopName
+	return notifier.Notification{
+		UserId:   e.UserId,
+		Category: notifier.CategoryOrder,
+		Event:    "payment.refunded",
+		Template: "refund",
+		Data: map[string]any{
+			"order_id":       e.OrderId,
+			"refund_id":      e.RefundId,
+			"amount":         e.Amount,
+			"refunded_total": e.RefundedTotal,
+			"reason":         e.Reason,
+		},
+		SMS: render.Default.T("", "sms.payment_refunded", shop, fmt.Sprintf("%.2f", e.Amount), e.OrderId),
+	}
+}
+
+// subscribe keeps trying until the stream of the event exists, it is created by the
+// publishing service.
+func subscribe[E any, PE interface {
+	*E
+	proto.Message
+}](ctx context.Context, js jetstream.JetStream, opts eventbus.Options, build func(PE) notifier.Notification) {
+	handle := func(ctx context.Context, e PE) error {
+		return notifier.Default.Notify(ctx, build(e))
+	}
+	for {
+		sub, err := eventbus.Subscribe(ctx, js, opts, handle)
+		if err == nil {
+			<-ctx.Done()
+			sub.Stop()
+			return
+		}
+		klog.Warnf("notification consumer: %v, retrying", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+func ConsumerInit() {
+	c := conf.GetConf()
+	opts := eventbus.Options{
+		Group:      c.Kitex.Service,
+		MaxDeliver: c.Consumer.MaxDeliver,
+		Backoff:    time.Duration(c.Consumer.BackoffBaseSeconds) * time.Second,
+		BackoffMax: time.Duration(c.Consumer.BackoffMaxSeconds) * time.Second,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	go subscribe(ctx, mq.JS, opts, userRegistered)
+	go subscribe(ctx, mq.JS, opts, orderPaid)
+	go subscribe(ctx, mq.JS, opts, paymentRefunded)
+	go notifier.Default.RunDeferred(ctx, time.Minute)
+
+	server.RegisterShutdownHook(cancel)
+}
@@ -0,0 +1,169 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/email/conf"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/protobuf/proto"
+)
+
+// headers added to dead letters
+const (
+	HeaderReason     = "Email-Dead-Reason"
+	HeaderDeliveries = "Email-Dead-Deliveries"
+	HeaderStreamSeq  = "Email-Dead-Stream-Seq"
+	HeaderDeadAt     = "Email-Dead-At"
+)
+
+var deadHeaders = []string{HeaderReason, HeaderDeliveries, HeaderStreamSeq, HeaderDeadAt, jetstream.MsgIDHeader}
+
+type Letter struct {
+	Seq        uint64
+	Reason     string
+	Deliveries int
+	DeadAt     time.Time
+	// Req is nil when the message could not be decoded
+	Req *email.EmailReq
+}
+
+// Store keeps mails that could not be delivered in a stream, from where they can be replayed
+// to the email subject.
+type Store struct {
+	js            jetstream.JetStream
+	stream        string
+	subject       string
+	replaySubject string
+}
+
+func NewStore(js jetstream.JetStream, stream, subject, replaySubject string) *Store {
+	return &Store{js: js, stream: stream, subject: subject, replaySubject: replaySubject}
+}
+
+func NewStoreFromConf(js jetstream.JetStream) *Store {
+	c := conf.GetConf().Consumer
+	return NewStore(js, c.DeadLetterStream, c.DeadLetterSubject, c.Subject)
+}
+
+// Add stores a failed message. sourceSeq, its sequence in the email stream, makes adding it again a no-op.
+func (s *Store) Add(ctx context.Context, data []byte, header nats.Header, reason string, deliveries, sourceSeq uint64) error {
+	msg := nats.NewMsg(s.subject)
+	msg.Data = data
+	for k, v := range header {
+		msg.Header[k] = v
+	}
+	msg.Header.Set(HeaderReason, reason)
+	msg.Header.Set(HeaderDeliveries, strconv.FormatUint(deliveries, 10))
+	msg.Header.Set(HeaderStreamSeq, strconv.FormatUint(sourceSeq, 10))
+	msg.Header.Set(HeaderDeadAt, time.Now().UTC().Format(time.RFC3339))
+	_, err := s.js.PublishMsg(ctx, msg, jetstream.WithMsgID(fmt.Sprintf("dead-%d", sourceSeq)))
+	return err
+}
+
+// List returns up to limit dead letters from startSeq on, oldest first, and the sequence to
+// continue from, which is 0 when there are no more.
+func (s *Store) List(ctx context.Context, startSeq uint64, limit int) (letters []Letter, next uint64, err error) {
+	stream, err := s.js.Stream(ctx, s.stream)
+	if err != nil {
+		return nil, 0, err
+	}
+	seq := max(startSeq, 1)
+	for len(letters) < limit {
+		raw, err := stream.GetMsg(ctx, seq, jetstream.WithGetMsgSubject(s.subject))
+		if errors.Is(err, jetstream.ErrMsgNotFound) {
+			return letters, 0, nil
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		letters = append(letters, toLetter(raw))
+		seq = raw.Sequence + 1
+	}
+	return letters, seq, nil
+}
+
+// Replay publishes the dead letter to the email subject again and deletes it.
+func (s *Store) Replay(ctx context.Context, seq uint64) error {
+	stream, err := s.js.Stream(ctx, s.stream)
+	if err != nil {
+		return err
+	}
+	raw, err := stream.GetMsg(ctx, seq)
+	if err != nil {
+		return err
+	}
+	msg := nats.NewMsg(s.replaySubject)
+	msg.Data = raw.Data
+	for k, v := range raw.Header {
+		msg.Header[k] = v
+	}
+	for _, k := range deadHeaders {
+		msg.Header.Del(k)
+	}
+	// a replay retried before the delete went through is dropped as a duplicate
+	if _, err := s.js.PublishMsg(ctx, msg, jetstream.WithMsgID(fmt.Sprintf("replay-%s-%d", s.stream, seq))); err != nil {
+		return err
+	}
+	return stream.DeleteMsg(ctx, seq)
+}
+
+// FindTo returns the dead letters addressed to to, oldest first.
+func (s *Store) FindTo(ctx context.Context, to string) (found []Letter, err error) {
+	for seq := uint64(1); seq != 0; {
+		var letters []Letter
+		if letters, seq, err = s.List(ctx, seq, 100); err != nil {
+			return nil, err
+		}
+		for _, l := range letters {
+			if l.Req != nil && strings.EqualFold(l.Req.To, to) {
+				found = append(found, l)
+			}
+		}
+	}
+	return found, nil
+}
+
+// Delete removes the dead letter seq, one that is gone already is no error.
+func (s *Store) Delete(ctx context.Context, seq uint64) error {
+	stream, err := s.js.Stream(ctx, s.stream)
+	if err != nil {
+		return err
+	}
+	err = stream.DeleteMsg(ctx, seq)
+	if errors.Is(err, jetstream.ErrMsgNotFound) {
+		return nil
+	}
+	return err
+}
+
+func toLetter(raw *jetstream.RawStreamMsg) Letter {
+	l := Letter{Seq: raw.Sequence, Reason: raw.Header.Get(HeaderReason)}
+	l.Deliveries, _ = strconv.Atoi(raw.Header.Get(HeaderDeliveries))
+	l.DeadAt, _ = time.Parse(time.RFC3339, raw.Header.Get(HeaderDeadAt))
+	var req email.EmailReq
+	if proto.Unmarshal(raw.Data, &req) == nil {
+		l.Req = &req
+	}
+	return l
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DeferredSMS is an SMS held back in quiet hours. The text is kept, the phone number is read
+// from the preferences again when it is sent.
+type DeferredSMS struct {
+	UserId   uint32 `json:"user_id"`
+	Category string `json:"category"`
+	Event    string `json:"event"`
+	Text     string `json:"text"`
+}
+
+// SMSQueue keeps the deferred SMS until they are due. Claim holds the due SMS back for the
+// lease so other instances skip them; they are due again unless Done is called in time.
+type SMSQueue interface {
+	Defer(ctx context.Context, sms DeferredSMS, until time.Time) error
+	Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]DeferredSMS, error)
+	Done(ctx context.Context, sms DeferredSMS) error
+}
+
+const deferredSMSKey = "notifier:sms:deferred"
+
+// claimScript moves up to ARGV[3] members due at ARGV[1] to ARGV[2] and returns them.
+var claimScript = redis.NewScript(`
+local due = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[3])
+for _, m in ipairs(due) do
+	redis.call('ZADD', KEYS[1], ARGV[2], m)
+end
+return due
+`)
+
+type RedisSMSQueue struct {
+	rdb *redis.Client
+}
+
+func NewRedisSMSQueue(rdb *redis.Client) *RedisSMSQueue {
+	return &RedisSMSQueue{rdb: rdb}
+}
+
+// Defer stores the same SMS only once, so a retried notification is not sent twice.
+func (q *RedisSMSQueue) Defer(ctx context.Context, sms DeferredSMS, until time.Time) error {
+	b, err := json.Marshal(sms)
+	if err != nil {
+		return err
+	}
+	return q.rdb.ZAdd(ctx, deferredSMSKey, redis.Z{Score: float64(until.Unix()), Member: string(b)}).Err()
+}
+
+func (q *RedisSMSQueue) Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]DeferredSMS, error) {
+	members, err := claimScript.Run(ctx, q.rdb, []string{deferredSMSKey}, now.Unix(), now.Add(lease).Unix(), limit).StringSlice()
+	if err != nil {
+		return nil, err
+	}
+	due := make([]DeferredSMS, 0, len(members))
+	for _, m := range members {
+		var sms DeferredSMS
+		if err := json.Unmarshal([]byte(m), &sms); err != nil {
+			// never due again otherwise
+			q.rdb.ZRem(ctx, deferredSMSKey, m)
+			continue
+		}
+		due = append(due, sms)
+	}
+	return due, nil
+}
+
+func (q *RedisSMSQueue) Done(ctx context.Context, sms DeferredSMS) error {
+	b, err := json.Marshal(sms)
+	if err != nil {
+		return err
+	}
+	return q.rdb.ZRem(ctx, deferredSMSKey, string(b)).Err()
+}
+
+type MemorySMSQueue struct {
+	mu  sync.Mutex
+	due map[DeferredSMS]time.Time
+}
+
+func NewMemorySMSQueue() *MemorySMSQueue {
+	return &MemorySMSQueue{due: map[DeferredSMS]time.Time{}}
+}
+
+func (q *MemorySMSQueue) Defer(ctx context.Context, sms DeferredSMS, until time.Time) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.due[sms] = until
+	return nil
+}
+
+func (q *MemorySMSQueue) Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]DeferredSMS, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var due []DeferredSMS
+	for sms, at := range q.due {
+		if !at.After(now) {
+			due = append(due, sms)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return q.due[due[i]].Before(q.due[due[j]]) })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	for _, sms := range due {
+		q.due[sms] = now.Add(lease)
+	}
+	return due, nil
+}
+
+func (q *MemorySMSQueue) Done(ctx context.Context, sms DeferredSMS) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.due, sms)
+	return nil
+}
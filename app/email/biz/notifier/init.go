@@ -0,0 +1,49 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/service"
+	"github.com/cloudwego/biz-demo/gomall/app/email/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/email/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+)
+
+var Default *Notifier
+
+func Init() {
+	c := conf.GetConf()
+	sms, err := NewSMSProvider(c.Notifier.SMS.Provider)
+	if err != nil {
+		panic(err)
+	}
+	Default = NewNotifier(
+		NewUserPreferences(rpc.UserClient),
+		func(ctx context.Context, req *email.EmailReq) error {
+			_, err := service.NewSendService(ctx).Run(req)
+			return err
+		},
+		sms,
+		NewWebhook(time.Duration(c.Notifier.Webhook.TimeoutSeconds)*time.Second, c.Notifier.Webhook.AllowPrivate),
+		NewRedisLimiter(redis.RedisClient, c.Notifier.RateLimit, time.Duration(c.Notifier.RateWindowMinutes)*time.Minute),
+		NewRedisSMSQueue(redis.RedisClient),
+		Options{ShopURL: strings.TrimRight(c.Email.ShopURL, "/")},
+	)
+}
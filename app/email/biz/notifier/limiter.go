@@ -0,0 +1,76 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Limiter allows a number of calls per key in a time window.
+type Limiter interface {
+	Allow(ctx context.Context, key string) (bool, error)
+}
+
+// RedisLimiter counts in Redis, so that all instances of the service share the limit.
+type RedisLimiter struct {
+	rdb    *redis.Client
+	limit  int
+	window time.Duration
+}
+
+func NewRedisLimiter(rdb *redis.Client, limit int, window time.Duration) *RedisLimiter {
+	return &RedisLimiter{rdb: rdb, limit: limit, window: window}
+}
+
+func (l *RedisLimiter) Allow(ctx context.Context, key string) (bool, error) {
+	k := fmt.Sprintf("notifier:rate:%s:%d", key, time.Now().UnixNano()/int64(l.window))
+	pipe := l.rdb.TxPipeline()
+	count := pipe.Incr(ctx, k)
+	pipe.Expire(ctx, k, l.window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, err
+	}
+	return count.Val() <= int64(l.limit), nil
+}
+
+// MemoryLimiter counts in the process.
+type MemoryLimiter struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	counts map[string]int
+	start  time.Time
+	now    func() time.Time
+}
+
+func NewMemoryLimiter(limit int, window time.Duration) *MemoryLimiter {
+	return &MemoryLimiter{limit: limit, window: window, counts: map[string]int{}, now: time.Now}
+}
+
+func (l *MemoryLimiter) Allow(ctx context.Context, key string) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now := l.now(); now.Sub(l.start) >= l.window {
+		l.start = now.Truncate(l.window)
+		l.counts = map[string]int{}
+	}
+	l.counts[key]++
+	return l.counts[key] <= l.limit, nil
+}
@@ -0,0 +1,308 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package notifier delivers notifications about domain events to users by email, SMS or
+// webhook, as chosen in their notification preferences in the user service.
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// The categories and channels of the user service.
+const (
+	CategoryOrder     = "order"
+	CategoryAccount   = "account"
+	CategoryMarketing = "marketing"
+
+	ChannelEmail   = "email"
+	ChannelSMS     = "sms"
+	ChannelWebhook = "webhook"
+)
+
+var channels = []string{ChannelEmail, ChannelSMS, ChannelWebhook}
+
+// Notification is one message to a user, sent on every channel the user enabled for its
+// category that it has content for.
+type Notification struct {
+	UserId   uint32
+	Category string
+	// Event names the notification in webhook calls, e.g. order.paid
+	Event string
+	// Template is the email to send, the notification is not mailed without one
+	Template string
+	// Data is the template data and the webhook payload
+	Data map[string]any
+	// SMS is the text message, none is sent when it is empty
+	SMS string
+}
+
+type Preferences struct {
+	Email            string
+	Phone            string
+	WebhookURL       string
+	WebhookSecret    string
+	UnsubscribeToken string
+	// QuietStart and QuietEnd are minutes after midnight in Location, SMS are held back until
+	// the end of the quiet period
+	QuietStart int
+	QuietEnd   int
+	Location   *time.Location
+	// Enabled holds the channels by category
+	Enabled map[string]map[string]bool
+}
+
+func (p *Preferences) quiet(t time.Time) bool {
+	if p.QuietStart == p.QuietEnd {
+		return false
+	}
+	t = t.In(p.Location)
+	m := t.Hour()*60 + t.Minute()
+	if p.QuietStart < p.QuietEnd {
+		return m >= p.QuietStart && m < p.QuietEnd
+	}
+	return m >= p.QuietStart || m < p.QuietEnd
+}
+
+// quietEnd returns the end of the quiet period t is in.
+func (p *Preferences) quietEnd(t time.Time) time.Time {
+	t = t.In(p.Location)
+	end := time.Date(t.Year(), t.Month(), t.Day(), p.QuietEnd/60, p.QuietEnd%60, 0, 0, p.Location)
+	if !end.After(t) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return end
+}
+
+type PreferenceSource interface {
+	Preferences(ctx context.Context, userId uint32) (*Preferences, error)
+}
+
+type SendEmailFunc func(ctx context.Context, req *email.EmailReq) error
+
+type SMSProvider interface {
+	SendSMS(ctx context.Context, phone, text string) error
+}
+
+type Options struct {
+	// ShopURL is the base of the unsubscribe links
+	ShopURL string
+}
+
+const (
+	// deferredLease is how long a claimed deferred SMS is held back from other instances
+	deferredLease = 5 * time.Minute
+	deferredBatch = 100
+)
+
+type Notifier struct {
+	prefs    PreferenceSource
+	email    SendEmailFunc
+	sms      SMSProvider
+	webhook  *Webhook
+	limiter  Limiter
+	deferred SMSQueue
+	opts     Options
+	now      func() time.Time
+}
+
+func NewNotifier(prefs PreferenceSource, sendEmail SendEmailFunc, sms SMSProvider, webhook *Webhook, limiter Limiter, deferred SMSQueue, opts Options) *Notifier {
+	return &Notifier{
+		prefs:    prefs,
+		email:    sendEmail,
+		sms:      sms,
+		webhook:  webhook,
+		limiter:  limiter,
+		deferred: deferred,
+		opts:     opts,
+		now:      time.Now,
+	}
+}
+
+// Notify sends n on the user's channels. Channels over the rate limit are skipped, SMS in
+// quiet hours are deferred to the end of the quiet period. An error is only returned when no
+// channel could be delivered, so a retry does not repeat the channels that worked.
+func (n *Notifier) Notify(ctx context.Context, nt Notification) error {
+	p, err := n.prefs.Preferences(ctx, nt.UserId)
+	if err != nil {
+		return fmt.Errorf("preferences of user %d: %w", nt.UserId, err)
+	}
+	var (
+		sent int
+		errs []error
+	)
+	for _, channel := range channels {
+		if !p.Enabled[nt.Category][channel] || !n.deliverable(nt, p, channel) {
+			continue
+		}
+		if now := n.now(); channel == ChannelSMS && p.quiet(now) {
+			sms := DeferredSMS{UserId: nt.UserId, Category: nt.Category, Event: nt.Event, Text: nt.SMS}
+			if err := n.deferred.Defer(ctx, sms, p.quietEnd(now)); err != nil {
+				errs = append(errs, fmt.Errorf("defer %s: %w", channel, err))
+				continue
+			}
+			klog.CtxInfof(ctx, "notifier: %s by sms to user %d deferred to the end of the quiet hours", nt.Event, nt.UserId)
+			sent++
+			continue
+		}
+		allowed, err := n.limiter.Allow(ctx, fmt.Sprintf("%d:%s", nt.UserId, channel))
+		if err != nil {
+			// do not lose notifications when the limiter is down
+			klog.CtxWarnf(ctx, "notifier: rate limiter: %v", err)
+		} else if !allowed {
+			klog.CtxWarnf(ctx, "notifier: %s by %s to user %d dropped, rate limit reached", nt.Event, channel, nt.UserId)
+			continue
+		}
+		if err := n.send(ctx, nt, p, channel); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", channel, err))
+			continue
+		}
+		sent++
+	}
+	if sent == 0 && len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	for _, err := range errs {
+		klog.CtxErrorf(ctx, "notifier: %s to user %d: %v", nt.Event, nt.UserId, err)
+	}
+	return nil
+}
+
+// SendDeferred sends the SMS whose quiet period is over and returns how many were sent. An SMS
+// that cannot be sent now is retried after deferredLease.
+func (n *Notifier) SendDeferred(ctx context.Context) (int, error) {
+	due, err := n.deferred.Claim(ctx, n.now(), deferredLease, deferredBatch)
+	if err != nil {
+		return 0, err
+	}
+	sent := 0
+	for _, sms := range due {
+		ok, err := n.sendDeferred(ctx, sms)
+		if errors.Is(err, errStillQuiet) {
+			continue
+		}
+		if err != nil {
+			klog.CtxWarnf(ctx, "notifier: deferred %s to user %d, retrying: %v", sms.Event, sms.UserId, err)
+			continue
+		}
+		if err := n.deferred.Done(ctx, sms); err != nil {
+			klog.CtxWarnf(ctx, "notifier: deferred %s to user %d: %v", sms.Event, sms.UserId, err)
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+var errStillQuiet = errors.New("deferred again, the quiet hours changed")
+
+// sendDeferred returns whether the SMS was sent, it is not when the user turned SMS off or
+// it is over the rate limit.
+func (n *Notifier) sendDeferred(ctx context.Context, sms DeferredSMS) (bool, error) {
+	p, err := n.prefs.Preferences(ctx, sms.UserId)
+	if err != nil {
+		return false, fmt.Errorf("preferences of user %d: %w", sms.UserId, err)
+	}
+	if !p.Enabled[sms.Category][ChannelSMS] || p.Phone == "" {
+		return false, nil
+	}
+	if now := n.now(); p.quiet(now) {
+		// the quiet hours changed in the meantime
+		if err := n.deferred.Defer(ctx, sms, p.quietEnd(now)); err != nil {
+			return false, err
+		}
+		return false, errStillQuiet
+	}
+	allowed, err := n.limiter.Allow(ctx, fmt.Sprintf("%d:%s", sms.UserId, ChannelSMS))
+	if err != nil {
+		klog.CtxWarnf(ctx, "notifier: rate limiter: %v", err)
+	} else if !allowed {
+		klog.CtxWarnf(ctx, "notifier: %s by sms to user %d dropped, rate limit reached", sms.Event, sms.UserId)
+		return false, nil
+	}
+	return true, n.sms.SendSMS(ctx, p.Phone, sms.Text)
+}
+
+// RunDeferred sends the deferred SMS every interval until ctx is done.
+func (n *Notifier) RunDeferred(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := n.SendDeferred(ctx); err != nil {
+				klog.CtxWarnf(ctx, "notifier: deferred sms: %v", err)
+			}
+		}
+	}
+}
+
+func (n *Notifier) deliverable(nt Notification, p *Preferences, channel string) bool {
+	switch channel {
+	case ChannelEmail:
+		return nt.Template != "" && p.Email != ""
+	case ChannelSMS:
+		return nt.SMS != "" && p.Phone != ""
+	case ChannelWebhook:
+		return p.WebhookURL != ""
+	}
+	return false
+}
+
+func (n *Notifier) send(ctx context.Context, nt Notification, p *Preferences, channel string) error {
+	switch channel {
+	case ChannelEmail:
+		data := map[string]any{}
+		for k, v := range nt.Data {
+			data[k] = v
+		}
+		data["unsubscribe_url"] = n.unsubscribeURL(p.UnsubscribeToken, nt.Category)
+		b, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		return n.email(ctx, &email.EmailReq{To: p.Email, Template: nt.Template, TemplateData: string(b)})
+	case ChannelSMS:
+		return n.sms.SendSMS(ctx, p.Phone, nt.SMS)
+	case ChannelWebhook:
+		body, err := json.Marshal(map[string]any{
+			"event":    nt.Event,
+			"category": nt.Category,
+			"user_id":  nt.UserId,
+			"data":     nt.Data,
+			"sent_at":  n.now().Unix(),
+		})
+		if err != nil {
+			return err
+		}
+		return n.webhook.Post(ctx, p.WebhookURL, p.WebhookSecret, nt.Event, body)
+	}
+	return fmt.Errorf("unknown channel %s", channel)
+}
+
+func (n *Notifier) unsubscribeURL(token, category string) string {
+	q := url.Values{"token": {token}, "category": {category}}
+	return n.opts.ShopURL + "/notifications/unsubscribe?" + q.Encode()
+}
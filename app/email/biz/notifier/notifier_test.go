@@ -0,0 +1,241 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+)
+
+type fakePrefs struct{ p *Preferences }
+
+func (f fakePrefs) Preferences(ctx context.Context, userId uint32) (*Preferences, error) {
+	return f.p, nil
+}
+
+type fakeSMS struct{ sent []string }
+
+func (f *fakeSMS) SendSMS(ctx context.Context, phone, text string) error {
+	f.sent = append(f.sent, phone+": "+text)
+	return nil
+}
+
+type recorder struct {
+	emails []*email.EmailReq
+	sms    fakeSMS
+	hooks  []*http.Request
+	bodies [][]byte
+	server *httptest.Server
+}
+
+func newTestNotifier(t *testing.T, p *Preferences, limit int) (*Notifier, *recorder) {
+	rec := &recorder{}
+	rec.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		rec.hooks = append(rec.hooks, r)
+		rec.bodies = append(rec.bodies, body)
+	}))
+	t.Cleanup(rec.server.Close)
+	if p.WebhookURL == "hook" {
+		p.WebhookURL = rec.server.URL
+	}
+	sendEmail := func(ctx context.Context, req *email.EmailReq) error {
+		rec.emails = append(rec.emails, req)
+		return nil
+	}
+	n := NewNotifier(fakePrefs{p}, sendEmail, &rec.sms, NewWebhook(time.Second, true), NewMemoryLimiter(limit, time.Minute), NewMemorySMSQueue(), Options{ShopURL: "https://shop.example"})
+	n.now = func() time.Time { return time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC) }
+	return n, rec
+}
+
+func testPreferences() *Preferences {
+	return &Preferences{
+		Email:            "jane@example.com",
+		Phone:            "+15550100",
+		WebhookURL:       "hook",
+		WebhookSecret:    "secret",
+		UnsubscribeToken: "tok",
+		Location:         time.UTC,
+		Enabled: map[string]map[string]bool{
+			CategoryOrder:     {ChannelEmail: true, ChannelSMS: true, ChannelWebhook: true},
+			CategoryMarketing: {ChannelEmail: false},
+		},
+	}
+}
+
+var refund = Notification{
+	UserId:   1,
+	Category: CategoryOrder,
+	Event:    "payment.refunded",
+	Template: "refund",
+	Data:     map[string]any{"order_id": "o1"},
+	SMS:      "refunded",
+}
+
+func TestNotifyAllChannels(t *testing.T) {
+	n, rec := newTestNotifier(t, testPreferences(), 10)
+	if err := n.Notify(context.Background(), refund); err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.emails) != 1 || len(rec.sms.sent) != 1 || len(rec.hooks) != 1 {
+		t.Fatalf("sent %d emails, %d sms, %d webhooks", len(rec.emails), len(rec.sms.sent), len(rec.hooks))
+	}
+	var data map[string]any
+	if err := json.Unmarshal([]byte(rec.emails[0].TemplateData), &data); err != nil {
+		t.Fatal(err)
+	}
+	if data["unsubscribe_url"] != "https://shop.example/notifications/unsubscribe?category=order&token=tok" {
+		t.Errorf("unsubscribe_url = %v", data["unsubscribe_url"])
+	}
+}
+
+func TestNotifyRespectsPreferences(t *testing.T) {
+	p := testPreferences()
+	p.Enabled[CategoryOrder][ChannelSMS] = false
+	n, rec := newTestNotifier(t, p, 10)
+	if err := n.Notify(context.Background(), refund); err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.sms.sent) != 0 {
+		t.Errorf("sms sent although disabled")
+	}
+
+	promo := Notification{UserId: 1, Category: CategoryMarketing, Event: "promo", Template: "promo"}
+	if err := n.Notify(context.Background(), promo); err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.emails) != 1 {
+		t.Errorf("sent %d emails, want only the refund", len(rec.emails))
+	}
+}
+
+func TestQuietHours(t *testing.T) {
+	at := func(h, m int) time.Time { return time.Date(2024, 5, 1, h, m, 0, 0, time.UTC) }
+	tests := []struct {
+		start, end int
+		t          time.Time
+		quiet      bool
+	}{
+		{0, 0, at(3, 0), false},
+		{60, 120, at(1, 30), true},
+		{60, 120, at(2, 0), false},
+		{22 * 60, 7 * 60, at(23, 0), true},
+		{22 * 60, 7 * 60, at(6, 59), true},
+		{22 * 60, 7 * 60, at(12, 0), false},
+	}
+	for _, tt := range tests {
+		p := Preferences{QuietStart: tt.start, QuietEnd: tt.end, Location: time.UTC}
+		if got := p.quiet(tt.t); got != tt.quiet {
+			t.Errorf("quiet(%d-%d, %s) = %v", tt.start, tt.end, tt.t.Format("15:04"), got)
+		}
+	}
+
+	shanghai, _ := time.LoadLocation("Asia/Shanghai")
+	p := testPreferences()
+	// 12:00 UTC is 20:00 in Shanghai
+	p.QuietStart, p.QuietEnd, p.Location = 19*60, 8*60, shanghai
+	n, rec := newTestNotifier(t, p, 10)
+	if err := n.Notify(context.Background(), refund); err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.sms.sent) != 0 || len(rec.emails) != 1 {
+		t.Errorf("sent %d sms, %d emails in quiet hours", len(rec.sms.sent), len(rec.emails))
+	}
+	// a retried notification defers the SMS only once
+	if err := n.Notify(context.Background(), refund); err != nil {
+		t.Fatal(err)
+	}
+	if sent, err := n.SendDeferred(context.Background()); err != nil || sent != 0 {
+		t.Errorf("SendDeferred in quiet hours = %d, %v", sent, err)
+	}
+	// 00:00 UTC is 08:00 in Shanghai
+	n.now = func() time.Time { return time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC) }
+	if sent, err := n.SendDeferred(context.Background()); err != nil || sent != 1 {
+		t.Errorf("SendDeferred after quiet hours = %d, %v", sent, err)
+	}
+	if len(rec.sms.sent) != 1 || rec.sms.sent[0] != "+15550100: refunded" {
+		t.Errorf("sms = %v", rec.sms.sent)
+	}
+	if sent, _ := n.SendDeferred(context.Background()); sent != 0 {
+		t.Errorf("deferred sms sent again")
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	n, rec := newTestNotifier(t, testPreferences(), 2)
+	for i := 0; i < 3; i++ {
+		if err := n.Notify(context.Background(), refund); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(rec.emails) != 2 || len(rec.sms.sent) != 2 || len(rec.hooks) != 2 {
+		t.Errorf("sent %d emails, %d sms, %d webhooks, want 2 each", len(rec.emails), len(rec.sms.sent), len(rec.hooks))
+	}
+}
+
+func TestWebhookSignature(t *testing.T) {
+	n, rec := newTestNotifier(t, testPreferences(), 10)
+	if err := n.Notify(context.Background(), refund); err != nil {
+		t.Fatal(err)
+	}
+	r := rec.hooks[0]
+	if r.Header.Get("X-Gomall-Event") != "payment.refunded" {
+		t.Errorf("event header = %q", r.Header.Get("X-Gomall-Event"))
+	}
+	ts, err := strconv.ParseInt(r.Header.Get("X-Gomall-Timestamp"), 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Header.Get("X-Gomall-Signature") != "sha256="+Sign("secret", ts, rec.bodies[0]) {
+		t.Errorf("bad signature")
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(rec.bodies[0], &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload["event"] != "payment.refunded" || payload["category"] != CategoryOrder {
+		t.Errorf("payload = %v", payload)
+	}
+}
+
+func TestWebhookRefusesPrivateAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	err := NewWebhook(time.Second, false).Post(context.Background(), srv.URL, "s", "e", []byte("{}"))
+	if err == nil || !strings.Contains(err.Error(), "not public") {
+		t.Fatalf("err = %v, want refusal", err)
+	}
+}
+
+func TestNotifyFailsWhenNothingDelivered(t *testing.T) {
+	p := testPreferences()
+	p.Phone = ""
+	p.WebhookURL = ""
+	n, _ := newTestNotifier(t, p, 10)
+	n.email = func(ctx context.Context, req *email.EmailReq) error { return errors.New("smtp down") }
+	if err := n.Notify(context.Background(), refund); err == nil {
+		t.Fatal("want error")
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user/userservice"
+)
+
+// UserPreferences reads the preferences from the user service.
+type UserPreferences struct {
+	client userservice.Client
+}
+
+func NewUserPreferences(client userservice.Client) *UserPreferences {
+	return &UserPreferences{client: client}
+}
+
+func (u *UserPreferences) Preferences(ctx context.Context, userId uint32) (*Preferences, error) {
+	resp, err := u.client.GetNotificationPreferences(ctx, &user.GetNotificationPreferencesReq{UserId: userId})
+	if err != nil {
+		return nil, err
+	}
+	p := &Preferences{
+		Email:            resp.Email,
+		UnsubscribeToken: resp.UnsubscribeToken,
+		Location:         time.UTC,
+		Enabled:          map[string]map[string]bool{},
+	}
+	if s := resp.Settings; s != nil {
+		p.Phone = s.Phone
+		p.WebhookURL = s.WebhookUrl
+		p.WebhookSecret = s.WebhookSecret
+		p.QuietStart = int(s.QuietStart)
+		p.QuietEnd = int(s.QuietEnd)
+		if loc, err := time.LoadLocation(s.Timezone); err == nil {
+			p.Location = loc
+		}
+	}
+	for _, v := range resp.Preferences {
+		if p.Enabled[v.Category] == nil {
+			p.Enabled[v.Category] = map[string]bool{}
+		}
+		p.Enabled[v.Category][v.Channel] = v.Enabled
+	}
+	return p, nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// LogSMS is a stand-in SMS provider that writes the messages to the log.
+type LogSMS struct{}
+
+func (LogSMS) SendSMS(ctx context.Context, phone, text string) error {
+	klog.CtxInfof(ctx, "sms to %s: %s", maskPhone(phone), text)
+	return nil
+}
+
+func NewSMSProvider(provider string) (SMSProvider, error) {
+	switch provider {
+	case "log", "":
+		return LogSMS{}, nil
+	}
+	return nil, fmt.Errorf("unknown sms provider %q", provider)
+}
+
+// maskPhone keeps the last four digits.
+func maskPhone(phone string) string {
+	if len(phone) <= 4 {
+		return phone
+	}
+	return strings.Repeat("*", len(phone)-4) + phone[len(phone)-4:]
+}
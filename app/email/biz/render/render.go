@@ -0,0 +1,184 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/cloudwego/biz-demo/gomall/app/email/conf"
+)
+
+//go:embed templates locales
+var files embed.FS
+
+// Templates lists the emails that can be rendered.
+var Templates = []string{"order_confirmation", "shipping", "refund", "password_reset", "account_locked", "verify_email", "welcome", "delete_account"}
+
+const fallbackLocale = "en"
+
+type Shop struct {
+	Name string
+	URL  string
+}
+
+type Email struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+type Renderer struct {
+	shop          Shop
+	defaultLocale string
+	catalogs      map[string]map[string]string
+}
+
+// NewRenderer loads the message catalogs. Emails in a locale without a catalog are rendered in defaultLocale.
+func NewRenderer(shop Shop, defaultLocale string) (*Renderer, error) {
+	r := &Renderer{shop: shop, defaultLocale: defaultLocale, catalogs: map[string]map[string]string{}}
+	entries, err := fs.ReadDir(files, "locales")
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		data, err := files.ReadFile(path.Join("locales", e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		catalog := map[string]string{}
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			return nil, fmt.Errorf("locale %s: %w", e.Name(), err)
+		}
+		r.catalogs[strings.TrimSuffix(e.Name(), ".json")] = catalog
+	}
+	if _, ok := r.catalogs[r.defaultLocale]; !ok {
+		r.defaultLocale = fallbackLocale
+	}
+	return r, nil
+}
+
+// Locale maps a requested locale such as zh-CN to one with a catalog.
+func (r *Renderer) Locale(locale string) string {
+	locale = strings.ToLower(locale)
+	if i := strings.IndexAny(locale, "-_"); i >= 0 {
+		locale = locale[:i]
+	}
+	if _, ok := r.catalogs[locale]; ok {
+		return locale
+	}
+	return r.defaultLocale
+}
+
+// Render renders the subject, text and HTML body of the named email. The text body is
+// sent as the alternative for mail clients that do not show HTML.
+func (r *Renderer) Render(name, locale string, data map[string]any) (Email, error) {
+	if !validTemplate(name) {
+		return Email{}, fmt.Errorf("unknown email template %q", name)
+	}
+	locale = r.Locale(locale)
+	funcs := map[string]any{
+		"t":     r.translator(locale),
+		"money": money,
+	}
+	view := map[string]any{"Shop": r.shop, "Locale": locale, "Data": data}
+
+	text, err := texttemplate.New("").Funcs(funcs).ParseFS(files, "templates/layout.txt", "templates/"+name+".txt")
+	if err != nil {
+		return Email{}, err
+	}
+	html, err := htmltemplate.New("").Funcs(funcs).ParseFS(files, "templates/layout.html", "templates/"+name+".html")
+	if err != nil {
+		return Email{}, err
+	}
+
+	var subject, textBody, htmlBody bytes.Buffer
+	if err := text.ExecuteTemplate(&subject, "subject", view); err != nil {
+		return Email{}, err
+	}
+	if err := text.ExecuteTemplate(&textBody, "layout", view); err != nil {
+		return Email{}, err
+	}
+	if err := html.ExecuteTemplate(&htmlBody, "layout", view); err != nil {
+		return Email{}, err
+	}
+	return Email{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(textBody.String()) + "\n",
+		HTML:    htmlBody.String(),
+	}, nil
+}
+
+// T translates a catalog key outside of an email, e.g. for an SMS.
+func (r *Renderer) T(locale, key string, args ...any) string {
+	return r.translator(r.Locale(locale))(key, args...)
+}
+
+// translator looks keys up in the locale's catalog, then in English, and formats them with args.
+func (r *Renderer) translator(locale string) func(key string, args ...any) string {
+	return func(key string, args ...any) string {
+		format, ok := r.catalogs[locale][key]
+		if !ok {
+			format, ok = r.catalogs[fallbackLocale][key]
+		}
+		if !ok {
+			return key
+		}
+		if len(args) == 0 {
+			return format
+		}
+		return fmt.Sprintf(format, args...)
+	}
+}
+
+func money(v any) string {
+	switch n := v.(type) {
+	case float64:
+		return fmt.Sprintf("%.2f", n)
+	case float32:
+		return fmt.Sprintf("%.2f", n)
+	case int:
+		return fmt.Sprintf("%d.00", n)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func validTemplate(name string) bool {
+	for _, v := range Templates {
+		if v == name {
+			return true
+		}
+	}
+	return false
+}
+
+var Default *Renderer
+
+func Init() {
+	c := conf.GetConf().Email
+	var err error
+	Default, err = NewRenderer(Shop{Name: c.ShopName, URL: strings.TrimRight(c.ShopURL, "/")}, c.DefaultLocale)
+	if err != nil {
+		panic(err)
+	}
+}
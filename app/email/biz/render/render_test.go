@@ -0,0 +1,146 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func testRenderer(t *testing.T) *Renderer {
+	r, err := NewRenderer(Shop{Name: "CloudWeGo Shop", URL: "http://shop.test"}, "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestRenderOrderConfirmation(t *testing.T) {
+	r := testRenderer(t)
+	data := map[string]any{
+		"order_id":    "o-1",
+		"items":       []any{map[string]any{"name": "<Notebook>", "quantity": 2.0, "amount": 19.8}},
+		"shipping":    5.0,
+		"tax":         2.57,
+		"total":       27.37,
+		"has_invoice": true,
+	}
+	e, err := r.Render("order_confirmation", "en", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Subject != "Your order o-1 is confirmed" {
+		t.Errorf("got subject %q", e.Subject)
+	}
+	if !strings.Contains(e.HTML, "&lt;Notebook&gt;") {
+		t.Error("want the product name escaped in the HTML body")
+	}
+	if !strings.Contains(e.HTML, "$27.37") || !strings.Contains(e.HTML, "http://shop.test/order/o-1") {
+		t.Error("want the total and the order link in the HTML body")
+	}
+	for _, want := range []string{"- <Notebook> x 2: $19.80", "Tax: $2.57", "Total: $27.37", "invoice is attached"} {
+		if !strings.Contains(e.Text, want) {
+			t.Errorf("want %q in the text body:\n%s", want, e.Text)
+		}
+	}
+	if strings.Contains(e.Text, "<p>") {
+		t.Error("want no HTML in the text body")
+	}
+}
+
+func TestRenderLocales(t *testing.T) {
+	r := testRenderer(t)
+	e, err := r.Render("shipping", "zh-CN", map[string]any{"order_id": "o-1", "carrier": "CW", "tracking_number": "CW123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Subject != "您的订单 o-1 已发货" {
+		t.Errorf("got subject %q", e.Subject)
+	}
+	if !strings.Contains(e.HTML, `lang="zh"`) {
+		t.Error("want the html lang set to zh")
+	}
+
+	e, err = r.Render("welcome", "fr", map[string]any{"email": "a@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Subject != "Welcome to CloudWeGo Shop" {
+		t.Errorf("got subject %q for an unknown locale, want English", e.Subject)
+	}
+}
+
+func TestRenderAllTemplates(t *testing.T) {
+	r := testRenderer(t)
+	for _, name := range Templates {
+		for locale := range r.catalogs {
+			e, err := r.Render(name, locale, map[string]any{})
+			if err != nil {
+				t.Errorf("%s/%s: %v", name, locale, err)
+				continue
+			}
+			if e.Subject == "" || e.Text == "" || e.HTML == "" {
+				t.Errorf("%s/%s: got an empty part", name, locale)
+			}
+		}
+	}
+	if _, err := r.Render("missing", "en", nil); err == nil {
+		t.Error("want an error for an unknown template")
+	}
+}
+
+func TestCatalogsComplete(t *testing.T) {
+	r := testRenderer(t)
+	for locale, catalog := range r.catalogs {
+		for key := range r.catalogs[fallbackLocale] {
+			if _, ok := catalog[key]; !ok {
+				t.Errorf("locale %s misses %s", locale, key)
+			}
+		}
+	}
+}
+
+func TestRenderUnsubscribe(t *testing.T) {
+	r := testRenderer(t)
+	e, err := r.Render("refund", "en", map[string]any{
+		"order_id":        "o-1",
+		"amount":          9.9,
+		"refunded_total":  9.9,
+		"unsubscribe_url": "http://shop.test/notifications/unsubscribe?token=abc&category=order",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(e.Text, "We refunded $9.90 for your order o-1.") {
+		t.Errorf("got text body:\n%s", e.Text)
+	}
+	if !strings.Contains(e.Text, "Unsubscribe from these emails: http://shop.test/notifications/unsubscribe?token=abc&category=order") {
+		t.Errorf("want the unsubscribe link in the text body:\n%s", e.Text)
+	}
+	if !strings.Contains(e.HTML, `href="http://shop.test/notifications/unsubscribe?token=abc&amp;category=order"`) {
+		t.Error("want the unsubscribe link in the HTML body")
+	}
+
+	e, err = r.Render("welcome", "en", map[string]any{"email": "a@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(e.Text, "Unsubscribe") || strings.Contains(e.HTML, "Unsubscribe") {
+		t.Error("want no unsubscribe link without an unsubscribe url")
+	}
+	if got := r.T("zh", "sms.payment_refunded", "Shop", "9.90", "o-1"); got != "Shop：订单 o-1 已退款 $9.90。" {
+		t.Errorf("got sms %q", got)
+	}
+}
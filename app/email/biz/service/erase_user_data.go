@@ -0,0 +1,53 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/deadletter"
+	"github.com/cloudwego/biz-demo/gomall/app/email/infra/mq"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	email "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type EraseUserDataService struct {
+	ctx context.Context
+} // NewEraseUserDataService new EraseUserDataService
+func NewEraseUserDataService(ctx context.Context) *EraseUserDataService {
+	return &EraseUserDataService{ctx: ctx}
+}
+
+// Run deletes the dead letters to the user's email.
+func (s *EraseUserDataService) Run(req *email.EraseUserDataReq) (resp *email.EraseUserDataResp, err error) {
+	if err = authn.Require(s.ctx, authn.PermUsersErase); err != nil {
+		return nil, err
+	}
+	if req.Email == "" {
+		return nil, kerrors.NewBizStatusError(40000, "email is required")
+	}
+	store := deadletter.NewStoreFromConf(mq.JS)
+	letters, err := store.FindTo(s.ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range letters {
+		if err = store.Delete(s.ctx, l.Seq); err != nil {
+			return nil, err
+		}
+	}
+	return &email.EraseUserDataResp{}, nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/deadletter"
+	"github.com/cloudwego/biz-demo/gomall/app/email/infra/mq"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	email "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type ExportUserDataService struct {
+	ctx context.Context
+} // NewExportUserDataService new ExportUserDataService
+func NewExportUserDataService(ctx context.Context) *ExportUserDataService {
+	return &ExportUserDataService{ctx: ctx}
+}
+
+// exportedLetter leaves out the template data and the attachments, they may hold account
+// links that are still valid.
+type exportedLetter struct {
+	Subject  string    `json:"subject"`
+	Template string    `json:"template"`
+	Content  string    `json:"content"`
+	Reason   string    `json:"reason"`
+	DeadAt   time.Time `json:"dead_at"`
+}
+
+// Run exports the mails to the user that could not be delivered, sent mails are not kept.
+// Only the user service knows the email of the user, a user could ask for any address.
+func (s *ExportUserDataService) Run(req *email.ExportUserDataReq) (resp *email.ExportUserDataResp, err error) {
+	if err = authn.RequireService(s.ctx); err != nil {
+		return nil, err
+	}
+	if req.Email == "" {
+		return nil, kerrors.NewBizStatusError(40000, "email is required")
+	}
+	letters, err := deadletter.NewStoreFromConf(mq.JS).FindTo(s.ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	export := struct {
+		UndeliveredMails []exportedLetter `json:"undelivered_mails"`
+	}{UndeliveredMails: []exportedLetter{}}
+	for _, l := range letters {
+		export.UndeliveredMails = append(export.UndeliveredMails, exportedLetter{
+			Subject:  l.Req.Subject,
+			Template: l.Req.Template,
+			Content:  l.Req.Content,
+			Reason:   l.Reason,
+			DeadAt:   l.DeadAt,
+		})
+	}
+	data, err := json.Marshal(export)
+	if err != nil {
+		return nil, err
+	}
+	return &email.ExportUserDataResp{Data: data}, nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/deadletter"
+	"github.com/cloudwego/biz-demo/gomall/app/email/infra/mq"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	email "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+)
+
+const (
+	defaultDeadLetterLimit = 50
+	maxDeadLetterLimit     = 500
+)
+
+type ListDeadLettersService struct {
+	ctx context.Context
+} // NewListDeadLettersService new ListDeadLettersService
+func NewListDeadLettersService(ctx context.Context) *ListDeadLettersService {
+	return &ListDeadLettersService{ctx: ctx}
+}
+
+// Run lists the mails that could not be delivered
+func (s *ListDeadLettersService) Run(req *email.ListDeadLettersReq) (resp *email.ListDeadLettersResp, err error) {
+	if err = authn.Require(s.ctx, authn.PermNotificationsManage); err != nil {
+		return nil, err
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultDeadLetterLimit
+	}
+	limit = min(limit, maxDeadLetterLimit)
+	letters, next, err := deadletter.NewStoreFromConf(mq.JS).List(s.ctx, req.StartSeq, limit)
+	if err != nil {
+		return nil, err
+	}
+	resp = &email.ListDeadLettersResp{NextSeq: next}
+	for _, l := range letters {
+		letter := &email.DeadLetter{
+			Seq:        l.Seq,
+			Reason:     l.Reason,
+			Deliveries: int32(l.Deliveries),
+			DeadAt:     l.DeadAt.Unix(),
+		}
+		if l.Req != nil {
+			letter.To = l.Req.To
+			letter.Subject = l.Req.Subject
+			letter.Template = l.Req.Template
+		}
+		resp.Letters = append(resp.Letters, letter)
+	}
+	return resp, nil
+}
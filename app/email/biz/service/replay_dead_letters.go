@@ -0,0 +1,58 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/deadletter"
+	"github.com/cloudwego/biz-demo/gomall/app/email/infra/mq"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	email "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type ReplayDeadLettersService struct {
+	ctx context.Context
+} // NewReplayDeadLettersService new ReplayDeadLettersService
+func NewReplayDeadLettersService(ctx context.Context) *ReplayDeadLettersService {
+	return &ReplayDeadLettersService{ctx: ctx}
+}
+
+// Run queues the dead letters for delivery again
+func (s *ReplayDeadLettersService) Run(req *email.ReplayDeadLettersReq) (resp *email.ReplayDeadLettersResp, err error) {
+	if err = authn.Require(s.ctx, authn.PermNotificationsManage); err != nil {
+		return nil, err
+	}
+	if len(req.Seqs) == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "seqs are required")
+	}
+	store := deadletter.NewStoreFromConf(mq.JS)
+	resp = &email.ReplayDeadLettersResp{}
+	for _, seq := range req.Seqs {
+		err := store.Replay(s.ctx, seq)
+		if errors.Is(err, jetstream.ErrMsgNotFound) {
+			resp.NotFound = append(resp.NotFound, seq)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		resp.Replayed = append(resp.Replayed, seq)
+	}
+	return resp, nil
+}
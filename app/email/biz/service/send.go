@@ -16,8 +16,14 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/render"
+	"github.com/cloudwego/biz-demo/gomall/app/email/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/email/infra/notify"
 	email "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"github.com/cloudwego/kitex/pkg/kerrors"
 )
 
 type SendService struct {
@@ -27,9 +33,49 @@ func NewSendService(ctx context.Context) *SendService {
 	return &SendService{ctx: ctx}
 }
 
-// Run create note info
+// Run renders the email when it names a template and delivers it with the configured sender
 func (s *SendService) Run(req *email.EmailReq) (resp *email.EmailResp, err error) {
-	// Finish your business logic.
+	if req.To == "" {
+		return nil, kerrors.NewBizStatusError(40000, "recipient is required")
+	}
+	msg := &notify.Message{
+		From: req.From,
+		To:   strings.Split(req.To, ","),
+	}
+	if msg.From == "" {
+		msg.From = conf.GetConf().Email.From
+	}
 
-	return
+	if req.Template != "" {
+		data := map[string]any{}
+		if req.TemplateData != "" {
+			if err := json.Unmarshal([]byte(req.TemplateData), &data); err != nil {
+				return nil, kerrors.NewBizStatusError(40000, "template data must be a JSON object")
+			}
+		}
+		rendered, err := render.Default.Render(req.Template, req.Locale, data)
+		if err != nil {
+			return nil, kerrors.NewBizStatusError(40000, err.Error())
+		}
+		msg.Subject, msg.Text, msg.HTML = rendered.Subject, rendered.Text, rendered.HTML
+	} else {
+		msg.Subject = req.Subject
+		if strings.HasPrefix(req.ContentType, "text/html") {
+			msg.HTML = req.Content
+		} else {
+			msg.Text = req.Content
+		}
+	}
+	for _, a := range req.Attachments {
+		msg.Attachments = append(msg.Attachments, notify.Attachment{
+			Filename:    a.Filename,
+			ContentType: a.ContentType,
+			Content:     a.Content,
+		})
+	}
+
+	if err = notify.DefaultSender.Send(s.ctx, msg); err != nil {
+		return nil, err
+	}
+	return &email.EmailResp{}, nil
 }
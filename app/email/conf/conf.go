@@ -36,6 +36,68 @@ type Config struct {
 	MySQL    MySQL    `yaml:"mysql"`
 	Redis    Redis    `yaml:"redis"`
 	Registry Registry `yaml:"registry"`
+	Email    Email    `yaml:"email"`
+	Consumer Consumer `yaml:"consumer"`
+	Notifier Notifier `yaml:"notifier"`
+}
+
+// Notifier routes domain events to the channels users chose. Every user may get RateLimit
+// notifications per channel in RateWindowMinutes.
+type Notifier struct {
+	RateLimit         int     `yaml:"rate_limit"`
+	RateWindowMinutes int     `yaml:"rate_window_minutes"`
+	SMS               SMS     `yaml:"sms"`
+	Webhook           Webhook `yaml:"webhook"`
+}
+
+// SMS selects the SMS provider, only "log" which writes the messages to the log exists yet.
+type SMS struct {
+	Provider string `yaml:"provider"`
+}
+
+// Webhook calls to private and loopback addresses are refused unless AllowPrivate is set.
+type Webhook struct {
+	TimeoutSeconds int  `yaml:"timeout_seconds"`
+	AllowPrivate   bool `yaml:"allow_private"`
+}
+
+// Consumer configures the JetStream consumer of the email subject. Failed mails are redelivered
+// with exponential backoff and moved to the dead letter stream after MaxDeliver attempts.
+type Consumer struct {
+	Stream                string `yaml:"stream"`
+	Subject               string `yaml:"subject"`
+	Durable               string `yaml:"durable"`
+	MaxDeliver            int    `yaml:"max_deliver"`
+	BackoffBaseSeconds    int    `yaml:"backoff_base_seconds"`
+	BackoffMaxSeconds     int    `yaml:"backoff_max_seconds"`
+	DeadLetterStream      string `yaml:"dead_letter_stream"`
+	DeadLetterSubject     string `yaml:"dead_letter_subject"`
+	DeadLetterMaxAgeHours int    `yaml:"dead_letter_max_age_hours"`
+}
+
+// Email selects how mails are delivered. Backend is "smtp", "file" or "noop".
+type Email struct {
+	Backend       string `yaml:"backend"`
+	From          string `yaml:"from"`
+	DefaultLocale string `yaml:"default_locale"`
+	ShopName      string `yaml:"shop_name"`
+	ShopURL       string `yaml:"shop_url"`
+	SMTP          SMTP   `yaml:"smtp"`
+	File          File   `yaml:"file"`
+}
+
+// SMTP credentials are read from SMTP_USERNAME and SMTP_PASSWORD. TLS is "starttls",
+// "implicit" or "none".
+type SMTP struct {
+	Host           string `yaml:"host"`
+	Port           int    `yaml:"port"`
+	TLS            string `yaml:"tls"`
+	TimeoutSeconds int    `yaml:"timeout_seconds"`
+}
+
+// File appends every mail to an mbox file, to read them with a mail client during development.
+type File struct {
+	Path string `yaml:"path"`
 }
 
 type MySQL struct {
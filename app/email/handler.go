@@ -30,3 +30,31 @@ func (s *EmailServiceImpl) Send(ctx context.Context, req *email.EmailReq) (resp
 
 	return resp, err
 }
+
+// ListDeadLetters implements the EmailServiceImpl interface.
+func (s *EmailServiceImpl) ListDeadLetters(ctx context.Context, req *email.ListDeadLettersReq) (resp *email.ListDeadLettersResp, err error) {
+	resp, err = service.NewListDeadLettersService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ReplayDeadLetters implements the EmailServiceImpl interface.
+func (s *EmailServiceImpl) ReplayDeadLetters(ctx context.Context, req *email.ReplayDeadLettersReq) (resp *email.ReplayDeadLettersResp, err error) {
+	resp, err = service.NewReplayDeadLettersService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ExportUserData implements the EmailServiceImpl interface.
+func (s *EmailServiceImpl) ExportUserData(ctx context.Context, req *email.ExportUserDataReq) (resp *email.ExportUserDataResp, err error) {
+	resp, err = service.NewExportUserDataService(ctx).Run(req)
+
+	return resp, err
+}
+
+// EraseUserData implements the EmailServiceImpl interface.
+func (s *EmailServiceImpl) EraseUserData(ctx context.Context, req *email.EraseUserDataReq) (resp *email.EraseUserDataResp, err error) {
+	resp, err = service.NewEraseUserDataService(ctx).Run(req)
+
+	return resp, err
+}
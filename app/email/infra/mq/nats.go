@@ -16,10 +16,12 @@ package mq
 
 import (
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 )
 
 var (
 	Nc  *nats.Conn
+	JS  jetstream.JetStream
 	err error
 )
 
@@ -28,4 +30,8 @@ func Init() {
 	if err != nil {
 		panic(err)
 	}
+	JS, err = jetstream.New(Nc)
+	if err != nil {
+		panic(err)
+	}
 }
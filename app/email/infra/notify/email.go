@@ -15,17 +15,58 @@
 package notify
 
 import (
-	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/email/conf"
 	"github.com/kr/pretty"
 )
 
+// Sender delivers rendered emails.
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+var DefaultSender Sender
+
+// Init selects the sender configured for the environment.
+func Init() {
+	c := conf.GetConf().Email
+	switch c.Backend {
+	case "smtp":
+		DefaultSender = NewSMTPEmail(SMTPOptions{
+			Host:     c.SMTP.Host,
+			Port:     c.SMTP.Port,
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			TLS:      c.SMTP.TLS,
+			Timeout:  time.Duration(c.SMTP.TimeoutSeconds) * time.Second,
+		})
+	case "file":
+		DefaultSender = NewFileEmail(c.File.Path)
+	case "noop", "":
+		DefaultSender = NewNoopEmail()
+	default:
+		panic(fmt.Sprintf("unknown email backend %q", c.Backend))
+	}
+}
+
 type NoopEmail struct{}
 
-func (e *NoopEmail) Send(req *email.EmailReq) error {
-	pretty.Printf("%v", req)
+func (e *NoopEmail) Send(ctx context.Context, msg *Message) error {
+	// print attachment names and sizes instead of their content
+	printed := *msg
+	printed.Attachments = nil
+	for _, a := range msg.Attachments {
+		a.Content = []byte(fmt.Sprintf("<%d bytes>", len(a.Content)))
+		printed.Attachments = append(printed.Attachments, a)
+	}
+	pretty.Printf("%v", printed)
 	return nil
 }
 
-func NewNoopEmail() NoopEmail {
-	return NoopEmail{}
+func NewNoopEmail() *NoopEmail {
+	return &NoopEmail{}
 }
@@ -0,0 +1,43 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileEmailSend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mail", "mail.mbox")
+	sender := NewFileEmail(path)
+	for _, body := range []string{"first", "From the shop"} {
+		if err := sender.Send(context.Background(), &Message{From: "a@example.com", To: []string{"b@example.com"}, Subject: "Hi", Text: body}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Count(string(data), "\nFrom a@example.com ") + 1; got != 2 || !strings.HasPrefix(string(data), "From a@example.com ") {
+		t.Errorf("got %d messages in the mbox, want 2", got)
+	}
+	if !strings.Contains(string(data), "\n>From the shop") {
+		t.Error("want body lines starting with From quoted")
+	}
+}
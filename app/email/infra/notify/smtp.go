@@ -0,0 +1,133 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+type SMTPOptions struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// TLS is "starttls" (the default), "implicit" for SMTPS, or "none"
+	TLS     string
+	Timeout time.Duration
+	// TLSConfig overrides the default TLS configuration, e.g. to trust a test certificate
+	TLSConfig *tls.Config
+}
+
+// SMTPEmail sends emails through an SMTP relay.
+type SMTPEmail struct {
+	opts SMTPOptions
+}
+
+func NewSMTPEmail(opts SMTPOptions) *SMTPEmail {
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+	if opts.TLS == "" {
+		opts.TLS = "starttls"
+	}
+	if opts.TLSConfig == nil {
+		opts.TLSConfig = &tls.Config{ServerName: opts.Host, MinVersion: tls.VersionTLS12}
+	}
+	return &SMTPEmail{opts: opts}
+}
+
+func (e *SMTPEmail) Send(ctx context.Context, msg *Message) error {
+	from, to, err := msg.Envelope()
+	if err != nil {
+		return err
+	}
+	data, err := msg.Bytes(time.Now())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.opts.Timeout)
+	defer cancel()
+	conn, err := e.dial(ctx)
+	if err != nil {
+		return err
+	}
+	// the deadline covers the whole transaction, net/smtp has no context support
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close() //nolint:errcheck
+		return err
+	}
+	c, err := smtp.NewClient(conn, e.opts.Host)
+	if err != nil {
+		conn.Close() //nolint:errcheck
+		return err
+	}
+	defer c.Close() //nolint:errcheck
+
+	if e.opts.TLS == "starttls" {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			return errors.New("smtp server does not support STARTTLS")
+		}
+		if err := c.StartTLS(e.opts.TLSConfig); err != nil {
+			return err
+		}
+	}
+	if e.opts.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", e.opts.Username, e.opts.Password, e.opts.Host)); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+	for _, v := range to {
+		if err := c.Rcpt(v); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+func (e *SMTPEmail) dial(ctx context.Context) (net.Conn, error) {
+	addr := net.JoinHostPort(e.opts.Host, strconv.Itoa(e.opts.Port))
+	switch e.opts.TLS {
+	case "implicit":
+		d := &tls.Dialer{Config: e.opts.TLSConfig}
+		return d.DialContext(ctx, "tcp", addr)
+	case "starttls", "none":
+		var d net.Dialer
+		return d.DialContext(ctx, "tcp", addr)
+	default:
+		return nil, fmt.Errorf("unknown smtp tls mode %q", e.opts.TLS)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeSMTPServer accepts one mail per connection and records the transaction.
+type fakeSMTPServer struct {
+	ln   net.Listener
+	mu   sync.Mutex
+	auth string
+	from string
+	to   []string
+	data string
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeSMTPServer{ln: ln}
+	t.Cleanup(func() { ln.Close() }) //nolint:errcheck
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeSMTPServer) port() int {
+	return s.ln.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTPServer) serve(conn net.Conn) {
+	defer conn.Close() //nolint:errcheck
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 fake ESMTP")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		s.mu.Lock()
+		switch cmd {
+		case "EHLO":
+			_ = tp.PrintfLine("250-fake")
+			_ = tp.PrintfLine("250 AUTH PLAIN")
+		case "AUTH":
+			s.auth = strings.TrimPrefix(line, "AUTH PLAIN ")
+			_ = tp.PrintfLine("235 ok")
+		case "MAIL":
+			s.from = line
+			_ = tp.PrintfLine("250 ok")
+		case "RCPT":
+			s.to = append(s.to, line)
+			_ = tp.PrintfLine("250 ok")
+		case "DATA":
+			_ = tp.PrintfLine("354 go ahead")
+			data, _ := tp.ReadDotBytes()
+			s.data = string(data)
+			_ = tp.PrintfLine("250 queued")
+		case "QUIT":
+			_ = tp.PrintfLine("221 bye")
+			s.mu.Unlock()
+			return
+		default:
+			_ = tp.PrintfLine("502 unknown command")
+		}
+		s.mu.Unlock()
+	}
+}
+
+func TestSMTPEmailSend(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	sender := NewSMTPEmail(SMTPOptions{
+		Host:     "127.0.0.1",
+		Port:     server.port(),
+		Username: "shop",
+		Password: "secret",
+		TLS:      "none",
+		Timeout:  5 * time.Second,
+	})
+	msg := &Message{
+		From:    "Shop <noreply@example.com>",
+		To:      []string{"Alice <alice@example.com>"},
+		Subject: "Hello",
+		Text:    "plain body",
+		HTML:    "<p>html body</p>",
+	}
+	if err := sender.Send(context.Background(), msg); err != nil {
+		t.Fatal(err)
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	auth, _ := base64.StdEncoding.DecodeString(server.auth)
+	if string(auth) != "\x00shop\x00secret" {
+		t.Errorf("got auth %q", auth)
+	}
+	if !strings.HasPrefix(server.from, "MAIL FROM:<noreply@example.com>") {
+		t.Errorf("got sender %q", server.from)
+	}
+	if len(server.to) != 1 || server.to[0] != "RCPT TO:<alice@example.com>" {
+		t.Errorf("got recipients %q", server.to)
+	}
+	r := textproto.NewReader(bufio.NewReader(strings.NewReader(server.data)))
+	header, err := r.ReadMIMEHeader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header.Get("Subject") != "Hello" || !strings.HasPrefix(header.Get("Content-Type"), "multipart/alternative") {
+		t.Errorf("got headers %v", header)
+	}
+}
+
+func TestSMTPEmailRequiresStartTLS(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	sender := NewSMTPEmail(SMTPOptions{Host: "127.0.0.1", Port: server.port(), TLS: "starttls"})
+	err := sender.Send(context.Background(), &Message{From: "a@example.com", To: []string{"b@example.com"}, Text: "x"})
+	if err == nil || !strings.Contains(err.Error(), "STARTTLS") {
+		t.Errorf("got error %v, want a missing STARTTLS error", err)
+	}
+}
@@ -0,0 +1,464 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/biz/service"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/biz/utils"
+	account "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/account"
+	common "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
+	"github.com/cloudwego/hertz/pkg/app"
+	hertzUtils "github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// NotificationSettings .
+// @router /account/notifications [GET]
+func NotificationSettings(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req common.Empty
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewNotificationSettingsService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "account-notifications", hertzUtils.H{"error": err})
+		return
+	}
+
+	c.HTML(consts.StatusOK, "account-notifications", utils.WarpResponse(ctx, c, resp))
+}
+
+// UpdateNotificationSettings .
+// @router /account/notifications [POST]
+func UpdateNotificationSettings(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req account.NotificationsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	err = service.NewUpdateNotificationSettingsService(ctx, c).Run(&req)
+	resp, formErr := service.NewNotificationSettingsService(ctx, c).Run(&common.Empty{})
+	if formErr != nil {
+		resp = hertzUtils.H{}
+	}
+	if err != nil {
+		resp["warning"] = err
+	} else {
+		resp["saved"] = true
+	}
+
+	c.HTML(consts.StatusOK, "account-notifications", utils.WarpResponse(ctx, c, resp))
+}
+
+// UnsubscribePage .
+// @router /notifications/unsubscribe [GET]
+func UnsubscribePage(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req account.UnsubscribeReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewUnsubscribePageService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "unsubscribe", hertzUtils.H{"error": err})
+		return
+	}
+
+	c.HTML(consts.StatusOK, "unsubscribe", utils.WarpResponse(ctx, c, resp))
+}
+
+// Unsubscribe .
+// @router /notifications/unsubscribe [POST]
+func Unsubscribe(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req account.UnsubscribeReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewUnsubscribeService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "unsubscribe", hertzUtils.H{"error": err})
+		return
+	}
+
+	c.HTML(consts.StatusOK, "unsubscribe", utils.WarpResponse(ctx, c, resp))
+}
+
+// SessionList .
+// @router /account/sessions [GET]
+func SessionList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req common.Empty
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewSessionListService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "sessions", hertzUtils.H{"error": err})
+		return
+	}
+
+	c.HTML(consts.StatusOK, "sessions", utils.WarpResponse(ctx, c, resp))
+}
+
+// RevokeSession .
+// @router /account/sessions/revoke [POST]
+func RevokeSession(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req account.RevokeSessionReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	err = service.NewRevokeSessionService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "sessions", hertzUtils.H{"error": err})
+		return
+	}
+
+	c.Redirect(consts.StatusFound, []byte("/account/sessions"))
+}
+
+// LogoutAll .
+// @router /account/sessions/logout_all [POST]
+func LogoutAll(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req common.Empty
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	err = service.NewLogoutAllService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "sessions", hertzUtils.H{"error": err})
+		return
+	}
+
+	c.Redirect(consts.StatusFound, []byte("/"))
+}
+
+// ResendVerification .
+// @router /account/verify-email [POST]
+func ResendVerification(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req common.Empty
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewResendVerificationService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "verify-email", utils.WarpResponse(ctx, c, hertzUtils.H{"title": "Verify email", "error": err}))
+		return
+	}
+
+	c.HTML(consts.StatusOK, "verify-email", utils.WarpResponse(ctx, c, resp))
+}
+
+// Security .
+// @router /account/security [GET]
+func Security(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req common.Empty
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewSecurityService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "security", utils.WarpResponse(ctx, c, hertzUtils.H{"title": "Security", "error": err}))
+		return
+	}
+
+	c.HTML(consts.StatusOK, "security", utils.WarpResponse(ctx, c, resp))
+}
+
+// EnrollTotp .
+// @router /account/security/totp/enroll [POST]
+func EnrollTotp(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req common.Empty
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewEnrollTotpService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "security", utils.WarpResponse(ctx, c, hertzUtils.H{"title": "Security", "error": err}))
+		return
+	}
+
+	c.HTML(consts.StatusOK, "security", utils.WarpResponse(ctx, c, resp))
+}
+
+// ConfirmTotp .
+// @router /account/security/totp/confirm [POST]
+func ConfirmTotp(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req account.TotpCodeReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewConfirmTotpService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "security", utils.WarpResponse(ctx, c, hertzUtils.H{"title": "Security", "error": err}))
+		return
+	}
+
+	c.HTML(consts.StatusOK, "security", utils.WarpResponse(ctx, c, resp))
+}
+
+// DisableTotp .
+// @router /account/security/totp/disable [POST]
+func DisableTotp(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req account.DisableTotpReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	err = service.NewDisableTotpService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "security", utils.WarpResponse(ctx, c, hertzUtils.H{"title": "Security", "error": err}))
+		return
+	}
+
+	c.Redirect(consts.StatusFound, []byte("/account/security"))
+}
+
+// RegenerateRecoveryCodes .
+// @router /account/security/recovery_codes [POST]
+func RegenerateRecoveryCodes(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req account.TotpCodeReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewRegenerateRecoveryCodesService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "security", utils.WarpResponse(ctx, c, hertzUtils.H{"title": "Security", "error": err}))
+		return
+	}
+
+	c.HTML(consts.StatusOK, "security", utils.WarpResponse(ctx, c, resp))
+}
+
+// Profile .
+// @router /account/profile [GET]
+func Profile(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req common.Empty
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewProfileService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "profile", utils.WarpResponse(ctx, c, hertzUtils.H{"title": "Profile", "error": err}))
+		return
+	}
+
+	c.HTML(consts.StatusOK, "profile", utils.WarpResponse(ctx, c, resp))
+}
+
+// UpdateProfile .
+// @router /account/profile [POST]
+func UpdateProfile(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req account.ProfileReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	err = service.NewUpdateProfileService(ctx, c).Run(&req)
+	if err != nil {
+		profileError(ctx, c, err)
+		return
+	}
+
+	c.Redirect(consts.StatusFound, []byte("/account/profile"))
+}
+
+// CreateAddress .
+// @router /account/addresses [POST]
+func CreateAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req account.AddressReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	err = service.NewCreateAddressService(ctx, c).Run(&req)
+	if err != nil {
+		profileError(ctx, c, err)
+		return
+	}
+
+	c.Redirect(consts.StatusFound, []byte("/account/profile"))
+}
+
+// UpdateAddress .
+// @router /account/addresses/update [POST]
+func UpdateAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req account.AddressReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	err = service.NewUpdateAddressService(ctx, c).Run(&req)
+	if err != nil {
+		profileError(ctx, c, err)
+		return
+	}
+
+	c.Redirect(consts.StatusFound, []byte("/account/profile"))
+}
+
+// DeleteAddress .
+// @router /account/addresses/delete [POST]
+func DeleteAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req account.AddressIdReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	err = service.NewDeleteAddressService(ctx, c).Run(&req)
+	if err != nil {
+		profileError(ctx, c, err)
+		return
+	}
+
+	c.Redirect(consts.StatusFound, []byte("/account/profile"))
+}
+
+// SetDefaultAddress .
+// @router /account/addresses/default [POST]
+func SetDefaultAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req account.AddressIdReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	err = service.NewSetDefaultAddressService(ctx, c).Run(&req)
+	if err != nil {
+		profileError(ctx, c, err)
+		return
+	}
+
+	c.Redirect(consts.StatusFound, []byte("/account/profile"))
+}
+
+// profileError shows the profile page again with the error of a change.
+func profileError(ctx context.Context, c *app.RequestContext, err error) {
+	resp, pageErr := service.NewProfileService(ctx, c).Run(&common.Empty{})
+	if pageErr != nil {
+		resp = hertzUtils.H{"title": "Profile"}
+	}
+	resp["error"] = err
+	c.HTML(consts.StatusOK, "profile", utils.WarpResponse(ctx, c, resp))
+}
+
+// ExportData .
+// @router /account/export [GET]
+func ExportData(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req common.Empty
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewExportDataService(ctx, c).Run(&req)
+	if err != nil {
+		profileError(ctx, c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.Filename))
+	c.Data(consts.StatusOK, "application/zip", resp.Data)
+}
+
+// DeleteAccount .
+// @router /account/delete [POST]
+func DeleteAccount(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req account.DeleteAccountReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewDeleteAccountService(ctx, c).Run(&req)
+	if err != nil {
+		profileError(ctx, c, err)
+		return
+	}
+
+	c.HTML(consts.StatusOK, "profile", utils.WarpResponse(ctx, c, resp))
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by hertz generator.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/biz/service"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/biz/utils"
+	admin "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/admin"
+	"github.com/cloudwego/hertz/pkg/app"
+	hertzUtils "github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// Roles .
+// @router /admin/roles [GET]
+func Roles(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.RolesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewAdminRolesService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "admin-roles", hertzUtils.H{"error": err})
+		return
+	}
+
+	c.HTML(consts.StatusOK, "admin-roles", utils.WarpResponse(ctx, c, resp))
+}
+
+// GrantRole .
+// @router /admin/roles/grant [POST]
+func GrantRole(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.RoleChangeReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	roleChanged(ctx, c, &req, service.NewAdminRoleChangeService(ctx, c).Run(&req, true))
+}
+
+// RevokeRole .
+// @router /admin/roles/revoke [POST]
+func RevokeRole(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req admin.RoleChangeReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	roleChanged(ctx, c, &req, service.NewAdminRoleChangeService(ctx, c).Run(&req, false))
+}
+
+// roleChanged shows the roles page of the user, with the error of the change if it failed.
+func roleChanged(ctx context.Context, c *app.RequestContext, req *admin.RoleChangeReq, err error) {
+	if err != nil {
+		resp, listErr := service.NewAdminRolesService(ctx, c).Run(&admin.RolesReq{TargetUserId: req.TargetUserId})
+		if listErr != nil {
+			resp = hertzUtils.H{}
+		}
+		resp["warning"] = err
+		c.HTML(consts.StatusOK, "admin-roles", utils.WarpResponse(ctx, c, resp))
+		return
+	}
+
+	c.Redirect(consts.StatusFound, []byte(fmt.Sprintf("/admin/roles?user_id=%d", req.TargetUserId)))
+}
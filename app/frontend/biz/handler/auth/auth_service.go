@@ -85,3 +85,208 @@ func Logout(ctx context.Context, c *app.RequestContext) {
 
 	c.Redirect(consts.StatusFound, []byte(redirect))
 }
+
+// Jwks .
+// @router /.well-known/jwks.json [GET]
+func Jwks(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req common.Empty
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewJwksService(ctx, c).Run(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusServiceUnavailable, err)
+		return
+	}
+
+	// keys are published ahead of their use, verifiers may cache them for a while
+	c.Header("Cache-Control", "public, max-age=300")
+	c.Data(consts.StatusOK, "application/json", []byte(resp))
+}
+
+// ForgotPassword .
+// @router /auth/forgot_password [POST]
+func ForgotPassword(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req auth.ForgotPasswordReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewForgotPasswordService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "forgot-password", hertzUtils.H{"title": "Forgot password", "error": err})
+		return
+	}
+
+	c.HTML(consts.StatusOK, "forgot-password", resp)
+}
+
+// ResetPassword .
+// @router /auth/reset_password [POST]
+func ResetPassword(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req auth.ResetPasswordReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	err = service.NewResetPasswordService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "reset-password", hertzUtils.H{"title": "Reset password", "token": req.Token, "error": err})
+		return
+	}
+
+	c.HTML(consts.StatusOK, "sign-in", hertzUtils.H{
+		"title":   "Sign in",
+		"message": "Your password was reset, sign in with the new one.",
+	})
+}
+
+// VerifyEmail .
+// @router /verify-email [GET]
+func VerifyEmail(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req auth.VerifyEmailReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewVerifyEmailService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "verify-email", utils.WarpResponse(ctx, c, hertzUtils.H{"title": "Verify email", "error": err}))
+		return
+	}
+
+	c.HTML(consts.StatusOK, "verify-email", utils.WarpResponse(ctx, c, resp))
+}
+
+// UnlockAccount .
+// @router /unlock-account [GET]
+func UnlockAccount(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req auth.UnlockAccountReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewUnlockAccountService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "unlock-account", utils.WarpResponse(ctx, c, hertzUtils.H{"title": "Unlock account", "error": err}))
+		return
+	}
+
+	c.HTML(consts.StatusOK, "unlock-account", utils.WarpResponse(ctx, c, resp))
+}
+
+// TwoFactor .
+// @router /auth/two_factor [POST]
+func TwoFactor(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req auth.TwoFactorReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewTwoFactorService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "two-factor", utils.WarpResponse(ctx, c, hertzUtils.H{"title": "Two-factor authentication", "next": req.Next, "error": err}))
+		return
+	}
+
+	c.Redirect(consts.StatusFound, []byte(resp))
+}
+
+// OidcLogin .
+// @router /auth/oidc/:provider [GET]
+func OidcLogin(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req auth.OidcLoginReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewOidcLoginService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "sign-in", utils.WarpResponse(ctx, c, hertzUtils.H{"title": "Sign in", "next": req.Next, "providers": service.SignInProviders(ctx), "error": err}))
+		return
+	}
+
+	c.Redirect(consts.StatusFound, []byte(resp))
+}
+
+// OidcCallback .
+// @router /auth/oidc/:provider/callback [GET]
+func OidcCallback(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req auth.OidcCallbackReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewOidcCallbackService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "sign-in", utils.WarpResponse(ctx, c, hertzUtils.H{"title": "Sign in", "providers": service.SignInProviders(ctx), "error": err}))
+		return
+	}
+
+	c.Redirect(consts.StatusFound, []byte(resp))
+}
+
+// ConfirmAccountDeletion .
+// @router /auth/delete_account [POST]
+func ConfirmAccountDeletion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req auth.ConfirmAccountDeletionReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewConfirmAccountDeletionService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "delete-account", utils.WarpResponse(ctx, c, hertzUtils.H{"title": "Delete account", "token": req.Token, "error": err}))
+		return
+	}
+
+	c.Redirect(consts.StatusFound, []byte(resp))
+}
+
+// AccountDeletion .
+// @router /account-deletion/:job_id [GET]
+func AccountDeletion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req auth.AccountDeletionReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewAccountDeletionService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "account-deletion", utils.WarpResponse(ctx, c, hertzUtils.H{"title": "Account deletion", "error": err}))
+		return
+	}
+
+	c.HTML(consts.StatusOK, "account-deletion", utils.WarpResponse(ctx, c, resp))
+}
@@ -20,7 +20,6 @@ import (
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/biz/service"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/biz/utils"
 	checkout "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/checkout"
-	common "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
 	"github.com/cloudwego/hertz/pkg/app"
 	hertzUtils "github.com/cloudwego/hertz/pkg/common/utils"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
@@ -66,11 +65,68 @@ func CheckoutWaiting(ctx context.Context, c *app.RequestContext) {
 	c.HTML(consts.StatusOK, "waiting", utils.WarpResponse(ctx, c, resp))
 }
 
+// ShippingQuote .
+// @router /checkout/shipping [GET]
+func ShippingQuote(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req checkout.ShippingQuoteReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusBadRequest, err)
+		return
+	}
+
+	resp, err := service.NewShippingQuoteService(ctx, c).Run(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusInternalServerError, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
+// CheckoutQuote .
+// @router /checkout/quote [GET]
+func CheckoutQuote(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req checkout.CheckoutQuoteReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusBadRequest, err)
+		return
+	}
+
+	resp, err := service.NewCheckoutQuoteService(ctx, c).Run(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusInternalServerError, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
+// CheckoutStatus .
+// @router /checkout/status [GET]
+func CheckoutStatus(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req checkout.CheckoutStatusReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusBadRequest, err)
+		return
+	}
+
+	resp, err := service.NewCheckoutStatusService(ctx, c).Run(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusInternalServerError, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
+
 // CheckoutResult .
 // @router /checkout/result [GET]
 func CheckoutResult(ctx context.Context, c *app.RequestContext) {
 	var err error
-	var req common.Empty
+	var req checkout.CheckoutResultReq
 	err = c.BindAndValidate(&req)
 	if err != nil {
 		c.HTML(consts.StatusOK, "waiting", utils.WarpResponse(ctx, c, hertzUtils.H{"warning": err}))
@@ -85,3 +141,62 @@ func CheckoutResult(ctx context.Context, c *app.RequestContext) {
 
 	c.HTML(consts.StatusOK, "result", utils.WarpResponse(ctx, c, resp))
 }
+
+// FlashSaleCheckout .
+// @router /checkout/flashsale [GET]
+func FlashSaleCheckout(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req checkout.CheckoutReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "checkout", utils.WarpResponse(ctx, c, hertzUtils.H{"warning": err}))
+		return
+	}
+
+	resp, err := service.NewFlashSaleCheckoutService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "checkout", utils.WarpResponse(ctx, c, hertzUtils.H{"error": err}))
+		return
+	}
+
+	c.HTML(consts.StatusOK, "checkout", utils.WarpResponse(ctx, c, resp))
+}
+
+// FlashSaleWaiting .
+// @router /checkout/flashsale/waiting [POST]
+func FlashSaleWaiting(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req checkout.CheckoutReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "waiting", utils.WarpResponse(ctx, c, hertzUtils.H{"warning": err}))
+		return
+	}
+
+	resp, err := service.NewFlashSaleWaitingService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "waiting", utils.WarpResponse(ctx, c, hertzUtils.H{"error": err}))
+		return
+	}
+
+	c.HTML(consts.StatusOK, "waiting", utils.WarpResponse(ctx, c, resp))
+}
+
+// FlashSaleStatus .
+// @router /checkout/flashsale/status [GET]
+func FlashSaleStatus(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req checkout.FlashSaleStatusReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusBadRequest, err)
+		return
+	}
+
+	resp, err := service.NewFlashSaleStatusService(ctx, c).Run(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusInternalServerError, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
@@ -53,6 +53,51 @@ func TestCheckoutWaiting(t *testing.T) {
 	// assert.DeepEqual(t, "null", string(resp.Body()))
 }
 
+func TestShippingQuote(t *testing.T) {
+	h := server.Default()
+	h.GET("/checkout/shipping", ShippingQuote)
+	path := "/checkout/shipping"                              // todo: you can customize query
+	body := &ut.Body{Body: bytes.NewBufferString(""), Len: 1} // todo: you can customize body
+	header := ut.Header{}                                     // todo: you can customize header
+	w := ut.PerformRequest(h.Engine, "GET", path, body, header)
+	resp := w.Result()
+	t.Log(string(resp.Body()))
+
+	// todo edit your unit test.
+	// assert.DeepEqual(t, 200, resp.StatusCode())
+	// assert.DeepEqual(t, "null", string(resp.Body()))
+}
+
+func TestCheckoutQuote(t *testing.T) {
+	h := server.Default()
+	h.GET("/checkout/quote", CheckoutQuote)
+	path := "/checkout/quote"                                 // todo: you can customize query
+	body := &ut.Body{Body: bytes.NewBufferString(""), Len: 1} // todo: you can customize body
+	header := ut.Header{}                                     // todo: you can customize header
+	w := ut.PerformRequest(h.Engine, "GET", path, body, header)
+	resp := w.Result()
+	t.Log(string(resp.Body()))
+
+	// todo edit your unit test.
+	// assert.DeepEqual(t, 200, resp.StatusCode())
+	// assert.DeepEqual(t, "null", string(resp.Body()))
+}
+
+func TestCheckoutStatus(t *testing.T) {
+	h := server.Default()
+	h.GET("/checkout/status", CheckoutStatus)
+	path := "/checkout/status"                                // todo: you can customize query
+	body := &ut.Body{Body: bytes.NewBufferString(""), Len: 1} // todo: you can customize body
+	header := ut.Header{}                                     // todo: you can customize header
+	w := ut.PerformRequest(h.Engine, "GET", path, body, header)
+	resp := w.Result()
+	t.Log(string(resp.Body()))
+
+	// todo edit your unit test.
+	// assert.DeepEqual(t, 200, resp.StatusCode())
+	// assert.DeepEqual(t, "null", string(resp.Body()))
+}
+
 func TestCheckoutResult(t *testing.T) {
 	h := server.Default()
 	h.GET("/checkout/result", CheckoutResult)
@@ -67,3 +112,48 @@ func TestCheckoutResult(t *testing.T) {
 	// assert.DeepEqual(t, 200, resp.StatusCode())
 	// assert.DeepEqual(t, "null", string(resp.Body()))
 }
+
+func TestFlashSaleCheckout(t *testing.T) {
+	h := server.Default()
+	h.GET("/checkout/flashsale", FlashSaleCheckout)
+	path := "/checkout/flashsale"                             // todo: you can customize query
+	body := &ut.Body{Body: bytes.NewBufferString(""), Len: 1} // todo: you can customize body
+	header := ut.Header{}                                     // todo: you can customize header
+	w := ut.PerformRequest(h.Engine, "GET", path, body, header)
+	resp := w.Result()
+	t.Log(string(resp.Body()))
+
+	// todo edit your unit test.
+	// assert.DeepEqual(t, 200, resp.StatusCode())
+	// assert.DeepEqual(t, "null", string(resp.Body()))
+}
+
+func TestFlashSaleWaiting(t *testing.T) {
+	h := server.Default()
+	h.POST("/checkout/flashsale/waiting", FlashSaleWaiting)
+	path := "/checkout/flashsale/waiting"                     // todo: you can customize query
+	body := &ut.Body{Body: bytes.NewBufferString(""), Len: 1} // todo: you can customize body
+	header := ut.Header{}                                     // todo: you can customize header
+	w := ut.PerformRequest(h.Engine, "POST", path, body, header)
+	resp := w.Result()
+	t.Log(string(resp.Body()))
+
+	// todo edit your unit test.
+	// assert.DeepEqual(t, 200, resp.StatusCode())
+	// assert.DeepEqual(t, "null", string(resp.Body()))
+}
+
+func TestFlashSaleStatus(t *testing.T) {
+	h := server.Default()
+	h.GET("/checkout/flashsale/status", FlashSaleStatus)
+	path := "/checkout/flashsale/status"                      // todo: you can customize query
+	body := &ut.Body{Body: bytes.NewBufferString(""), Len: 1} // todo: you can customize body
+	header := ut.Header{}                                     // todo: you can customize header
+	w := ut.PerformRequest(h.Engine, "GET", path, body, header)
+	resp := w.Result()
+	t.Log(string(resp.Body()))
+
+	// todo edit your unit test.
+	// assert.DeepEqual(t, 200, resp.StatusCode())
+	// assert.DeepEqual(t, "null", string(resp.Body()))
+}
@@ -16,10 +16,11 @@ package order
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/biz/service"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/biz/utils"
-	common "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
+	order "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/order"
 	"github.com/cloudwego/hertz/pkg/app"
 	hertzUtils "github.com/cloudwego/hertz/pkg/common/utils"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
@@ -29,7 +30,7 @@ import (
 // @router /order [GET]
 func OrderList(ctx context.Context, c *app.RequestContext) {
 	var err error
-	var req common.Empty
+	var req order.OrderListReq
 	err = c.BindAndValidate(&req)
 	if err != nil {
 		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
@@ -44,3 +45,89 @@ func OrderList(ctx context.Context, c *app.RequestContext) {
 
 	c.HTML(consts.StatusOK, "order", utils.WarpResponse(ctx, c, resp))
 }
+
+// OrderDetail .
+// @router /order/:id [GET]
+func OrderDetail(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.OrderDetailReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewOrderDetailService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "order-detail", hertzUtils.H{"error": err})
+		return
+	}
+
+	c.HTML(consts.StatusOK, "order-detail", utils.WarpResponse(ctx, c, resp))
+}
+
+// OrderInvoice .
+// @router /order/:id/invoice [GET]
+func OrderInvoice(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.OrderInvoiceReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewOrderInvoiceService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "order-detail", hertzUtils.H{"error": err})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.FileName))
+	c.Data(consts.StatusOK, "application/pdf", resp.Content)
+}
+
+// OrderReturnForm .
+// @router /order/:id/return [GET]
+func OrderReturnForm(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.OrderReturnFormReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	resp, err := service.NewOrderReturnFormService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "order-return", hertzUtils.H{"error": err})
+		return
+	}
+
+	c.HTML(consts.StatusOK, "order-return", utils.WarpResponse(ctx, c, resp))
+}
+
+// OrderReturn .
+// @router /order/:id/return [POST]
+func OrderReturn(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.OrderReturnReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	_, err = service.NewOrderReturnService(ctx, c).Run(&req)
+	if err != nil {
+		resp, formErr := service.NewOrderReturnFormService(ctx, c).Run(&order.OrderReturnFormReq{OrderId: req.OrderId})
+		if formErr != nil {
+			resp = hertzUtils.H{}
+		}
+		resp["warning"] = err
+		c.HTML(consts.StatusOK, "order-return", utils.WarpResponse(ctx, c, resp))
+		return
+	}
+
+	c.Redirect(consts.StatusFound, []byte("/order/"+req.OrderId))
+}
@@ -61,3 +61,22 @@ func SearchProducs(ctx context.Context, c *app.RequestContext) {
 	}
 	c.HTML(consts.StatusOK, "search", utils.WarpResponse(ctx, c, resp))
 }
+
+// SuggestProducts .
+// @router /search/suggest [GET]
+func SuggestProducts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.SuggestProductsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusBadRequest, err)
+		return
+	}
+
+	resp, err := service.NewSuggestProductsService(ctx, c).Run(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusInternalServerError, err)
+		return
+	}
+	utils.SendSuccessResponse(ctx, c, consts.StatusOK, resp)
+}
@@ -0,0 +1,162 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by hertz generator.
+
+package account
+
+import (
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/middleware"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _accountMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{middleware.Auth()}
+}
+
+func _notificationsettingsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatenotificationsettingsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _notificationsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _unsubscribeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _sessionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _sessionlistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _logoutallMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokesessionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _resendverificationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _securityMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _security0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _regeneraterecoverycodesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _totpMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _confirmtotpMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _disabletotpMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _enrolltotpMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _addressesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _setdefaultaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deleteaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _profileMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateprofileMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deleteaccountMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _exportdataMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _unsubscribepageMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -31,10 +31,30 @@ import (
 func Register(r *server.Hertz) {
 
 	root := r.Group("/", rootMw()...)
+	{
+		__well_known := root.Group("/.well-known", __well_knownMw()...)
+		__well_known.GET("/jwks.json", append(_jwksMw(), auth.Jwks)...)
+	}
+	root.GET("/unlock-account", append(_unlockaccountMw(), auth.UnlockAccount)...)
+	root.GET("/verify-email", append(_verifyemailMw(), auth.VerifyEmail)...)
+	{
+		_account_deletion := root.Group("/account-deletion", _account_deletionMw()...)
+		_account_deletion.GET("/:job_id", append(_accountdeletionMw(), auth.AccountDeletion)...)
+	}
 	{
 		_auth := root.Group("/auth", _authMw()...)
+		_auth.POST("/delete_account", append(_confirmaccountdeletionMw(), auth.ConfirmAccountDeletion)...)
+		_auth.POST("/forgot_password", append(_forgotpasswordMw(), auth.ForgotPassword)...)
 		_auth.POST("/login", append(_loginMw(), auth.Login)...)
 		_auth.POST("/logout", append(_logoutMw(), auth.Logout)...)
 		_auth.POST("/register", append(_registerMw(), auth.Register)...)
+		_auth.POST("/reset_password", append(_resetpasswordMw(), auth.ResetPassword)...)
+		_auth.POST("/two_factor", append(_twofactorMw(), auth.TwoFactor)...)
+		{
+			_oidc := _auth.Group("/oidc", _oidcMw()...)
+			_oidc.GET("/:provider", append(_oidcloginMw(), auth.OidcLogin)...)
+			_provider := _oidc.Group("/:provider", _providerMw()...)
+			_provider.GET("/callback", append(_oidccallbackMw(), auth.OidcCallback)...)
+		}
 	}
 }
@@ -44,3 +44,73 @@ func _registerMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func __well_knownMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _jwksMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _verifyemailMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _forgotpasswordMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _resetpasswordMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _unlockaccountMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _twofactorMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _oidcMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _providerMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _oidcloginMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _oidccallbackMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _account_deletionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _accountdeletionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _confirmaccountdeletionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
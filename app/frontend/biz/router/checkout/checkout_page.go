@@ -33,6 +33,13 @@ func Register(r *server.Hertz) {
 	root := r.Group("/", rootMw()...)
 	root.GET("/checkout", append(_checkout0Mw(), checkout.Checkout)...)
 	_checkout := root.Group("/checkout", _checkoutMw()...)
+	_checkout.GET("/flashsale", append(_flashsalecheckoutMw(), checkout.FlashSaleCheckout)...)
+	_flashsale := _checkout.Group("/flashsale", _flashsaleMw()...)
+	_flashsale.GET("/status", append(_flashsalestatusMw(), checkout.FlashSaleStatus)...)
+	_flashsale.POST("/waiting", append(_flashsalewaitingMw(), checkout.FlashSaleWaiting)...)
+	_checkout.GET("/quote", append(_checkoutquoteMw(), checkout.CheckoutQuote)...)
 	_checkout.GET("/result", append(_checkoutresultMw(), checkout.CheckoutResult)...)
+	_checkout.GET("/shipping", append(_shippingquoteMw(), checkout.ShippingQuote)...)
+	_checkout.GET("/status", append(_checkoutstatusMw(), checkout.CheckoutStatus)...)
 	_checkout.POST("/waiting", append(_checkoutwaitingMw(), checkout.CheckoutWaiting)...)
 }
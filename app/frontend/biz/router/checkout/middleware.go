@@ -45,3 +45,38 @@ func _checkoutwaitingMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _flashsaleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _flashsalecheckoutMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _flashsalestatusMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _flashsalewaitingMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _checkoutstatusMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _shippingquoteMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _checkoutquoteMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
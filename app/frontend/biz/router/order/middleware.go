@@ -30,3 +30,33 @@ func _orderlistMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _orderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _orderdetailMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _orderinvoiceMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _orderreturnformMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _orderreturnMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -32,4 +32,10 @@ func Register(r *server.Hertz) {
 
 	root := r.Group("/", rootMw()...)
 	root.GET("/order", append(_orderlistMw(), order.OrderList)...)
+	_order := root.Group("/order", _orderMw()...)
+	_order.GET("/:id", append(_orderdetailMw(), order.OrderDetail)...)
+	_id := _order.Group("/:id", _idMw()...)
+	_id.GET("/invoice", append(_orderinvoiceMw(), order.OrderInvoice)...)
+	_id.GET("/return", append(_orderreturnformMw(), order.OrderReturnForm)...)
+	_id.POST("/return", append(_orderreturnMw(), order.OrderReturn)...)
 }
@@ -34,3 +34,13 @@ func _searchproducsMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _searchMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _suggestproductsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
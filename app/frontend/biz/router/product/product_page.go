@@ -33,4 +33,6 @@ func Register(r *server.Hertz) {
 	root := r.Group("/", rootMw()...)
 	root.GET("/product", append(_getproductMw(), product.GetProduct)...)
 	root.GET("/search", append(_searchproducsMw(), product.SearchProducs)...)
+	_search := root.Group("/search", _searchMw()...)
+	_search.GET("/suggest", append(_suggestproductsMw(), product.SuggestProducts)...)
 }
@@ -18,6 +18,8 @@ package router
 
 import (
 	about "github.com/cloudwego/biz-demo/gomall/app/frontend/biz/router/about"
+	account "github.com/cloudwego/biz-demo/gomall/app/frontend/biz/router/account"
+	admin "github.com/cloudwego/biz-demo/gomall/app/frontend/biz/router/admin"
 	auth "github.com/cloudwego/biz-demo/gomall/app/frontend/biz/router/auth"
 	cart "github.com/cloudwego/biz-demo/gomall/app/frontend/biz/router/cart"
 	category "github.com/cloudwego/biz-demo/gomall/app/frontend/biz/router/category"
@@ -31,6 +33,10 @@ import (
 // GeneratedRegister registers routers generated by IDL.
 func GeneratedRegister(r *server.Hertz) {
 	//INSERT_POINT: DO NOT DELETE THIS LINE!
+	admin.Register(r)
+
+	account.Register(r)
+
 	about.Register(r)
 
 	order.Register(r)
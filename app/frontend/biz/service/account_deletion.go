@@ -0,0 +1,53 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	auth "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/auth"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+)
+
+type AccountDeletionService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewAccountDeletionService(Context context.Context, RequestContext *app.RequestContext) *AccountDeletionService {
+	return &AccountDeletionService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *AccountDeletionService) Run(req *auth.AccountDeletionReq) (resp map[string]any, err error) {
+	job, err := rpc.UserClient.GetAccountDeletion(h.Context, &rpcuser.GetAccountDeletionReq{JobId: req.JobId})
+	if err != nil {
+		return nil, err
+	}
+	resp = utils.H{
+		"title":      "Account deletion",
+		"job_id":     req.JobId,
+		"state":      job.State,
+		"steps":      job.Steps,
+		"created_at": time.Unix(job.CreatedAt, 0).Format("2006-01-02 15:04:05"),
+	}
+	if job.CompletedAt != 0 {
+		resp["completed_at"] = time.Unix(job.CompletedAt, 0).Format("2006-01-02 15:04:05")
+	}
+	return resp, nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/admin"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type AdminRoleChangeService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewAdminRoleChangeService(Context context.Context, RequestContext *app.RequestContext) *AdminRoleChangeService {
+	return &AdminRoleChangeService{RequestContext: RequestContext, Context: Context}
+}
+
+// Run grants the role, or revokes it when grant is false.
+func (h *AdminRoleChangeService) Run(req *admin.RoleChangeReq, grant bool) (err error) {
+	if grant {
+		_, err = rpc.UserClient.GrantRole(h.Context, &rpcuser.GrantRoleReq{
+			TargetUserId: req.TargetUserId,
+			Role:         req.Role,
+			Reason:       req.Reason,
+		})
+		return
+	}
+	_, err = rpc.UserClient.RevokeRole(h.Context, &rpcuser.RevokeRoleReq{
+		TargetUserId: req.TargetUserId,
+		Role:         req.Role,
+		Reason:       req.Reason,
+	})
+	return
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/admin"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/types"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+)
+
+type AdminRolesService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewAdminRolesService(Context context.Context, RequestContext *app.RequestContext) *AdminRolesService {
+	return &AdminRolesService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *AdminRolesService) Run(req *admin.RolesReq) (resp map[string]any, err error) {
+	res, err := rpc.UserClient.ListRoleAudit(h.Context, &rpcuser.ListRoleAuditReq{TargetUserId: req.TargetUserId})
+	if err != nil {
+		return nil, err
+	}
+	var entries []types.RoleAudit
+	for _, v := range res.Entries {
+		actor := v.ActorService
+		if v.ActorUserId != 0 {
+			actor = "user " + strconv.FormatUint(uint64(v.ActorUserId), 10)
+		}
+		entries = append(entries, types.RoleAudit{
+			Actor:        actor,
+			TargetUserId: v.TargetUserId,
+			Role:         v.Role,
+			Action:       v.Action,
+			Reason:       v.Reason,
+			CreatedAt:    time.Unix(v.CreatedAt, 0).Format("2006-01-02 15:04:05"),
+		})
+	}
+	return utils.H{
+		"title":          "Roles",
+		"target_user_id": req.TargetUserId,
+		"entries":        entries,
+	}, nil
+}
@@ -23,7 +23,9 @@ import (
 	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
 	rpccart "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
 	rpcproduct "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/cloudwego/hertz/pkg/common/utils"
 )
 
@@ -63,10 +65,35 @@ func (h *CheckoutService) Run(req *checkout.CheckoutReq) (resp map[string]any, e
 		total += float32(v.Quantity) * p.Price
 	}
 
+	email, address := checkoutContact(h.Context, userId)
 	return utils.H{
 		"title":    "Checkout",
 		"items":    items,
 		"cart_num": len(items),
 		"total":    strconv.FormatFloat(float64(total), 'f', 2, 64),
+		"email":    email,
+		"address":  address,
 	}, nil
 }
+
+// checkoutContact is the email and the default address the checkout form starts with. The
+// form is filled in by hand when the user service does not answer.
+func checkoutContact(ctx context.Context, userId uint32) (email string, address *rpcuser.Address) {
+	profile, err := rpc.UserClient.GetProfile(ctx, &rpcuser.GetProfileReq{UserId: userId})
+	if err != nil {
+		hlog.CtxWarnf(ctx, "checkout profile: %v", err)
+	} else {
+		email = profile.Profile.GetEmail()
+	}
+	addresses, err := rpc.UserClient.ListAddresses(ctx, &rpcuser.ListAddressesReq{UserId: userId})
+	if err != nil {
+		hlog.CtxWarnf(ctx, "checkout addresses: %v", err)
+		return
+	}
+	for _, a := range addresses.Addresses {
+		if a.IsDefault {
+			return email, a
+		}
+	}
+	return
+}
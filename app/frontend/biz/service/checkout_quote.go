@@ -0,0 +1,65 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/checkout"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpccheckout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+)
+
+type CheckoutQuoteService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewCheckoutQuoteService(Context context.Context, RequestContext *app.RequestContext) *CheckoutQuoteService {
+	return &CheckoutQuoteService{RequestContext: RequestContext, Context: Context}
+}
+
+// Run returns the price breakdown of the checkout for the entered address and shipping method.
+func (h *CheckoutQuoteService) Run(req *checkout.CheckoutQuoteReq) (resp map[string]any, err error) {
+	quoteResp, err := rpc.CheckoutClient.Quote(h.Context, &rpccheckout.QuoteReq{
+		UserId:         frontendutils.GetUserIdFromCtx(h.Context),
+		Address:        &rpccheckout.Address{Country: req.Country, State: req.Province},
+		ShippingMethod: req.ShippingMethod,
+		ProductId:      req.ProductId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	taxLines := make([]map[string]any, 0, len(quoteResp.TaxLines))
+	for _, l := range quoteResp.TaxLines {
+		taxLines = append(taxLines, map[string]any{
+			"name":   l.Name,
+			"rate":   l.Rate,
+			"amount": l.Amount,
+		})
+	}
+	return utils.H{
+		"subtotal":           quoteResp.Subtotal,
+		"shipping_method":    quoteResp.ShippingMethod,
+		"shipping_cost":      quoteResp.ShippingCost,
+		"tax_lines":          taxLines,
+		"tax":                quoteResp.Tax,
+		"prices_include_tax": quoteResp.PricesIncludeTax,
+		"total":              quoteResp.Total,
+	}, nil
+}
@@ -16,10 +16,14 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/cloudwego/hertz/pkg/common/utils"
 
-	common "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/checkout"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpccheckout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
 	"github.com/cloudwego/hertz/pkg/app"
 )
 
@@ -32,6 +36,30 @@ func NewCheckoutResultService(Context context.Context, RequestContext *app.Reque
 	return &CheckoutResultService{RequestContext: RequestContext, Context: Context}
 }
 
-func (h *CheckoutResultService) Run(req *common.Empty) (resp map[string]any, err error) {
-	return utils.H{}, nil
+func (h *CheckoutResultService) Run(req *checkout.CheckoutResultReq) (resp map[string]any, err error) {
+	userId := frontendutils.GetUserIdFromCtx(h.Context)
+	resp = utils.H{"title": "Checkout Result"}
+	if req.ProductId != 0 {
+		status, err := rpc.CheckoutClient.GetFlashSaleStatus(h.Context, &rpccheckout.GetFlashSaleStatusReq{UserId: userId, ProductId: req.ProductId})
+		if err != nil {
+			return nil, err
+		}
+		resp["status"] = status.Status
+		resp["order_id"] = status.OrderId
+		resp["transaction_id"] = status.TransactionId
+		resp["reason"] = status.Reason
+		return resp, nil
+	}
+	if req.Id == "" {
+		return nil, errors.New("checkout id is required")
+	}
+	status, err := rpc.CheckoutClient.GetCheckoutStatus(h.Context, &rpccheckout.GetCheckoutStatusReq{UserId: userId, CheckoutId: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	resp["status"] = status.Status
+	resp["order_id"] = status.OrderId
+	resp["transaction_id"] = status.TransactionId
+	resp["reason"] = status.Reason
+	return resp, nil
 }
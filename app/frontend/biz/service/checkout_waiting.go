@@ -16,6 +16,7 @@ package service
 
 import (
 	"context"
+	"net/url"
 
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/checkout"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
@@ -37,11 +38,12 @@ func NewCheckoutWaitingService(Context context.Context, RequestContext *app.Requ
 
 func (h *CheckoutWaitingService) Run(req *checkout.CheckoutReq) (resp map[string]any, err error) {
 	userId := frontendutils.GetUserIdFromCtx(h.Context)
-	_, err = rpc.CheckoutClient.Checkout(h.Context, &rpccheckout.CheckoutReq{
-		UserId:    userId,
-		Email:     req.Email,
-		Firstname: req.Firstname,
-		Lastname:  req.Lastname,
+	result, err := rpc.CheckoutClient.SubmitCheckout(h.Context, &rpccheckout.CheckoutReq{
+		UserId:         userId,
+		Email:          req.Email,
+		Firstname:      req.Firstname,
+		Lastname:       req.Lastname,
+		ShippingMethod: req.ShippingMethod,
 		Address: &rpccheckout.Address{
 			Country:       req.Country,
 			ZipCode:       req.Zipcode,
@@ -61,7 +63,8 @@ func (h *CheckoutWaitingService) Run(req *checkout.CheckoutReq) (resp map[string
 	}
 
 	return utils.H{
-		"title":    "waiting",
-		"redirect": "/checkout/result",
+		"title":      "waiting",
+		"status_url": "/checkout/status?id=" + url.QueryEscape(result.CheckoutId),
+		"result_url": "/checkout/result?id=" + url.QueryEscape(result.CheckoutId),
 	}, nil
 }
@@ -0,0 +1,50 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	auth "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/auth"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/hertz-contrib/sessions"
+)
+
+type ConfirmAccountDeletionService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewConfirmAccountDeletionService(Context context.Context, RequestContext *app.RequestContext) *ConfirmAccountDeletionService {
+	return &ConfirmAccountDeletionService{RequestContext: RequestContext, Context: Context}
+}
+
+// Run deletes the account and returns the page of the deletion job. The link may be opened
+// signed in as someone else, only the deleted user is signed out.
+func (h *ConfirmAccountDeletionService) Run(req *auth.ConfirmAccountDeletionReq) (resp string, err error) {
+	res, err := rpc.UserClient.ConfirmAccountDeletion(h.Context, &rpcuser.ConfirmAccountDeletionReq{Token: req.Token})
+	if err != nil {
+		return "", err
+	}
+	if frontendutils.GetUserIdFromCtx(h.Context) == res.UserId {
+		session := sessions.Default(h.RequestContext)
+		session.Clear()
+		session.Save() //nolint:errcheck
+	}
+	return "/account-deletion/" + res.JobId, nil
+}
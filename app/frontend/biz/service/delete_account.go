@@ -0,0 +1,51 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	account "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/account"
+	common "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type DeleteAccountService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewDeleteAccountService(Context context.Context, RequestContext *app.RequestContext) *DeleteAccountService {
+	return &DeleteAccountService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *DeleteAccountService) Run(req *account.DeleteAccountReq) (resp map[string]any, err error) {
+	_, err = rpc.UserClient.DeleteAccount(h.Context, &rpcuser.DeleteAccountReq{
+		UserId:   frontendutils.GetUserIdFromCtx(h.Context),
+		Password: req.Password,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, err = NewProfileService(h.Context, h.RequestContext).Run(&common.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	resp["message"] = "We emailed you a link, your account is deleted once you open it."
+	return resp, nil
+}
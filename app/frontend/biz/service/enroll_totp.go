@@ -0,0 +1,50 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"html/template"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+)
+
+type EnrollTotpService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewEnrollTotpService(Context context.Context, RequestContext *app.RequestContext) *EnrollTotpService {
+	return &EnrollTotpService{RequestContext: RequestContext, Context: Context}
+}
+
+// Run shows the new secret, to be added to an app and confirmed with a code.
+func (h *EnrollTotpService) Run(req *common.Empty) (resp map[string]any, err error) {
+	res, err := rpc.UserClient.EnrollTotp(h.Context, &rpcuser.EnrollTotpReq{UserId: frontendutils.GetUserIdFromCtx(h.Context)})
+	if err != nil {
+		return nil, err
+	}
+	return utils.H{
+		"title":  "Set up two-factor authentication",
+		"secret": res.Secret,
+		// html/template would drop the otpauth scheme, the URI comes from the user service
+		"provisioning_uri": template.URL(res.ProvisioningUri),
+	}, nil
+}
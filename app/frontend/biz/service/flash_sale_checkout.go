@@ -0,0 +1,68 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/checkout"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpccheckout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	rpcproduct "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+)
+
+type FlashSaleCheckoutService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewFlashSaleCheckoutService(Context context.Context, RequestContext *app.RequestContext) *FlashSaleCheckoutService {
+	return &FlashSaleCheckoutService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *FlashSaleCheckoutService) Run(req *checkout.CheckoutReq) (resp map[string]any, err error) {
+	status, err := rpc.CheckoutClient.GetFlashSaleStatus(h.Context, &rpccheckout.GetFlashSaleStatusReq{ProductId: req.ProductId})
+	if err != nil {
+		return nil, err
+	}
+	if !status.OnSale {
+		return nil, errors.New("this product is not on flash sale")
+	}
+	productResp, err := rpc.ProductClient.GetProduct(h.Context, &rpcproduct.GetProductReq{Id: req.ProductId})
+	if err != nil {
+		return nil, err
+	}
+	p := productResp.Product
+	price := strconv.FormatFloat(float64(p.Price), 'f', 2, 64)
+	email, address := checkoutContact(h.Context, frontendutils.GetUserIdFromCtx(h.Context))
+
+	return utils.H{
+		"title": "Flash Sale",
+		"items": []map[string]string{
+			{"Name": p.Name, "Price": price, "Picture": p.Picture, "Qty": "1"},
+		},
+		"total":      price,
+		"action":     "/checkout/flashsale/waiting",
+		"product_id": req.ProductId,
+		"stock":      status.Stock,
+		"email":      email,
+		"address":    address,
+	}, nil
+}
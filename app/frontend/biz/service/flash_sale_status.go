@@ -0,0 +1,51 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/checkout"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpccheckout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+)
+
+type FlashSaleStatusService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewFlashSaleStatusService(Context context.Context, RequestContext *app.RequestContext) *FlashSaleStatusService {
+	return &FlashSaleStatusService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *FlashSaleStatusService) Run(req *checkout.FlashSaleStatusReq) (resp map[string]any, err error) {
+	status, err := rpc.CheckoutClient.GetFlashSaleStatus(h.Context, &rpccheckout.GetFlashSaleStatusReq{
+		UserId:    frontendutils.GetUserIdFromCtx(h.Context),
+		ProductId: req.ProductId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.H{
+		"status":   status.Status,
+		"order_id": status.OrderId,
+		"reason":   status.Reason,
+		"stock":    status.Stock,
+	}, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/checkout"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpccheckout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	rpcpayment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+)
+
+var flashSaleRejectMessages = map[string]string{
+	"not_on_sale": "This product is not on flash sale.",
+	"sold_out":    "Sorry, this product is sold out.",
+	"duplicate":   "You have already bought this product.",
+}
+
+type FlashSaleWaitingService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewFlashSaleWaitingService(Context context.Context, RequestContext *app.RequestContext) *FlashSaleWaitingService {
+	return &FlashSaleWaitingService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *FlashSaleWaitingService) Run(req *checkout.CheckoutReq) (resp map[string]any, err error) {
+	userId := frontendutils.GetUserIdFromCtx(h.Context)
+	result, err := rpc.CheckoutClient.FlashSaleCheckout(h.Context, &rpccheckout.FlashSaleCheckoutReq{
+		UserId:         userId,
+		ProductId:      req.ProductId,
+		Email:          req.Email,
+		Firstname:      req.Firstname,
+		Lastname:       req.Lastname,
+		ShippingMethod: req.ShippingMethod,
+		Address: &rpccheckout.Address{
+			Country:       req.Country,
+			ZipCode:       req.Zipcode,
+			City:          req.City,
+			State:         req.Province,
+			StreetAddress: req.Street,
+		},
+		CreditCard: &rpcpayment.CreditCardInfo{
+			CreditCardNumber:          req.CardNum,
+			CreditCardExpirationYear:  req.ExpirationYear,
+			CreditCardExpirationMonth: req.ExpirationMonth,
+			CreditCardCvv:             req.Cvv,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !result.Accepted {
+		if msg, ok := flashSaleRejectMessages[result.Reason]; ok {
+			return nil, errors.New(msg)
+		}
+		return nil, errors.New(result.Reason)
+	}
+
+	return utils.H{
+		"title":      "waiting",
+		"status_url": fmt.Sprintf("/checkout/flashsale/status?productId=%d", req.ProductId),
+		"result_url": fmt.Sprintf("/checkout/result?productId=%d", req.ProductId),
+	}, nil
+}
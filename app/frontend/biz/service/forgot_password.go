@@ -0,0 +1,47 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	auth "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/auth"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+)
+
+type ForgotPasswordService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewForgotPasswordService(Context context.Context, RequestContext *app.RequestContext) *ForgotPasswordService {
+	return &ForgotPasswordService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *ForgotPasswordService) Run(req *auth.ForgotPasswordReq) (resp map[string]any, err error) {
+	_, err = rpc.UserClient.RequestPasswordReset(h.Context, &rpcuser.RequestPasswordResetReq{Email: req.Email})
+	if err != nil {
+		return nil, err
+	}
+	// the same answer whether the account exists or not
+	return utils.H{
+		"title":   "Forgot password",
+		"message": fmt.Sprintf("If %s has an account, we sent it a link to reset the password.", req.Email),
+	}, nil
+}
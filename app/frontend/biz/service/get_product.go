@@ -19,8 +19,10 @@ import (
 
 	product "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/product"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	rpccheckout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
 	rpcproduct "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/cloudwego/hertz/pkg/common/utils"
 )
 
@@ -38,7 +40,23 @@ func (h *GetProductService) Run(req *product.ProductReq) (resp map[string]any, e
 	if err != nil {
 		return nil, err
 	}
-	return utils.H{
+	resp = utils.H{
 		"item": p.Product,
-	}, nil
+	}
+	history, err := rpc.ProductClient.GetPriceHistory(h.Context, &rpcproduct.GetPriceHistoryReq{ProductId: req.GetId(), Limit: 1})
+	if err != nil {
+		// the regular price is a nice-to-have, show the product without it
+		hlog.CtxWarnf(h.Context, "product %d price history: %v", req.GetId(), err)
+	} else if history.RegularPrice > p.Product.Price {
+		resp["was_price"] = history.RegularPrice
+	}
+	flashSale, err := rpc.CheckoutClient.GetFlashSaleStatus(h.Context, &rpccheckout.GetFlashSaleStatusReq{ProductId: req.GetId()})
+	if err != nil {
+		// without the flash sale status the product is shown at its regular terms
+		hlog.CtxWarnf(h.Context, "product %d flash sale status: %v", req.GetId(), err)
+	} else if flashSale.OnSale {
+		resp["flash_sale"] = true
+		resp["flash_stock"] = flashSale.Stock
+	}
+	return resp, nil
 }
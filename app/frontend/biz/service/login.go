@@ -16,6 +16,7 @@ package service
 
 import (
 	"context"
+	"net/url"
 
 	auth "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/auth"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
@@ -35,22 +36,39 @@ func NewLoginService(Context context.Context, RequestContext *app.RequestContext
 }
 
 func (h *LoginService) Run(req *auth.LoginReq) (resp string, err error) {
-	res, err := rpc.UserClient.Login(h.Context, &rpcuser.LoginReq{Email: req.Email, Password: req.Password})
+	res, err := rpc.UserClient.Login(h.Context, &rpcuser.LoginReq{
+		Email:     req.Email,
+		Password:  req.Password,
+		UserAgent: string(h.RequestContext.UserAgent()),
+		Ip:        h.RequestContext.ClientIP(),
+	})
 	if err != nil {
 		return
 	}
 
-	session := sessions.Default(h.RequestContext)
-	session.Set("user_id", res.UserId)
-	err = session.Save()
-	frontendutils.MustHandleError(err)
-	redirect := "/"
-	if frontendutils.ValidateNext(req.Next) {
-		redirect = req.Next
+	return completeLogin(h.RequestContext, res, req.Next)
+}
+
+// completeLogin keeps the login res of the user service in the session and tells where to
+// go next: to the code of the authenticator app if the login asks for it, else to next.
+func completeLogin(c *app.RequestContext, res *rpcuser.LoginResp, next string) (redirect string, err error) {
+	session := sessions.Default(c)
+	if res.TwoFactorRequired {
+		session.Set(frontendutils.SessionLoginChallenge, res.ChallengeToken)
+		if err = session.Save(); err != nil {
+			return "", err
+		}
+		if frontendutils.ValidateNext(next) {
+			return "/two-factor?next=" + url.QueryEscape(next), nil
+		}
+		return "/two-factor", nil
 	}
+	err = frontendutils.SaveLogin(session, res.UserId, res.Token, res.RefreshToken, res.ExpiresIn, res.SessionId, res.EmailVerified)
 	if err != nil {
 		return "", err
 	}
-
-	return redirect, nil
+	if frontendutils.ValidateNext(next) {
+		return next, nil
+	}
+	return "/", nil
 }
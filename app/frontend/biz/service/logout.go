@@ -18,7 +18,11 @@ import (
 	"context"
 
 	common "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/hertz-contrib/sessions"
 )
 
@@ -33,6 +37,13 @@ func NewLogoutService(Context context.Context, RequestContext *app.RequestContex
 
 func (h *LogoutService) Run(req *common.Empty) (resp *common.Empty, err error) {
 	session := sessions.Default(h.RequestContext)
+	if refreshToken := frontendutils.SessionString(session, frontendutils.SessionRefreshToken); refreshToken != "" {
+		// the local session ends anyway, a failed revocation leaves the refresh token to expire
+		_, err := rpc.UserClient.RevokeToken(h.Context, &rpcuser.RevokeTokenReq{RefreshToken: refreshToken})
+		if err != nil {
+			hlog.CtxWarnf(h.Context, "revoke refresh token: %v", err)
+		}
+	}
 	session.Clear()
 	session.Save() //nolint:errcheck
 	return
@@ -0,0 +1,80 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/types"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+)
+
+type NotificationSettingsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewNotificationSettingsService(Context context.Context, RequestContext *app.RequestContext) *NotificationSettingsService {
+	return &NotificationSettingsService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *NotificationSettingsService) Run(req *common.Empty) (resp map[string]any, err error) {
+	prefs, err := rpc.UserClient.GetNotificationPreferences(h.Context, &rpcuser.GetNotificationPreferencesReq{UserId: frontendutils.GetUserIdFromCtx(h.Context)})
+	if err != nil {
+		return nil, err
+	}
+	s := prefs.Settings
+	return utils.H{
+		"title":          "Notifications",
+		"email":          prefs.Email,
+		"rows":           notificationRows(prefs.Preferences),
+		"phone":          s.Phone,
+		"webhook_url":    s.WebhookUrl,
+		"webhook_secret": s.WebhookSecret,
+		"quiet_start":    formatMinutes(s.QuietStart),
+		"quiet_end":      formatMinutes(s.QuietEnd),
+		"timezone":       s.Timezone,
+	}, nil
+}
+
+// notificationRows groups the preferences by category, keeping the order of the user service.
+func notificationRows(prefs []*rpcuser.NotificationPreference) (rows []types.NotificationRow) {
+	for _, p := range prefs {
+		if len(rows) == 0 || rows[len(rows)-1].Category != p.Category {
+			rows = append(rows, types.NotificationRow{Category: p.Category})
+		}
+		row := &rows[len(rows)-1]
+		row.Channels = append(row.Channels, types.NotificationChannel{
+			Channel: p.Channel,
+			Field:   preferenceField(p.Category, p.Channel),
+			Enabled: p.Enabled,
+		})
+	}
+	return
+}
+
+func preferenceField(category, channel string) string {
+	return "pref_" + category + "_" + channel
+}
+
+func formatMinutes(m int32) string {
+	return fmt.Sprintf("%02d:%02d", m/60, m%60)
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+
+	auth "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/auth"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/hertz-contrib/sessions"
+)
+
+type OidcCallbackService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewOidcCallbackService(Context context.Context, RequestContext *app.RequestContext) *OidcCallbackService {
+	return &OidcCallbackService{RequestContext: RequestContext, Context: Context}
+}
+
+// Run finishes the sign-in the session started at the provider.
+func (h *OidcCallbackService) Run(req *auth.OidcCallbackReq) (resp string, err error) {
+	session := sessions.Default(h.RequestContext)
+	state := frontendutils.SessionString(session, frontendutils.SessionOidcState)
+	next := frontendutils.SessionString(session, frontendutils.SessionOidcNext)
+	session.Delete(frontendutils.SessionOidcState)
+	session.Delete(frontendutils.SessionOidcNext)
+	if err = session.Save(); err != nil {
+		return "", err
+	}
+	if req.Error != "" {
+		return "", errors.New("the sign-in at the provider was cancelled or failed")
+	}
+	// a callback this browser did not start is someone else's sign-in forced on it
+	if state == "" || subtle.ConstantTimeCompare([]byte(state), []byte(req.State)) != 1 {
+		return "", errors.New("the sign-in has expired, please try again")
+	}
+	res, err := rpc.UserClient.FinishOidcLogin(h.Context, &rpcuser.FinishOidcLoginReq{
+		Provider:  req.Provider,
+		State:     req.State,
+		Code:      req.Code,
+		UserAgent: string(h.RequestContext.UserAgent()),
+		Ip:        h.RequestContext.ClientIP(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return completeLogin(h.RequestContext, res, next)
+}
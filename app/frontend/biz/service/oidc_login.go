@@ -0,0 +1,66 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	auth "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/auth"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/hertz-contrib/sessions"
+)
+
+type OidcLoginService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewOidcLoginService(Context context.Context, RequestContext *app.RequestContext) *OidcLoginService {
+	return &OidcLoginService{RequestContext: RequestContext, Context: Context}
+}
+
+// Run starts a sign-in at the provider and returns the URL to send the browser to. The
+// session remembers the state, so only this browser can finish the sign-in.
+func (h *OidcLoginService) Run(req *auth.OidcLoginReq) (resp string, err error) {
+	res, err := rpc.UserClient.StartOidcLogin(h.Context, &rpcuser.StartOidcLoginReq{Provider: req.Provider})
+	if err != nil {
+		return "", err
+	}
+	session := sessions.Default(h.RequestContext)
+	session.Set(frontendutils.SessionOidcState, res.State)
+	session.Delete(frontendutils.SessionOidcNext)
+	if frontendutils.ValidateNext(req.Next) {
+		session.Set(frontendutils.SessionOidcNext, req.Next)
+	}
+	if err = session.Save(); err != nil {
+		return "", err
+	}
+	return res.AuthorizationUrl, nil
+}
+
+// SignInProviders lists the providers for the sign-in page, none if the user service does
+// not answer so the password sign-in still works.
+func SignInProviders(ctx context.Context) []*rpcuser.OidcProvider {
+	res, err := rpc.UserClient.ListOidcProviders(ctx, &rpcuser.ListOidcProvidersReq{})
+	if err != nil {
+		hlog.CtxWarnf(ctx, "list sign-in providers: %v", err)
+		return nil
+	}
+	return res.Providers
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/order"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/types"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcorder "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	rpcshipping "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+)
+
+type OrderDetailService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewOrderDetailService(Context context.Context, RequestContext *app.RequestContext) *OrderDetailService {
+	return &OrderDetailService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *OrderDetailService) Run(req *order.OrderDetailReq) (resp map[string]any, err error) {
+	userId := frontendutils.GetUserIdFromCtx(h.Context)
+	orderResp, err := rpc.OrderClient.GetOrder(h.Context, &rpcorder.GetOrderReq{UserId: userId, OrderId: req.OrderId})
+	if err != nil {
+		return nil, err
+	}
+	o, err := toOrder(h.Context, orderResp.Order)
+	if err != nil {
+		return nil, err
+	}
+	shipmentsResp, err := rpc.ShippingClient.ListShipments(h.Context, &rpcshipping.ListShipmentsReq{UserId: userId, OrderIds: []string{o.OrderId}})
+	if err != nil {
+		return nil, err
+	}
+	if len(shipmentsResp.Shipments) > 0 {
+		o.Shipment = toShipment(shipmentsResp.Shipments[0])
+	}
+	returnsResp, err := rpc.OrderClient.ListReturns(h.Context, &rpcorder.ListReturnsReq{UserId: userId, OrderId: o.OrderId})
+	if err != nil {
+		return nil, err
+	}
+	var returns []types.Return
+	for _, r := range returnsResp.Returns {
+		returns = append(returns, toReturn(r))
+	}
+	return utils.H{
+		"title":   "Order " + o.OrderId,
+		"order":   o,
+		"returns": returns,
+	}, nil
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/order"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcorder "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type OrderInvoiceService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewOrderInvoiceService(Context context.Context, RequestContext *app.RequestContext) *OrderInvoiceService {
+	return &OrderInvoiceService{RequestContext: RequestContext, Context: Context}
+}
+
+// Run returns the PDF invoice, the handler sends it as a download.
+func (h *OrderInvoiceService) Run(req *order.OrderInvoiceReq) (resp *rpcorder.GetInvoiceResp, err error) {
+	return rpc.OrderClient.GetInvoice(h.Context, &rpcorder.GetInvoiceReq{
+		UserId:  frontendutils.GetUserIdFromCtx(h.Context),
+		OrderId: req.OrderId,
+	})
+}
@@ -16,14 +16,18 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"strconv"
 	"time"
 
-	common "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/order"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/types"
 	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
 	rpcorder "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
 	rpcproduct "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	rpcshipping "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/utils"
 )
@@ -37,56 +41,154 @@ func NewOrderListService(Context context.Context, RequestContext *app.RequestCon
 	return &OrderListService{RequestContext: RequestContext, Context: Context}
 }
 
-func (h *OrderListService) Run(req *common.Empty) (resp map[string]any, err error) {
+var orderStates = []string{"placed", "paid", "canceled"}
+
+func (h *OrderListService) Run(req *order.OrderListReq) (resp map[string]any, err error) {
 	userId := frontendutils.GetUserIdFromCtx(h.Context)
+	listReq := &rpcorder.ListOrderReq{UserId: userId, Cursor: req.Cursor, State: req.State}
+	if req.From != "" {
+		from, err := time.ParseInLocation(time.DateOnly, req.From, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from date %q", req.From)
+		}
+		listReq.StartTime = from.Unix()
+	}
+	if req.To != "" {
+		to, err := time.ParseInLocation(time.DateOnly, req.To, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to date %q", req.To)
+		}
+		listReq.EndTime = to.AddDate(0, 0, 1).Unix()
+	}
+	filters := url.Values{}
+	for k, v := range map[string]string{"state": req.State, "from": req.From, "to": req.To} {
+		if v != "" {
+			filters.Set(k, v)
+		}
+	}
 	var orders []*types.Order
-	listOrderResp, err := rpc.OrderClient.ListOrder(h.Context, &rpcorder.ListOrderReq{UserId: userId})
+	listOrderResp, err := rpc.OrderClient.ListOrder(h.Context, listReq)
 	if err != nil {
 		return nil, err
 	}
-	if listOrderResp == nil || len(listOrderResp.Orders) == 0 {
-		return utils.H{
-			"title":  "Order",
-			"orders": orders,
-		}, nil
+	page := utils.H{
+		"title":      "Order",
+		"orders":     orders,
+		"total":      listOrderResp.Total,
+		"states":     orderStates,
+		"filter":     req,
+		"first_page": "/order?" + filters.Encode(),
+		"is_first":   req.Cursor == "",
+	}
+	if listOrderResp.NextCursor != "" {
+		next := url.Values{}
+		for k, v := range filters {
+			next[k] = v
+		}
+		next.Set("cursor", listOrderResp.NextCursor)
+		page["next_page"] = "/order?" + next.Encode()
+	}
+	if len(listOrderResp.Orders) == 0 {
+		return page, nil
 	}
 
 	for _, v := range listOrderResp.Orders {
-		var items []types.OrderItem
-		var total float32
-		if len(v.OrderItems) > 0 {
-			for _, vv := range v.OrderItems {
-				total += vv.Cost
-				i := vv.Item
-				productResp, err := rpc.ProductClient.GetProduct(h.Context, &rpcproduct.GetProductReq{Id: i.ProductId})
-				if err != nil {
-					return nil, err
-				}
-				if productResp.Product == nil {
-					continue
-				}
-				p := productResp.Product
-				items = append(items, types.OrderItem{
-					ProductId:   i.ProductId,
-					Qty:         uint32(i.Quantity),
-					ProductName: p.Name,
-					Picture:     p.Picture,
-					Cost:        vv.Cost,
-				})
-			}
+		o, err := toOrder(h.Context, v)
+		if err != nil {
+			return nil, err
 		}
-		timeObj := time.Unix(int64(v.CreatedAt), 0)
-		orders = append(orders, &types.Order{
-			Cost:        total,
-			Items:       items,
-			CreatedDate: timeObj.Format("2006-01-02 15:04:05"),
-			OrderId:     v.OrderId,
-			Consignee:   types.Consignee{Email: v.Email},
-		})
+		orders = append(orders, o)
+	}
+
+	orderIds := make([]string, 0, len(orders))
+	for _, o := range orders {
+		orderIds = append(orderIds, o.OrderId)
+	}
+	shipmentsResp, err := rpc.ShippingClient.ListShipments(h.Context, &rpcshipping.ListShipmentsReq{UserId: userId, OrderIds: orderIds})
+	if err != nil {
+		return nil, err
 	}
+	shipments := make(map[string]*types.Shipment, len(shipmentsResp.Shipments))
+	for _, s := range shipmentsResp.Shipments {
+		shipments[s.OrderId] = toShipment(s)
+	}
+	for _, o := range orders {
+		o.Shipment = shipments[o.OrderId]
+	}
+
+	page["orders"] = orders
+	return page, nil
+}
 
-	return utils.H{
-		"title":  "Order",
-		"orders": orders,
+func toOrder(ctx context.Context, v *rpcorder.Order) (*types.Order, error) {
+	var items []types.OrderItem
+	var total float32
+	for _, vv := range v.OrderItems {
+		total += vv.Cost
+		i := vv.Item
+		productResp, err := rpc.ProductClient.GetProduct(ctx, &rpcproduct.GetProductReq{Id: i.ProductId})
+		if err != nil {
+			return nil, err
+		}
+		if productResp.Product == nil {
+			continue
+		}
+		p := productResp.Product
+		items = append(items, types.OrderItem{
+			ProductId:   i.ProductId,
+			Qty:         uint32(i.Quantity),
+			ProductName: p.Name,
+			Picture:     p.Picture,
+			UnitPrice:   vv.UnitPrice,
+			Cost:        vv.Cost,
+		})
+	}
+	if !v.PricesIncludeTax {
+		total += v.Tax
+	}
+	var taxLines []types.TaxLine
+	for _, l := range v.TaxLines {
+		taxLines = append(taxLines, types.TaxLine{Name: l.Name, Rate: strconv.FormatFloat(float64(l.Rate*100), 'f', -1, 32), Amount: l.Amount})
+	}
+	var history []types.OrderStateChange
+	for _, c := range v.StateHistory {
+		history = append(history, types.OrderStateChange{State: c.State, Time: time.Unix(c.CreatedAt, 0).Format("2006-01-02 15:04:05")})
+	}
+	consignee := types.Consignee{Email: v.Email}
+	if a := v.Address; a != nil {
+		consignee.StreetAddress = a.StreetAddress
+		consignee.City = a.City
+		consignee.State = a.State
+		consignee.Country = a.Country
+		consignee.ZipCode = a.ZipCode
+	}
+	timeObj := time.Unix(int64(v.CreatedAt), 0)
+	return &types.Order{
+		Cost:             total + v.ShippingCost,
+		Items:            items,
+		CreatedDate:      timeObj.Format("2006-01-02 15:04:05"),
+		OrderId:          v.OrderId,
+		OrderState:       v.OrderState,
+		Consignee:        consignee,
+		ShippingCost:     v.ShippingCost,
+		ShippingMethod:   v.ShippingMethod,
+		Tax:              v.Tax,
+		PricesIncludeTax: v.PricesIncludeTax,
+		TaxLines:         taxLines,
+		TransactionId:    v.TransactionId,
+		InvoiceNumber:    v.InvoiceNumber,
+		StateHistory:     history,
 	}, nil
 }
+
+func toShipment(s *rpcshipping.Shipment) *types.Shipment {
+	shipment := &types.Shipment{Carrier: s.Carrier, TrackingNumber: s.TrackingNumber, Status: s.Status}
+	for _, e := range s.Events {
+		shipment.Events = append(shipment.Events, types.TrackingEvent{
+			Status:      e.Status,
+			Description: e.Description,
+			Time:        time.Unix(e.CreatedAt, 0).Format("2006-01-02 15:04:05"),
+		})
+	}
+	return shipment
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/order"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcorder "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type OrderReturnService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewOrderReturnService(Context context.Context, RequestContext *app.RequestContext) *OrderReturnService {
+	return &OrderReturnService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *OrderReturnService) Run(req *order.OrderReturnReq) (resp *rpcorder.CreateReturnResp, err error) {
+	userId := frontendutils.GetUserIdFromCtx(h.Context)
+	orderResp, err := rpc.OrderClient.GetOrder(h.Context, &rpcorder.GetOrderReq{UserId: userId, OrderId: req.OrderId})
+	if err != nil {
+		return nil, err
+	}
+	var items []*rpcorder.ReturnItem
+	for _, v := range orderResp.Order.OrderItems {
+		productId := v.Item.ProductId
+		qtyField := h.RequestContext.PostForm(fmt.Sprintf("qty_%d", productId))
+		if qtyField == "" || qtyField == "0" {
+			continue
+		}
+		qty, err := strconv.Atoi(qtyField)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity for %s", v.ProductName)
+		}
+		items = append(items, &rpcorder.ReturnItem{
+			ProductId: productId,
+			Quantity:  int32(qty),
+			Reason:    h.RequestContext.PostForm(fmt.Sprintf("reason_%d", productId)),
+		})
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("select at least one item to return")
+	}
+	return rpc.OrderClient.CreateReturn(h.Context, &rpcorder.CreateReturnReq{
+		UserId:  userId,
+		OrderId: req.OrderId,
+		Items:   items,
+		Comment: req.Comment,
+	})
+}
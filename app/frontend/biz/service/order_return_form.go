@@ -0,0 +1,118 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/order"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/types"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcorder "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+)
+
+var returnReasons = []types.ReturnReason{
+	{Value: "damaged", Label: "Arrived damaged"},
+	{Value: "wrong_item", Label: "Wrong item sent"},
+	{Value: "not_as_described", Label: "Not as described"},
+	{Value: "no_longer_needed", Label: "No longer needed"},
+	{Value: "other", Label: "Other"},
+}
+
+type OrderReturnFormService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewOrderReturnFormService(Context context.Context, RequestContext *app.RequestContext) *OrderReturnFormService {
+	return &OrderReturnFormService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *OrderReturnFormService) Run(req *order.OrderReturnFormReq) (resp map[string]any, err error) {
+	userId := frontendutils.GetUserIdFromCtx(h.Context)
+	orderResp, err := rpc.OrderClient.GetOrder(h.Context, &rpcorder.GetOrderReq{UserId: userId, OrderId: req.OrderId})
+	if err != nil {
+		return nil, err
+	}
+	o, err := toOrder(h.Context, orderResp.Order)
+	if err != nil {
+		return nil, err
+	}
+	returnsResp, err := rpc.OrderClient.ListReturns(h.Context, &rpcorder.ListReturnsReq{UserId: userId, OrderId: req.OrderId})
+	if err != nil {
+		return nil, err
+	}
+	// the order service checks again on submit, this only keeps the form from offering too much
+	returned := make(map[uint32]int32)
+	for _, r := range returnsResp.Returns {
+		if r.State == "rejected" {
+			continue
+		}
+		for _, v := range r.Items {
+			returned[v.ProductId] += v.Quantity
+		}
+	}
+	var items []types.ReturnableItem
+	for _, v := range o.Items {
+		left := int32(v.Qty) - returned[v.ProductId]
+		if left <= 0 {
+			continue
+		}
+		items = append(items, types.ReturnableItem{
+			ProductId:   v.ProductId,
+			ProductName: v.ProductName,
+			Picture:     v.Picture,
+			UnitPrice:   v.UnitPrice,
+			Returnable:  left,
+		})
+	}
+	return utils.H{
+		"title":   "Return items of order " + o.OrderId,
+		"order":   o,
+		"items":   items,
+		"reasons": returnReasons,
+	}, nil
+}
+
+func toReturn(r *rpcorder.Return) types.Return {
+	ret := types.Return{
+		ReturnId:       r.ReturnId,
+		State:          r.State,
+		CreatedDate:    time.Unix(r.CreatedAt, 0).Format("2006-01-02 15:04:05"),
+		Comment:        r.Comment,
+		AdminNote:      r.AdminNote,
+		Carrier:        r.Carrier,
+		TrackingNumber: r.TrackingNumber,
+		RefundAmount:   r.RefundAmount,
+	}
+	for _, v := range r.Items {
+		reason := v.Reason
+		for _, rr := range returnReasons {
+			if rr.Value == v.Reason {
+				reason = rr.Label
+			}
+		}
+		ret.Items = append(ret.Items, types.ReturnItem{ProductId: v.ProductId, ProductName: v.ProductName, Qty: v.Quantity, Reason: reason})
+	}
+	if a := r.ShipTo; a != nil {
+		ret.ShipTo = []string{a.Name, a.StreetAddress, strings.TrimSpace(a.City + ", " + a.State + " " + a.ZipCode), a.Country}
+	}
+	return ret
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+)
+
+// the choices of the profile form, the user service accepts the same
+var (
+	profileLocales    = []string{"en", "zh"}
+	profileCurrencies = []string{"USD", "CNY", "EUR"}
+)
+
+type ProfileService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewProfileService(Context context.Context, RequestContext *app.RequestContext) *ProfileService {
+	return &ProfileService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *ProfileService) Run(req *common.Empty) (resp map[string]any, err error) {
+	userId := frontendutils.GetUserIdFromCtx(h.Context)
+	profile, err := rpc.UserClient.GetProfile(h.Context, &rpcuser.GetProfileReq{UserId: userId})
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := rpc.UserClient.ListAddresses(h.Context, &rpcuser.ListAddressesReq{UserId: userId})
+	if err != nil {
+		return nil, err
+	}
+	return utils.H{
+		"title":     "Profile",
+		"profile":   profile.Profile,
+		"addresses": addresses.Addresses,
+		// the empty form to add an address
+		"new_address": &rpcuser.Address{},
+		"locales":     profileLocales,
+		"currencies":  profileCurrencies,
+	}, nil
+}
@@ -20,6 +20,7 @@ import (
 	auth "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/auth"
 	common "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
 	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/hertz-contrib/sessions"
@@ -35,7 +36,7 @@ func NewRegisterService(Context context.Context, RequestContext *app.RequestCont
 }
 
 func (h *RegisterService) Run(req *auth.RegisterReq) (resp *common.Empty, err error) {
-	res, err := rpc.UserClient.Register(h.Context, &rpcuser.RegisterReq{
+	_, err = rpc.UserClient.Register(h.Context, &rpcuser.RegisterReq{
 		Email:           req.Email,
 		Password:        req.Password,
 		ConfirmPassword: req.Password,
@@ -43,10 +44,18 @@ func (h *RegisterService) Run(req *auth.RegisterReq) (resp *common.Empty, err er
 	if err != nil {
 		return nil, err
 	}
+	res, err := rpc.UserClient.Login(h.Context, &rpcuser.LoginReq{
+		Email:     req.Email,
+		Password:  req.Password,
+		UserAgent: string(h.RequestContext.UserAgent()),
+		Ip:        h.RequestContext.ClientIP(),
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	session := sessions.Default(h.RequestContext)
-	session.Set("user_id", res.UserId)
-	err = session.Save()
+	err = frontendutils.SaveLogin(session, res.UserId, res.Token, res.RefreshToken, res.ExpiresIn, res.SessionId, res.EmailVerified)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,49 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	auth "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/auth"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/hertz-contrib/sessions"
+)
+
+type ResetPasswordService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewResetPasswordService(Context context.Context, RequestContext *app.RequestContext) *ResetPasswordService {
+	return &ResetPasswordService{RequestContext: RequestContext, Context: Context}
+}
+
+// Run sets the new password. Every session of the user ends, this one is cleared too.
+func (h *ResetPasswordService) Run(req *auth.ResetPasswordReq) (err error) {
+	_, err = rpc.UserClient.ResetPassword(h.Context, &rpcuser.ResetPasswordReq{
+		Token:           req.Token,
+		Password:        req.Password,
+		ConfirmPassword: req.ConfirmPassword,
+	})
+	if err != nil {
+		return err
+	}
+	session := sessions.Default(h.RequestContext)
+	session.Clear()
+	return session.Save()
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+)
+
+type SecurityService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewSecurityService(Context context.Context, RequestContext *app.RequestContext) *SecurityService {
+	return &SecurityService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *SecurityService) Run(req *common.Empty) (resp map[string]any, err error) {
+	res, err := rpc.UserClient.GetTotpStatus(h.Context, &rpcuser.GetTotpStatusReq{UserId: frontendutils.GetUserIdFromCtx(h.Context)})
+	if err != nil {
+		return nil, err
+	}
+	return utils.H{
+		"title":               "Security",
+		"status":              true,
+		"enabled":             res.Enabled,
+		"recovery_codes_left": res.RecoveryCodesLeft,
+		"has_password":        res.HasPassword,
+	}, nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/types"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/hertz-contrib/sessions"
+)
+
+type SessionListService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewSessionListService(Context context.Context, RequestContext *app.RequestContext) *SessionListService {
+	return &SessionListService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *SessionListService) Run(req *common.Empty) (resp map[string]any, err error) {
+	res, err := rpc.UserClient.ListSessions(h.Context, &rpcuser.ListSessionsReq{UserId: frontendutils.GetUserIdFromCtx(h.Context)})
+	if err != nil {
+		return nil, err
+	}
+	current := frontendutils.SessionString(sessions.Default(h.RequestContext), frontendutils.SessionId)
+	var list []types.Session
+	for _, v := range res.Sessions {
+		list = append(list, types.Session{
+			SessionId: v.SessionId,
+			UserAgent: v.UserAgent,
+			Ip:        v.Ip,
+			CreatedAt: time.Unix(v.CreatedAt, 0).Format("2006-01-02 15:04:05"),
+			LastUsed:  time.Unix(v.LastUsedAt, 0).Format("2006-01-02 15:04:05"),
+			Current:   v.SessionId == current,
+		})
+	}
+	return utils.H{
+		"title":    "Signed-in devices",
+		"sessions": list,
+	}, nil
+}
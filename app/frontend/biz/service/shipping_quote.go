@@ -0,0 +1,85 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/checkout"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpccart "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
+	rpcproduct "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	rpcshipping "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+)
+
+type ShippingQuoteService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewShippingQuoteService(Context context.Context, RequestContext *app.RequestContext) *ShippingQuoteService {
+	return &ShippingQuoteService{RequestContext: RequestContext, Context: Context}
+}
+
+// Run quotes shipping for the cart, or for a single flash sale product when product_id is set.
+func (h *ShippingQuoteService) Run(req *checkout.ShippingQuoteReq) (resp map[string]any, err error) {
+	var weight float32
+	if req.ProductId != 0 {
+		productResp, err := rpc.ProductClient.GetProduct(h.Context, &rpcproduct.GetProductReq{Id: req.ProductId})
+		if err != nil {
+			return nil, err
+		}
+		weight = productResp.Product.Weight
+	} else {
+		carts, err := rpc.CartClient.GetCart(h.Context, &rpccart.GetCartReq{UserId: frontendutils.GetUserIdFromCtx(h.Context)})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range carts.Cart.Items {
+			productResp, err := rpc.ProductClient.GetProduct(h.Context, &rpcproduct.GetProductReq{Id: v.ProductId})
+			if err != nil {
+				return nil, err
+			}
+			if productResp.Product == nil {
+				continue
+			}
+			weight += productResp.Product.Weight * float32(v.Quantity)
+		}
+	}
+	quoteResp, err := rpc.ShippingClient.GetQuote(h.Context, &rpcshipping.GetQuoteReq{
+		Address: &rpcshipping.Address{Country: req.Country},
+		Weight:  weight,
+	})
+	if err != nil {
+		return nil, err
+	}
+	quotes := make([]map[string]any, 0, len(quoteResp.Quotes))
+	for _, q := range quoteResp.Quotes {
+		quotes = append(quotes, map[string]any{
+			"method":   q.Method,
+			"name":     q.Name,
+			"cost":     q.Cost,
+			"min_days": q.MinDays,
+			"max_days": q.MaxDays,
+		})
+	}
+	return utils.H{
+		"zone":   quoteResp.Zone,
+		"quotes": quotes,
+	}, nil
+}
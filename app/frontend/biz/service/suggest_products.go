@@ -0,0 +1,52 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"strings"
+
+	product "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/product"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	rpcproduct "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+)
+
+type SuggestProductsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewSuggestProductsService(Context context.Context, RequestContext *app.RequestContext) *SuggestProductsService {
+	return &SuggestProductsService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *SuggestProductsService) Run(req *product.SuggestProductsReq) (resp map[string]any, err error) {
+	suggestions := []*rpcproduct.Suggestion{}
+	if strings.TrimSpace(req.Q) == "" {
+		return utils.H{"suggestions": suggestions}, nil
+	}
+	p, err := rpc.ProductClient.SuggestProducts(h.Context, &rpcproduct.SuggestProductsReq{Prefix: req.Q, Limit: req.Limit})
+	if err != nil {
+		return nil, err
+	}
+	if len(p.Suggestions) > 0 {
+		suggestions = p.Suggestions
+	}
+	return utils.H{
+		"suggestions": suggestions,
+	}, nil
+}
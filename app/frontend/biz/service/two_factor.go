@@ -0,0 +1,64 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	auth "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/auth"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/hertz-contrib/sessions"
+)
+
+type TwoFactorService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewTwoFactorService(Context context.Context, RequestContext *app.RequestContext) *TwoFactorService {
+	return &TwoFactorService{RequestContext: RequestContext, Context: Context}
+}
+
+// Run trades the challenge Login kept in the session and the code for the tokens.
+func (h *TwoFactorService) Run(req *auth.TwoFactorReq) (resp string, err error) {
+	session := sessions.Default(h.RequestContext)
+	challenge := frontendutils.SessionString(session, frontendutils.SessionLoginChallenge)
+	if challenge == "" {
+		return "", errors.New("the sign-in has expired, please sign in again")
+	}
+	res, err := rpc.UserClient.VerifyTwoFactor(h.Context, &rpcuser.VerifyTwoFactorReq{
+		ChallengeToken: challenge,
+		Code:           req.Code,
+		UserAgent:      string(h.RequestContext.UserAgent()),
+		Ip:             h.RequestContext.ClientIP(),
+	})
+	if err != nil {
+		return "", err
+	}
+	session.Delete(frontendutils.SessionLoginChallenge)
+	err = frontendutils.SaveLogin(session, res.UserId, res.Token, res.RefreshToken, res.ExpiresIn, res.SessionId, res.EmailVerified)
+	if err != nil {
+		return "", err
+	}
+	redirect := "/"
+	if frontendutils.ValidateNext(req.Next) {
+		redirect = req.Next
+	}
+	return redirect, nil
+}
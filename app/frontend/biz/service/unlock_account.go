@@ -0,0 +1,44 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	auth "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/auth"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+)
+
+type UnlockAccountService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewUnlockAccountService(Context context.Context, RequestContext *app.RequestContext) *UnlockAccountService {
+	return &UnlockAccountService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *UnlockAccountService) Run(req *auth.UnlockAccountReq) (resp map[string]any, err error) {
+	if _, err = rpc.UserClient.UnlockAccount(h.Context, &rpcuser.UnlockAccountReq{Token: req.Token}); err != nil {
+		return nil, err
+	}
+	return utils.H{
+		"title":    "Account unlocked",
+		"unlocked": true,
+	}, nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/account"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+)
+
+type UnsubscribePageService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewUnsubscribePageService(Context context.Context, RequestContext *app.RequestContext) *UnsubscribePageService {
+	return &UnsubscribePageService{RequestContext: RequestContext, Context: Context}
+}
+
+// Run only shows the confirmation, the form posts the token back to Unsubscribe.
+func (h *UnsubscribePageService) Run(req *account.UnsubscribeReq) (resp map[string]any, err error) {
+	if req.Token == "" {
+		return nil, errors.New("the unsubscribe link is invalid")
+	}
+	return utils.H{
+		"title":    "Unsubscribe",
+		"token":    req.Token,
+		"category": req.Category,
+	}, nil
+}
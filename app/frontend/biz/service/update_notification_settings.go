@@ -0,0 +1,85 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/account"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type UpdateNotificationSettingsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewUpdateNotificationSettingsService(Context context.Context, RequestContext *app.RequestContext) *UpdateNotificationSettingsService {
+	return &UpdateNotificationSettingsService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *UpdateNotificationSettingsService) Run(req *account.NotificationsReq) (err error) {
+	userId := frontendutils.GetUserIdFromCtx(h.Context)
+	quietStart, err := parseMinutes(req.QuietStart)
+	if err != nil {
+		return err
+	}
+	quietEnd, err := parseMinutes(req.QuietEnd)
+	if err != nil {
+		return err
+	}
+	// the current preferences name the categories and channels the form has checkboxes for
+	current, err := rpc.UserClient.GetNotificationPreferences(h.Context, &rpcuser.GetNotificationPreferencesReq{UserId: userId})
+	if err != nil {
+		return err
+	}
+	var prefs []*rpcuser.NotificationPreference
+	for _, p := range current.Preferences {
+		prefs = append(prefs, &rpcuser.NotificationPreference{
+			Category: p.Category,
+			Channel:  p.Channel,
+			Enabled:  h.RequestContext.PostForm(preferenceField(p.Category, p.Channel)) == "on",
+		})
+	}
+	_, err = rpc.UserClient.UpdateNotificationPreferences(h.Context, &rpcuser.UpdateNotificationPreferencesReq{
+		UserId:      userId,
+		Preferences: prefs,
+		Settings: &rpcuser.NotificationSettings{
+			Phone:      req.Phone,
+			WebhookUrl: req.WebhookUrl,
+			QuietStart: quietStart,
+			QuietEnd:   quietEnd,
+			Timezone:   req.Timezone,
+		},
+	})
+	return err
+}
+
+// parseMinutes reads hh:mm as minutes after midnight, empty is midnight.
+func parseMinutes(s string) (int32, error) {
+	if s == "" {
+		return 0, nil
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, use hh:mm", s)
+	}
+	return int32(t.Hour()*60 + t.Minute()), nil
+}
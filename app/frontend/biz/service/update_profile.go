@@ -0,0 +1,45 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/account"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type UpdateProfileService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewUpdateProfileService(Context context.Context, RequestContext *app.RequestContext) *UpdateProfileService {
+	return &UpdateProfileService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *UpdateProfileService) Run(req *account.ProfileReq) (err error) {
+	_, err = rpc.UserClient.UpdateProfile(h.Context, &rpcuser.UpdateProfileReq{
+		UserId:   frontendutils.GetUserIdFromCtx(h.Context),
+		Name:     req.Name,
+		Phone:    req.Phone,
+		Locale:   req.Locale,
+		Currency: req.Currency,
+	})
+	return
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	auth "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/auth"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/hertz-contrib/sessions"
+)
+
+type VerifyEmailService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewVerifyEmailService(Context context.Context, RequestContext *app.RequestContext) *VerifyEmailService {
+	return &VerifyEmailService{RequestContext: RequestContext, Context: Context}
+}
+
+// Run verifies the email of the link. When the user is signed in here the access token is
+// refreshed on the next request, to carry the verification to the services.
+func (h *VerifyEmailService) Run(req *auth.VerifyEmailReq) (resp map[string]any, err error) {
+	res, err := rpc.UserClient.VerifyEmail(h.Context, &rpcuser.VerifyEmailReq{Token: req.Token})
+	if err != nil {
+		return nil, err
+	}
+	if userId := frontendutils.GetUserIdFromCtx(h.Context); userId != 0 && userId == res.UserId {
+		session := sessions.Default(h.RequestContext)
+		session.Set(frontendutils.SessionEmailVerified, true)
+		frontendutils.ExpireAccess(session)
+		if err = session.Save(); err != nil {
+			return nil, err
+		}
+	}
+	return utils.H{
+		"title": "Email verified",
+		"email": res.Email,
+	}, nil
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/hertz-contrib/sessions"
 )
 
 // SendErrResponse  pack error response
@@ -45,5 +46,6 @@ func WarpResponse(ctx context.Context, c *app.RequestContext, content map[string
 	}
 	content["user_id"] = ctx.Value(frontendutils.UserIdKey)
 	content["cart_num"] = cartNum
+	content["email_verified"] = sessions.Default(c).Get(frontendutils.SessionEmailVerified) == true
 	return content
 }
@@ -61,6 +61,10 @@ type Hertz struct {
 	LogMaxBackups   int    `yaml:"log_max_backups"`
 	LogMaxAge       int    `yaml:"log_max_age"`
 	RegistryAddr    string `yaml:"registry_addr"`
+	// TrustedProxies are the addresses or CIDRs of the proxies in front of the frontend. Only
+	// their X-Forwarded-For and X-Real-IP headers are believed, the client IP is the remote
+	// address otherwise.
+	TrustedProxies []string `yaml:"trusted_proxies"`
 }
 
 // GetConf gets configuration instance
@@ -27,6 +27,7 @@ import (
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order/orderservice"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product/productcatalogservice"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping/shippingservice"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user/userservice"
 	"github.com/cloudwego/kitex/client"
 	"github.com/cloudwego/kitex/pkg/circuitbreak"
@@ -41,6 +42,7 @@ var (
 	CartClient     cartservice.Client
 	CheckoutClient checkoutservice.Client
 	OrderClient    orderservice.Client
+	ShippingClient shippingservice.Client
 	once           sync.Once
 	err            error
 	registryAddr   string
@@ -59,6 +61,7 @@ func InitClient() {
 		initCartClient()
 		initCheckoutClient()
 		initOrderClient()
+		initShippingClient()
 	})
 }
 
@@ -115,3 +118,8 @@ func initOrderClient() {
 	OrderClient, err = orderservice.NewClient("order", commonSuite)
 	frontendutils.MustHandleError(err)
 }
+
+func initShippingClient() {
+	ShippingClient, err = shippingservice.NewClient("shipping", commonSuite)
+	frontendutils.MustHandleError(err)
+}
@@ -21,6 +21,7 @@ import (
 	"os"
 
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/biz/router"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/biz/service"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/conf"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/mtl"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
@@ -69,10 +70,11 @@ func main() {
 	),
 		tracer,
 	)
+	h.SetClientIPFunc(middleware.ClientIP(conf.GetConf().Hertz.TrustedProxies))
 	h.LoadHTMLGlob("template/*")
 	h.Delims("{{", "}}")
 
-	h.Use(hertzoteltracing.ServerMiddleware(cfg))
+	h.Use(hertzoteltracing.ServerMiddleware(cfg), middleware.RedactQuery())
 	registerMiddleware(h)
 
 	// add a ping route to test
@@ -84,7 +86,14 @@ func main() {
 
 	h.GET("sign-in", func(ctx context.Context, c *app.RequestContext) {
 		c.HTML(consts.StatusOK, "sign-in", utils.H{
-			"title": "Sign in",
+			"title":     "Sign in",
+			"next":      c.Query("next"),
+			"providers": service.SignInProviders(ctx),
+		})
+	})
+	h.GET("two-factor", func(ctx context.Context, c *app.RequestContext) {
+		c.HTML(consts.StatusOK, "two-factor", utils.H{
+			"title": "Two-factor authentication",
 			"next":  c.Query("next"),
 		})
 	})
@@ -93,6 +102,23 @@ func main() {
 			"title": "Sign up",
 		})
 	})
+	h.GET("forgot-password", func(ctx context.Context, c *app.RequestContext) {
+		c.HTML(consts.StatusOK, "forgot-password", utils.H{
+			"title": "Forgot password",
+		})
+	})
+	h.GET("reset-password", func(ctx context.Context, c *app.RequestContext) {
+		c.HTML(consts.StatusOK, "reset-password", utils.H{
+			"title": "Reset password",
+			"token": c.Query("token"),
+		})
+	})
+	h.GET("delete-account", func(ctx context.Context, c *app.RequestContext) {
+		c.HTML(consts.StatusOK, "delete-account", utils.H{
+			"title": "Delete account",
+			"token": c.Query("token"),
+		})
+	})
 	h.GET("/redirect", func(ctx context.Context, c *app.RequestContext) {
 		c.HTML(consts.StatusOK, "about", utils.H{
 			"title": "Error",
@@ -17,29 +17,81 @@ package middleware
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/kitex/pkg/kerrors"
 	"github.com/hertz-contrib/sessions"
 )
 
+// sessionUser returns the user of the session, refreshing the access token when it
+// expired. Sessions whose refresh token was revoked, e.g. by logging out of all devices,
+// are cleared.
+func sessionUser(ctx context.Context, c *app.RequestContext) any {
+	session := sessions.Default(c)
+	userId := session.Get("user_id")
+	if userId == nil || !utils.AccessExpired(session, time.Now()) {
+		return userId
+	}
+	refreshToken := utils.SessionString(session, utils.SessionRefreshToken)
+	if refreshToken == "" {
+		// logged in before sessions had tokens
+		session.Clear()
+		session.Save() //nolint:errcheck
+		return nil
+	}
+	res, err := rpc.UserClient.RefreshToken(ctx, &rpcuser.RefreshTokenReq{
+		RefreshToken: refreshToken,
+		UserAgent:    string(c.UserAgent()),
+		Ip:           c.ClientIP(),
+	})
+	if err != nil {
+		bizErr, ok := kerrors.FromBizStatusError(err)
+		switch {
+		case ok && bizErr.BizStatusCode() == 40100:
+			session.Clear()
+			session.Save() //nolint:errcheck
+			return nil
+		case ok && bizErr.BizStatusCode() == 40900:
+			// a concurrent request of this session refreshed it
+		default:
+			hlog.CtxWarnf(ctx, "refresh session: %v", err)
+		}
+		return userId
+	}
+	if err = utils.SaveTokens(session, res.Token, res.RefreshToken, res.ExpiresIn, res.SessionId, res.EmailVerified); err != nil {
+		hlog.CtxErrorf(ctx, "save session: %v", err)
+	}
+	return userId
+}
+
+// withUser stores the user for the handlers and forwards the access token to the services
+// they call.
+func withUser(ctx context.Context, c *app.RequestContext, userId any) context.Context {
+	ctx = context.WithValue(ctx, utils.UserIdKey, userId)
+	return authn.WithToken(ctx, utils.SessionString(sessions.Default(c), utils.SessionAccessToken))
+}
+
 func GlobalAuth() app.HandlerFunc {
 	return func(ctx context.Context, c *app.RequestContext) {
-		session := sessions.Default(c)
-		userId := session.Get("user_id")
+		userId := sessionUser(ctx, c)
 		if userId == nil {
 			c.Next(ctx)
 			return
 		}
-		ctx = context.WithValue(ctx, utils.UserIdKey, userId)
+		ctx = withUser(ctx, c, userId)
 		c.Next(ctx)
 	}
 }
 
 func Auth() app.HandlerFunc {
 	return func(ctx context.Context, c *app.RequestContext) {
-		session := sessions.Default(c)
-		userId := session.Get("user_id")
+		userId := sessionUser(ctx, c)
 		if userId == nil {
 			byteRef := c.GetHeader("Referer")
 			ref := string(byteRef)
@@ -54,7 +106,7 @@ func Auth() app.HandlerFunc {
 			c.Next(ctx)
 			return
 		}
-		ctx = context.WithValue(ctx, utils.UserIdKey, userId)
+		ctx = withUser(ctx, c, userId)
 		c.Next(ctx)
 	}
 }
@@ -0,0 +1,52 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	"github.com/cloudwego/biz-demo/gomall/common/jwks"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+var tokenVerifier = jwks.NewVerifier(func(ctx context.Context) (*jwks.Set, error) {
+	resp, err := rpc.UserClient.GetJwks(ctx, &rpcuser.GetJwksReq{})
+	if err != nil {
+		return nil, err
+	}
+	return jwks.Parse([]byte(resp.Jwks))
+}, jwks.IssuerFromEnv(), 10*time.Minute)
+
+// RequirePermission lets through users whose access token grants perm, it goes after Auth.
+// The services check the permission again, this keeps the pages from others.
+func RequirePermission(perm string) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		claims, err := tokenVerifier.Verify(ctx, authn.Token(ctx))
+		if err != nil {
+			hlog.CtxWarnf(ctx, "verify token: %v", err)
+		}
+		if err != nil || !claims.HasPermission(perm) {
+			c.AbortWithMsg(consts.StatusMessage(consts.StatusForbidden), consts.StatusForbidden)
+			return
+		}
+		c.Next(ctx)
+	}
+}
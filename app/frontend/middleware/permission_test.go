@@ -0,0 +1,57 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	"github.com/cloudwego/biz-demo/gomall/common/authn/authntest"
+	"github.com/cloudwego/biz-demo/gomall/common/jwks"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+func TestRequirePermission(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		perms []string
+		want  int
+	}{
+		{"granted", []string{authn.PermRolesManage}, consts.StatusOK},
+		{"lacking", []string{authn.PermCatalogWrite}, consts.StatusForbidden},
+		{"none", nil, consts.StatusForbidden},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			token, verifier := authntest.Token(t, 1, tc.perms...)
+			defer func(v *jwks.Verifier) { tokenVerifier = v }(tokenVerifier)
+			tokenVerifier = verifier
+
+			h := server.Default()
+			h.GET("/admin/roles", func(ctx context.Context, c *app.RequestContext) {
+				c.Next(authn.WithToken(ctx, token))
+			}, RequirePermission(authn.PermRolesManage), func(ctx context.Context, c *app.RequestContext) {
+				c.String(consts.StatusOK, "roles")
+			})
+			w := ut.PerformRequest(h.Engine, "GET", "/admin/roles", nil)
+			if got := w.Result().StatusCode(); got != tc.want {
+				t.Fatalf("status = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
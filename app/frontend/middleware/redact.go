@@ -0,0 +1,40 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/common/redact"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// RedactQuery masks the tokens of emailed links and other credentials in the query once the
+// request is handled, so that the tracer does not record them with the URL.
+func RedactQuery() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		c.Next(ctx)
+		args := c.URI().QueryArgs()
+		var keys []string
+		args.VisitAll(func(k, _ []byte) {
+			if redact.Sensitive(string(k)) {
+				keys = append(keys, string(k))
+			}
+		})
+		for _, k := range keys {
+			args.Set(k, redact.Mask)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+(function () {
+    const container = document.getElementById("shipping-methods");
+    const country = document.getElementById("country");
+    const province = document.getElementById("province");
+    const summary = document.getElementById("checkout-summary");
+    if (!container || !country || !summary) {
+        return;
+    }
+    const form = container.closest("form");
+    const productId = form.querySelector("input[name=productId]");
+    const subtotal = document.getElementById("checkout-subtotal");
+    const shippingCost = document.getElementById("checkout-shipping-cost");
+    const taxLines = document.getElementById("checkout-tax-lines");
+    const tax = document.getElementById("checkout-tax");
+    const taxNote = document.getElementById("checkout-tax-note");
+    const total = document.getElementById("checkout-total");
+    let controller = null;
+    let totalController = null;
+
+    function withProduct(url) {
+        if (productId) {
+            url += "&productId=" + encodeURIComponent(productId.value);
+        }
+        return url;
+    }
+
+    function renderTotal(data) {
+        subtotal.textContent = data.subtotal.toFixed(2);
+        shippingCost.textContent = data.shipping_method ? data.shipping_cost.toFixed(2) : "-";
+        taxLines.innerHTML = "";
+        (data.tax_lines || []).forEach(function (l) {
+            const li = document.createElement("li");
+            li.textContent = l.name + " " + (l.rate * 100).toFixed(2).replace(/\.?0+$/, "") + "%: $" + l.amount.toFixed(2);
+            taxLines.appendChild(li);
+        });
+        tax.textContent = data.tax.toFixed(2);
+        taxNote.textContent = data.prices_include_tax ? "(included in prices)" : "";
+        total.textContent = data.total.toFixed(2);
+    }
+
+    function updateTotal() {
+        if (totalController) {
+            totalController.abort();
+        }
+        totalController = new AbortController();
+        const selected = form.querySelector("input[name=shippingMethod]:checked");
+        let url = summary.dataset.quoteUrl + "?country=" + encodeURIComponent(country.value);
+        if (province) {
+            url += "&province=" + encodeURIComponent(province.value);
+        }
+        if (selected) {
+            url += "&shippingMethod=" + encodeURIComponent(selected.value);
+        }
+        fetch(withProduct(url), {signal: totalController.signal})
+            .then(function (resp) {
+                return resp.ok ? resp.json() : null;
+            })
+            .then(function (data) {
+                if (data) {
+                    renderTotal(data);
+                }
+            })
+            .catch(function () {
+            });
+    }
+
+    function render(quotes) {
+        container.innerHTML = "";
+        if (quotes.length === 0) {
+            container.innerHTML = '<div class="text-danger">We do not ship to this country.</div>';
+        }
+        quotes.forEach(function (q, i) {
+            const id = "shipping-" + q.method;
+            const wrapper = document.createElement("div");
+            wrapper.className = "form-check";
+            const input = document.createElement("input");
+            input.className = "form-check-input";
+            input.type = "radio";
+            input.name = "shippingMethod";
+            input.id = id;
+            input.value = q.method;
+            input.checked = i === 0;
+            input.addEventListener("change", updateTotal);
+            const label = document.createElement("label");
+            label.className = "form-check-label";
+            label.htmlFor = id;
+            label.textContent = q.name + " (" + q.min_days + "-" + q.max_days + " days): $" + q.cost.toFixed(2);
+            wrapper.appendChild(input);
+            wrapper.appendChild(label);
+            container.appendChild(wrapper);
+        });
+        updateTotal();
+    }
+
+    function fetchQuotes() {
+        if (controller) {
+            controller.abort();
+        }
+        controller = new AbortController();
+        const url = container.dataset.quoteUrl + "?country=" + encodeURIComponent(country.value);
+        fetch(withProduct(url), {signal: controller.signal})
+            .then(function (resp) {
+                return resp.ok ? resp.json() : {quotes: []};
+            })
+            .then(function (data) {
+                render(data.quotes || []);
+            })
+            .catch(function () {
+            });
+    }
+
+    country.addEventListener("change", fetchQuotes);
+    if (province) {
+        province.addEventListener("change", updateTotal);
+    }
+    fetchQuotes();
+})();
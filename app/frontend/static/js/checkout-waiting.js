@@ -0,0 +1,38 @@
+(function () {
+    const container = document.getElementById("checkout-waiting");
+    if (!container || !container.dataset.statusUrl) {
+        return;
+    }
+    const statusUrl = container.dataset.statusUrl;
+    const resultUrl = container.dataset.resultUrl || "/checkout/result";
+    const stepText = document.getElementById("checkout-waiting-step");
+    const steps = {
+        cart: "Checking your cart",
+        order: "Creating your order",
+        payment: "Processing your payment",
+        confirm: "Confirming your order"
+    };
+    const intervalMs = 1000;
+
+    function poll() {
+        fetch(statusUrl)
+            .then(function (resp) {
+                return resp.ok ? resp.json() : {};
+            })
+            .then(function (data) {
+                if (data.status === "success" || data.status === "failed") {
+                    window.location.href = resultUrl;
+                    return;
+                }
+                if (stepText && steps[data.step]) {
+                    stepText.textContent = steps[data.step];
+                }
+                setTimeout(poll, intervalMs);
+            })
+            .catch(function () {
+                setTimeout(poll, intervalMs);
+            });
+    }
+
+    setTimeout(poll, intervalMs);
+})();
@@ -0,0 +1,50 @@
+(function () {
+    const input = document.getElementById("search-input");
+    const list = document.getElementById("search-suggestions");
+    if (!input || !list) {
+        return;
+    }
+    const debounceMs = 250;
+    // the product service ignores shorter prefixes
+    const minPrefixLength = 2;
+    let timer = null;
+    let controller = null;
+
+    function render(suggestions) {
+        list.innerHTML = "";
+        suggestions.forEach(function (s) {
+            const option = document.createElement("option");
+            option.value = s.text;
+            option.label = s.kind;
+            list.appendChild(option);
+        });
+    }
+
+    function fetchSuggestions(q) {
+        if (controller) {
+            controller.abort();
+        }
+        controller = new AbortController();
+        fetch("/search/suggest?q=" + encodeURIComponent(q), {signal: controller.signal})
+            .then(function (resp) {
+                return resp.ok ? resp.json() : {suggestions: []};
+            })
+            .then(function (data) {
+                render(data.suggestions || []);
+            })
+            .catch(function () {
+            });
+    }
+
+    input.addEventListener("input", function () {
+        const q = input.value.trim();
+        clearTimeout(timer);
+        if (q.length < minPrefixLength) {
+            render([]);
+            return;
+        }
+        timer = setTimeout(function () {
+            fetchSuggestions(q);
+        }, debounceMs);
+    });
+})();
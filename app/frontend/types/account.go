@@ -0,0 +1,39 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// NotificationRow is one category of the notification settings form.
+type NotificationRow struct {
+	Category string
+	Channels []NotificationChannel
+}
+
+type NotificationChannel struct {
+	Channel string
+	// Field is the checkbox name, pref_<category>_<channel>
+	Field   string
+	Enabled bool
+}
+
+// Session is a device the user is signed in on.
+type Session struct {
+	SessionId string
+	UserAgent string
+	Ip        string
+	CreatedAt string
+	LastUsed  string
+	// Current is the session of this browser
+	Current bool
+}
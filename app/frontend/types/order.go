@@ -31,6 +31,42 @@ type Order struct {
 	OrderState  string
 	Cost        float32
 	Items       []OrderItem
+	// ShippingCost is included in Cost
+	ShippingCost   float32
+	ShippingMethod string
+	Shipment       *Shipment
+	// Tax is included in Cost, either on top of the items or already in their prices
+	Tax              float32
+	PricesIncludeTax bool
+	TaxLines         []TaxLine
+	TransactionId    string
+	InvoiceNumber    string
+	StateHistory     []OrderStateChange
+}
+
+type OrderStateChange struct {
+	State string
+	Time  string
+}
+
+type TaxLine struct {
+	Name string
+	// Rate is a percentage
+	Rate   string
+	Amount float32
+}
+
+type Shipment struct {
+	Carrier        string
+	TrackingNumber string
+	Status         string
+	Events         []TrackingEvent
+}
+
+type TrackingEvent struct {
+	Status      string
+	Description string
+	Time        string
 }
 
 type OrderItem struct {
@@ -38,5 +74,41 @@ type OrderItem struct {
 	ProductName string
 	Picture     string
 	Qty         uint32
+	UnitPrice   float32
 	Cost        float32
 }
+
+type Return struct {
+	ReturnId       string
+	State          string
+	CreatedDate    string
+	Items          []ReturnItem
+	Comment        string
+	AdminNote      string
+	Carrier        string
+	TrackingNumber string
+	// ShipTo is the address lines of the warehouse the parcel goes back to
+	ShipTo       []string
+	RefundAmount float32
+}
+
+type ReturnItem struct {
+	ProductId   uint32
+	ProductName string
+	Qty         int32
+	Reason      string
+}
+
+// ReturnableItem is an order item in the return form, Returnable being the units not returned yet.
+type ReturnableItem struct {
+	ProductId   uint32
+	ProductName string
+	Picture     string
+	UnitPrice   float32
+	Returnable  int32
+}
+
+type ReturnReason struct {
+	Value string
+	Label string
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"time"
+
+	"github.com/hertz-contrib/sessions"
+)
+
+// The session keys of a login, next to user_id.
+const (
+	SessionAccessToken     = "access_token"
+	SessionAccessExpiresAt = "access_expires_at"
+	SessionRefreshToken    = "refresh_token"
+	SessionId              = "session_id"
+	SessionEmailVerified   = "email_verified"
+	// SessionLoginChallenge keeps a sign-in waiting for the code of the authenticator app
+	SessionLoginChallenge = "login_challenge"
+	// SessionOidcState and SessionOidcNext keep a sign-in at an identity provider until the
+	// provider sends the browser back
+	SessionOidcState = "oidc_state"
+	SessionOidcNext  = "oidc_next"
+)
+
+// SaveLogin stores the user and the tokens of a login in the session.
+func SaveLogin(session sessions.Session, userId int32, accessToken, refreshToken string, expiresIn int64, sid string, emailVerified bool) error {
+	session.Set("user_id", userId)
+	return SaveTokens(session, accessToken, refreshToken, expiresIn, sid, emailVerified)
+}
+
+// SaveTokens stores refreshed tokens in the session.
+func SaveTokens(session sessions.Session, accessToken, refreshToken string, expiresIn int64, sid string, emailVerified bool) error {
+	session.Set(SessionEmailVerified, emailVerified)
+	session.Set(SessionAccessToken, accessToken)
+	session.Set(SessionAccessExpiresAt, time.Now().Unix()+expiresIn)
+	session.Set(SessionRefreshToken, refreshToken)
+	session.Set(SessionId, sid)
+	return session.Save()
+}
+
+// AccessExpired tells if the access token of the session needs a refresh, a little before
+// it expires so it does not run out during the request.
+func AccessExpired(session sessions.Session, now time.Time) bool {
+	var exp int64
+	switch v := session.Get(SessionAccessExpiresAt).(type) {
+	case float64:
+		// the JSON serializer of the session store reads numbers as float64
+		exp = int64(v)
+	case int64:
+		exp = v
+	}
+	return now.Unix() >= exp-30
+}
+
+// ExpireAccess makes the next request refresh the access token, for its claims to change.
+func ExpireAccess(session sessions.Session) {
+	session.Set(SessionAccessExpiresAt, 0)
+}
+
+func SessionString(session sessions.Session, key string) string {
+	s, _ := session.Get(key).(string)
+	return s
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
 	"github.com/cloudwego/biz-demo/gomall/app/order/conf"
+	"github.com/cloudwego/biz-demo/gomall/common/outbox"
 
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -45,6 +46,13 @@ func Init() {
 		DB.AutoMigrate( //nolint:errcheck
 			&model.Order{},
 			&model.OrderItem{},
+			&model.OrderTaxLine{},
+			&model.OrderStateHistory{},
+			&model.Invoice{},
+			&model.InvoiceSequence{},
+			&model.Return{},
+			&model.ReturnItem{},
+			&outbox.Message{},
 		)
 	}
 }
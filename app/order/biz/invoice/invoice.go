@@ -0,0 +1,231 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invoice
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/order/conf"
+	"github.com/go-pdf/fpdf"
+)
+
+type Party struct {
+	Name  string
+	Lines []string
+}
+
+type Item struct {
+	Description string
+	Quantity    int32
+	UnitPrice   float32
+	Amount      float32
+}
+
+type TaxLine struct {
+	Name   string
+	Rate   float32
+	Amount float32
+}
+
+type Document struct {
+	Number           string
+	IssuedAt         time.Time
+	OrderId          string
+	TransactionId    string
+	Currency         string
+	Seller           Party
+	BillTo           Party
+	Items            []Item
+	ShippingMethod   string
+	ShippingCost     float32
+	TaxLines         []TaxLine
+	Tax              float32
+	PricesIncludeTax bool
+}
+
+// FromOrder builds the invoice document of a paid order.
+func FromOrder(o model.Order, inv model.Invoice, seller conf.Seller) Document {
+	d := Document{
+		Number:           inv.InvoiceNumber,
+		IssuedAt:         inv.CreatedAt,
+		OrderId:          o.OrderId,
+		TransactionId:    o.TransactionId,
+		Currency:         o.UserCurrency,
+		ShippingMethod:   o.ShippingMethod,
+		ShippingCost:     o.ShippingCost,
+		Tax:              o.Tax,
+		PricesIncludeTax: o.PricesIncludeTax,
+		Seller:           Party{Name: seller.Name, Lines: seller.Address},
+	}
+	if seller.TaxId != "" {
+		d.Seller.Lines = append(append([]string{}, d.Seller.Lines...), "Tax ID: "+seller.TaxId)
+	}
+	if seller.Email != "" {
+		d.Seller.Lines = append(append([]string{}, d.Seller.Lines...), seller.Email)
+	}
+	c := o.Consignee
+	d.BillTo = Party{Name: c.Email}
+	for _, line := range []string{c.StreetAddress, joinNonEmpty(", ", c.City, joinNonEmpty(" ", c.State, zipCode(c.ZipCode))), c.Country} {
+		if line != "" {
+			d.BillTo.Lines = append(d.BillTo.Lines, line)
+		}
+	}
+	for _, v := range o.OrderItems {
+		description := v.ProductName
+		if description == "" {
+			description = fmt.Sprintf("Product #%d", v.ProductId)
+		}
+		var unitPrice float32
+		if v.Quantity > 0 {
+			unitPrice = v.Cost / float32(v.Quantity)
+		}
+		d.Items = append(d.Items, Item{Description: description, Quantity: v.Quantity, UnitPrice: unitPrice, Amount: v.Cost})
+	}
+	for _, v := range o.TaxLines {
+		d.TaxLines = append(d.TaxLines, TaxLine{Name: v.Name, Rate: v.Rate, Amount: v.Amount})
+	}
+	return d
+}
+
+func (d Document) Subtotal() (subtotal float32) {
+	for _, v := range d.Items {
+		subtotal += v.Amount
+	}
+	return
+}
+
+// Total is what the customer paid: tax is only added when it is not part of the prices.
+func (d Document) Total() float32 {
+	total := d.Subtotal() + d.ShippingCost
+	if !d.PricesIncludeTax {
+		total += d.Tax
+	}
+	return total
+}
+
+// Render lays the document out on an A4 page.
+func Render(d Document) ([]byte, error) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle("Invoice "+d.Number, true)
+	pdf.SetCreationDate(d.IssuedAt)
+	pdf.SetModificationDate(d.IssuedAt)
+	pdf.SetCatalogSort(true)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 20)
+	pdf.CellFormat(0, 10, tr("Invoice"), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	for _, line := range []string{
+		"Invoice number: " + d.Number,
+		"Date: " + d.IssuedAt.Format("2006-01-02"),
+		"Order ID: " + d.OrderId,
+		"Payment transaction: " + d.TransactionId,
+	} {
+		pdf.CellFormat(0, 5, tr(line), "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(6)
+
+	top := pdf.GetY()
+	writeParty(pdf, tr, "From", d.Seller, 10)
+	sellerBottom := pdf.GetY()
+	pdf.SetY(top)
+	writeParty(pdf, tr, "Bill to", d.BillTo, 110)
+	pdf.SetY(max(sellerBottom, pdf.GetY()) + 6)
+
+	widths := []float64{95, 20, 35, 40}
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.SetFillColor(235, 235, 235)
+	for i, h := range []string{"Description", "Qty", "Unit price", "Amount"} {
+		align := "R"
+		if i == 0 {
+			align = "L"
+		}
+		pdf.CellFormat(widths[i], 7, tr(h), "B", 0, align, true, 0, "")
+	}
+	pdf.Ln(-1)
+	pdf.SetFont("Helvetica", "", 10)
+	for _, v := range d.Items {
+		pdf.CellFormat(widths[0], 6, tr(v.Description), "", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[1], 6, fmt.Sprint(v.Quantity), "", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[2], 6, money(v.UnitPrice), "", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[3], 6, money(v.Amount), "", 1, "R", false, 0, "")
+	}
+	pdf.Ln(2)
+
+	labelWidth := widths[0] + widths[1] + widths[2]
+	summary := func(label string, amount float32, bold bool) {
+		style := ""
+		if bold {
+			style = "B"
+		}
+		pdf.SetFont("Helvetica", style, 10)
+		pdf.CellFormat(labelWidth, 6, tr(label), "", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[3], 6, money(amount), "", 1, "R", false, 0, "")
+	}
+	summary("Subtotal", d.Subtotal(), false)
+	if d.ShippingMethod != "" {
+		summary("Shipping ("+d.ShippingMethod+")", d.ShippingCost, false)
+	}
+	for _, v := range d.TaxLines {
+		label := fmt.Sprintf("%s %s%%", v.Name, strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.3f", v.Rate*100), "0"), "."))
+		if d.PricesIncludeTax {
+			label += " (included)"
+		}
+		summary(label, v.Amount, false)
+	}
+	summary("Total ("+d.Currency+")", d.Total(), true)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeParty(pdf *fpdf.Fpdf, tr func(string) string, title string, p Party, x float64) {
+	pdf.SetX(x)
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(90, 5, tr(title), "", 2, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	for _, line := range append([]string{p.Name}, p.Lines...) {
+		pdf.CellFormat(90, 5, tr(line), "", 2, "L", false, 0, "")
+	}
+}
+
+func money(v float32) string {
+	return fmt.Sprintf("%.2f", v)
+}
+
+func zipCode(v int32) string {
+	if v == 0 {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+func joinNonEmpty(sep string, parts ...string) string {
+	var nonEmpty []string
+	for _, p := range parts {
+		if p != "" {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+	return strings.Join(nonEmpty, sep)
+}
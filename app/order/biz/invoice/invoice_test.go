@@ -0,0 +1,87 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invoice
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/order/conf"
+)
+
+func testOrder() (model.Order, model.Invoice) {
+	o := model.Order{
+		OrderId:       "order-1",
+		UserCurrency:  "USD",
+		TransactionId: "tx-1",
+		Consignee:     model.Consignee{Email: "a@example.com", StreetAddress: "7th street", City: "hangzhou", State: "zhejiang", Country: "china", ZipCode: 310000},
+		OrderItems: []model.OrderItem{
+			{ProductId: 1, ProductName: "Notebook", Quantity: 2, Cost: 19.8},
+			{ProductId: 2, Quantity: 1, Cost: 8.8},
+		},
+		ShippingMethod: "standard",
+		ShippingCost:   5,
+		Tax:            3.72,
+		TaxLines:       []model.OrderTaxLine{{Name: "VAT", Rate: 0.13, Amount: 3.72}},
+	}
+	inv := model.Invoice{InvoiceNumber: "INV-2024-000001"}
+	inv.CreatedAt = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	return o, inv
+}
+
+func TestFromOrder(t *testing.T) {
+	o, inv := testOrder()
+	d := FromOrder(o, inv, conf.Seller{Name: "Shop", Address: []string{"Street"}, TaxId: "123"})
+	if d.Items[1].Description != "Product #2" {
+		t.Errorf("got description %q for an item without a name", d.Items[1].Description)
+	}
+	if d.Items[0].UnitPrice != 9.9 {
+		t.Errorf("got unit price %v, want 9.9", d.Items[0].UnitPrice)
+	}
+	if got := d.BillTo.Lines[1]; got != "hangzhou, zhejiang 310000" {
+		t.Errorf("got address line %q", got)
+	}
+	if got := d.Seller.Lines[len(d.Seller.Lines)-1]; got != "Tax ID: 123" {
+		t.Errorf("got seller line %q, want the tax id", got)
+	}
+	if got := d.Total(); got != 37.32 {
+		t.Errorf("got total %v, want 37.32", got)
+	}
+	d.PricesIncludeTax = true
+	if got := d.Total(); got != 33.6 {
+		t.Errorf("got total %v with inclusive prices, want 33.6", got)
+	}
+}
+
+func TestRender(t *testing.T) {
+	o, inv := testOrder()
+	d := FromOrder(o, inv, conf.Seller{Name: "Shop"})
+	first, err := Render(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(first, []byte("%PDF-")) {
+		t.Fatalf("output is not a PDF")
+	}
+	second, err := Render(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(first, second) {
+		t.Errorf("rendering the same invoice twice gave different files")
+	}
+}
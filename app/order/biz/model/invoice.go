@@ -0,0 +1,84 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Invoice struct {
+	Base
+	InvoiceNumber string `gorm:"uniqueIndex;size:64"`
+	Year          int    `gorm:"uniqueIndex:idx_invoice_year_sequence,priority:1"`
+	Sequence      int    `gorm:"uniqueIndex:idx_invoice_year_sequence,priority:2"`
+	OrderIdRefer  string `gorm:"uniqueIndex;size:256"`
+	UserId        uint32
+	// FileKey locates the PDF in the file store
+	FileKey string
+}
+
+func (i Invoice) TableName() string {
+	return "invoice"
+}
+
+// InvoiceSequence holds the last invoice number handed out in a year.
+type InvoiceSequence struct {
+	Year int `gorm:"primaryKey;autoIncrement:false"`
+	Last int
+}
+
+func (s InvoiceSequence) TableName() string {
+	return "invoice_sequence"
+}
+
+// CreateInvoice numbers a new invoice for the order. It must run in a transaction: the
+// sequence row stays locked until commit and a rollback gives the number back, so the
+// numbers of a year have no gaps.
+func CreateInvoice(tx *gorm.DB, ctx context.Context, o Order, prefix string, issuedAt time.Time) (inv Invoice, err error) {
+	year := issuedAt.Year()
+	tx = tx.WithContext(ctx)
+	if err = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&InvoiceSequence{Year: year}).Error; err != nil {
+		return
+	}
+	var seq InvoiceSequence
+	if err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&InvoiceSequence{Year: year}).First(&seq).Error; err != nil {
+		return
+	}
+	seq.Last++
+	if err = tx.Model(&InvoiceSequence{}).Where(&InvoiceSequence{Year: year}).Update("last", seq.Last).Error; err != nil {
+		return
+	}
+	inv = Invoice{
+		InvoiceNumber: fmt.Sprintf("%s-%d-%06d", prefix, year, seq.Last),
+		Year:          year,
+		Sequence:      seq.Last,
+		OrderIdRefer:  o.OrderId,
+		UserId:        o.UserId,
+		FileKey:       fmt.Sprintf("invoices/%d/%s.pdf", year, o.OrderId),
+	}
+	inv.CreatedAt = issuedAt
+	err = tx.Create(&inv).Error
+	return
+}
+
+func GetInvoiceByOrderId(db *gorm.DB, ctx context.Context, orderId string) (inv Invoice, err error) {
+	err = db.WithContext(ctx).Where(&Invoice{OrderIdRefer: orderId}).First(&inv).Error
+	return
+}
@@ -16,8 +16,10 @@ package model
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Consignee struct {
@@ -41,27 +43,124 @@ const (
 type Order struct {
 	Base
 	OrderId      string `gorm:"uniqueIndex;size:256"`
-	UserId       uint32
+	UserId       uint32 `gorm:"index"`
 	UserCurrency string
 	Consignee    Consignee   `gorm:"embedded"`
 	OrderItems   []OrderItem `gorm:"foreignKey:OrderIdRefer;references:OrderId"`
 	OrderState   OrderState
+	// ShippingMethod and ShippingCost are the shipping quote chosen at checkout
+	ShippingMethod string
+	ShippingCost   float32
+	// Tax is the sum of the tax lines. When PricesIncludeTax is set it is already part of the item costs.
+	Tax              float32
+	PricesIncludeTax bool
+	TaxLines         []OrderTaxLine `gorm:"foreignKey:OrderIdRefer;references:OrderId"`
+	// TransactionId is the payment transaction, set when the order is paid
+	TransactionId string
+	StateHistory  []OrderStateHistory `gorm:"foreignKey:OrderIdRefer;references:OrderId"`
+	Invoice       *Invoice            `gorm:"foreignKey:OrderIdRefer;references:OrderId"`
 }
 
 func (o Order) TableName() string {
 	return "order"
 }
 
-func ListOrder(db *gorm.DB, ctx context.Context, userId uint32) (orders []Order, err error) {
-	err = db.Model(&Order{}).Where(&Order{UserId: userId}).Preload("OrderItems").Find(&orders).Error
+type OrderFilter struct {
+	State OrderState
+	// StartTime is inclusive and EndTime exclusive, zero values are unbounded
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+func (f OrderFilter) scope(db *gorm.DB) *gorm.DB {
+	if f.State != "" {
+		db = db.Where("order_state = ?", f.State)
+	}
+	if !f.StartTime.IsZero() {
+		db = db.Where("created_at >= ?", f.StartTime)
+	}
+	if !f.EndTime.IsZero() {
+		db = db.Where("created_at < ?", f.EndTime)
+	}
+	return db
+}
+
+// ListOrder returns up to limit orders newest first. Ids grow with creation time, so afterId,
+// the id of the last order of the previous page, works as the cursor. Use 0 for the first page.
+func ListOrder(db *gorm.DB, ctx context.Context, userId uint32, filter OrderFilter, afterId int, limit int) (orders []Order, err error) {
+	query := db.WithContext(ctx).Model(&Order{}).Where(&Order{UserId: userId}).Scopes(filter.scope)
+	if afterId > 0 {
+		query = query.Where("id < ?", afterId)
+	}
+	err = query.Order("id desc").Limit(limit).Preload("OrderItems").Preload("TaxLines").Find(&orders).Error
+	return
+}
+
+func CountOrder(db *gorm.DB, ctx context.Context, userId uint32, filter OrderFilter) (total int64, err error) {
+	err = db.WithContext(ctx).Model(&Order{}).Where(&Order{UserId: userId}).Scopes(filter.scope).Count(&total).Error
 	return
 }
 
 func GetOrder(db *gorm.DB, ctx context.Context, userId uint32, orderId string) (order Order, err error) {
-	err = db.Where(&Order{UserId: userId, OrderId: orderId}).First(&order).Error
+	err = db.WithContext(ctx).Where(&Order{UserId: userId, OrderId: orderId}).First(&order).Error
+	return
+}
+
+// GetOrderForUpdate locks the order row until the transaction ends and loads its items.
+func GetOrderForUpdate(tx *gorm.DB, ctx context.Context, userId uint32, orderId string) (order Order, err error) {
+	err = tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(&Order{UserId: userId, OrderId: orderId}).
+		Preload("OrderItems").
+		First(&order).Error
 	return
 }
 
+// GetOrderDetail loads the order with its items, tax lines, state history and invoice.
+func GetOrderDetail(db *gorm.DB, ctx context.Context, userId uint32, orderId string) (order Order, err error) {
+	err = db.WithContext(ctx).Where(&Order{UserId: userId, OrderId: orderId}).
+		Preload("OrderItems").
+		Preload("TaxLines").
+		Preload("StateHistory", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id")
+		}).
+		Preload("Invoice").
+		First(&order).Error
+	return
+}
+
+// UpdateOrderState moves the order to state and records the change. Nothing is recorded
+// when the order is already in that state. Run it in a transaction to keep both in sync.
 func UpdateOrderState(db *gorm.DB, ctx context.Context, userId uint32, orderId string, state OrderState) error {
-	return db.Model(&Order{}).Where(&Order{UserId: userId, OrderId: orderId}).Update("order_state", state).Error
+	result := db.WithContext(ctx).Model(&Order{}).Where(&Order{UserId: userId, OrderId: orderId}).Where("order_state <> ?", state).Update("order_state", state)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Create(&OrderStateHistory{OrderIdRefer: orderId, State: state}).Error
+}
+
+func SetOrderTransactionId(db *gorm.DB, ctx context.Context, userId uint32, orderId, transactionId string) error {
+	return db.WithContext(ctx).Model(&Order{}).Where(&Order{UserId: userId, OrderId: orderId}).Update("transaction_id", transactionId).Error
+}
+
+// ListOrderDetails loads every order of the user like GetOrderDetail, oldest first.
+func ListOrderDetails(db *gorm.DB, ctx context.Context, userId uint32) (orders []Order, err error) {
+	err = db.WithContext(ctx).Where(&Order{UserId: userId}).Order("id").
+		Preload("OrderItems").
+		Preload("TaxLines").
+		Preload("StateHistory", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id")
+		}).
+		Preload("Invoice").
+		Find(&orders).Error
+	return
+}
+
+// AnonymizeOrders blanks the email and the street address of the user's orders. The orders
+// stay for the books, with the country and state their taxes were charged by.
+func AnonymizeOrders(db *gorm.DB, ctx context.Context, userId uint32) error {
+	return db.WithContext(ctx).Model(&Order{}).Where("user_id = ?", userId).
+		Updates(map[string]any{"email": "", "street_address": "", "city": "", "zip_code": 0}).Error
 }
@@ -20,6 +20,7 @@ type OrderItem struct {
 	OrderIdRefer string `gorm:"size:256;index"`
 	Quantity     int32
 	Cost         float32
+	ProductName  string
 }
 
 func (oi OrderItem) TableName() string {
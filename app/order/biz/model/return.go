@@ -0,0 +1,169 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type ReturnState string
+
+const (
+	ReturnStateRequested ReturnState = "requested"
+	ReturnStateApproved  ReturnState = "approved"
+	ReturnStateRejected  ReturnState = "rejected"
+	ReturnStateReceived  ReturnState = "received"
+	ReturnStateRefunded  ReturnState = "refunded"
+)
+
+var ErrReturnStateChanged = errors.New("return state has changed")
+
+type ReturnAddress struct {
+	Name          string
+	StreetAddress string
+	City          string
+	State         string
+	Country       string
+	ZipCode       string
+}
+
+// Return is a return merchandise authorization (RMA) for some items of a paid order.
+type Return struct {
+	Base
+	ReturnId     string      `gorm:"uniqueIndex;size:64"`
+	OrderIdRefer string      `gorm:"size:256;index"`
+	UserId       uint32      `gorm:"index"`
+	State        ReturnState `gorm:"index;size:32"`
+	Comment      string
+	AdminNote    string
+	Items        []ReturnItem `gorm:"foreignKey:ReturnIdRefer;references:ReturnId"`
+	// Carrier, TrackingNumber and ShipTo describe the return label, created on approval
+	Carrier        string
+	TrackingNumber string
+	ShipTo         ReturnAddress `gorm:"embedded;embeddedPrefix:ship_to_"`
+	RefundAmount   float32
+	RefundId       string
+}
+
+func (r Return) TableName() string {
+	return "return_request"
+}
+
+type ReturnItem struct {
+	Base
+	ReturnIdRefer string `gorm:"size:64;index"`
+	ProductId     uint32
+	ProductName   string
+	Quantity      int32
+	Reason        string
+	UnitPrice     float32
+}
+
+func (ri ReturnItem) TableName() string {
+	return "return_item"
+}
+
+func CreateReturn(db *gorm.DB, ctx context.Context, r *Return) error {
+	return db.WithContext(ctx).Create(r).Error
+}
+
+func GetReturn(db *gorm.DB, ctx context.Context, returnId string) (r Return, err error) {
+	err = db.WithContext(ctx).Where(&Return{ReturnId: returnId}).Preload("Items").First(&r).Error
+	return
+}
+
+// ListReturns returns the user's returns newest first, optionally only those of one order.
+func ListReturns(db *gorm.DB, ctx context.Context, userId uint32, orderId string) (returns []Return, err error) {
+	err = db.WithContext(ctx).Where(&Return{UserId: userId, OrderIdRefer: orderId}).
+		Order("id desc").Preload("Items").Find(&returns).Error
+	return
+}
+
+// ListReturnsByState returns up to limit returns oldest first, of every state when state is empty.
+func ListReturnsByState(db *gorm.DB, ctx context.Context, state ReturnState, limit int) (returns []Return, err error) {
+	err = db.WithContext(ctx).Where(&Return{State: state}).
+		Order("id").Limit(limit).Preload("Items").Find(&returns).Error
+	return
+}
+
+// ReturnedQuantities sums, per product, the units of the order covered by returns that were not rejected.
+func ReturnedQuantities(db *gorm.DB, ctx context.Context, orderId string) (map[uint32]int32, error) {
+	var rows []struct {
+		ProductId uint32
+		Quantity  int32
+	}
+	err := db.WithContext(ctx).Model(&ReturnItem{}).
+		Select("return_item.product_id, SUM(return_item.quantity) AS quantity").
+		Joins("JOIN return_request ON return_request.return_id = return_item.return_id_refer").
+		Where("return_request.order_id_refer = ? AND return_request.state <> ?", orderId, ReturnStateRejected).
+		Group("return_item.product_id").Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	quantities := make(map[uint32]int32, len(rows))
+	for _, v := range rows {
+		quantities[v.ProductId] = v.Quantity
+	}
+	return quantities, nil
+}
+
+// RefundedAmount sums the refunds already made for returns of the order.
+func RefundedAmount(db *gorm.DB, ctx context.Context, orderId string) (total float32, err error) {
+	err = db.WithContext(ctx).Model(&Return{}).
+		Where(&Return{OrderIdRefer: orderId, State: ReturnStateRefunded}).
+		Select("COALESCE(SUM(refund_amount), 0)").Scan(&total).Error
+	return
+}
+
+// TransitionReturn moves the return from one state to another and applies updates, failing with
+// ErrReturnStateChanged when the return is no longer in the from state.
+func TransitionReturn(db *gorm.DB, ctx context.Context, returnId string, from, to ReturnState, updates map[string]any) error {
+	if updates == nil {
+		updates = map[string]any{}
+	}
+	updates["state"] = to
+	res := db.WithContext(ctx).Model(&Return{}).Where(&Return{ReturnId: returnId, State: from}).Updates(updates)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrReturnStateChanged
+	}
+	return nil
+}
+
+// SetReturnRefundAmount records the amount a received return is refunded, before the refund
+// is made, so a retry refunds the same amount.
+func SetReturnRefundAmount(db *gorm.DB, ctx context.Context, returnId string, amount float32) error {
+	return db.WithContext(ctx).Model(&Return{}).
+		Where(&Return{ReturnId: returnId, State: ReturnStateReceived}).
+		Update("refund_amount", amount).Error
+}
+
+// EraseReturnNotes blanks the free text of the user's returns, which may name the user: the
+// comments, the reasons of the items and the notes of the operators.
+func EraseReturnNotes(tx *gorm.DB, ctx context.Context, userId uint32) error {
+	tx = tx.WithContext(ctx)
+	err := tx.Model(&Return{}).Where("user_id = ?", userId).Updates(map[string]any{"comment": "", "admin_note": ""}).Error
+	if err != nil {
+		return err
+	}
+	return tx.Model(&ReturnItem{}).
+		Where("return_id_refer IN (?)", tx.Model(&Return{}).Select("return_id").Where("user_id = ?", userId)).
+		Update("reason", "").Error
+}
@@ -0,0 +1,48 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/order/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/order/infra/mq"
+	"github.com/cloudwego/biz-demo/gomall/common/outbox"
+	"github.com/cloudwego/kitex/server"
+)
+
+// Init starts the relay of the outbox table, it stops with the server.
+func Init() {
+	c := conf.GetConf().Outbox
+	r := outbox.NewRelay(mysql.DB, mq.JS, outbox.Options{
+		Stream:    c.Stream,
+		Subjects:  c.Subjects,
+		Interval:  time.Duration(c.IntervalMs) * time.Millisecond,
+		BatchSize: c.BatchSize,
+		Retention: time.Duration(c.RetentionHours) * time.Hour,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := r.Setup(ctx); err != nil {
+		panic(err)
+	}
+	go r.Run(ctx)
+
+	server.RegisterShutdownHook(func() {
+		cancel()
+		mq.Nc.Close()
+	})
+}
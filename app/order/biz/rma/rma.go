@@ -0,0 +1,128 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rma
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+)
+
+var Reasons = []string{"damaged", "wrong_item", "not_as_described", "no_longer_needed", "other"}
+
+var ErrNoItems = errors.New("select at least one item to return")
+
+func ValidReason(reason string) bool {
+	for _, v := range Reasons {
+		if v == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// InWindow reports whether an order placed at placedAt can still be returned at now.
+// A window of 0 days or less means returns are not limited in time.
+func InWindow(placedAt, now time.Time, windowDays int) bool {
+	if windowDays <= 0 {
+		return true
+	}
+	return now.Before(placedAt.AddDate(0, 0, windowDays))
+}
+
+// Returnable gives, per product, the units of the order not yet covered by a return.
+func Returnable(o model.Order, returned map[uint32]int32) map[uint32]int32 {
+	left := make(map[uint32]int32, len(o.OrderItems))
+	for _, v := range o.OrderItems {
+		left[v.ProductId] += v.Quantity
+	}
+	for id, qty := range returned {
+		if _, ok := left[id]; ok {
+			left[id] = max(left[id]-qty, 0)
+		}
+	}
+	return left
+}
+
+// Check validates the requested items against what is still returnable.
+func Check(items []model.ReturnItem, returnable map[uint32]int32) error {
+	if len(items) == 0 {
+		return ErrNoItems
+	}
+	seen := make(map[uint32]bool, len(items))
+	for _, v := range items {
+		left, ok := returnable[v.ProductId]
+		if !ok {
+			return fmt.Errorf("product %d is not part of the order", v.ProductId)
+		}
+		if seen[v.ProductId] {
+			return fmt.Errorf("product %d is listed more than once", v.ProductId)
+		}
+		seen[v.ProductId] = true
+		if v.Quantity <= 0 {
+			return fmt.Errorf("quantity of product %d must be positive", v.ProductId)
+		}
+		if v.Quantity > left {
+			return fmt.Errorf("only %d of product %d can be returned", left, v.ProductId)
+		}
+		if !ValidReason(v.Reason) {
+			return fmt.Errorf("invalid return reason %q", v.Reason)
+		}
+	}
+	return nil
+}
+
+// UnitPrices gives the price paid per unit of each product of the order.
+func UnitPrices(o model.Order) map[uint32]float32 {
+	cost := make(map[uint32]float32, len(o.OrderItems))
+	qty := make(map[uint32]int32, len(o.OrderItems))
+	for _, v := range o.OrderItems {
+		cost[v.ProductId] += v.Cost
+		qty[v.ProductId] += v.Quantity
+	}
+	prices := make(map[uint32]float32, len(cost))
+	for id, c := range cost {
+		if qty[id] > 0 {
+			prices[id] = c / float32(qty[id])
+		}
+	}
+	return prices
+}
+
+// Refund computes what is paid back for the returned items: their price plus, when tax was
+// charged on top, the same share of the order's tax. Shipping is not refunded. The result never
+// takes the refunds of the order, alreadyRefunded included, above what was paid for the items.
+func Refund(o model.Order, items []model.ReturnItem, alreadyRefunded float32) float32 {
+	var subtotal, value float32
+	for _, v := range o.OrderItems {
+		subtotal += v.Cost
+	}
+	for _, v := range items {
+		value += v.UnitPrice * float32(v.Quantity)
+	}
+	refundable := subtotal
+	if !o.PricesIncludeTax && subtotal > 0 {
+		value += o.Tax * value / subtotal
+		refundable += o.Tax
+	}
+	return min(round(value), max(round(refundable-alreadyRefunded), 0))
+}
+
+func round(v float32) float32 {
+	return float32(math.Round(float64(v)*100) / 100)
+}
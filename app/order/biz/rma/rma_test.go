@@ -0,0 +1,100 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rma
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+)
+
+func testOrder() model.Order {
+	return model.Order{
+		OrderId: "order-1",
+		OrderItems: []model.OrderItem{
+			{ProductId: 1, Quantity: 2, Cost: 20},
+			{ProductId: 2, Quantity: 1, Cost: 10},
+		},
+		ShippingCost: 5,
+		Tax:          3,
+	}
+}
+
+func TestInWindow(t *testing.T) {
+	placed := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	if !InWindow(placed, placed.AddDate(0, 0, 29), 30) {
+		t.Error("want a return after 29 days to be inside a 30 day window")
+	}
+	if InWindow(placed, placed.AddDate(0, 0, 30), 30) {
+		t.Error("want a return after 30 days to be outside a 30 day window")
+	}
+	if !InWindow(placed, placed.AddDate(1, 0, 0), 0) {
+		t.Error("want no limit for a window of 0 days")
+	}
+}
+
+func TestCheck(t *testing.T) {
+	returnable := Returnable(testOrder(), map[uint32]int32{1: 1})
+	if returnable[1] != 1 || returnable[2] != 1 {
+		t.Fatalf("got returnable %v", returnable)
+	}
+	tests := []struct {
+		name  string
+		items []model.ReturnItem
+		ok    bool
+	}{
+		{"valid", []model.ReturnItem{{ProductId: 1, Quantity: 1, Reason: "damaged"}, {ProductId: 2, Quantity: 1, Reason: "other"}}, true},
+		{"empty", nil, false},
+		{"not in order", []model.ReturnItem{{ProductId: 3, Quantity: 1, Reason: "damaged"}}, false},
+		{"already returned", []model.ReturnItem{{ProductId: 1, Quantity: 2, Reason: "damaged"}}, false},
+		{"zero quantity", []model.ReturnItem{{ProductId: 2, Quantity: 0, Reason: "damaged"}}, false},
+		{"duplicate", []model.ReturnItem{{ProductId: 1, Quantity: 1, Reason: "damaged"}, {ProductId: 1, Quantity: 1, Reason: "damaged"}}, false},
+		{"unknown reason", []model.ReturnItem{{ProductId: 2, Quantity: 1, Reason: "bored"}}, false},
+	}
+	for _, tt := range tests {
+		if err := Check(tt.items, returnable); (err == nil) != tt.ok {
+			t.Errorf("%s: got error %v", tt.name, err)
+		}
+	}
+}
+
+func TestRefund(t *testing.T) {
+	o := testOrder()
+	prices := UnitPrices(o)
+	items := []model.ReturnItem{{ProductId: 1, Quantity: 1, UnitPrice: prices[1]}}
+
+	// 10 of a 30 subtotal carries a third of the tax
+	if got := Refund(o, items, 0); got != 11 {
+		t.Errorf("got refund %v, want 11", got)
+	}
+
+	o.PricesIncludeTax = true
+	if got := Refund(o, items, 0); got != 10 {
+		t.Errorf("got refund %v with tax included, want 10", got)
+	}
+
+	o.PricesIncludeTax = false
+	all := []model.ReturnItem{
+		{ProductId: 1, Quantity: 2, UnitPrice: prices[1]},
+		{ProductId: 2, Quantity: 1, UnitPrice: prices[2]},
+	}
+	if got := Refund(o, all, 0); got != 33 {
+		t.Errorf("got refund %v for every item, want 33 without shipping", got)
+	}
+	if got := Refund(o, all, 30); got != 3 {
+		t.Errorf("got refund %v after 30 was refunded, want the remaining 3", got)
+	}
+}
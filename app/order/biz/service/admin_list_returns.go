@@ -0,0 +1,57 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+)
+
+const (
+	defaultAdminReturnLimit = 50
+	maxAdminReturnLimit     = 200
+)
+
+type AdminListReturnsService struct {
+	ctx context.Context
+} // NewAdminListReturnsService new AdminListReturnsService
+func NewAdminListReturnsService(ctx context.Context) *AdminListReturnsService {
+	return &AdminListReturnsService{ctx: ctx}
+}
+
+// Run returns the returns of every user, oldest first, to work through the queue.
+func (s *AdminListReturnsService) Run(req *order.AdminListReturnsReq) (resp *order.AdminListReturnsResp, err error) {
+	if err = authn.Require(s.ctx, authn.PermOrdersManage); err != nil {
+		return nil, err
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultAdminReturnLimit
+	}
+	limit = min(limit, maxAdminReturnLimit)
+	returns, err := model.ListReturnsByState(mysql.DB, s.ctx, model.ReturnState(req.State), limit)
+	if err != nil {
+		return nil, err
+	}
+	resp = &order.AdminListReturnsResp{}
+	for _, v := range returns {
+		resp.Returns = append(resp.Returns, toReturn(v))
+	}
+	return resp, nil
+}
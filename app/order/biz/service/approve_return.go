@@ -0,0 +1,110 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/order/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+type ApproveReturnService struct {
+	ctx context.Context
+} // NewApproveReturnService new ApproveReturnService
+func NewApproveReturnService(ctx context.Context) *ApproveReturnService {
+	return &ApproveReturnService{ctx: ctx}
+}
+
+// Run approves a requested return and creates its return shipping label.
+func (s *ApproveReturnService) Run(req *order.ApproveReturnReq) (resp *order.ApproveReturnResp, err error) {
+	if err = authn.Require(s.ctx, authn.PermOrdersManage); err != nil {
+		return nil, err
+	}
+	r, err := getReturn(s.ctx, req.ReturnId)
+	if err != nil {
+		return nil, err
+	}
+	if r.State == model.ReturnStateApproved {
+		return &order.ApproveReturnResp{Return: toReturn(r)}, nil
+	}
+	if r.State != model.ReturnStateRequested {
+		return nil, kerrors.NewBizStatusError(40000, "only requested returns can be approved")
+	}
+	o, err := model.GetOrder(mysql.DB, s.ctx, r.UserId, r.OrderIdRefer)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "order not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var weight float32
+	for _, v := range r.Items {
+		p, err := rpc.ProductClient.GetProduct(s.ctx, &product.GetProductReq{Id: v.ProductId})
+		if err != nil {
+			return nil, err
+		}
+		if p.Product != nil {
+			weight += p.Product.Weight * float32(v.Quantity)
+		}
+	}
+	// labels are idempotent per return, so a retry after a failed update reuses the label
+	label, err := rpc.ShippingClient.CreateReturnLabel(s.ctx, &shipping.CreateReturnLabelReq{
+		UserId:   r.UserId,
+		OrderId:  r.OrderIdRefer,
+		ReturnId: r.ReturnId,
+		Address: &shipping.Address{
+			StreetAddress: o.Consignee.StreetAddress,
+			City:          o.Consignee.City,
+			State:         o.Consignee.State,
+			Country:       o.Consignee.Country,
+			ZipCode:       strconv.Itoa(int(o.Consignee.ZipCode)),
+		},
+		Weight: weight,
+	})
+	if err != nil {
+		klog.CtxErrorf(s.ctx, "create return label for %s failed: %v", r.ReturnId, err)
+		return nil, err
+	}
+	updates := map[string]any{
+		"admin_note":      req.Note,
+		"carrier":         label.Shipment.GetCarrier(),
+		"tracking_number": label.Shipment.GetTrackingNumber(),
+		"ship_to_name":    label.ShipToName,
+	}
+	if label.ShipTo != nil {
+		updates["ship_to_street_address"] = label.ShipTo.StreetAddress
+		updates["ship_to_city"] = label.ShipTo.City
+		updates["ship_to_state"] = label.ShipTo.State
+		updates["ship_to_country"] = label.ShipTo.Country
+		updates["ship_to_zip_code"] = label.ShipTo.ZipCode
+	}
+	ret, err := transitionReturn(s.ctx, r, model.ReturnStateApproved, updates)
+	if err != nil {
+		return nil, err
+	}
+	return &order.ApproveReturnResp{Return: ret}, nil
+}
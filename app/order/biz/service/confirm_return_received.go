@@ -0,0 +1,103 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/rma"
+	"github.com/cloudwego/biz-demo/gomall/app/order/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+type ConfirmReturnReceivedService struct {
+	ctx context.Context
+} // NewConfirmReturnReceivedService new ConfirmReturnReceivedService
+func NewConfirmReturnReceivedService(ctx context.Context) *ConfirmReturnReceivedService {
+	return &ConfirmReturnReceivedService{ctx: ctx}
+}
+
+// Run marks the parcel of an approved return as received and refunds the returned items.
+// When the refund fails the return stays received and calling Run again retries it.
+func (s *ConfirmReturnReceivedService) Run(req *order.ConfirmReturnReceivedReq) (resp *order.ConfirmReturnReceivedResp, err error) {
+	if err = authn.Require(s.ctx, authn.PermOrdersManage); err != nil {
+		return nil, err
+	}
+	r, err := getReturn(s.ctx, req.ReturnId)
+	if err != nil {
+		return nil, err
+	}
+	switch r.State {
+	case model.ReturnStateRefunded:
+		return &order.ConfirmReturnReceivedResp{Return: toReturn(r)}, nil
+	case model.ReturnStateApproved:
+		if _, err = transitionReturn(s.ctx, r, model.ReturnStateReceived, nil); err != nil {
+			return nil, err
+		}
+		r.State = model.ReturnStateReceived
+	case model.ReturnStateReceived:
+	default:
+		return nil, kerrors.NewBizStatusError(40000, "only approved returns can be received")
+	}
+
+	o, err := model.GetOrderDetail(mysql.DB, s.ctx, r.UserId, r.OrderIdRefer)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "order not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	// a retry refunds the amount of the first attempt, the refund key is bound to it
+	amount := r.RefundAmount
+	if amount == 0 {
+		refunded, err := model.RefundedAmount(mysql.DB, s.ctx, o.OrderId)
+		if err != nil {
+			return nil, err
+		}
+		amount = rma.Refund(o, r.Items, refunded)
+		if err = model.SetReturnRefundAmount(mysql.DB, s.ctx, r.ReturnId, amount); err != nil {
+			return nil, err
+		}
+	}
+	updates := map[string]any{"refund_amount": amount}
+	if amount > 0 {
+		refund, err := rpc.PaymentClient.Refund(s.ctx, &payment.RefundReq{
+			UserId:        o.UserId,
+			OrderId:       o.OrderId,
+			TransactionId: o.TransactionId,
+			Amount:        amount,
+			Reason:        "return " + r.ReturnId,
+			RefundKey:     "return-" + r.ReturnId,
+		})
+		if err != nil {
+			klog.CtxErrorf(s.ctx, "refund of return %s failed: %v", r.ReturnId, err)
+			return nil, err
+		}
+		updates["refund_id"] = refund.RefundId
+	}
+	ret, err := transitionReturn(s.ctx, r, model.ReturnStateRefunded, updates)
+	if err != nil {
+		return nil, err
+	}
+	return &order.ConfirmReturnReceivedResp{Return: ret}, nil
+}
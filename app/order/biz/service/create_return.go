@@ -0,0 +1,95 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/rma"
+	"github.com/cloudwego/biz-demo/gomall/app/order/conf"
+	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+type CreateReturnService struct {
+	ctx context.Context
+} // NewCreateReturnService new CreateReturnService
+func NewCreateReturnService(ctx context.Context) *CreateReturnService {
+	return &CreateReturnService{ctx: ctx}
+}
+
+// Run requests the return of some items of a paid order. The return waits for an admin to approve it.
+func (s *CreateReturnService) Run(req *order.CreateReturnReq) (resp *order.CreateReturnResp, err error) {
+	if req.UserId == 0 || req.OrderId == "" {
+		return nil, kerrors.NewBizStatusError(40000, "user id and order id are required")
+	}
+	r := &model.Return{
+		ReturnId:     newReturnId(),
+		OrderIdRefer: req.OrderId,
+		UserId:       req.UserId,
+		State:        model.ReturnStateRequested,
+		Comment:      req.Comment,
+	}
+	// the order row is locked so concurrent requests cannot return the same units twice
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		o, err := model.GetOrderForUpdate(tx, s.ctx, req.UserId, req.OrderId)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return kerrors.NewBizStatusError(40004, "order not found")
+		}
+		if err != nil {
+			return err
+		}
+		if o.OrderState != model.OrderStatePaid {
+			return kerrors.NewBizStatusError(40000, "only paid orders can be returned")
+		}
+		if !rma.InWindow(o.CreatedAt, time.Now(), conf.GetConf().Returns.WindowDays) {
+			return kerrors.NewBizStatusError(40000, "the return window of this order has closed")
+		}
+
+		names := make(map[uint32]string, len(o.OrderItems))
+		for _, v := range o.OrderItems {
+			names[v.ProductId] = v.ProductName
+		}
+		prices := rma.UnitPrices(o)
+		for _, v := range req.Items {
+			r.Items = append(r.Items, model.ReturnItem{
+				ProductId:   v.ProductId,
+				ProductName: names[v.ProductId],
+				Quantity:    v.Quantity,
+				Reason:      v.Reason,
+				UnitPrice:   prices[v.ProductId],
+			})
+		}
+		returned, err := model.ReturnedQuantities(tx, s.ctx, req.OrderId)
+		if err != nil {
+			return err
+		}
+		if err := rma.Check(r.Items, rma.Returnable(o, returned)); err != nil {
+			return kerrors.NewBizStatusError(40000, err.Error())
+		}
+		return model.CreateReturn(tx, s.ctx, r)
+	})
+	if err != nil {
+		klog.CtxErrorf(s.ctx, "create return for order %s failed: %v", req.OrderId, err)
+		return nil, err
+	}
+	return &order.CreateReturnResp{Return: toReturn(*r)}, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type EraseUserDataService struct {
+	ctx context.Context
+} // NewEraseUserDataService new EraseUserDataService
+func NewEraseUserDataService(ctx context.Context) *EraseUserDataService {
+	return &EraseUserDataService{ctx: ctx}
+}
+
+// Run anonymizes the orders and returns of the user, they are kept as financial records.
+// Issued invoices are kept unchanged, they have to be.
+func (s *EraseUserDataService) Run(req *order.EraseUserDataReq) (resp *order.EraseUserDataResp, err error) {
+	if err = authn.Require(s.ctx, authn.PermUsersErase); err != nil {
+		return nil, err
+	}
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		if err := model.AnonymizeOrders(tx, s.ctx, req.UserId); err != nil {
+			return err
+		}
+		return model.EraseReturnNotes(tx, s.ctx, req.UserId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &order.EraseUserDataResp{}, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/eventbus"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/events"
+	"gorm.io/gorm"
+)
+
+// addOrderPlaced queues the OrderPlaced event of o in the transaction tx.
+func addOrderPlaced(tx *gorm.DB, ctx context.Context, o model.Order) error {
+	var items []*events.OrderItem
+	for _, v := range o.OrderItems {
+		items = append(items, &events.OrderItem{
+			ProductId:   v.ProductId,
+			ProductName: v.ProductName,
+			Quantity:    v.Quantity,
+			Cost:        v.Cost,
+		})
+	}
+	return eventbus.AddToOutbox(tx, ctx, &events.OrderPlaced{
+		OrderId:      o.OrderId,
+		UserId:       o.UserId,
+		Email:        o.Consignee.Email,
+		Currency:     o.UserCurrency,
+		Items:        items,
+		ShippingCost: o.ShippingCost,
+		Tax:          o.Tax,
+		Total:        orderTotal(o),
+		PlacedAt:     time.Now().Unix(),
+	})
+}
+
+// addOrderPaid queues the OrderPaid event of o in the transaction tx.
+func addOrderPaid(tx *gorm.DB, ctx context.Context, o model.Order) error {
+	return eventbus.AddToOutbox(tx, ctx, &events.OrderPaid{
+		OrderId:       o.OrderId,
+		UserId:        o.UserId,
+		Email:         o.Consignee.Email,
+		Currency:      o.UserCurrency,
+		Total:         orderTotal(o),
+		TransactionId: o.TransactionId,
+		PaidAt:        time.Now().Unix(),
+	})
+}
+
+// orderTotal is what the customer pays for o, shipping and tax included.
+func orderTotal(o model.Order) float32 {
+	total := o.ShippingCost
+	for _, v := range o.OrderItems {
+		total += v.Cost
+	}
+	if !o.PricesIncludeTax {
+		total += o.Tax
+	}
+	return total
+}
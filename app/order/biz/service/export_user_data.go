@@ -0,0 +1,63 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type ExportUserDataService struct {
+	ctx context.Context
+} // NewExportUserDataService new ExportUserDataService
+func NewExportUserDataService(ctx context.Context) *ExportUserDataService {
+	return &ExportUserDataService{ctx: ctx}
+}
+
+// Run exports the orders and the returns of the user as they are stored. The invoices are
+// in the export by number, the PDFs can be downloaded from the orders.
+func (s *ExportUserDataService) Run(req *order.ExportUserDataReq) (resp *order.ExportUserDataResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	orders, err := model.ListOrderDetails(mysql.DB, s.ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	returns, err := model.ListReturns(mysql.DB, s.ctx, req.UserId, "")
+	if err != nil {
+		return nil, err
+	}
+	export := struct {
+		Orders  []model.Order  `json:"orders"`
+		Returns []model.Return `json:"returns"`
+	}{Orders: orders, Returns: returns}
+	if export.Orders == nil {
+		export.Orders = []model.Order{}
+	}
+	if export.Returns == nil {
+		export.Returns = []model.Return{}
+	}
+	data, err := json.Marshal(export)
+	if err != nil {
+		return nil, err
+	}
+	return &order.ExportUserDataResp{Data: data}, nil
+}
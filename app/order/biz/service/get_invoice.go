@@ -0,0 +1,57 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
+	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+type GetInvoiceService struct {
+	ctx context.Context
+} // NewGetInvoiceService new GetInvoiceService
+func NewGetInvoiceService(ctx context.Context) *GetInvoiceService {
+	return &GetInvoiceService{ctx: ctx}
+}
+
+// Run returns the invoice PDF of a paid order, issuing it if MarkOrderPaid could not.
+func (s *GetInvoiceService) Run(req *order.GetInvoiceReq) (resp *order.GetInvoiceResp, err error) {
+	if req.UserId == 0 || req.OrderId == "" {
+		return nil, kerrors.NewBizStatusError(40000, "user id and order id are required")
+	}
+	inv, pdf, err := issueInvoice(mysql.DB, s.ctx, req.UserId, req.OrderId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "order not found")
+	}
+	if errors.Is(err, errOrderNotPaid) {
+		return nil, kerrors.NewBizStatusError(40000, "order is not paid")
+	}
+	if err != nil {
+		klog.CtxErrorf(s.ctx, "issue invoice for order %s failed: %v", req.OrderId, err)
+		return nil, err
+	}
+	return &order.GetInvoiceResp{
+		InvoiceNumber: inv.InvoiceNumber,
+		IssuedAt:      inv.CreatedAt.Unix(),
+		FileName:      inv.InvoiceNumber + ".pdf",
+		Content:       pdf,
+	}, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
+	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+type GetOrderService struct {
+	ctx context.Context
+} // NewGetOrderService new GetOrderService
+func NewGetOrderService(ctx context.Context) *GetOrderService {
+	return &GetOrderService{ctx: ctx}
+}
+
+// Run returns one order of the user with its state history.
+func (s *GetOrderService) Run(req *order.GetOrderReq) (resp *order.GetOrderResp, err error) {
+	if req.UserId == 0 || req.OrderId == "" {
+		return nil, kerrors.NewBizStatusError(40000, "user id and order id are required")
+	}
+	o, err := model.GetOrderDetail(mysql.DB, s.ctx, req.UserId, req.OrderId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "order not found")
+	}
+	if err != nil {
+		klog.Errorf("model.GetOrderDetail.err:%v", err)
+		return nil, err
+	}
+	return &order.GetOrderResp{Order: toOrder(o)}, nil
+}
+
+func toOrder(v model.Order) *order.Order {
+	var items []*order.OrderItem
+	for _, v := range v.OrderItems {
+		var unitPrice float32
+		if v.Quantity > 0 {
+			unitPrice = v.Cost / float32(v.Quantity)
+		}
+		items = append(items, &order.OrderItem{
+			Cost:        v.Cost,
+			UnitPrice:   unitPrice,
+			ProductName: v.ProductName,
+			Item: &cart.CartItem{
+				ProductId: v.ProductId,
+				Quantity:  v.Quantity,
+			},
+		})
+	}
+	var taxLines []*order.TaxLine
+	for _, v := range v.TaxLines {
+		taxLines = append(taxLines, &order.TaxLine{Name: v.Name, Rate: v.Rate, Amount: v.Amount})
+	}
+	var history []*order.OrderStateChange
+	for _, v := range v.StateHistory {
+		history = append(history, &order.OrderStateChange{State: string(v.State), CreatedAt: v.CreatedAt.Unix()})
+	}
+	var invoiceNumber string
+	if v.Invoice != nil {
+		invoiceNumber = v.Invoice.InvoiceNumber
+	}
+	return &order.Order{
+		OrderId:      v.OrderId,
+		UserId:       v.UserId,
+		UserCurrency: v.UserCurrency,
+		Email:        v.Consignee.Email,
+		CreatedAt:    int32(v.CreatedAt.Unix()),
+		Address: &order.Address{
+			Country:       v.Consignee.Country,
+			State:         v.Consignee.State,
+			City:          v.Consignee.City,
+			StreetAddress: v.Consignee.StreetAddress,
+			ZipCode:       v.Consignee.ZipCode,
+		},
+		OrderItems:       items,
+		ShippingMethod:   v.ShippingMethod,
+		ShippingCost:     v.ShippingCost,
+		TaxLines:         taxLines,
+		Tax:              v.Tax,
+		PricesIncludeTax: v.PricesIncludeTax,
+		OrderState:       string(v.OrderState),
+		TransactionId:    v.TransactionId,
+		StateHistory:     history,
+		InvoiceNumber:    invoiceNumber,
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/invoice"
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/order/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/order/infra/filestore"
+	"gorm.io/gorm"
+)
+
+var errOrderNotPaid = errors.New("order is not paid")
+
+// issueInvoice numbers and stores the invoice of a paid order. It is safe to call again:
+// an existing invoice keeps its number and only the missing file is written. When db is a
+// transaction the number is only taken if it commits.
+func issueInvoice(db *gorm.DB, ctx context.Context, userId uint32, orderId string) (inv model.Invoice, pdf []byte, err error) {
+	o, err := model.GetOrderDetail(db, ctx, userId, orderId)
+	if err != nil {
+		return
+	}
+	if o.OrderState != model.OrderStatePaid {
+		return inv, nil, errOrderNotPaid
+	}
+	if o.Invoice != nil {
+		inv = *o.Invoice
+		pdf, err = filestore.Store.Get(ctx, inv.FileKey)
+		if !errors.Is(err, filestore.ErrNotFound) {
+			return
+		}
+	} else {
+		err = db.Transaction(func(tx *gorm.DB) error {
+			var err error
+			inv, err = model.CreateInvoice(tx, ctx, o, conf.GetConf().Invoice.Prefix, time.Now())
+			return err
+		})
+		if err != nil {
+			return
+		}
+	}
+	pdf, err = invoice.Render(invoice.FromOrder(o, inv, conf.GetConf().Invoice.Seller))
+	if err != nil {
+		return
+	}
+	err = filestore.Store.Put(ctx, inv.FileKey, pdf)
+	return
+}
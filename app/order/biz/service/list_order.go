@@ -16,11 +16,13 @@ package service
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
 	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
-	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
 	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/kitex/pkg/kerrors"
 	"github.com/cloudwego/kitex/pkg/klog"
 )
 
@@ -31,44 +33,52 @@ func NewListOrderService(ctx context.Context) *ListOrderService {
 	return &ListOrderService{ctx: ctx}
 }
 
-// Run create note info
+const (
+	defaultOrderPageSize = 10
+	maxOrderPageSize     = 50
+)
+
+// Run lists the orders of the user newest first, one page at a time.
 func (s *ListOrderService) Run(req *order.ListOrderReq) (resp *order.ListOrderResp, err error) {
-	// Finish your business logic.
-	orders, err := model.ListOrder(mysql.DB, s.ctx, req.UserId)
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultOrderPageSize
+	}
+	if pageSize > maxOrderPageSize {
+		pageSize = maxOrderPageSize
+	}
+	var afterId int
+	if req.Cursor != "" {
+		afterId, err = strconv.Atoi(req.Cursor)
+		if err != nil || afterId <= 0 {
+			return nil, kerrors.NewBizStatusError(40000, "invalid cursor")
+		}
+	}
+	filter := model.OrderFilter{State: model.OrderState(req.State)}
+	if req.StartTime > 0 {
+		filter.StartTime = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		filter.EndTime = time.Unix(req.EndTime, 0)
+	}
+	// fetch one more order to know if there is a next page
+	orders, err := model.ListOrder(mysql.DB, s.ctx, req.UserId, filter, afterId, pageSize+1)
 	if err != nil {
 		klog.Errorf("model.ListOrder.err:%v", err)
 		return nil, err
 	}
-	var list []*order.Order
-	for _, v := range orders {
-		var items []*order.OrderItem
-		for _, v := range v.OrderItems {
-			items = append(items, &order.OrderItem{
-				Cost: v.Cost,
-				Item: &cart.CartItem{
-					ProductId: v.ProductId,
-					Quantity:  v.Quantity,
-				},
-			})
-		}
-		o := &order.Order{
-			OrderId:      v.OrderId,
-			UserId:       v.UserId,
-			UserCurrency: v.UserCurrency,
-			Email:        v.Consignee.Email,
-			CreatedAt:    int32(v.CreatedAt.Unix()),
-			Address: &order.Address{
-				Country:       v.Consignee.Country,
-				City:          v.Consignee.City,
-				StreetAddress: v.Consignee.StreetAddress,
-				ZipCode:       v.Consignee.ZipCode,
-			},
-			OrderItems: items,
-		}
-		list = append(list, o)
+	total, err := model.CountOrder(mysql.DB, s.ctx, req.UserId, filter)
+	if err != nil {
+		klog.Errorf("model.CountOrder.err:%v", err)
+		return nil, err
+	}
+	resp = &order.ListOrderResp{Total: total}
+	if len(orders) > pageSize {
+		orders = orders[:pageSize]
+		resp.NextCursor = strconv.Itoa(orders[pageSize-1].ID)
 	}
-	resp = &order.ListOrderResp{
-		Orders: list,
+	for _, v := range orders {
+		resp.Orders = append(resp.Orders, toOrder(v))
 	}
 	return
 }
@@ -0,0 +1,47 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type ListReturnsService struct {
+	ctx context.Context
+} // NewListReturnsService new ListReturnsService
+func NewListReturnsService(ctx context.Context) *ListReturnsService {
+	return &ListReturnsService{ctx: ctx}
+}
+
+// Run returns the user's returns, newest first.
+func (s *ListReturnsService) Run(req *order.ListReturnsReq) (resp *order.ListReturnsResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	returns, err := model.ListReturns(mysql.DB, s.ctx, req.UserId, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	resp = &order.ListReturnsResp{}
+	for _, v := range returns {
+		resp.Returns = append(resp.Returns, toReturn(v))
+	}
+	return resp, nil
+}
@@ -20,8 +20,10 @@ import (
 
 	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
 	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
 	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
 	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
 )
 
 type MarkOrderPaidService struct {
@@ -34,16 +36,43 @@ func NewMarkOrderPaidService(ctx context.Context) *MarkOrderPaidService {
 // Run create note info
 func (s *MarkOrderPaidService) Run(req *order.MarkOrderPaidReq) (resp *order.MarkOrderPaidResp, err error) {
 	// Finish your business logic.
+	if err = authn.RequireService(s.ctx); err != nil {
+		return nil, err
+	}
 	if req.UserId == 0 || req.OrderId == "" {
 		err = fmt.Errorf("user_id or order_id can not be empty")
 		return
 	}
-	_, err = model.GetOrder(mysql.DB, s.ctx, req.UserId, req.OrderId)
-	if err != nil {
-		klog.Errorf("model.ListOrder.err:%v", err)
-		return nil, err
-	}
-	err = model.UpdateOrderState(mysql.DB, s.ctx, req.UserId, req.OrderId, model.OrderStatePaid)
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		o, err := model.GetOrderForUpdate(tx, s.ctx, req.UserId, req.OrderId)
+		if err != nil {
+			return err
+		}
+		// a repeated call must not queue the events again
+		if o.OrderState == model.OrderStatePaid {
+			return nil
+		}
+		if req.TransactionId != "" {
+			if err := model.SetOrderTransactionId(tx, s.ctx, req.UserId, req.OrderId, req.TransactionId); err != nil {
+				return err
+			}
+			o.TransactionId = req.TransactionId
+		}
+		if err := model.UpdateOrderState(tx, s.ctx, req.UserId, req.OrderId, model.OrderStatePaid); err != nil {
+			return err
+		}
+		o.OrderState = model.OrderStatePaid
+		if err := addOrderPaid(tx, s.ctx, o); err != nil {
+			return err
+		}
+		// the order is paid even if the invoice fails, GetInvoice issues it later
+		inv, pdf, err := issueInvoice(tx, s.ctx, req.UserId, req.OrderId)
+		if err != nil {
+			klog.CtxErrorf(s.ctx, "issue invoice for order %s failed: %v", req.OrderId, err)
+			pdf = nil
+		}
+		return addOrderEmail(tx, s.ctx, o, inv, pdf)
+	})
 	if err != nil {
 		klog.Errorf("model.ListOrder.err:%v", err)
 		return nil, err
@@ -18,7 +18,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/cloudwego/biz-demo/gomall/common/authn/authntest"
 	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/kitex/pkg/kerrors"
 )
 
 func TestMarkOrderPaid_Run(t *testing.T) {
@@ -33,3 +35,11 @@ func TestMarkOrderPaid_Run(t *testing.T) {
 
 	// todo: edit your unit test
 }
+
+func TestMarkOrderPaid_RejectsUsers(t *testing.T) {
+	ctx := authntest.UserContext(t, 1)
+	_, err := NewMarkOrderPaidService(ctx).Run(&order.MarkOrderPaidReq{UserId: 1, OrderId: "o1"})
+	if bizErr, ok := kerrors.FromBizStatusError(err); !ok || bizErr.BizStatusCode() != 40300 {
+		t.Errorf("a user marked their own order paid: %v", err)
+	}
+}
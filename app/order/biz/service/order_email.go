@@ -0,0 +1,80 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/outbox"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+const subjectEmail = "email"
+
+type orderEmailItem struct {
+	Name     string  `json:"name"`
+	Quantity int32   `json:"quantity"`
+	Amount   float32 `json:"amount"`
+}
+
+// addOrderEmail queues the order confirmation in the transaction tx. The invoice is
+// attached when pdf is set, otherwise the email goes out without it.
+func addOrderEmail(tx *gorm.DB, ctx context.Context, o model.Order, inv model.Invoice, pdf []byte) error {
+	if o.Consignee.Email == "" {
+		return nil
+	}
+	req := &email.EmailReq{
+		To:           o.Consignee.Email,
+		ContentType:  "text/plain",
+		Subject:      "You just created an order in CloudWeGo shop",
+		Content:      "You just created an order in CloudWeGo shop",
+		Template:     "order_confirmation",
+		TemplateData: orderEmailData(o, len(pdf) > 0),
+	}
+	if len(pdf) > 0 {
+		req.Attachments = append(req.Attachments, &email.Attachment{
+			Filename:    inv.InvoiceNumber + ".pdf",
+			ContentType: "application/pdf",
+			Content:     pdf,
+		})
+	}
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	return outbox.Add(tx, ctx, subjectEmail, data)
+}
+
+// orderEmailData is the template data of the order_confirmation email.
+func orderEmailData(o model.Order, hasInvoice bool) string {
+	var items []orderEmailItem
+	for _, v := range o.OrderItems {
+		items = append(items, orderEmailItem{Name: v.ProductName, Quantity: v.Quantity, Amount: v.Cost})
+	}
+	data, _ := json.Marshal(map[string]any{
+		"order_id":           o.OrderId,
+		"items":              items,
+		"shipping":           o.ShippingCost,
+		"tax":                o.Tax,
+		"prices_include_tax": o.PricesIncludeTax,
+		"total":              orderTotal(o),
+		"has_invoice":        hasInvoice,
+	})
+	return string(data)
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
 	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
 	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -35,6 +36,9 @@ func NewPlaceOrderService(ctx context.Context) *PlaceOrderService {
 // Run create note info
 func (s *PlaceOrderService) Run(req *order.PlaceOrderReq) (resp *order.PlaceOrderResp, err error) {
 	// Finish your business logic.
+	if err = authn.RequireService(s.ctx); err != nil {
+		return nil, err
+	}
 	if len(req.OrderItems) == 0 {
 		err = fmt.Errorf("OrderItems empty")
 		return
@@ -44,10 +48,14 @@ func (s *PlaceOrderService) Run(req *order.PlaceOrderReq) (resp *order.PlaceOrde
 		orderId, _ := uuid.NewUUID()
 
 		o := &model.Order{
-			OrderId:      orderId.String(),
-			OrderState:   model.OrderStatePlaced,
-			UserId:       req.UserId,
-			UserCurrency: req.UserCurrency,
+			OrderId:          orderId.String(),
+			OrderState:       model.OrderStatePlaced,
+			UserId:           req.UserId,
+			UserCurrency:     req.UserCurrency,
+			ShippingMethod:   req.ShippingMethod,
+			ShippingCost:     req.ShippingCost,
+			Tax:              req.Tax,
+			PricesIncludeTax: req.PricesIncludeTax,
 			Consignee: model.Consignee{
 				Email: req.Email,
 			},
@@ -62,19 +70,42 @@ func (s *PlaceOrderService) Run(req *order.PlaceOrderReq) (resp *order.PlaceOrde
 		if err := tx.Create(o).Error; err != nil {
 			return err
 		}
+		if err := tx.Create(&model.OrderStateHistory{OrderIdRefer: o.OrderId, State: o.OrderState}).Error; err != nil {
+			return err
+		}
 
-		var itemList []*model.OrderItem
+		var itemList []model.OrderItem
 		for _, v := range req.OrderItems {
-			itemList = append(itemList, &model.OrderItem{
+			itemList = append(itemList, model.OrderItem{
 				OrderIdRefer: o.OrderId,
 				ProductId:    v.Item.ProductId,
 				Quantity:     v.Item.Quantity,
 				Cost:         v.Cost,
+				ProductName:  v.ProductName,
 			})
 		}
 		if err := tx.Create(&itemList).Error; err != nil {
 			return err
 		}
+		o.OrderItems = itemList
+
+		if len(req.TaxLines) > 0 {
+			var taxLines []*model.OrderTaxLine
+			for _, v := range req.TaxLines {
+				taxLines = append(taxLines, &model.OrderTaxLine{
+					OrderIdRefer: o.OrderId,
+					Name:         v.Name,
+					Rate:         v.Rate,
+					Amount:       v.Amount,
+				})
+			}
+			if err := tx.Create(&taxLines).Error; err != nil {
+				return err
+			}
+		}
+		if err := addOrderPlaced(tx, s.ctx, *o); err != nil {
+			return err
+		}
 		resp = &order.PlaceOrderResp{
 			Order: &order.OrderResult{
 				OrderId: orderId.String(),
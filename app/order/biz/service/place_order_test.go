@@ -16,7 +16,19 @@ package service
 
 import (
 	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/common/authn/authntest"
+	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/kitex/pkg/kerrors"
 )
 
 func TestPlaceOrder_Run(t *testing.T) {
 }
+
+func TestPlaceOrder_RejectsUsers(t *testing.T) {
+	ctx := authntest.UserContext(t, 1)
+	_, err := NewPlaceOrderService(ctx).Run(&order.PlaceOrderReq{UserId: 1})
+	if bizErr, ok := kerrors.FromBizStatusError(err); !ok || bizErr.BizStatusCode() != 40300 {
+		t.Errorf("a user placed an order directly: %v", err)
+	}
+}
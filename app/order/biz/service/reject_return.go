@@ -0,0 +1,53 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type RejectReturnService struct {
+	ctx context.Context
+} // NewRejectReturnService new RejectReturnService
+func NewRejectReturnService(ctx context.Context) *RejectReturnService {
+	return &RejectReturnService{ctx: ctx}
+}
+
+// Run rejects a requested return. Its items can be requested again in a new return.
+func (s *RejectReturnService) Run(req *order.RejectReturnReq) (resp *order.RejectReturnResp, err error) {
+	if err = authn.Require(s.ctx, authn.PermOrdersManage); err != nil {
+		return nil, err
+	}
+	r, err := getReturn(s.ctx, req.ReturnId)
+	if err != nil {
+		return nil, err
+	}
+	if r.State == model.ReturnStateRejected {
+		return &order.RejectReturnResp{Return: toReturn(r)}, nil
+	}
+	if r.State != model.ReturnStateRequested {
+		return nil, kerrors.NewBizStatusError(40000, "only requested returns can be rejected")
+	}
+	ret, err := transitionReturn(s.ctx, r, model.ReturnStateRejected, map[string]any{"admin_note": req.Note})
+	if err != nil {
+		return nil, err
+	}
+	return &order.RejectReturnResp{Return: ret}, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func newReturnId() string {
+	return "RMA" + strings.ToUpper(strings.ReplaceAll(uuid.NewString(), "-", "")[:12])
+}
+
+func getReturn(ctx context.Context, returnId string) (model.Return, error) {
+	if returnId == "" {
+		return model.Return{}, kerrors.NewBizStatusError(40000, "return id is required")
+	}
+	r, err := model.GetReturn(mysql.DB, ctx, returnId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return r, kerrors.NewBizStatusError(40004, "return not found")
+	}
+	return r, err
+}
+
+// transitionReturn applies the state change and reloads the return.
+func transitionReturn(ctx context.Context, r model.Return, to model.ReturnState, updates map[string]any) (*order.Return, error) {
+	err := model.TransitionReturn(mysql.DB, ctx, r.ReturnId, r.State, to, updates)
+	if errors.Is(err, model.ErrReturnStateChanged) {
+		return nil, kerrors.NewBizStatusError(40000, "the return was changed by someone else, reload it")
+	}
+	if err != nil {
+		return nil, err
+	}
+	r, err = model.GetReturn(mysql.DB, ctx, r.ReturnId)
+	if err != nil {
+		return nil, err
+	}
+	return toReturn(r), nil
+}
+
+func toReturn(r model.Return) *order.Return {
+	var items []*order.ReturnItem
+	for _, v := range r.Items {
+		items = append(items, &order.ReturnItem{
+			ProductId:   v.ProductId,
+			Quantity:    v.Quantity,
+			Reason:      v.Reason,
+			ProductName: v.ProductName,
+			UnitPrice:   v.UnitPrice,
+		})
+	}
+	ret := &order.Return{
+		ReturnId:       r.ReturnId,
+		OrderId:        r.OrderIdRefer,
+		UserId:         r.UserId,
+		State:          string(r.State),
+		Items:          items,
+		Comment:        r.Comment,
+		AdminNote:      r.AdminNote,
+		Carrier:        r.Carrier,
+		TrackingNumber: r.TrackingNumber,
+		RefundAmount:   r.RefundAmount,
+		RefundId:       r.RefundId,
+		CreatedAt:      r.CreatedAt.Unix(),
+	}
+	if r.TrackingNumber != "" {
+		ret.ShipTo = &order.ReturnAddress{
+			Name:          r.ShipTo.Name,
+			StreetAddress: r.ShipTo.StreetAddress,
+			City:          r.ShipTo.City,
+			State:         r.ShipTo.State,
+			Country:       r.ShipTo.Country,
+			ZipCode:       r.ShipTo.ZipCode,
+		}
+	}
+	return ret
+}
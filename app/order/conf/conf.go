@@ -31,11 +31,49 @@ var (
 )
 
 type Config struct {
-	Env      string
-	Kitex    Kitex    `yaml:"kitex"`
-	MySQL    MySQL    `yaml:"mysql"`
-	Redis    Redis    `yaml:"redis"`
-	Registry Registry `yaml:"registry"`
+	Env       string
+	Kitex     Kitex     `yaml:"kitex"`
+	MySQL     MySQL     `yaml:"mysql"`
+	Redis     Redis     `yaml:"redis"`
+	Registry  Registry  `yaml:"registry"`
+	Invoice   Invoice   `yaml:"invoice"`
+	FileStore FileStore `yaml:"file_store"`
+	Returns   Returns   `yaml:"returns"`
+	Outbox    Outbox    `yaml:"outbox"`
+}
+
+// Outbox configures the relay publishing the outbox table to NATS. Stream is created for
+// Subjects, the order events.
+type Outbox struct {
+	Stream         string   `yaml:"stream"`
+	Subjects       []string `yaml:"subjects"`
+	IntervalMs     int      `yaml:"interval_ms"`
+	BatchSize      int      `yaml:"batch_size"`
+	RetentionHours int      `yaml:"retention_hours"`
+}
+
+// Returns limits how long after an order was placed its items can be returned.
+type Returns struct {
+	WindowDays int `yaml:"window_days"`
+}
+
+type Invoice struct {
+	// Prefix starts every invoice number, e.g. INV-2024-000001
+	Prefix string `yaml:"prefix"`
+	Seller Seller `yaml:"seller"`
+}
+
+type Seller struct {
+	Name    string   `yaml:"name"`
+	Address []string `yaml:"address"`
+	TaxId   string   `yaml:"tax_id"`
+	Email   string   `yaml:"email"`
+}
+
+// FileStore selects where generated files are kept. Type is "local" or "memory".
+type FileStore struct {
+	Type string `yaml:"type"`
+	Dir  string `yaml:"dir"`
 }
 
 type MySQL struct {
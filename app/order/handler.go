@@ -44,3 +44,73 @@ func (s *OrderServiceImpl) MarkOrderPaid(ctx context.Context, req *order.MarkOrd
 
 	return resp, err
 }
+
+// GetOrder implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) GetOrder(ctx context.Context, req *order.GetOrderReq) (resp *order.GetOrderResp, err error) {
+	resp, err = service.NewGetOrderService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetInvoice implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) GetInvoice(ctx context.Context, req *order.GetInvoiceReq) (resp *order.GetInvoiceResp, err error) {
+	resp, err = service.NewGetInvoiceService(ctx).Run(req)
+
+	return resp, err
+}
+
+// CreateReturn implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) CreateReturn(ctx context.Context, req *order.CreateReturnReq) (resp *order.CreateReturnResp, err error) {
+	resp, err = service.NewCreateReturnService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListReturns implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) ListReturns(ctx context.Context, req *order.ListReturnsReq) (resp *order.ListReturnsResp, err error) {
+	resp, err = service.NewListReturnsService(ctx).Run(req)
+
+	return resp, err
+}
+
+// AdminListReturns implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) AdminListReturns(ctx context.Context, req *order.AdminListReturnsReq) (resp *order.AdminListReturnsResp, err error) {
+	resp, err = service.NewAdminListReturnsService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ApproveReturn implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) ApproveReturn(ctx context.Context, req *order.ApproveReturnReq) (resp *order.ApproveReturnResp, err error) {
+	resp, err = service.NewApproveReturnService(ctx).Run(req)
+
+	return resp, err
+}
+
+// RejectReturn implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) RejectReturn(ctx context.Context, req *order.RejectReturnReq) (resp *order.RejectReturnResp, err error) {
+	resp, err = service.NewRejectReturnService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ConfirmReturnReceived implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) ConfirmReturnReceived(ctx context.Context, req *order.ConfirmReturnReceivedReq) (resp *order.ConfirmReturnReceivedResp, err error) {
+	resp, err = service.NewConfirmReturnReceivedService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ExportUserData implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) ExportUserData(ctx context.Context, req *order.ExportUserDataReq) (resp *order.ExportUserDataResp, err error) {
+	resp, err = service.NewExportUserDataService(ctx).Run(req)
+
+	return resp, err
+}
+
+// EraseUserData implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) EraseUserData(ctx context.Context, req *order.EraseUserDataReq) (resp *order.EraseUserDataResp, err error) {
+	resp, err = service.NewEraseUserDataService(ctx).Run(req)
+
+	return resp, err
+}
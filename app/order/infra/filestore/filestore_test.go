@@ -0,0 +1,56 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+)
+
+func testStore(t *testing.T, s FileStore) {
+	ctx := context.Background()
+	if _, err := s.Get(ctx, "invoices/2024/a.pdf"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("got %v for a missing file, want ErrNotFound", err)
+	}
+	if err := s.Put(ctx, "invoices/2024/a.pdf", []byte("first")); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Put(ctx, "invoices/2024/a.pdf", []byte("second")); err != nil {
+		t.Fatal(err)
+	}
+	data, err := s.Get(ctx, "invoices/2024/a.pdf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, []byte("second")) {
+		t.Errorf("got %q, want second", data)
+	}
+}
+
+func TestLocalStore(t *testing.T) {
+	s := NewLocalStore(t.TempDir())
+	testStore(t, s)
+	for _, key := range []string{"", "../outside", "/etc/passwd"} {
+		if err := s.Put(context.Background(), key, nil); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Put(%q) = %v, want ErrInvalidKey", key, err)
+		}
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, NewMemoryStore())
+}
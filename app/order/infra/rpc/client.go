@@ -0,0 +1,67 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"sync"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/conf"
+	orderutils "github.com/cloudwego/biz-demo/gomall/app/order/utils"
+	"github.com/cloudwego/biz-demo/gomall/common/clientsuite"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment/paymentservice"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product/productcatalogservice"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping/shippingservice"
+	"github.com/cloudwego/kitex/client"
+)
+
+var (
+	ProductClient  productcatalogservice.Client
+	PaymentClient  paymentservice.Client
+	ShippingClient shippingservice.Client
+	once           sync.Once
+	err            error
+	registryAddr   string
+	serviceName    string
+	commonSuite    client.Option
+)
+
+func InitClient() {
+	once.Do(func() {
+		registryAddr = conf.GetConf().Registry.RegistryAddress[0]
+		serviceName = conf.GetConf().Kitex.Service
+		commonSuite = client.WithSuite(clientsuite.CommonGrpcClientSuite{
+			CurrentServiceName: serviceName,
+			RegistryAddr:       registryAddr,
+		})
+		initProductClient()
+		initPaymentClient()
+		initShippingClient()
+	})
+}
+
+func initProductClient() {
+	ProductClient, err = productcatalogservice.NewClient("product", commonSuite)
+	orderutils.MustHandleError(err)
+}
+
+func initPaymentClient() {
+	PaymentClient, err = paymentservice.NewClient("payment", commonSuite)
+	orderutils.MustHandleError(err)
+}
+
+func initShippingClient() {
+	ShippingClient, err = shippingservice.NewClient("shipping", commonSuite)
+	orderutils.MustHandleError(err)
+}
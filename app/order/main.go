@@ -22,7 +22,11 @@ import (
 	"gopkg.in/natefinch/lumberjack.v2"
 
 	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal"
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/relay"
 	"github.com/cloudwego/biz-demo/gomall/app/order/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/order/infra/filestore"
+	"github.com/cloudwego/biz-demo/gomall/app/order/infra/mq"
+	"github.com/cloudwego/biz-demo/gomall/app/order/infra/rpc"
 	"github.com/cloudwego/biz-demo/gomall/common/mtl"
 	"github.com/cloudwego/biz-demo/gomall/common/utils"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order/orderservice"
@@ -44,6 +48,10 @@ func main() {
 	mtl.InitTracing(serviceName)
 	mtl.InitMetric(serviceName, conf.GetConf().Kitex.MetricsPort, conf.GetConf().Registry.RegistryAddress[0])
 	dal.Init()
+	filestore.Init()
+	rpc.InitClient()
+	mq.Init()
+	relay.Init()
 	opts := kitexInit()
 
 	svr := orderservice.NewServer(new(OrderServiceImpl), opts...)
@@ -20,6 +20,7 @@ import (
 
 	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
 	"github.com/cloudwego/biz-demo/gomall/app/payment/conf"
+	"github.com/cloudwego/biz-demo/gomall/common/outbox"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
@@ -43,6 +44,8 @@ func Init() {
 	if os.Getenv("GO_ENV") != "online" {
 		DB.AutoMigrate( //nolint:errcheck
 			&model.PaymentLog{},
+			&model.Refund{},
+			&outbox.Message{},
 		)
 	}
 }
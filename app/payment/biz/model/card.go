@@ -0,0 +1,79 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// cardTokenTTL bounds how long a queued checkout can take to be charged.
+const cardTokenTTL = time.Hour
+
+type StoredCard struct {
+	UserId          uint32 `redis:"user_id"`
+	Number          string `redis:"number"`
+	Cvv             int32  `redis:"cvv"`
+	ExpirationYear  int32  `redis:"expiration_year"`
+	ExpirationMonth int32  `redis:"expiration_month"`
+}
+
+// CardVault keeps tokenized cards until they are charged or expire. Cards are never
+// written to the database.
+type CardVault struct {
+	ctx         context.Context
+	cacheClient *redis.Client
+	prefix      string
+}
+
+func NewCardVault(ctx context.Context, cacheClient *redis.Client) CardVault {
+	return CardVault{ctx: ctx, cacheClient: cacheClient, prefix: "cloudwego_shop"}
+}
+
+func (v CardVault) key(token string) string {
+	return fmt.Sprintf("%s_card_%s", v.prefix, token)
+}
+
+func (v CardVault) Put(card StoredCard) (token string, err error) {
+	token = uuid.NewString()
+	key := v.key(token)
+	_, err = v.cacheClient.TxPipelined(v.ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(v.ctx, key, card)
+		pipe.Expire(v.ctx, key, cardTokenTTL)
+		return nil
+	})
+	return token, err
+}
+
+// Get returns the card, and false when the token is unknown or has expired.
+func (v CardVault) Get(token string) (card StoredCard, ok bool, err error) {
+	res := v.cacheClient.HGetAll(v.ctx, v.key(token))
+	if res.Err() != nil {
+		return card, false, res.Err()
+	}
+	if len(res.Val()) == 0 {
+		return card, false, nil
+	}
+	err = res.Scan(&card)
+	return card, err == nil, err
+}
+
+func (v CardVault) Delete(token string) error {
+	return v.cacheClient.Del(v.ctx, v.key(token)).Err()
+}
@@ -37,3 +37,15 @@ func (p PaymentLog) TableName() string {
 func CreatePaymentLog(db *gorm.DB, ctx context.Context, payment *PaymentLog) error {
 	return db.WithContext(ctx).Model(&PaymentLog{}).Create(payment).Error
 }
+
+// GetPaymentLogByOrder returns the charge of the order, so a repeated charge does not
+// take the money twice.
+func GetPaymentLogByOrder(db *gorm.DB, ctx context.Context, userId uint32, orderId string) (payment PaymentLog, err error) {
+	err = db.WithContext(ctx).Where(&PaymentLog{UserId: userId, OrderId: orderId}).First(&payment).Error
+	return
+}
+
+func ListPaymentLogs(db *gorm.DB, ctx context.Context, userId uint32) (payments []PaymentLog, err error) {
+	err = db.WithContext(ctx).Where(&PaymentLog{UserId: userId}).Order("id").Find(&payments).Error
+	return
+}
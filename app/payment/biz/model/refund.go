@@ -0,0 +1,71 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Refund struct {
+	Base
+	RefundId      string  `gorm:"uniqueIndex;size:64"`
+	RefundKey     string  `gorm:"uniqueIndex;size:128"`
+	UserId        uint32  `json:"user_id"`
+	OrderId       string  `json:"order_id"`
+	TransactionId string  `gorm:"index;size:100"`
+	Amount        float32 `json:"amount"`
+	Reason        string  `json:"reason"`
+}
+
+func (r Refund) TableName() string {
+	return "refund"
+}
+
+// GetPaymentLogForUpdate locks the charge so concurrent refunds against it are serialized.
+func GetPaymentLogForUpdate(tx *gorm.DB, ctx context.Context, transactionId string) (payment PaymentLog, err error) {
+	err = tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(&PaymentLog{TransactionId: transactionId}).First(&payment).Error
+	return
+}
+
+func GetRefundByKey(db *gorm.DB, ctx context.Context, refundKey string) (refund Refund, err error) {
+	err = db.WithContext(ctx).Where(&Refund{RefundKey: refundKey}).First(&refund).Error
+	return
+}
+
+func SumRefunds(db *gorm.DB, ctx context.Context, transactionId string) (total float32, err error) {
+	err = db.WithContext(ctx).Model(&Refund{}).
+		Where(&Refund{TransactionId: transactionId}).
+		Select("COALESCE(SUM(amount), 0)").Scan(&total).Error
+	return
+}
+
+func CreateRefund(db *gorm.DB, ctx context.Context, refund *Refund) error {
+	return db.WithContext(ctx).Create(refund).Error
+}
+
+func ListRefunds(db *gorm.DB, ctx context.Context, userId uint32) (refunds []Refund, err error) {
+	err = db.WithContext(ctx).Where(&Refund{UserId: userId}).Order("id").Find(&refunds).Error
+	return
+}
+
+// EraseRefundReasons blanks the reasons of the user's refunds, free text that may name the
+// user. The amounts stay for the books.
+func EraseRefundReasons(db *gorm.DB, ctx context.Context, userId uint32) error {
+	return db.WithContext(ctx).Model(&Refund{}).Where("user_id = ? AND reason <> ''", userId).Update("reason", "").Error
+}
@@ -16,15 +16,21 @@ package service
 
 import (
 	"context"
+	"errors"
+	"math"
 	"strconv"
 	"time"
 
 	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/dal/redis"
 	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
 	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
 	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
 	creditcard "github.com/durango/go-credit-card"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type ChargeService struct {
@@ -34,34 +40,93 @@ func NewChargeService(ctx context.Context) *ChargeService {
 	return &ChargeService{ctx: ctx}
 }
 
-// Run create note info
+// Run charges the card, or the tokenized card, for the order. A repeated charge of the
+// order returns the first transaction, so queued checkouts can be retried.
 func (s *ChargeService) Run(req *payment.ChargeReq) (resp *payment.ChargeResp, err error) {
-	card := creditcard.Card{
-		Number: req.CreditCard.CreditCardNumber,
-		Cvv:    strconv.Itoa(int(req.CreditCard.CreditCardCvv)),
-		Month:  strconv.Itoa(int(req.CreditCard.CreditCardExpirationMonth)),
-		Year:   strconv.Itoa(int(req.CreditCard.CreditCardExpirationYear)),
+	if err = authn.RequireService(s.ctx); err != nil {
+		return nil, err
+	}
+	if req.OrderId != "" {
+		existing, err := model.GetPaymentLogByOrder(mysql.DB, s.ctx, req.UserId, req.OrderId)
+		if err == nil {
+			if cents(existing.Amount) != cents(req.Amount) {
+				return nil, kerrors.NewBizStatusError(40900, "order already charged with a different amount")
+			}
+			return &payment.ChargeResp{TransactionId: existing.TransactionId}, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
 	}
 
-	err = card.Validate(true)
-	if err != nil {
-		return nil, kerrors.NewBizStatusError(400, err.Error())
+	vault := model.NewCardVault(s.ctx, redis.RedisClient)
+	cardInfo := req.CreditCard
+	if cardInfo == nil {
+		if req.CardToken == "" {
+			return nil, kerrors.NewBizStatusError(400, "credit card is required")
+		}
+		stored, ok, err := vault.Get(req.CardToken)
+		if err != nil {
+			return nil, err
+		}
+		if !ok || stored.UserId != req.UserId {
+			return nil, kerrors.NewBizStatusError(400, "card token is invalid or expired")
+		}
+		cardInfo = &payment.CreditCardInfo{
+			CreditCardNumber:          stored.Number,
+			CreditCardCvv:             stored.Cvv,
+			CreditCardExpirationYear:  stored.ExpirationYear,
+			CreditCardExpirationMonth: stored.ExpirationMonth,
+		}
+	}
+	if err = validateCard(cardInfo); err != nil {
+		return nil, err
 	}
 
 	transactionId, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
 	}
-	err = model.CreatePaymentLog(mysql.DB, s.ctx, &model.PaymentLog{
+	paymentLog := &model.PaymentLog{
 		UserId:        req.UserId,
 		OrderId:       req.OrderId,
 		TransactionId: transactionId.String(),
 		Amount:        req.Amount,
 		PayAt:         time.Now(),
+	}
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		if err := model.CreatePaymentLog(tx, s.ctx, paymentLog); err != nil {
+			return err
+		}
+		return addPaymentCharged(tx, s.ctx, paymentLog)
 	})
 	if err != nil {
 		return nil, err
 	}
-	
+	if req.CardToken != "" {
+		if err := vault.Delete(req.CardToken); err != nil {
+			klog.CtxWarnf(s.ctx, "delete charged card token: %v", err)
+		}
+	}
+
 	return &payment.ChargeResp{TransactionId: transactionId.String()}, nil
 }
+
+func validateCard(info *payment.CreditCardInfo) error {
+	card := creditcard.Card{
+		Number: info.CreditCardNumber,
+		Cvv:    strconv.Itoa(int(info.CreditCardCvv)),
+		Month:  strconv.Itoa(int(info.CreditCardExpirationMonth)),
+		Year:   strconv.Itoa(int(info.CreditCardExpirationYear)),
+	}
+	if err := card.Validate(true); err != nil {
+		return kerrors.NewBizStatusError(400, err.Error())
+	}
+	return nil
+}
+
+// cents compares amounts, the float32 of a request rarely equals the decimal(10,2) it is
+// stored as exactly.
+func cents(v float32) int64 {
+	return int64(math.Round(float64(v) * 100))
+}
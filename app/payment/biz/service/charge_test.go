@@ -16,7 +16,30 @@ package service
 
 import (
 	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/common/authn/authntest"
+	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
 )
 
 func TestCharge_Run(t *testing.T) {
 }
+
+func TestCents(t *testing.T) {
+	// a sum of two decimal prices as checkout sends it, and as it is read back
+	sum := float32(12.34) + float32(5.99)
+	if cents(sum) != cents(18.33) || cents(18.33) != 1833 {
+		t.Errorf("cents(%v) = %d, cents(18.33) = %d", sum, cents(sum), cents(18.33))
+	}
+	if cents(18.33) == cents(18.34) {
+		t.Error("different amounts compare equal")
+	}
+}
+
+func TestCharge_RejectsUsers(t *testing.T) {
+	ctx := authntest.UserContext(t, 1)
+	_, err := NewChargeService(ctx).Run(&payment.ChargeReq{UserId: 1, OrderId: "o1", Amount: 1})
+	if bizErr, ok := kerrors.FromBizStatusError(err); !ok || bizErr.BizStatusCode() != 40300 {
+		t.Errorf("a user charged an order directly: %v", err)
+	}
+}
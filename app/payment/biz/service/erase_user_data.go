@@ -0,0 +1,47 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type EraseUserDataService struct {
+	ctx context.Context
+} // NewEraseUserDataService new EraseUserDataService
+func NewEraseUserDataService(ctx context.Context) *EraseUserDataService {
+	return &EraseUserDataService{ctx: ctx}
+}
+
+// Run keeps the charges and refunds, they are financial records, and removes what they hold
+// about the user beyond the user id of the deleted account.
+func (s *EraseUserDataService) Run(req *payment.EraseUserDataReq) (resp *payment.EraseUserDataResp, err error) {
+	if err = authn.Require(s.ctx, authn.PermUsersErase); err != nil {
+		return nil, err
+	}
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	if err = model.EraseRefundReasons(mysql.DB, s.ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	return &payment.EraseUserDataResp{}, nil
+}
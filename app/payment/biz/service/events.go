@@ -0,0 +1,48 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/eventbus"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/events"
+	"gorm.io/gorm"
+)
+
+func addPaymentCharged(tx *gorm.DB, ctx context.Context, p *model.PaymentLog) error {
+	return eventbus.AddToOutbox(tx, ctx, &events.PaymentCharged{
+		UserId:        p.UserId,
+		OrderId:       p.OrderId,
+		TransactionId: p.TransactionId,
+		Amount:        p.Amount,
+		ChargedAt:     p.PayAt.Unix(),
+	})
+}
+
+func addPaymentRefunded(tx *gorm.DB, ctx context.Context, r *model.Refund, refundedTotal float32) error {
+	return eventbus.AddToOutbox(tx, ctx, &events.PaymentRefunded{
+		RefundId:      r.RefundId,
+		UserId:        r.UserId,
+		OrderId:       r.OrderId,
+		TransactionId: r.TransactionId,
+		Amount:        r.Amount,
+		RefundedTotal: refundedTotal,
+		Reason:        r.Reason,
+		RefundedAt:    time.Now().Unix(),
+	})
+}
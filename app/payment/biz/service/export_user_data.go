@@ -0,0 +1,78 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type ExportUserDataService struct {
+	ctx context.Context
+} // NewExportUserDataService new ExportUserDataService
+func NewExportUserDataService(ctx context.Context) *ExportUserDataService {
+	return &ExportUserDataService{ctx: ctx}
+}
+
+type exportedRefund struct {
+	RefundId      string    `json:"refund_id"`
+	OrderId       string    `json:"order_id"`
+	TransactionId string    `json:"transaction_id"`
+	Amount        float32   `json:"amount"`
+	Reason        string    `json:"reason"`
+	RefundedAt    time.Time `json:"refunded_at"`
+}
+
+func (s *ExportUserDataService) Run(req *payment.ExportUserDataReq) (resp *payment.ExportUserDataResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	payments, err := model.ListPaymentLogs(mysql.DB, s.ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	refunds, err := model.ListRefunds(mysql.DB, s.ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	export := struct {
+		Payments []model.PaymentLog `json:"payments"`
+		Refunds  []exportedRefund   `json:"refunds"`
+	}{Payments: payments, Refunds: []exportedRefund{}}
+	if export.Payments == nil {
+		export.Payments = []model.PaymentLog{}
+	}
+	for _, r := range refunds {
+		export.Refunds = append(export.Refunds, exportedRefund{
+			RefundId:      r.RefundId,
+			OrderId:       r.OrderId,
+			TransactionId: r.TransactionId,
+			Amount:        r.Amount,
+			Reason:        r.Reason,
+			RefundedAt:    r.CreatedAt,
+		})
+	}
+	data, err := json.Marshal(export)
+	if err != nil {
+		return nil, err
+	}
+	return &payment.ExportUserDataResp{Data: data}, nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type RefundService struct {
+	ctx context.Context
+} // NewRefundService new RefundService
+func NewRefundService(ctx context.Context) *RefundService {
+	return &RefundService{ctx: ctx}
+}
+
+// Run refunds part or all of a charge, at most once per refund key
+func (s *RefundService) Run(req *payment.RefundReq) (resp *payment.RefundResp, err error) {
+	if err = authn.Require(s.ctx, authn.PermPaymentsRefund); err != nil {
+		return nil, err
+	}
+	if req.TransactionId == "" || req.RefundKey == "" {
+		return nil, kerrors.NewBizStatusError(40000, "transaction id and refund key are required")
+	}
+	if req.Amount <= 0 {
+		return nil, kerrors.NewBizStatusError(40000, "refund amount must be positive")
+	}
+
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		charge, err := model.GetPaymentLogForUpdate(tx, s.ctx, req.TransactionId)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return kerrors.NewBizStatusError(40004, "charge not found")
+			}
+			return err
+		}
+		if charge.UserId != req.UserId || charge.OrderId != req.OrderId {
+			return kerrors.NewBizStatusError(40004, "charge not found")
+		}
+
+		existing, err := model.GetRefundByKey(tx, s.ctx, req.RefundKey)
+		if err == nil {
+			if existing.TransactionId != req.TransactionId || cents(existing.Amount) != cents(req.Amount) {
+				return kerrors.NewBizStatusError(40000, "refund key already used for a different refund")
+			}
+			refunded, err := model.SumRefunds(tx, s.ctx, req.TransactionId)
+			if err != nil {
+				return err
+			}
+			resp = &payment.RefundResp{RefundId: existing.RefundId, RefundedTotal: refunded}
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		refunded, err := model.SumRefunds(tx, s.ctx, req.TransactionId)
+		if err != nil {
+			return err
+		}
+		// amounts are stored with two decimals, compare in cents to allow for float rounding
+		if cents(refunded+req.Amount) > cents(charge.Amount) {
+			return kerrors.NewBizStatusError(40000, "refund exceeds the charged amount")
+		}
+
+		refundId, err := uuid.NewRandom()
+		if err != nil {
+			return err
+		}
+		refund := &model.Refund{
+			RefundId:      refundId.String(),
+			RefundKey:     req.RefundKey,
+			UserId:        req.UserId,
+			OrderId:       req.OrderId,
+			TransactionId: req.TransactionId,
+			Amount:        req.Amount,
+			Reason:        req.Reason,
+		}
+		if err = model.CreateRefund(tx, s.ctx, refund); err != nil {
+			return err
+		}
+		if err = addPaymentRefunded(tx, s.ctx, refund, refunded+req.Amount); err != nil {
+			return err
+		}
+		resp = &payment.RefundResp{RefundId: refundId.String(), RefundedTotal: refunded + req.Amount}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
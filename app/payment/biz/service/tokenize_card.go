@@ -0,0 +1,56 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type TokenizeCardService struct {
+	ctx context.Context
+} // NewTokenizeCardService new TokenizeCardService
+func NewTokenizeCardService(ctx context.Context) *TokenizeCardService {
+	return &TokenizeCardService{ctx: ctx}
+}
+
+// Run validates the card and keeps it for a later charge by the same user.
+func (s *TokenizeCardService) Run(req *payment.TokenizeCardReq) (resp *payment.TokenizeCardResp, err error) {
+	if err = authn.RequireService(s.ctx); err != nil {
+		return nil, err
+	}
+	if req.UserId == 0 || req.CreditCard == nil {
+		return nil, kerrors.NewBizStatusError(400, "user id and credit card are required")
+	}
+	if err = validateCard(req.CreditCard); err != nil {
+		return nil, err
+	}
+	token, err := model.NewCardVault(s.ctx, redis.RedisClient).Put(model.StoredCard{
+		UserId:          req.UserId,
+		Number:          req.CreditCard.CreditCardNumber,
+		Cvv:             req.CreditCard.CreditCardCvv,
+		ExpirationYear:  req.CreditCard.CreditCardExpirationYear,
+		ExpirationMonth: req.CreditCard.CreditCardExpirationMonth,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &payment.TokenizeCardResp{CardToken: token}, nil
+}
@@ -36,6 +36,17 @@ type Config struct {
 	MySQL    MySQL    `yaml:"mysql"`
 	Redis    Redis    `yaml:"redis"`
 	Registry Registry `yaml:"registry"`
+	Outbox   Outbox   `yaml:"outbox"`
+}
+
+// Outbox configures the relay publishing the outbox table to NATS. Stream is created for
+// Subjects, the payment events.
+type Outbox struct {
+	Stream         string   `yaml:"stream"`
+	Subjects       []string `yaml:"subjects"`
+	IntervalMs     int      `yaml:"interval_ms"`
+	BatchSize      int      `yaml:"batch_size"`
+	RetentionHours int      `yaml:"retention_hours"`
 }
 
 type MySQL struct {
@@ -30,3 +30,31 @@ func (s *PaymentServiceImpl) Charge(ctx context.Context, req *payment.ChargeReq)
 
 	return resp, err
 }
+
+// Refund implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) Refund(ctx context.Context, req *payment.RefundReq) (resp *payment.RefundResp, err error) {
+	resp, err = service.NewRefundService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ExportUserData implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) ExportUserData(ctx context.Context, req *payment.ExportUserDataReq) (resp *payment.ExportUserDataResp, err error) {
+	resp, err = service.NewExportUserDataService(ctx).Run(req)
+
+	return resp, err
+}
+
+// EraseUserData implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) EraseUserData(ctx context.Context, req *payment.EraseUserDataReq) (resp *payment.EraseUserDataResp, err error) {
+	resp, err = service.NewEraseUserDataService(ctx).Run(req)
+
+	return resp, err
+}
+
+// TokenizeCard implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) TokenizeCard(ctx context.Context, req *payment.TokenizeCardReq) (resp *payment.TokenizeCardResp, err error) {
+	resp, err = service.NewTokenizeCardService(ctx).Run(req)
+
+	return resp, err
+}
@@ -19,7 +19,9 @@ import (
 	"strings"
 
 	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/dal"
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/relay"
 	"github.com/cloudwego/biz-demo/gomall/app/payment/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/payment/infra/mq"
 	"github.com/cloudwego/biz-demo/gomall/app/payment/middleware"
 	"github.com/cloudwego/biz-demo/gomall/common/mtl"
 	"github.com/cloudwego/biz-demo/gomall/common/serversuite"
@@ -44,6 +46,8 @@ func main() {
 	mtl.InitTracing(serviceName)
 	mtl.InitMetric(serviceName, conf.GetConf().Kitex.MetricsPort, conf.GetConf().Registry.RegistryAddress[0])
 	dal.Init()
+	mq.Init()
+	relay.Init()
 	opts := kitexInit()
 
 	svr := paymentservice.NewServer(new(PaymentServiceImpl), opts...)
@@ -9,4 +9,39 @@ create table payment
     created_at     datetime       not null default current_timestamp,
     updated_at     datetime       not null default current_timestamp on update current_timestamp,
     constraint payment_pk primary key (id)
-);
\ No newline at end of file
+);
+create table refund
+(
+    id             int auto_increment,
+    refund_id      varchar(64)    not null,
+    refund_key     varchar(128)   not null,
+    user_id        int            not null,
+    order_id       varchar(100)   not null,
+    transaction_id varchar(100)   not null,
+    amount         decimal(10, 2) not null,
+    reason         varchar(255)   not null default '',
+    created_at     datetime       not null default current_timestamp,
+    updated_at     datetime       not null default current_timestamp on update current_timestamp,
+    constraint refund_pk primary key (id),
+    constraint refund_id_uk unique (refund_id),
+    constraint refund_key_uk unique (refund_key),
+    index refund_transaction_id_idx (transaction_id)
+);
+create table outbox
+(
+    id              bigint unsigned auto_increment,
+    msg_id          varchar(64)   not null,
+    subject         varchar(256)  not null,
+    header          text          not null,
+    payload         longblob      not null,
+    created_at      datetime      not null default current_timestamp,
+    published_at    datetime      null,
+    attempts        bigint        not null default 0,
+    last_error      varchar(1024) not null default '',
+    next_attempt_at datetime      null,
+    failed_at       datetime      null,
+    constraint outbox_pk primary key (id),
+    constraint outbox_msg_id_uk unique (msg_id),
+    index outbox_published_at_idx (published_at),
+    index outbox_due_idx (published_at, failed_at, next_attempt_at)
+);
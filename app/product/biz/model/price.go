@@ -0,0 +1,149 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PriceHistory struct {
+	Base
+	ProductId int `gorm:"index"`
+	Price     float32
+	Reason    string
+}
+
+func (p PriceHistory) TableName() string {
+	return "price_history"
+}
+
+type PriceRuleState string
+
+const (
+	PriceRuleStatePending PriceRuleState = "pending"
+	PriceRuleStateActive  PriceRuleState = "active"
+	PriceRuleStateExpired PriceRuleState = "expired"
+)
+
+// PriceRule overrides the price of a product between StartAt and EndAt.
+type PriceRule struct {
+	Base
+	Name      string
+	ProductId int `gorm:"index"`
+	Price     float32
+	// RegularPrice is the price the product had when the rule was activated; it is restored on expiry.
+	RegularPrice float32
+	StartAt      time.Time
+	EndAt        time.Time
+	State        PriceRuleState `gorm:"index;size:16"`
+}
+
+func (p PriceRule) TableName() string {
+	return "price_rule"
+}
+
+// UpdateProductPrice changes the price of a product and records the change in the price history.
+func UpdateProductPrice(db *gorm.DB, ctx context.Context, productId int, price float32, reason string) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&Product{}).Where(&Product{Base: Base{ID: productId}}).Update("price", price).Error; err != nil {
+			return err
+		}
+		return tx.Create(&PriceHistory{ProductId: productId, Price: price, Reason: reason}).Error
+	})
+}
+
+func ListPriceHistory(db *gorm.DB, ctx context.Context, productId, limit int) (history []PriceHistory, err error) {
+	err = db.WithContext(ctx).Model(&PriceHistory{}).Where(&PriceHistory{ProductId: productId}).Order("id desc").Limit(limit).Find(&history).Error
+	return
+}
+
+func CreatePriceRule(db *gorm.DB, ctx context.Context, rule *PriceRule) error {
+	return db.WithContext(ctx).Create(rule).Error
+}
+
+func GetActivePriceRule(db *gorm.DB, ctx context.Context, productId int) (rule PriceRule, err error) {
+	err = db.WithContext(ctx).Model(&PriceRule{}).Where(&PriceRule{ProductId: productId, State: PriceRuleStateActive}).First(&rule).Error
+	return
+}
+
+// ListStartedPriceRules returns pending rules whose start time has passed.
+func ListStartedPriceRules(db *gorm.DB, ctx context.Context, now time.Time) (rules []PriceRule, err error) {
+	err = db.WithContext(ctx).Model(&PriceRule{}).Where("state = ? and start_at <= ?", PriceRuleStatePending, now).Order("start_at").Find(&rules).Error
+	return
+}
+
+// ListEndedPriceRules returns active rules whose end time has passed.
+func ListEndedPriceRules(db *gorm.DB, ctx context.Context, now time.Time) (rules []PriceRule, err error) {
+	err = db.WithContext(ctx).Model(&PriceRule{}).Where("state = ? and end_at <= ?", PriceRuleStateActive, now).Find(&rules).Error
+	return
+}
+
+// ActivatePriceRule applies the rule price. It returns false if the rule was
+// already handled, e.g. by another product service instance, or if another rule is
+// running for the product. The product row is locked while checking, so two instances
+// cannot start two rules of one product.
+func ActivatePriceRule(db *gorm.DB, ctx context.Context, rule PriceRule) (activated bool, err error) {
+	err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var p Product
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Model(&Product{}).Where(&Product{Base: Base{ID: rule.ProductId}}).First(&p).Error; err != nil {
+			return err
+		}
+		_, err := GetActivePriceRule(tx, ctx, rule.ProductId)
+		if err == nil {
+			// start once the running rule ends
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		result := tx.Model(&PriceRule{}).
+			Where("id = ? and state = ?", rule.ID, PriceRuleStatePending).
+			Updates(map[string]interface{}{"state": PriceRuleStateActive, "regular_price": p.Price})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		activated = true
+		return UpdateProductPrice(tx, ctx, rule.ProductId, rule.Price, "price rule started: "+rule.Name)
+	})
+	return
+}
+
+// ExpirePriceRule restores the regular price if the rule is active, and marks the rule as expired.
+// It returns false if the rule is no longer in the state it was read in, e.g. because
+// another product service instance activated or expired it in the meantime.
+func ExpirePriceRule(db *gorm.DB, ctx context.Context, rule PriceRule) (expired bool, err error) {
+	if rule.State != PriceRuleStatePending && rule.State != PriceRuleStateActive {
+		return false, nil
+	}
+	err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&PriceRule{}).
+			Where("id = ? and state = ?", rule.ID, rule.State).
+			Update("state", PriceRuleStateExpired)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		expired = true
+		if rule.State != PriceRuleStateActive {
+			return nil
+		}
+		return UpdateProductPrice(tx, ctx, rule.ProductId, rule.RegularPrice, "price rule ended: "+rule.Name)
+	})
+	return
+}
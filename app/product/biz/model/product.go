@@ -30,6 +30,8 @@ type Product struct {
 	Description string     `json:"description"`
 	Picture     string     `json:"picture"`
 	Price       float32    `json:"price"`
+	Weight      float32    `json:"weight"`
+	TaxCategory string     `json:"tax_category"`
 	Categories  []Category `json:"categories" gorm:"many2many:product_category"`
 }
 
@@ -57,8 +59,12 @@ type CachedProductQuery struct {
 	prefix       string
 }
 
+func (c CachedProductQuery) cacheKey(productId int) string {
+	return fmt.Sprintf("%s_%s_%d", c.prefix, "product_by_id", productId)
+}
+
 func (c CachedProductQuery) GetById(productId int) (product Product, err error) {
-	cacheKey := fmt.Sprintf("%s_%s_%d", c.prefix, "product_by_id", productId)
+	cacheKey := c.cacheKey(productId)
 	cachedResult := c.cacheClient.Get(c.productQuery.ctx, cacheKey)
 
 	err = func() error {
@@ -90,6 +96,11 @@ func (c CachedProductQuery) GetById(productId int) (product Product, err error)
 	return
 }
 
+// Invalidate drops the cached product, so the next GetById reads it from the database.
+func (c CachedProductQuery) Invalidate(productId int) error {
+	return c.cacheClient.Del(c.productQuery.ctx, c.cacheKey(productId)).Err()
+}
+
 func NewCachedProductQuery(pq ProductQuery, cacheClient *redis.Client) CachedProductQuery {
 	return CachedProductQuery{productQuery: pq, cacheClient: cacheClient, prefix: "cloudwego_shop"}
 }
@@ -0,0 +1,253 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const (
+	SuggestionKindProduct  = "product"
+	SuggestionKindCategory = "category"
+	SuggestionKindQuery    = "query"
+
+	// a query has to be searched this many times before it is suggested to others
+	minSuggestedQueryHits = 3
+	maxSuggestedQueryLen  = 64
+	// shorter prefixes match too much to be useful, and shorter queries are not counted
+	minSuggestionPrefixLen = 2
+	// only the most searched queries are counted, the rest are dropped once the slack
+	// is used up so a new query gets some time to collect hits
+	maxTrackedQueries   = 10000
+	trackedQueriesSlack = 1000
+	suggestionSeparator = "\x00"
+)
+
+type Suggestion struct {
+	Text      string
+	Kind      string
+	ProductId int
+	Hits      float64
+}
+
+// SuggestionIndex is a prefix index kept in redis sorted sets. All members share
+// the same score, so ZRANGEBYLEX can be used to look up entries by prefix.
+type SuggestionIndex struct {
+	ctx         context.Context
+	cacheClient *redis.Client
+	prefix      string
+}
+
+func NewSuggestionIndex(ctx context.Context, cacheClient *redis.Client) SuggestionIndex {
+	return SuggestionIndex{ctx: ctx, cacheClient: cacheClient, prefix: "cloudwego_shop"}
+}
+
+func (s SuggestionIndex) catalogKey() string {
+	return fmt.Sprintf("%s_%s", s.prefix, "suggest_catalog")
+}
+
+func (s SuggestionIndex) queryKey() string {
+	return fmt.Sprintf("%s_%s", s.prefix, "suggest_query")
+}
+
+func (s SuggestionIndex) queryHitsKey() string {
+	return fmt.Sprintf("%s_%s", s.prefix, "suggest_query_hits")
+}
+
+// Rebuild replaces the product and category entries with the current catalog.
+func (s SuggestionIndex) Rebuild(db *gorm.DB) error {
+	var products []Product
+	if err := db.WithContext(s.ctx).Model(&Product{}).Find(&products).Error; err != nil {
+		return err
+	}
+	var categories []Category
+	if err := db.WithContext(s.ctx).Model(&Category{}).Find(&categories).Error; err != nil {
+		return err
+	}
+	var members []redis.Z
+	for _, p := range products {
+		members = append(members, redis.Z{Member: encodeSuggestion(Suggestion{Text: p.Name, Kind: SuggestionKindProduct, ProductId: p.ID})})
+	}
+	for _, c := range categories {
+		members = append(members, redis.Z{Member: encodeSuggestion(Suggestion{Text: c.Name, Kind: SuggestionKindCategory})})
+	}
+	if len(members) == 0 {
+		return s.cacheClient.Del(s.ctx, s.catalogKey()).Err()
+	}
+	tmpKey := s.catalogKey() + "_tmp"
+	_, err := s.cacheClient.TxPipelined(s.ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(s.ctx, tmpKey)
+		pipe.ZAdd(s.ctx, tmpKey, members...)
+		pipe.Rename(s.ctx, tmpKey, s.catalogKey())
+		return nil
+	})
+	return err
+}
+
+// CatalogVersion changes whenever a product or category is added, changed or removed, so
+// the index is rebuilt only when it is out of date.
+func CatalogVersion(db *gorm.DB, ctx context.Context) (string, error) {
+	var version []string
+	for _, m := range []any{&Product{}, &Category{}} {
+		var row struct {
+			N    int64
+			Last *time.Time
+		}
+		if err := db.WithContext(ctx).Model(m).Select("COUNT(*) AS n, MAX(updated_at) AS last").Scan(&row).Error; err != nil {
+			return "", err
+		}
+		last := ""
+		if row.Last != nil {
+			last = row.Last.UTC().Format(time.RFC3339Nano)
+		}
+		version = append(version, fmt.Sprintf("%d@%s", row.N, last))
+	}
+	return strings.Join(version, ","), nil
+}
+
+// RecordQuery counts a search query. Queries become suggestions once they are popular enough.
+func (s SuggestionIndex) RecordQuery(q string) error {
+	q = NormalizeSuggestionText(q)
+	if utf8.RuneCountInString(q) < minSuggestionPrefixLen || len(q) > maxSuggestedQueryLen {
+		return nil
+	}
+	var hits *redis.FloatCmd
+	var tracked *redis.IntCmd
+	_, err := s.cacheClient.TxPipelined(s.ctx, func(pipe redis.Pipeliner) error {
+		hits = pipe.ZIncrBy(s.ctx, s.queryHitsKey(), 1, q)
+		tracked = pipe.ZCard(s.ctx, s.queryHitsKey())
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if tracked.Val() > maxTrackedQueries+trackedQueriesSlack {
+		if err = s.dropRareQueries(tracked.Val() - maxTrackedQueries); err != nil {
+			return err
+		}
+	}
+	if hits.Val() < minSuggestedQueryHits {
+		return nil
+	}
+	return s.cacheClient.ZAddNX(s.ctx, s.queryKey(), redis.Z{Member: encodeSuggestion(Suggestion{Text: q, Kind: SuggestionKindQuery})}).Err()
+}
+
+// dropRareQueries forgets the n least searched queries, and stops suggesting them.
+func (s SuggestionIndex) dropRareQueries(n int64) error {
+	dropped, err := s.cacheClient.ZPopMin(s.ctx, s.queryHitsKey(), n).Result()
+	if err != nil || len(dropped) == 0 {
+		return err
+	}
+	members := make([]any, 0, len(dropped))
+	for _, z := range dropped {
+		members = append(members, encodeSuggestion(Suggestion{Text: z.Member, Kind: SuggestionKindQuery}))
+	}
+	return s.cacheClient.ZRem(s.ctx, s.queryKey(), members...).Err()
+}
+
+// Suggest returns popular queries first, then products and categories starting with prefix.
+func (s SuggestionIndex) Suggest(prefix string, limit int) (suggestions []Suggestion, err error) {
+	prefix = NormalizeSuggestionText(prefix)
+	if utf8.RuneCountInString(prefix) < minSuggestionPrefixLen || limit <= 0 {
+		return nil, nil
+	}
+	queries, err := s.rangeByPrefix(s.queryKey(), prefix, limit*4)
+	if err != nil {
+		return nil, err
+	}
+	if len(queries) > 0 {
+		texts := make([]string, 0, len(queries))
+		for _, v := range queries {
+			texts = append(texts, v.Text)
+		}
+		hits, err := s.cacheClient.ZMScore(s.ctx, s.queryHitsKey(), texts...).Result()
+		if err != nil {
+			return nil, err
+		}
+		for i := range queries {
+			queries[i].Hits = hits[i]
+		}
+		sort.SliceStable(queries, func(i, j int) bool {
+			return queries[i].Hits > queries[j].Hits
+		})
+	}
+	catalog, err := s.rangeByPrefix(s.catalogKey(), prefix, limit*4)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	for _, v := range append(queries, catalog...) {
+		key := NormalizeSuggestionText(v.Text)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		suggestions = append(suggestions, v)
+		if len(suggestions) >= limit {
+			break
+		}
+	}
+	return suggestions, nil
+}
+
+func (s SuggestionIndex) rangeByPrefix(key, prefix string, count int) ([]Suggestion, error) {
+	members, err := s.cacheClient.ZRangeByLex(s.ctx, key, &redis.ZRangeBy{
+		Min:   "[" + prefix,
+		Max:   "[" + prefix + "\xff",
+		Count: int64(count),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	var suggestions []Suggestion
+	for _, m := range members {
+		if v, ok := decodeSuggestion(m); ok {
+			suggestions = append(suggestions, v)
+		}
+	}
+	return suggestions, nil
+}
+
+func NormalizeSuggestionText(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+// encodeSuggestion builds "<normalized text>\x00<kind>\x00<product id>\x00<text>",
+// so members sort by the normalized text.
+func encodeSuggestion(v Suggestion) string {
+	return strings.Join([]string{NormalizeSuggestionText(v.Text), v.Kind, strconv.Itoa(v.ProductId), v.Text}, suggestionSeparator)
+}
+
+func decodeSuggestion(member string) (v Suggestion, ok bool) {
+	parts := strings.SplitN(member, suggestionSeparator, 4)
+	if len(parts) != 4 {
+		return v, false
+	}
+	productId, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return v, false
+	}
+	return Suggestion{Text: parts[3], Kind: parts[1], ProductId: productId}, true
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSuggestionEncoding(t *testing.T) {
+	in := Suggestion{Text: "Mouse-Pad  Pro", Kind: SuggestionKindProduct, ProductId: 2}
+	member := encodeSuggestion(in)
+	out, ok := decodeSuggestion(member)
+	if !ok {
+		t.Fatalf("decode %q failed", member)
+	}
+	if out != in {
+		t.Errorf("got %+v, want %+v", out, in)
+	}
+	if NormalizeSuggestionText("  Mouse-Pad  Pro ") != "mouse-pad pro" {
+		t.Errorf("unexpected normalized text %q", NormalizeSuggestionText("  Mouse-Pad  Pro "))
+	}
+}
+
+func TestSuggestShortPrefix(t *testing.T) {
+	// short prefixes are answered without a redis client
+	s := NewSuggestionIndex(context.Background(), nil)
+	for _, prefix := range []string{"", " ", "m", " M "} {
+		suggestions, err := s.Suggest(prefix, 5)
+		if err != nil || suggestions != nil {
+			t.Errorf("Suggest(%q) = %v, %v, want nothing", prefix, suggestions, err)
+		}
+		if err = s.RecordQuery(prefix); err != nil {
+			t.Errorf("RecordQuery(%q) = %v", prefix, err)
+		}
+	}
+}
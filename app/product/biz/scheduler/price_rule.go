@@ -0,0 +1,99 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/server"
+)
+
+const priceRuleInterval = 30 * time.Second
+
+// InitPriceRule starts the loop that activates and expires scheduled price rules.
+func InitPriceRule() {
+	ctx, cancel := context.WithCancel(context.Background())
+	ticker := time.NewTicker(priceRuleInterval)
+	go func() {
+		runPriceRules(ctx, time.Now())
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				runPriceRules(ctx, now)
+			}
+		}
+	}()
+
+	server.RegisterShutdownHook(func() {
+		ticker.Stop()
+		cancel()
+	})
+}
+
+func runPriceRules(ctx context.Context, now time.Time) {
+	ended, err := model.ListEndedPriceRules(mysql.DB, ctx, now)
+	if err != nil {
+		klog.Errorf("model.ListEndedPriceRules.err:%v", err)
+		return
+	}
+	for _, rule := range ended {
+		expired, err := model.ExpirePriceRule(mysql.DB, ctx, rule)
+		if err != nil {
+			klog.Errorf("expire price rule %d failed: %v", rule.ID, err)
+			continue
+		}
+		if expired {
+			invalidateProduct(ctx, rule.ProductId)
+		}
+	}
+
+	started, err := model.ListStartedPriceRules(mysql.DB, ctx, now)
+	if err != nil {
+		klog.Errorf("model.ListStartedPriceRules.err:%v", err)
+		return
+	}
+	for _, rule := range started {
+		if !rule.EndAt.After(now) {
+			// the rule window passed while nothing was running, skip it
+			if _, err := model.ExpirePriceRule(mysql.DB, ctx, rule); err != nil {
+				klog.Errorf("expire price rule %d failed: %v", rule.ID, err)
+			}
+			continue
+		}
+		// a rule still running for the product leaves this one pending until it ends
+		activated, err := model.ActivatePriceRule(mysql.DB, ctx, rule)
+		if err != nil {
+			klog.Errorf("activate price rule %d failed: %v", rule.ID, err)
+			continue
+		}
+		if activated {
+			invalidateProduct(ctx, rule.ProductId)
+		}
+	}
+}
+
+func invalidateProduct(ctx context.Context, productId int) {
+	err := model.NewCachedProductQuery(model.NewProductQuery(ctx, mysql.DB), redis.RedisClient).Invalidate(productId)
+	if err != nil {
+		klog.Errorf("invalidate product %d cache failed: %v", productId, err)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/server"
+)
+
+const suggestionInterval = time.Minute
+
+// InitSuggestionIndex builds the suggestion index and rebuilds it whenever the catalog
+// changed, products are added and renamed outside this service.
+func InitSuggestionIndex() {
+	ctx, cancel := context.WithCancel(context.Background())
+	ticker := time.NewTicker(suggestionInterval)
+	go func() {
+		var version string
+		for {
+			version = refreshSuggestionIndex(ctx, version)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	server.RegisterShutdownHook(func() {
+		ticker.Stop()
+		cancel()
+	})
+}
+
+// refreshSuggestionIndex rebuilds the index unless the catalog is still at version, and
+// returns the version the index is at.
+func refreshSuggestionIndex(ctx context.Context, version string) string {
+	current, err := model.CatalogVersion(mysql.DB, ctx)
+	if err != nil {
+		klog.Errorf("model.CatalogVersion.err:%v", err)
+		return version
+	}
+	if current == version {
+		return version
+	}
+	if err = model.NewSuggestionIndex(ctx, redis.RedisClient).Rebuild(mysql.DB); err != nil {
+		klog.Errorf("rebuild suggestion index failed: %v", err)
+		return version
+	}
+	return current
+}
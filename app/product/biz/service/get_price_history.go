@@ -0,0 +1,72 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultPriceHistoryLimit = 20
+	maxPriceHistoryLimit     = 100
+)
+
+type GetPriceHistoryService struct {
+	ctx context.Context
+} // NewGetPriceHistoryService new GetPriceHistoryService
+func NewGetPriceHistoryService(ctx context.Context) *GetPriceHistoryService {
+	return &GetPriceHistoryService{ctx: ctx}
+}
+
+// Run create note info
+func (s *GetPriceHistoryService) Run(req *product.GetPriceHistoryReq) (resp *product.GetPriceHistoryResp, err error) {
+	if req.ProductId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "product id is required")
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultPriceHistoryLimit
+	}
+	if limit > maxPriceHistoryLimit {
+		limit = maxPriceHistoryLimit
+	}
+	history, err := model.ListPriceHistory(mysql.DB, s.ctx, int(req.ProductId), limit)
+	if err != nil {
+		return nil, err
+	}
+	resp = &product.GetPriceHistoryResp{}
+	for _, v := range history {
+		resp.Entries = append(resp.Entries, &product.PriceHistoryEntry{
+			Price:     v.Price,
+			ChangedAt: v.CreatedAt.Unix(),
+			Reason:    v.Reason,
+		})
+	}
+	rule, err := model.GetActivePriceRule(mysql.DB, s.ctx, int(req.ProductId))
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		resp.RegularPrice = rule.RegularPrice
+	}
+	return resp, nil
+}
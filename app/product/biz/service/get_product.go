@@ -47,6 +47,8 @@ func (s *GetProductService) Run(req *product.GetProductReq) (resp *product.GetPr
 			Id:          uint32(p.ID),
 			Picture:     p.Picture,
 			Price:       p.Price,
+			Weight:      p.Weight,
+			TaxCategory: p.TaxCategory,
 			Description: p.Description,
 			Name:        p.Name,
 		},
@@ -0,0 +1,71 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type SchedulePriceChangeService struct {
+	ctx context.Context
+} // NewSchedulePriceChangeService new SchedulePriceChangeService
+func NewSchedulePriceChangeService(ctx context.Context) *SchedulePriceChangeService {
+	return &SchedulePriceChangeService{ctx: ctx}
+}
+
+// Run create note info
+func (s *SchedulePriceChangeService) Run(req *product.SchedulePriceChangeReq) (resp *product.SchedulePriceChangeResp, err error) {
+	if err = authn.Require(s.ctx, authn.PermCatalogWrite); err != nil {
+		return nil, err
+	}
+	if req.ProductId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "product id is required")
+	}
+	if req.Price <= 0 {
+		return nil, kerrors.NewBizStatusError(40000, "price must be greater than 0")
+	}
+	startAt, endAt := time.Unix(req.StartAt, 0), time.Unix(req.EndAt, 0)
+	if !endAt.After(startAt) || !endAt.After(time.Now()) {
+		return nil, kerrors.NewBizStatusError(40000, "end_at must be after start_at and in the future")
+	}
+	_, err = model.GetProductById(mysql.DB, s.ctx, int(req.ProductId))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "product not exist")
+	}
+	if err != nil {
+		return nil, err
+	}
+	rule := &model.PriceRule{
+		Name:      req.Name,
+		ProductId: int(req.ProductId),
+		Price:     req.Price,
+		StartAt:   startAt,
+		EndAt:     endAt,
+		State:     model.PriceRuleStatePending,
+	}
+	if err = model.CreatePriceRule(mysql.DB, s.ctx, rule); err != nil {
+		return nil, err
+	}
+	return &product.SchedulePriceChangeResp{RuleId: uint32(rule.ID)}, nil
+}
@@ -18,8 +18,10 @@ import (
 	"context"
 
 	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/redis"
 	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
 	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/klog"
 )
 
 type SearchProductsService struct {
@@ -33,6 +35,12 @@ func NewSearchProductsService(ctx context.Context) *SearchProductsService {
 func (s *SearchProductsService) Run(req *product.SearchProductsReq) (resp *product.SearchProductsResp, err error) {
 	// Finish your business logic.
 	p, err := model.SearchProduct(mysql.DB, s.ctx, req.Query)
+	if err == nil && len(p) > 0 {
+		// only queries with results are worth suggesting
+		if recordErr := model.NewSuggestionIndex(s.ctx, redis.RedisClient).RecordQuery(req.Query); recordErr != nil {
+			klog.CtxWarnf(s.ctx, "record search query failed: %v", recordErr)
+		}
+	}
 	var results []*product.Product
 	for _, v := range p {
 		results = append(results, &product.Product{
@@ -41,6 +49,8 @@ func (s *SearchProductsService) Run(req *product.SearchProductsReq) (resp *produ
 			Description: v.Description,
 			Picture:     v.Picture,
 			Price:       v.Price,
+			Weight:      v.Weight,
+			TaxCategory: v.TaxCategory,
 		})
 	}
 	return &product.SearchProductsResp{Results: results}, err
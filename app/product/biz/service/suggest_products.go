@@ -0,0 +1,59 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+)
+
+const (
+	defaultSuggestLimit = 8
+	maxSuggestLimit     = 20
+)
+
+type SuggestProductsService struct {
+	ctx context.Context
+} // NewSuggestProductsService new SuggestProductsService
+func NewSuggestProductsService(ctx context.Context) *SuggestProductsService {
+	return &SuggestProductsService{ctx: ctx}
+}
+
+// Run create note info
+func (s *SuggestProductsService) Run(req *product.SuggestProductsReq) (resp *product.SuggestProductsResp, err error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+	if limit > maxSuggestLimit {
+		limit = maxSuggestLimit
+	}
+	suggestions, err := model.NewSuggestionIndex(s.ctx, redis.RedisClient).Suggest(req.Prefix, limit)
+	if err != nil {
+		return nil, err
+	}
+	resp = &product.SuggestProductsResp{}
+	for _, v := range suggestions {
+		resp.Suggestions = append(resp.Suggestions, &product.Suggestion{
+			Text:      v.Text,
+			Kind:      v.Kind,
+			ProductId: uint32(v.ProductId),
+		})
+	}
+	return resp, nil
+}
@@ -44,3 +44,24 @@ func (s *ProductCatalogServiceImpl) SearchProducts(ctx context.Context, req *pro
 
 	return resp, err
 }
+
+// SuggestProducts implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) SuggestProducts(ctx context.Context, req *product.SuggestProductsReq) (resp *product.SuggestProductsResp, err error) {
+	resp, err = service.NewSuggestProductsService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetPriceHistory implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) GetPriceHistory(ctx context.Context, req *product.GetPriceHistoryReq) (resp *product.GetPriceHistoryResp, err error) {
+	resp, err = service.NewGetPriceHistoryService(ctx).Run(req)
+
+	return resp, err
+}
+
+// SchedulePriceChange implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) SchedulePriceChange(ctx context.Context, req *product.SchedulePriceChangeReq) (resp *product.SchedulePriceChangeResp, err error) {
+	resp, err = service.NewSchedulePriceChangeService(ctx).Run(req)
+
+	return resp, err
+}
@@ -19,6 +19,7 @@ import (
 	"strings"
 
 	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/scheduler"
 	"github.com/cloudwego/biz-demo/gomall/app/product/conf"
 	"github.com/cloudwego/biz-demo/gomall/common/mtl"
 	"github.com/cloudwego/biz-demo/gomall/common/serversuite"
@@ -44,6 +45,8 @@ func main() {
 	mtl.InitTracing(serviceName)
 	mtl.InitMetric(serviceName, conf.GetConf().Kitex.MetricsPort, conf.GetConf().Registry.RegistryAddress[0])
 	dal.Init()
+	scheduler.InitPriceRule()
+	scheduler.InitSuggestionIndex()
 	opts := kitexInit()
 
 	svr := productcatalogservice.NewServer(new(ProductCatalogServiceImpl), opts...)
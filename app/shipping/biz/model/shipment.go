@@ -0,0 +1,175 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ShipmentStatus string
+
+const (
+	ShipmentStatusCreated        ShipmentStatus = "created"
+	ShipmentStatusPickedUp       ShipmentStatus = "picked_up"
+	ShipmentStatusInTransit      ShipmentStatus = "in_transit"
+	ShipmentStatusOutForDelivery ShipmentStatus = "out_for_delivery"
+	ShipmentStatusDelivered      ShipmentStatus = "delivered"
+)
+
+var shipmentFlow = []struct {
+	status      ShipmentStatus
+	description string
+}{
+	{ShipmentStatusCreated, "Shipping label created"},
+	{ShipmentStatusPickedUp, "Picked up by the carrier"},
+	{ShipmentStatusInTransit, "In transit"},
+	{ShipmentStatusOutForDelivery, "Out for delivery"},
+	{ShipmentStatusDelivered, "Delivered"},
+}
+
+// NextShipmentStatus returns the status following status, and false once the shipment is delivered.
+func NextShipmentStatus(status ShipmentStatus) (next ShipmentStatus, description string, ok bool) {
+	for i := 0; i < len(shipmentFlow)-1; i++ {
+		if shipmentFlow[i].status == status {
+			return shipmentFlow[i+1].status, shipmentFlow[i+1].description, true
+		}
+	}
+	return "", "", false
+}
+
+var ErrShipmentStatusChanged = errors.New("shipment status has changed")
+
+type ShippingAddress struct {
+	StreetAddress string
+	City          string
+	State         string
+	Country       string
+	ZipCode       string
+}
+
+// Shipment is either the delivery of an order or, when ReturnId is set, a parcel the shopper
+// sends back for that return.
+type Shipment struct {
+	Base
+	OrderId        string `gorm:"uniqueIndex:idx_shipment_order_return;size:256"`
+	ReturnId       string `gorm:"uniqueIndex:idx_shipment_order_return;size:64;default:''"`
+	UserId         uint32 `gorm:"index"`
+	TrackingNumber string `gorm:"uniqueIndex;size:64"`
+	Carrier        string
+	Method         string
+	Cost           float32
+	Weight         float32
+	Status         ShipmentStatus  `gorm:"index;size:32"`
+	Address        ShippingAddress `gorm:"embedded"`
+	Events         []ShipmentEvent
+}
+
+func (s Shipment) TableName() string {
+	return "shipment"
+}
+
+type ShipmentEvent struct {
+	Base
+	ShipmentId  int `gorm:"index"`
+	Status      ShipmentStatus
+	Description string
+}
+
+func (e ShipmentEvent) TableName() string {
+	return "shipment_event"
+}
+
+// MigrateShipment drops the unique index on order_id of the first shipping release, which
+// allowed a single shipment per order. AutoMigrate adds the new index but keeps the old one,
+// so return labels could not be stored.
+func MigrateShipment(db *gorm.DB) error {
+	m := db.Migrator()
+	if !m.HasIndex(&Shipment{}, "idx_shipment_order_id") {
+		return nil
+	}
+	return m.DropIndex(&Shipment{}, "idx_shipment_order_id")
+}
+
+// CreateShipment saves the shipment together with its first tracking event.
+func CreateShipment(db *gorm.DB, ctx context.Context, s *Shipment) error {
+	s.Status = ShipmentStatusCreated
+	s.Events = []ShipmentEvent{{Status: ShipmentStatusCreated, Description: shipmentFlow[0].description}}
+	return db.WithContext(ctx).Create(s).Error
+}
+
+func GetShipmentByOrderId(db *gorm.DB, ctx context.Context, orderId string) (s Shipment, err error) {
+	err = db.WithContext(ctx).Preload("Events", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id")
+	}).Where(&Shipment{OrderId: orderId}).Where("return_id = ''").First(&s).Error
+	return
+}
+
+func GetShipmentByReturnId(db *gorm.DB, ctx context.Context, orderId, returnId string) (s Shipment, err error) {
+	err = db.WithContext(ctx).Preload("Events", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id")
+	}).Where(&Shipment{OrderId: orderId, ReturnId: returnId}).First(&s).Error
+	return
+}
+
+func ListShipments(db *gorm.DB, ctx context.Context, userId uint32, orderIds []string) (shipments []Shipment, err error) {
+	err = db.WithContext(ctx).Preload("Events", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id")
+	}).Where(&Shipment{UserId: userId}).Where("order_id IN ? AND return_id = ''", orderIds).Find(&shipments).Error
+	return
+}
+
+// ListUserShipments returns the shipments and return parcels of the user with their events.
+func ListUserShipments(db *gorm.DB, ctx context.Context, userId uint32) (shipments []Shipment, err error) {
+	err = db.WithContext(ctx).Preload("Events", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id")
+	}).Where(&Shipment{UserId: userId}).Order("id").Find(&shipments).Error
+	return
+}
+
+// AnonymizeShipments clears the addresses of the user's shipments, the country is kept for
+// the shipping statistics.
+func AnonymizeShipments(db *gorm.DB, ctx context.Context, userId uint32) error {
+	return db.WithContext(ctx).Model(&Shipment{}).Where("user_id = ?", userId).
+		Updates(map[string]any{"street_address": "", "city": "", "state": "", "zip_code": ""}).Error
+}
+
+// ListShipmentsToAdvance returns undelivered shipments that have not changed since before.
+func ListShipmentsToAdvance(db *gorm.DB, ctx context.Context, before time.Time, limit int) (shipments []Shipment, err error) {
+	err = db.WithContext(ctx).Where("status <> ? AND updated_at < ?", ShipmentStatusDelivered, before).
+		Order("updated_at").Limit(limit).Find(&shipments).Error
+	return
+}
+
+// AdvanceShipment moves the shipment to the next status and records a tracking event.
+func AdvanceShipment(db *gorm.DB, ctx context.Context, s Shipment) error {
+	next, description, ok := NextShipmentStatus(s.Status)
+	if !ok {
+		return nil
+	}
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&Shipment{}).Where("id = ? AND status = ?", s.ID, s.Status).Update("status", next)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrShipmentStatusChanged
+		}
+		return tx.Create(&ShipmentEvent{ShipmentId: s.ID, Status: next, Description: description}).Error
+	})
+}
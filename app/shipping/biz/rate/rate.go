@@ -0,0 +1,95 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rate
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/conf"
+)
+
+type Quote struct {
+	Method  string
+	Name    string
+	Cost    float32
+	MinDays int32
+	MaxDays int32
+}
+
+// Zone returns the first zone listing the country, falling back to a zone listing "*".
+func Zone(zones []conf.ShippingZone, country string) (string, bool) {
+	country = strings.ToLower(strings.TrimSpace(country))
+	fallback := ""
+	for _, z := range zones {
+		for _, c := range z.Countries {
+			c = strings.ToLower(strings.TrimSpace(c))
+			if c == country {
+				return z.Name, true
+			}
+			if c == "*" && fallback == "" {
+				fallback = z.Name
+			}
+		}
+	}
+	return fallback, fallback != ""
+}
+
+// Quotes lists the methods that ship to the country at the given weight, cheapest first.
+func Quotes(c conf.Shipping, country string, weight float32) (zone string, quotes []Quote) {
+	zone, ok := Zone(c.Zones, country)
+	if !ok {
+		return "", nil
+	}
+	for _, m := range c.Methods {
+		for _, r := range m.Rates {
+			if r.Zone != zone {
+				continue
+			}
+			if cost, ok := Price(r, weight); ok {
+				quotes = append(quotes, Quote{Method: m.Id, Name: m.Name, Cost: cost, MinDays: m.MinDays, MaxDays: m.MaxDays})
+			}
+			break
+		}
+	}
+	sort.SliceStable(quotes, func(i, j int) bool {
+		return quotes[i].Cost < quotes[j].Cost
+	})
+	return zone, quotes
+}
+
+// Price looks the weight up in the rate table. It returns false when the parcel is too heavy.
+func Price(r conf.ShippingRate, weight float32) (float32, bool) {
+	if len(r.Brackets) == 0 {
+		return 0, false
+	}
+	brackets := make([]conf.WeightBracket, len(r.Brackets))
+	copy(brackets, r.Brackets)
+	sort.Slice(brackets, func(i, j int) bool {
+		return brackets[i].MaxWeight < brackets[j].MaxWeight
+	})
+	for _, b := range brackets {
+		if weight <= b.MaxWeight {
+			return b.Price, true
+		}
+	}
+	if r.ExtraPerKg <= 0 {
+		return 0, false
+	}
+	last := brackets[len(brackets)-1]
+	extraKg := float32(math.Ceil(float64(weight - last.MaxWeight)))
+	return float32(math.Round(float64(last.Price+extraKg*r.ExtraPerKg)*100) / 100), true
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rate
+
+import (
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/conf"
+)
+
+var testShipping = conf.Shipping{
+	Zones: []conf.ShippingZone{
+		{Name: "domestic", Countries: []string{"china"}},
+		{Name: "international", Countries: []string{"*"}},
+	},
+	Methods: []conf.ShippingMethod{
+		{Id: "express", Name: "Express", Rates: []conf.ShippingRate{
+			{Zone: "domestic", Brackets: []conf.WeightBracket{{MaxWeight: 1, Price: 12}}},
+		}},
+		{Id: "standard", Name: "Standard", Rates: []conf.ShippingRate{
+			{Zone: "domestic", Brackets: []conf.WeightBracket{{MaxWeight: 5, Price: 8}, {MaxWeight: 1, Price: 5}}, ExtraPerKg: 1.5},
+			{Zone: "international", Brackets: []conf.WeightBracket{{MaxWeight: 1, Price: 20}}},
+		}},
+	},
+}
+
+func TestZone(t *testing.T) {
+	if zone, _ := Zone(testShipping.Zones, " China "); zone != "domestic" {
+		t.Errorf("got zone %q, want domestic", zone)
+	}
+	if zone, _ := Zone(testShipping.Zones, "france"); zone != "international" {
+		t.Errorf("got zone %q, want international", zone)
+	}
+	if _, ok := Zone(testShipping.Zones[:1], "france"); ok {
+		t.Errorf("expected no zone without a fallback")
+	}
+}
+
+func TestPrice(t *testing.T) {
+	r := testShipping.Methods[1].Rates[0]
+	for _, c := range []struct {
+		weight float32
+		want   float32
+	}{
+		{0, 5},
+		{1, 5},
+		{1.2, 8},
+		{5, 8},
+		{6.5, 11},
+	} {
+		if got, ok := Price(r, c.weight); !ok || got != c.want {
+			t.Errorf("Price(%v) = %v, %v, want %v", c.weight, got, ok, c.want)
+		}
+	}
+	if _, ok := Price(testShipping.Methods[1].Rates[1], 2); ok {
+		t.Errorf("expected parcel over the last bracket to be rejected")
+	}
+}
+
+func TestQuotes(t *testing.T) {
+	zone, quotes := Quotes(testShipping, "china", 2)
+	if zone != "domestic" || len(quotes) != 1 || quotes[0].Method != "standard" {
+		t.Fatalf("unexpected quotes %s %+v", zone, quotes)
+	}
+	_, quotes = Quotes(testShipping, "china", 0.5)
+	if len(quotes) != 2 || quotes[0].Method != "standard" || quotes[1].Method != "express" {
+		t.Errorf("expected cheapest first, got %+v", quotes)
+	}
+}
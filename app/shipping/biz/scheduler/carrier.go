@@ -0,0 +1,69 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/conf"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/server"
+)
+
+const carrierBatchSize = 100
+
+// InitCarrier starts the local carrier simulator, which moves every shipment one
+// status further each step until it is delivered.
+func InitCarrier() {
+	simulator := conf.GetConf().Shipping.Simulator
+	if !simulator.Enable || simulator.StepSeconds <= 0 {
+		return
+	}
+	step := time.Duration(simulator.StepSeconds) * time.Second
+	ctx, cancel := context.WithCancel(context.Background())
+	ticker := time.NewTicker(step)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				advanceShipments(ctx, now.Add(-step))
+			}
+		}
+	}()
+
+	server.RegisterShutdownHook(func() {
+		ticker.Stop()
+		cancel()
+	})
+}
+
+func advanceShipments(ctx context.Context, before time.Time) {
+	shipments, err := model.ListShipmentsToAdvance(mysql.DB, ctx, before, carrierBatchSize)
+	if err != nil {
+		klog.Errorf("model.ListShipmentsToAdvance.err:%v", err)
+		return
+	}
+	for _, s := range shipments {
+		if err := model.AdvanceShipment(mysql.DB, ctx, s); err != nil && !errors.Is(err, model.ErrShipmentStatusChanged) {
+			klog.Errorf("advance shipment %s failed: %v", s.TrackingNumber, err)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/biz/rate"
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/conf"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	shipping "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type CreateReturnLabelService struct {
+	ctx context.Context
+} // NewCreateReturnLabelService new CreateReturnLabelService
+func NewCreateReturnLabelService(ctx context.Context) *CreateReturnLabelService {
+	return &CreateReturnLabelService{ctx: ctx}
+}
+
+// Run creates the label for sending a return back to the warehouse. Calling it again for the
+// same return gives back the existing label.
+func (s *CreateReturnLabelService) Run(req *shipping.CreateReturnLabelReq) (resp *shipping.CreateReturnLabelResp, err error) {
+	if err = authn.RequireService(s.ctx); err != nil {
+		return nil, err
+	}
+	if req.OrderId == "" || req.ReturnId == "" || req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "order id, return id and user id are required")
+	}
+	if req.Address == nil {
+		return nil, kerrors.NewBizStatusError(40000, "address is required")
+	}
+	returnsConf := conf.GetConf().Shipping.Returns
+	resp = &shipping.CreateReturnLabelResp{
+		ShipToName: returnsConf.Name,
+		ShipTo: &shipping.Address{
+			StreetAddress: returnsConf.Address.StreetAddress,
+			City:          returnsConf.Address.City,
+			State:         returnsConf.Address.State,
+			Country:       returnsConf.Address.Country,
+			ZipCode:       returnsConf.Address.ZipCode,
+		},
+	}
+
+	existing, err := model.GetShipmentByReturnId(mysql.DB, s.ctx, req.OrderId, req.ReturnId)
+	if err == nil {
+		resp.Shipment = toShipment(existing)
+		return resp, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	// returns are priced like a delivery to the shopper's address
+	shippingConf := conf.GetConf().Shipping
+	_, quotes := rate.Quotes(shippingConf, req.Address.Country, req.Weight)
+	var quote *rate.Quote
+	for i := range quotes {
+		if quotes[i].Method == returnsConf.Method {
+			quote = &quotes[i]
+			break
+		}
+	}
+	if quote == nil {
+		return nil, kerrors.NewBizStatusError(40001, "no return shipping is available for this address")
+	}
+	shipment := &model.Shipment{
+		OrderId:        req.OrderId,
+		ReturnId:       req.ReturnId,
+		UserId:         req.UserId,
+		TrackingNumber: newTrackingNumber(),
+		Carrier:        shippingConf.Carrier,
+		Method:         quote.Method,
+		Cost:           quote.Cost,
+		Weight:         req.Weight,
+		Address: model.ShippingAddress{
+			StreetAddress: returnsConf.Address.StreetAddress,
+			City:          returnsConf.Address.City,
+			State:         returnsConf.Address.State,
+			Country:       returnsConf.Address.Country,
+			ZipCode:       returnsConf.Address.ZipCode,
+		},
+	}
+	if err = model.CreateShipment(mysql.DB, s.ctx, shipment); err != nil {
+		return nil, err
+	}
+	resp.Shipment = toShipment(*shipment)
+	return resp, nil
+}
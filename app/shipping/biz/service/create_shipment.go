@@ -0,0 +1,98 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/biz/rate"
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/conf"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	shipping "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CreateShipmentService struct {
+	ctx context.Context
+} // NewCreateShipmentService new CreateShipmentService
+func NewCreateShipmentService(ctx context.Context) *CreateShipmentService {
+	return &CreateShipmentService{ctx: ctx}
+}
+
+// Run creates the shipment of an order. Calling it again for the same order returns the existing shipment.
+func (s *CreateShipmentService) Run(req *shipping.CreateShipmentReq) (resp *shipping.CreateShipmentResp, err error) {
+	if err = authn.RequireService(s.ctx); err != nil {
+		return nil, err
+	}
+	if req.OrderId == "" || req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "order id and user id are required")
+	}
+	if req.Address == nil {
+		return nil, kerrors.NewBizStatusError(40000, "address is required")
+	}
+	existing, err := model.GetShipmentByOrderId(mysql.DB, s.ctx, req.OrderId)
+	if err == nil {
+		if existing.UserId != req.UserId {
+			return nil, kerrors.NewBizStatusError(40004, "order not found")
+		}
+		return &shipping.CreateShipmentResp{Shipment: toShipment(existing)}, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	shippingConf := conf.GetConf().Shipping
+	_, quotes := rate.Quotes(shippingConf, req.Address.Country, req.Weight)
+	var quote *rate.Quote
+	for i := range quotes {
+		if quotes[i].Method == req.Method {
+			quote = &quotes[i]
+			break
+		}
+	}
+	if quote == nil {
+		return nil, kerrors.NewBizStatusError(40001, "shipping method is not available for this address")
+	}
+	shipment := &model.Shipment{
+		OrderId:        req.OrderId,
+		UserId:         req.UserId,
+		TrackingNumber: newTrackingNumber(),
+		Carrier:        shippingConf.Carrier,
+		Method:         quote.Method,
+		Cost:           quote.Cost,
+		Weight:         req.Weight,
+		Address: model.ShippingAddress{
+			StreetAddress: req.Address.StreetAddress,
+			City:          req.Address.City,
+			State:         req.Address.State,
+			Country:       req.Address.Country,
+			ZipCode:       req.Address.ZipCode,
+		},
+	}
+	if err = model.CreateShipment(mysql.DB, s.ctx, shipment); err != nil {
+		return nil, err
+	}
+	return &shipping.CreateShipmentResp{Shipment: toShipment(*shipment)}, nil
+}
+
+func newTrackingNumber() string {
+	return "CW" + strings.ToUpper(strings.ReplaceAll(uuid.NewString(), "-", "")[:16])
+}
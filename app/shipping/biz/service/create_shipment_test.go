@@ -0,0 +1,34 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/common/authn/authntest"
+	shipping "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+func TestCreateShipment_Run(t *testing.T) {
+}
+
+func TestCreateShipment_RejectsUsers(t *testing.T) {
+	ctx := authntest.UserContext(t, 1)
+	_, err := NewCreateShipmentService(ctx).Run(&shipping.CreateShipmentReq{UserId: 1, OrderId: "o1"})
+	if bizErr, ok := kerrors.FromBizStatusError(err); !ok || bizErr.BizStatusCode() != 40300 {
+		t.Errorf("a user shipped their own order: %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	shipping "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type EraseUserDataService struct {
+	ctx context.Context
+} // NewEraseUserDataService new EraseUserDataService
+func NewEraseUserDataService(ctx context.Context) *EraseUserDataService {
+	return &EraseUserDataService{ctx: ctx}
+}
+
+// Run clears the addresses of the user's shipments, the shipments are kept with the orders.
+func (s *EraseUserDataService) Run(req *shipping.EraseUserDataReq) (resp *shipping.EraseUserDataResp, err error) {
+	if err = authn.Require(s.ctx, authn.PermUsersErase); err != nil {
+		return nil, err
+	}
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	if err = model.AnonymizeShipments(mysql.DB, s.ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	return &shipping.EraseUserDataResp{}, nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/biz/model"
+	shipping "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type ExportUserDataService struct {
+	ctx context.Context
+} // NewExportUserDataService new ExportUserDataService
+func NewExportUserDataService(ctx context.Context) *ExportUserDataService {
+	return &ExportUserDataService{ctx: ctx}
+}
+
+// Run exports the shipments and return parcels of the user, with the address and the
+// tracking events, as they are stored.
+func (s *ExportUserDataService) Run(req *shipping.ExportUserDataReq) (resp *shipping.ExportUserDataResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	shipments, err := model.ListUserShipments(mysql.DB, s.ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	export := struct {
+		Shipments []model.Shipment `json:"shipments"`
+	}{Shipments: shipments}
+	if export.Shipments == nil {
+		export.Shipments = []model.Shipment{}
+	}
+	data, err := json.Marshal(export)
+	if err != nil {
+		return nil, err
+	}
+	return &shipping.ExportUserDataResp{Data: data}, nil
+}
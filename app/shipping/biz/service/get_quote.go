@@ -0,0 +1,53 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/biz/rate"
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/conf"
+	shipping "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type GetQuoteService struct {
+	ctx context.Context
+} // NewGetQuoteService new GetQuoteService
+func NewGetQuoteService(ctx context.Context) *GetQuoteService {
+	return &GetQuoteService{ctx: ctx}
+}
+
+// Run returns the available shipping methods and their costs, cheapest first.
+func (s *GetQuoteService) Run(req *shipping.GetQuoteReq) (resp *shipping.GetQuoteResp, err error) {
+	if req.Address == nil || req.Address.Country == "" {
+		return nil, kerrors.NewBizStatusError(40000, "country is required")
+	}
+	if req.Weight < 0 {
+		return nil, kerrors.NewBizStatusError(40000, "weight must not be negative")
+	}
+	zone, quotes := rate.Quotes(conf.GetConf().Shipping, req.Address.Country, req.Weight)
+	resp = &shipping.GetQuoteResp{Zone: zone}
+	for _, q := range quotes {
+		resp.Quotes = append(resp.Quotes, &shipping.Quote{
+			Method:  q.Method,
+			Name:    q.Name,
+			Cost:    q.Cost,
+			MinDays: q.MinDays,
+			MaxDays: q.MaxDays,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/biz/model"
+	shipping "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type ListShipmentsService struct {
+	ctx context.Context
+} // NewListShipmentsService new ListShipmentsService
+func NewListShipmentsService(ctx context.Context) *ListShipmentsService {
+	return &ListShipmentsService{ctx: ctx}
+}
+
+// Run returns the shipments of the user's orders, with their tracking events.
+func (s *ListShipmentsService) Run(req *shipping.ListShipmentsReq) (resp *shipping.ListShipmentsResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	resp = &shipping.ListShipmentsResp{}
+	if len(req.OrderIds) == 0 {
+		return resp, nil
+	}
+	shipments, err := model.ListShipments(mysql.DB, s.ctx, req.UserId, req.OrderIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range shipments {
+		resp.Shipments = append(resp.Shipments, toShipment(v))
+	}
+	return resp, nil
+}
+
+func toShipment(s model.Shipment) *shipping.Shipment {
+	shipment := &shipping.Shipment{
+		OrderId:        s.OrderId,
+		ReturnId:       s.ReturnId,
+		TrackingNumber: s.TrackingNumber,
+		Carrier:        s.Carrier,
+		Method:         s.Method,
+		Cost:           s.Cost,
+		Status:         string(s.Status),
+	}
+	for _, e := range s.Events {
+		shipment.Events = append(shipment.Events, &shipping.TrackingEvent{
+			Status:      string(e.Status),
+			Description: e.Description,
+			CreatedAt:   e.CreatedAt.Unix(),
+		})
+	}
+	return shipment
+}
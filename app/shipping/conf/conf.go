@@ -0,0 +1,184 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conf
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/kr/pretty"
+	"gopkg.in/validator.v2"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	conf *Config
+	once sync.Once
+)
+
+type Config struct {
+	Env      string
+	Kitex    Kitex    `yaml:"kitex"`
+	MySQL    MySQL    `yaml:"mysql"`
+	Redis    Redis    `yaml:"redis"`
+	Registry Registry `yaml:"registry"`
+	Shipping Shipping `yaml:"shipping"`
+}
+
+type Shipping struct {
+	Carrier   string           `yaml:"carrier"`
+	Zones     []ShippingZone   `yaml:"zones"`
+	Methods   []ShippingMethod `yaml:"methods"`
+	Simulator Simulator        `yaml:"simulator"`
+	Returns   Returns          `yaml:"returns"`
+}
+
+// Returns configures return labels: the method they are shipped with and the warehouse receiving them.
+type Returns struct {
+	Method  string        `yaml:"method"`
+	Name    string        `yaml:"name"`
+	Address ReturnAddress `yaml:"address"`
+}
+
+type ReturnAddress struct {
+	StreetAddress string `yaml:"street_address"`
+	City          string `yaml:"city"`
+	State         string `yaml:"state"`
+	Country       string `yaml:"country"`
+	ZipCode       string `yaml:"zip_code"`
+}
+
+// ShippingZone groups countries that share the same rates. A country of "*" matches every country.
+type ShippingZone struct {
+	Name      string   `yaml:"name"`
+	Countries []string `yaml:"countries"`
+}
+
+type ShippingMethod struct {
+	Id      string         `yaml:"id"`
+	Name    string         `yaml:"name"`
+	MinDays int32          `yaml:"min_days"`
+	MaxDays int32          `yaml:"max_days"`
+	Rates   []ShippingRate `yaml:"rates"`
+}
+
+// ShippingRate is the rate table of a method in one zone. Parcels heavier than the last
+// bracket cost ExtraPerKg for every started kg, or are not accepted when it is 0.
+type ShippingRate struct {
+	Zone       string          `yaml:"zone"`
+	Brackets   []WeightBracket `yaml:"brackets"`
+	ExtraPerKg float32         `yaml:"extra_per_kg"`
+}
+
+type WeightBracket struct {
+	MaxWeight float32 `yaml:"max_weight"`
+	Price     float32 `yaml:"price"`
+}
+
+// Simulator moves shipments to the next status every StepSeconds, standing in for a real carrier.
+type Simulator struct {
+	Enable      bool `yaml:"enable"`
+	StepSeconds int  `yaml:"step_seconds"`
+}
+
+type MySQL struct {
+	DSN string `yaml:"dsn"`
+}
+
+type Redis struct {
+	Address  string `yaml:"address"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	DB       int    `yaml:"db"`
+}
+
+type Kitex struct {
+	Service         string `yaml:"service"`
+	Address         string `yaml:"address"`
+	MetricsPort     string `yaml:"metrics_port"`
+	EnablePprof     bool   `yaml:"enable_pprof"`
+	EnableGzip      bool   `yaml:"enable_gzip"`
+	EnableAccessLog bool   `yaml:"enable_access_log"`
+	LogLevel        string `yaml:"log_level"`
+	LogFileName     string `yaml:"log_file_name"`
+	LogMaxSize      int    `yaml:"log_max_size"`
+	LogMaxBackups   int    `yaml:"log_max_backups"`
+	LogMaxAge       int    `yaml:"log_max_age"`
+}
+
+type Registry struct {
+	RegistryAddress []string `yaml:"registry_address"`
+	Username        string   `yaml:"username"`
+	Password        string   `yaml:"password"`
+}
+
+// GetConf gets configuration instance
+func GetConf() *Config {
+	once.Do(initConf)
+	return conf
+}
+
+func initConf() {
+	prefix := "conf"
+	confFileRelPath := filepath.Join(prefix, filepath.Join(GetEnv(), "conf.yaml"))
+	content, err := os.ReadFile(confFileRelPath)
+	if err != nil {
+		panic(err)
+	}
+	conf = new(Config)
+	err = yaml.Unmarshal(content, conf)
+	if err != nil {
+		klog.Error("parse yaml error - %v", err)
+		panic(err)
+	}
+	if err := validator.Validate(conf); err != nil {
+		klog.Error("validate config error - %v", err)
+		panic(err)
+	}
+	conf.Env = GetEnv()
+	pretty.Printf("%+v\n", conf)
+}
+
+func GetEnv() string {
+	e := os.Getenv("GO_ENV")
+	if len(e) == 0 {
+		return "test"
+	}
+	return e
+}
+
+func LogLevel() klog.Level {
+	level := GetConf().Kitex.LogLevel
+	switch level {
+	case "trace":
+		return klog.LevelTrace
+	case "debug":
+		return klog.LevelDebug
+	case "info":
+		return klog.LevelInfo
+	case "notice":
+		return klog.LevelNotice
+	case "warn":
+		return klog.LevelWarn
+	case "error":
+		return klog.LevelError
+	case "fatal":
+		return klog.LevelFatal
+	default:
+		return klog.LevelInfo
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/shipping/biz/service"
+	shipping "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+)
+
+// ShippingServiceImpl implements the last service interface defined in the IDL.
+type ShippingServiceImpl struct{}
+
+// GetQuote implements the ShippingServiceImpl interface.
+func (s *ShippingServiceImpl) GetQuote(ctx context.Context, req *shipping.GetQuoteReq) (resp *shipping.GetQuoteResp, err error) {
+	resp, err = service.NewGetQuoteService(ctx).Run(req)
+
+	return resp, err
+}
+
+// CreateShipment implements the ShippingServiceImpl interface.
+func (s *ShippingServiceImpl) CreateShipment(ctx context.Context, req *shipping.CreateShipmentReq) (resp *shipping.CreateShipmentResp, err error) {
+	resp, err = service.NewCreateShipmentService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListShipments implements the ShippingServiceImpl interface.
+func (s *ShippingServiceImpl) ListShipments(ctx context.Context, req *shipping.ListShipmentsReq) (resp *shipping.ListShipmentsResp, err error) {
+	resp, err = service.NewListShipmentsService(ctx).Run(req)
+
+	return resp, err
+}
+
+// CreateReturnLabel implements the ShippingServiceImpl interface.
+func (s *ShippingServiceImpl) CreateReturnLabel(ctx context.Context, req *shipping.CreateReturnLabelReq) (resp *shipping.CreateReturnLabelResp, err error) {
+	resp, err = service.NewCreateReturnLabelService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ExportUserData implements the ShippingServiceImpl interface.
+func (s *ShippingServiceImpl) ExportUserData(ctx context.Context, req *shipping.ExportUserDataReq) (resp *shipping.ExportUserDataResp, err error) {
+	resp, err = service.NewExportUserDataService(ctx).Run(req)
+
+	return resp, err
+}
+
+// EraseUserData implements the ShippingServiceImpl interface.
+func (s *ShippingServiceImpl) EraseUserData(ctx context.Context, req *shipping.EraseUserDataReq) (resp *shipping.EraseUserDataResp, err error) {
+	resp, err = service.NewEraseUserDataService(ctx).Run(req)
+
+	return resp, err
+}
@@ -15,11 +15,14 @@
 package mysql
 
 import (
+	"context"
 	"fmt"
 	"os"
 
 	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
 	"github.com/cloudwego/biz-demo/gomall/app/user/conf"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	"github.com/cloudwego/biz-demo/gomall/common/outbox"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
@@ -48,7 +51,24 @@ func Init() {
 		needDemoData := !DB.Migrator().HasTable(&model.User{})
 		DB.AutoMigrate( //nolint:errcheck
 			&model.User{},
+			&model.Role{},
+			&model.RoleAudit{},
+			&model.NotificationPreference{},
+			&model.NotificationSettings{},
+			&model.SigningKey{},
+			&model.Session{},
+			&model.RefreshToken{},
+			&model.UserToken{},
+			&model.UserTotp{},
+			&model.RecoveryCode{},
+			&model.UserIdentity{},
+			&model.Address{},
+			&model.AccountDeletion{},
+			&outbox.Message{},
 		)
+		if err = model.EnsureRole(DB, context.Background(), authn.RoleAdmin, authn.AllPermissions); err != nil {
+			panic(err)
+		}
 		if needDemoData {
 			DB.Exec("INSERT INTO `user` (`id`,`created_at`,`updated_at`,`email`,`password_hashed`) VALUES (1,'2023-12-26 09:46:19.852','2023-12-26 09:46:19.852','123@admin.com','$2a$10$jTvUFh7Z8Kw0hLV8WrAws.PRQTeuH4gopJ7ZMoiFvwhhz5Vw.bj7C')")
 		}
@@ -0,0 +1,212 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deletion runs the jobs erasing deleted accounts in the other services.
+package deletion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/user/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/user/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/server"
+	"gorm.io/gorm"
+)
+
+// StepUser is done when the job is created, the user's rows here go with the confirmation.
+const StepUser = "user"
+
+// Step erases the user of a job in one service. Steps may run again after a failure, so
+// erasing twice must do no harm.
+type Step struct {
+	Name  string
+	Erase func(ctx context.Context, d model.AccountDeletion) error
+}
+
+type Options struct {
+	// Interval is the pause between polls when no job is due, and the first retry delay
+	Interval    time.Duration
+	StepTimeout time.Duration
+	MaxBackoff  time.Duration
+}
+
+// Worker runs the due jobs one at a time. A job is claimed with SKIP LOCKED and a lease
+// before it runs, so several workers may share the table.
+type Worker struct {
+	db    *gorm.DB
+	steps []Step
+	opts  Options
+}
+
+func NewWorker(db *gorm.DB, steps []Step, opts Options) *Worker {
+	if opts.Interval <= 0 {
+		opts.Interval = 10 * time.Second
+	}
+	if opts.StepTimeout <= 0 {
+		opts.StepTimeout = 10 * time.Second
+	}
+	if opts.MaxBackoff < opts.Interval {
+		opts.MaxBackoff = opts.Interval
+	}
+	return &Worker{db: db, steps: steps, opts: opts}
+}
+
+// Init starts a worker erasing users in the cart, order, payment, shipping and email
+// services, it stops with the server.
+func Init() {
+	c := conf.GetConf().Deletion
+	w := NewWorker(mysql.DB, Steps(), Options{
+		Interval:    time.Duration(c.IntervalSeconds) * time.Second,
+		StepTimeout: time.Duration(c.StepTimeoutSeconds) * time.Second,
+		MaxBackoff:  time.Duration(c.RetryMaxMinutes) * time.Minute,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	go w.Run(ctx)
+	server.RegisterShutdownHook(cancel)
+}
+
+// Steps erases the user in the other services. They are called as the user service, which
+// may erase any user.
+func Steps() []Step {
+	return []Step{
+		{"cart", func(ctx context.Context, d model.AccountDeletion) error {
+			_, err := rpc.CartClient.EraseUserData(ctx, &cart.EraseUserDataReq{UserId: uint32(d.UserId)})
+			return err
+		}},
+		{"order", func(ctx context.Context, d model.AccountDeletion) error {
+			_, err := rpc.OrderClient.EraseUserData(ctx, &order.EraseUserDataReq{UserId: uint32(d.UserId)})
+			return err
+		}},
+		{"payment", func(ctx context.Context, d model.AccountDeletion) error {
+			_, err := rpc.PaymentClient.EraseUserData(ctx, &payment.EraseUserDataReq{UserId: uint32(d.UserId)})
+			return err
+		}},
+		{"shipping", func(ctx context.Context, d model.AccountDeletion) error {
+			_, err := rpc.ShippingClient.EraseUserData(ctx, &shipping.EraseUserDataReq{UserId: uint32(d.UserId)})
+			return err
+		}},
+		{"email", func(ctx context.Context, d model.AccountDeletion) error {
+			_, err := rpc.EmailClient.EraseUserData(ctx, &email.EraseUserDataReq{UserId: uint32(d.UserId), Email: d.Email})
+			return err
+		}},
+	}
+}
+
+// Run works through the due jobs until ctx is done.
+func (w *Worker) Run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+		found, err := w.Next(ctx)
+		if err != nil {
+			klog.CtxWarnf(ctx, "account deletion: %v", err)
+		}
+		if found && err == nil {
+			timer.Reset(0)
+		} else {
+			timer.Reset(w.opts.Interval)
+		}
+	}
+}
+
+// Next runs the oldest due job and tells whether there was one. The job is claimed for the
+// time its steps may take, the steps run outside of the transaction and each one done is
+// recorded right away. After a failure the job is retried from the failed step after a
+// backoff.
+func (w *Worker) Next(ctx context.Context) (found bool, err error) {
+	lease := time.Duration(len(w.steps))*w.opts.StepTimeout + w.opts.Interval
+	d, err := model.ClaimDueAccountDeletion(w.db, ctx, time.Now(), lease)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	done, stepErr := w.run(ctx, d, func(done []string) {
+		err := model.UpdateAccountDeletion(w.db, ctx, d.ID, map[string]any{"steps": strings.Join(done, ",")})
+		if err != nil {
+			// the step runs again with the next attempt, which does no harm
+			klog.CtxWarnf(ctx, "account deletion %s: record steps: %v", d.JobId, err)
+		}
+	})
+	now := time.Now()
+	updates := map[string]any{"steps": strings.Join(done, ",")}
+	if stepErr != nil {
+		klog.CtxWarnf(ctx, "account deletion %s: %v", d.JobId, stepErr)
+		updates["state"] = model.DeletionFailed
+		updates["attempts"] = d.Attempts + 1
+		updates["last_error"] = truncate(stepErr.Error(), 1024)
+		updates["next_attempt_at"] = now.Add(backoff(d.Attempts+1, w.opts.Interval, w.opts.MaxBackoff))
+	} else {
+		updates["state"] = model.DeletionDone
+		updates["last_error"] = ""
+		updates["completed_at"] = now
+		// nothing is left to find by the email
+		updates["email"] = ""
+	}
+	return true, model.UpdateAccountDeletion(w.db, ctx, d.ID, updates)
+}
+
+// run runs the steps the job has not done yet, in order, passes the steps done to saved after
+// each one and returns them.
+func (w *Worker) run(ctx context.Context, d model.AccountDeletion, saved func(done []string)) (done []string, err error) {
+	done = d.StepList()
+	for _, s := range w.steps {
+		if d.StepDone(s.Name) {
+			continue
+		}
+		sctx, cancel := context.WithTimeout(ctx, w.opts.StepTimeout)
+		err = s.Erase(sctx, d)
+		cancel()
+		if err != nil {
+			return done, fmt.Errorf("%s: %w", s.Name, err)
+		}
+		done = append(done, s.Name)
+		saved(done)
+	}
+	return done, nil
+}
+
+// backoff is the delay after the failed attempts, doubling from base up to ceiling.
+func backoff(attempts int, base, ceiling time.Duration) time.Duration {
+	d := base
+	for i := 1; i < attempts && d < ceiling; i++ {
+		d *= 2
+	}
+	return min(d, ceiling)
+}
+
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletion
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+)
+
+func TestWorkerRun(t *testing.T) {
+	var calls []string
+	step := func(name string, err error) Step {
+		return Step{name, func(ctx context.Context, d model.AccountDeletion) error {
+			calls = append(calls, name)
+			return err
+		}}
+	}
+	w := NewWorker(nil, []Step{step("cart", nil), step("order", nil), step("payment", errors.New("unavailable")), step("email", nil)}, Options{})
+	var saved []string
+	save := func(done []string) { saved = append(saved, strings.Join(done, ",")) }
+
+	done, err := w.run(context.Background(), model.AccountDeletion{Steps: "user,cart"}, save)
+	if err == nil || err.Error() != "payment: unavailable" {
+		t.Errorf("got error %v, want the payment step's", err)
+	}
+	if want := []string{"order", "payment"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("called %v, want %v", calls, want)
+	}
+	if want := []string{"user", "cart", "order"}; !reflect.DeepEqual(done, want) {
+		t.Errorf("done %v, want %v", done, want)
+	}
+	// every step done is recorded before the next one runs
+	if want := []string{"user,cart,order"}; !reflect.DeepEqual(saved, want) {
+		t.Errorf("saved %v, want %v", saved, want)
+	}
+
+	calls = nil
+	w.steps[2] = step("payment", nil)
+	saved = nil
+	done, err = w.run(context.Background(), model.AccountDeletion{Steps: "user,cart,order"}, save)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"payment", "email"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("called %v, want %v", calls, want)
+	}
+	if want := []string{"user", "cart", "order", "payment", "email"}; !reflect.DeepEqual(done, want) {
+		t.Errorf("done %v, want %v", done, want)
+	}
+	if want := []string{"user,cart,order,payment", "user,cart,order,payment,email"}; !reflect.DeepEqual(saved, want) {
+		t.Errorf("saved %v, want %v", saved, want)
+	}
+}
+
+func TestWorkerStepTimeout(t *testing.T) {
+	w := NewWorker(nil, []Step{{"cart", func(ctx context.Context, d model.AccountDeletion) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}}}, Options{StepTimeout: 10 * time.Millisecond})
+	if _, err := w.run(context.Background(), model.AccountDeletion{}, func([]string) {}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want the deadline", err)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 10 * time.Second},
+		{2, 20 * time.Second},
+		{4, 80 * time.Second},
+		{20, time.Hour},
+	}
+	for _, tt := range tests {
+		if got := backoff(tt.attempts, 10*time.Second, time.Hour); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// The states of an account deletion.
+const (
+	DeletionPending = "pending"
+	// DeletionFailed is tried again at NextAttemptAt
+	DeletionFailed = "failed"
+	DeletionDone   = "done"
+)
+
+// AccountDeletion is the job erasing a deleted user in the other services, the user's rows
+// here are gone when it is created. Email is kept until the job is done, the email service
+// knows the user only by it.
+type AccountDeletion struct {
+	Base
+	JobId  string `gorm:"uniqueIndex;size:36"`
+	UserId int    `gorm:"index"`
+	Email  string
+	State  string `gorm:"index:idx_account_deletion_due;size:16"`
+	// Steps is the comma separated services done with erasing the user
+	Steps         string `gorm:"size:255"`
+	Attempts      int
+	LastError     string    `gorm:"size:1024"`
+	NextAttemptAt time.Time `gorm:"index:idx_account_deletion_due"`
+	CompletedAt   *time.Time
+}
+
+func (d AccountDeletion) TableName() string {
+	return "account_deletion"
+}
+
+func (d AccountDeletion) StepList() []string {
+	if d.Steps == "" {
+		return nil
+	}
+	return strings.Split(d.Steps, ",")
+}
+
+func (d AccountDeletion) StepDone(step string) bool {
+	for _, s := range d.StepList() {
+		if s == step {
+			return true
+		}
+	}
+	return false
+}
+
+func CreateAccountDeletion(tx *gorm.DB, ctx context.Context, d *AccountDeletion) error {
+	return tx.WithContext(ctx).Create(d).Error
+}
+
+func GetAccountDeletion(db *gorm.DB, ctx context.Context, jobId string) (d AccountDeletion, err error) {
+	err = db.WithContext(ctx).Where("job_id = ?", jobId).First(&d).Error
+	return
+}
+
+// ClaimDueAccountDeletion takes the oldest unfinished job due at now and moves its next
+// attempt to now+lease, so other workers skip it while it runs outside of any transaction.
+// The job is found with SKIP LOCKED, gorm.ErrRecordNotFound tells there is none.
+func ClaimDueAccountDeletion(db *gorm.DB, ctx context.Context, now time.Time, lease time.Duration) (d AccountDeletion, err error) {
+	err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("state <> ? AND next_attempt_at <= ?", DeletionDone, now).
+			Order("id").First(&d).Error
+		if err != nil {
+			return err
+		}
+		d.NextAttemptAt = now.Add(lease)
+		return tx.Model(&AccountDeletion{}).Where("id = ?", d.ID).Update("next_attempt_at", d.NextAttemptAt).Error
+	})
+	return
+}
+
+func UpdateAccountDeletion(db *gorm.DB, ctx context.Context, id int, updates map[string]any) error {
+	return db.WithContext(ctx).Model(&AccountDeletion{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// DeleteUserData deletes the user and every row kept for them. The role audit stays, it
+// names users by id only.
+func DeleteUserData(tx *gorm.DB, ctx context.Context, userId int) error {
+	db := tx.WithContext(ctx)
+	sessions := db.Model(&Session{}).Select("id").Where("user_id = ?", userId)
+	if err := db.Where("session_id IN (?)", sessions).Delete(&RefreshToken{}).Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM user_role WHERE user_id = ?", userId).Error; err != nil {
+		return err
+	}
+	for _, m := range []any{
+		&Session{}, &UserToken{}, &UserTotp{}, &RecoveryCode{}, &UserIdentity{}, &Address{},
+		&NotificationPreference{}, &NotificationSettings{},
+	} {
+		if err := db.Where("user_id = ?", userId).Delete(m).Error; err != nil {
+			return err
+		}
+	}
+	return db.Where("id = ?", userId).Delete(&User{}).Error
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Address is a saved address of a user's address book, one of them is the default.
+type Address struct {
+	Base
+	UserId    int `gorm:"index"`
+	Label     string
+	FirstName string
+	LastName  string
+	Street    string
+	City      string
+	Province  string
+	Country   string
+	ZipCode   string `gorm:"size:32"`
+	Phone     string `gorm:"size:32"`
+	IsDefault bool
+}
+
+func (a Address) TableName() string {
+	return "address"
+}
+
+// ListAddresses lists the addresses of the user, the default first and then oldest first.
+func ListAddresses(db *gorm.DB, ctx context.Context, userId int) (addresses []Address, err error) {
+	err = db.WithContext(ctx).Where("user_id = ?", userId).Order("is_default DESC, id").Find(&addresses).Error
+	return
+}
+
+func CountAddresses(db *gorm.DB, ctx context.Context, userId int) (n int64, err error) {
+	err = db.WithContext(ctx).Model(&Address{}).Where("user_id = ?", userId).Count(&n).Error
+	return
+}
+
+func GetAddress(db *gorm.DB, ctx context.Context, userId, id int) (a Address, err error) {
+	err = db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userId).First(&a).Error
+	return
+}
+
+func CreateAddress(tx *gorm.DB, ctx context.Context, a *Address) error {
+	return tx.WithContext(ctx).Create(a).Error
+}
+
+// SaveAddress stores the fields of a, which is_default is not one of.
+func SaveAddress(tx *gorm.DB, ctx context.Context, a *Address) error {
+	return tx.WithContext(ctx).Model(a).Select("label", "first_name", "last_name", "street", "city",
+		"province", "country", "zip_code", "phone").Updates(a).Error
+}
+
+func DeleteAddress(tx *gorm.DB, ctx context.Context, userId, id int) error {
+	return tx.WithContext(ctx).Where("id = ? AND user_id = ?", id, userId).Delete(&Address{}).Error
+}
+
+// SetDefaultAddress makes the address id the default of the user and no other one. Run it
+// with the user locked, see GetByIdForUpdate.
+func SetDefaultAddress(tx *gorm.DB, ctx context.Context, userId, id int) error {
+	err := tx.WithContext(ctx).Model(&Address{}).Where("user_id = ? AND id <> ? AND is_default", userId, id).
+		Update("is_default", false).Error
+	if err != nil {
+		return err
+	}
+	return tx.WithContext(ctx).Model(&Address{}).Where("user_id = ? AND id = ?", userId, id).Update("is_default", true).Error
+}
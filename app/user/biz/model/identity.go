@@ -0,0 +1,53 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// UserIdentity links a user to their account at an identity provider, Subject being the
+// provider's id for it. Email is what the provider last said the address is.
+type UserIdentity struct {
+	Base
+	UserId   int    `gorm:"index"`
+	Provider string `gorm:"uniqueIndex:idx_provider_subject;size:64"`
+	Subject  string `gorm:"uniqueIndex:idx_provider_subject;size:255"`
+	Email    string
+}
+
+func (i UserIdentity) TableName() string {
+	return "user_identity"
+}
+
+func GetUserIdentity(db *gorm.DB, ctx context.Context, provider, subject string) (i UserIdentity, err error) {
+	err = db.WithContext(ctx).Where(&UserIdentity{Provider: provider, Subject: subject}).First(&i).Error
+	return
+}
+
+func CreateUserIdentity(tx *gorm.DB, ctx context.Context, i *UserIdentity) error {
+	return tx.WithContext(ctx).Create(i).Error
+}
+
+func UpdateUserIdentityEmail(db *gorm.DB, ctx context.Context, id int, email string) error {
+	return db.WithContext(ctx).Model(&UserIdentity{}).Where("id = ?", id).Update("email", email).Error
+}
+
+func ListUserIdentities(db *gorm.DB, ctx context.Context, userId int) (identities []UserIdentity, err error) {
+	err = db.WithContext(ctx).Where("user_id = ?", userId).Order("id").Find(&identities).Error
+	return
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	LockScopeAccount = "account"
+	LockScopeIP      = "ip"
+)
+
+// LoginPolicy sets how failed sign-ins are limited, see conf.Login.
+type LoginPolicy struct {
+	Window       time.Duration
+	BackoffAfter int
+	BackoffBase  time.Duration
+	BackoffMax   time.Duration
+	LockAfter    int
+	LockFor      time.Duration
+	IPBlockAfter int
+	IPBlockFor   time.Duration
+}
+
+// Backoff is how long an account waits after its nth failure in a row: nothing before
+// BackoffAfter failures, then BackoffBase doubled for every further failure up to BackoffMax.
+func (p LoginPolicy) Backoff(failures int) time.Duration {
+	if p.BackoffAfter <= 0 || failures < p.BackoffAfter {
+		return 0
+	}
+	d := p.BackoffBase
+	for i := p.BackoffAfter; i < failures && d < p.BackoffMax; i++ {
+		d *= 2
+	}
+	if d > p.BackoffMax {
+		d = p.BackoffMax
+	}
+	return d
+}
+
+// LoginBlock tells why and for how long sign-ins are refused, a zero Wait means they are not.
+type LoginBlock struct {
+	Scope  string
+	Locked bool
+	Wait   time.Duration
+}
+
+// LoginGuard counts failed sign-ins in Redis per account and per client address, so that
+// every instance of the service sees the same counts.
+type LoginGuard struct {
+	ctx         context.Context
+	cacheClient *redis.Client
+	policy      LoginPolicy
+}
+
+func NewLoginGuard(ctx context.Context, cacheClient *redis.Client, policy LoginPolicy) LoginGuard {
+	return LoginGuard{ctx: ctx, cacheClient: cacheClient, policy: policy}
+}
+
+func accountKey(kind, email string) string {
+	return "login:" + kind + ":account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func ipKey(kind, ip string) string {
+	return "login:" + kind + ":ip:" + ip
+}
+
+// Check tells whether the account of email may try to sign in from ip now.
+func (g LoginGuard) Check(email, ip string) (LoginBlock, error) {
+	pipe := g.cacheClient.Pipeline()
+	var ipLock *redis.DurationCmd
+	if ip != "" {
+		ipLock = pipe.PTTL(g.ctx, ipKey("lock", ip))
+	}
+	lock := pipe.PTTL(g.ctx, accountKey("lock", email))
+	wait := pipe.PTTL(g.ctx, accountKey("wait", email))
+	if _, err := pipe.Exec(g.ctx); err != nil {
+		return LoginBlock{}, err
+	}
+	// PTTL is negative for missing keys
+	switch {
+	case ipLock != nil && ipLock.Val() > 0:
+		return LoginBlock{Scope: LockScopeIP, Locked: true, Wait: ipLock.Val()}, nil
+	case lock.Val() > 0:
+		return LoginBlock{Scope: LockScopeAccount, Locked: true, Wait: lock.Val()}, nil
+	case wait.Val() > 0:
+		return LoginBlock{Scope: LockScopeAccount, Wait: wait.Val()}, nil
+	}
+	return LoginBlock{}, nil
+}
+
+// Fail records a failed sign-in of the account of email from ip. It returns the scopes this
+// failure locked, a lock is reported once however many failures follow it.
+func (g LoginGuard) Fail(email, ip string) (locked []string, err error) {
+	pipe := g.cacheClient.TxPipeline()
+	failures := pipe.Incr(g.ctx, accountKey("fail", email))
+	pipe.Expire(g.ctx, accountKey("fail", email), g.policy.Window)
+	var ipFailures *redis.IntCmd
+	if ip != "" {
+		ipFailures = pipe.Incr(g.ctx, ipKey("fail", ip))
+		pipe.Expire(g.ctx, ipKey("fail", ip), g.policy.Window)
+	}
+	if _, err = pipe.Exec(g.ctx); err != nil {
+		return nil, err
+	}
+
+	n := int(failures.Val())
+	if wait := g.policy.Backoff(n); wait > 0 {
+		if err = g.cacheClient.Set(g.ctx, accountKey("wait", email), 1, wait).Err(); err != nil {
+			return nil, err
+		}
+	}
+	if g.policy.LockAfter > 0 && n >= g.policy.LockAfter {
+		ok, err := g.cacheClient.SetNX(g.ctx, accountKey("lock", email), 1, g.policy.LockFor).Result()
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			locked = append(locked, LockScopeAccount)
+		}
+	}
+	if ipFailures != nil && g.policy.IPBlockAfter > 0 && int(ipFailures.Val()) >= g.policy.IPBlockAfter {
+		ok, err := g.cacheClient.SetNX(g.ctx, ipKey("lock", ip), 1, g.policy.IPBlockFor).Result()
+		if err != nil {
+			return locked, err
+		}
+		if ok {
+			locked = append(locked, LockScopeIP)
+		}
+	}
+	return locked, nil
+}
+
+// Succeed forgets the failures of the account of email. The address keeps its count, or one
+// account of their own would let an attacker reset it.
+func (g LoginGuard) Succeed(email string) error {
+	return g.cacheClient.Del(g.ctx, accountKey("fail", email), accountKey("wait", email)).Err()
+}
+
+// Unlock lifts the lock of the account of email and forgets its failures.
+func (g LoginGuard) Unlock(email string) error {
+	return g.cacheClient.Del(g.ctx, accountKey("fail", email), accountKey("wait", email), accountKey("lock", email)).Err()
+}
@@ -0,0 +1,111 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Notification categories. Receipts and security emails such as password resets are
+// always sent and have no category.
+const (
+	CategoryOrder     = "order"
+	CategoryAccount   = "account"
+	CategoryMarketing = "marketing"
+)
+
+const (
+	ChannelEmail   = "email"
+	ChannelSMS     = "sms"
+	ChannelWebhook = "webhook"
+)
+
+var (
+	Categories = []string{CategoryOrder, CategoryAccount, CategoryMarketing}
+	Channels   = []string{ChannelEmail, ChannelSMS, ChannelWebhook}
+)
+
+// DefaultEnabled tells if a channel is on for a user who never changed it: email is on for
+// everything but marketing, which needs an opt-in like the other channels.
+func DefaultEnabled(category, channel string) bool {
+	return channel == ChannelEmail && category != CategoryMarketing
+}
+
+type NotificationPreference struct {
+	Base
+	UserId   uint32 `gorm:"uniqueIndex:idx_notification_preference"`
+	Category string `gorm:"uniqueIndex:idx_notification_preference;size:32"`
+	Channel  string `gorm:"uniqueIndex:idx_notification_preference;size:16"`
+	Enabled  bool
+}
+
+func (p NotificationPreference) TableName() string {
+	return "notification_preference"
+}
+
+type NotificationSettings struct {
+	Base
+	UserId        uint32 `gorm:"uniqueIndex"`
+	Phone         string `gorm:"size:32"`
+	WebhookURL    string `gorm:"size:512"`
+	WebhookSecret string `gorm:"size:64"`
+	// QuietStart and QuietEnd are minutes after midnight in Timezone
+	QuietStart       int
+	QuietEnd         int
+	Timezone         string `gorm:"size:64"`
+	UnsubscribeToken string `gorm:"uniqueIndex;size:64"`
+}
+
+func (s NotificationSettings) TableName() string {
+	return "notification_settings"
+}
+
+func ListNotificationPreferences(db *gorm.DB, ctx context.Context, userId uint32) (prefs []NotificationPreference, err error) {
+	err = db.WithContext(ctx).Where(&NotificationPreference{UserId: userId}).Find(&prefs).Error
+	return
+}
+
+// SaveNotificationPreferences inserts the preferences or updates the existing ones.
+func SaveNotificationPreferences(db *gorm.DB, ctx context.Context, prefs []NotificationPreference) error {
+	if len(prefs) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "category"}, {Name: "channel"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "updated_at"}),
+	}).Create(&prefs).Error
+}
+
+func GetNotificationSettings(db *gorm.DB, ctx context.Context, userId uint32) (s NotificationSettings, err error) {
+	err = db.WithContext(ctx).Where(&NotificationSettings{UserId: userId}).First(&s).Error
+	return
+}
+
+func GetNotificationSettingsByToken(db *gorm.DB, ctx context.Context, token string) (s NotificationSettings, err error) {
+	err = db.WithContext(ctx).Where(&NotificationSettings{UnsubscribeToken: token}).First(&s).Error
+	return
+}
+
+// CreateNotificationSettings stores s unless the user already has settings.
+func CreateNotificationSettings(db *gorm.DB, ctx context.Context, s *NotificationSettings) error {
+	return db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(s).Error
+}
+
+func UpdateNotificationSettings(db *gorm.DB, ctx context.Context, userId uint32, updates map[string]any) error {
+	return db.WithContext(ctx).Model(&NotificationSettings{}).Where(&NotificationSettings{UserId: userId}).Updates(updates).Error
+}
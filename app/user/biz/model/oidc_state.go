@@ -0,0 +1,54 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// OidcState is what a started OIDC sign-in needs to finish, kept in Redis under the hash of
+// its state until the provider sends the browser back.
+type OidcState struct {
+	Provider string `json:"provider"`
+	Verifier string `json:"verifier"`
+	Nonce    string `json:"nonce"`
+}
+
+func oidcStateKey(stateHash string) string {
+	return "oidc:state:" + stateHash
+}
+
+func SaveOidcState(ctx context.Context, cacheClient *redis.Client, stateHash string, s OidcState, ttl time.Duration) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return cacheClient.Set(ctx, oidcStateKey(stateHash), b, ttl).Err()
+}
+
+// TakeOidcState returns the state and forgets it, so a code comes back once. It returns
+// redis.Nil for a state that was never started, has expired or was used.
+func TakeOidcState(ctx context.Context, cacheClient *redis.Client, stateHash string) (s OidcState, err error) {
+	b, err := cacheClient.GetDel(ctx, oidcStateKey(stateHash)).Bytes()
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(b, &s)
+	return
+}
@@ -0,0 +1,133 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Role is a named set of permissions, users get them through their roles.
+type Role struct {
+	Base
+	Name string `gorm:"uniqueIndex;size:64"`
+	// Permissions is comma separated
+	Permissions string `gorm:"size:1024"`
+}
+
+func (r Role) TableName() string {
+	return "role"
+}
+
+func (r Role) PermissionList() []string {
+	if r.Permissions == "" {
+		return nil
+	}
+	return strings.Split(r.Permissions, ",")
+}
+
+const (
+	RoleGranted = "grant"
+	RoleRevoked = "revoke"
+)
+
+// RoleAudit records who granted or revoked a role.
+type RoleAudit struct {
+	Base
+	ActorUserId  uint32
+	ActorService string `gorm:"size:64"`
+	TargetUserId uint32 `gorm:"index"`
+	Role         string `gorm:"size:64"`
+	Action       string `gorm:"size:16"`
+	Reason       string `gorm:"size:512"`
+}
+
+func (a RoleAudit) TableName() string {
+	return "role_audit"
+}
+
+// EnsureRole creates the role or updates its permissions, for the built-in roles.
+func EnsureRole(db *gorm.DB, ctx context.Context, name string, permissions []string) error {
+	return db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"permissions", "updated_at"}),
+	}).Create(&Role{Name: name, Permissions: strings.Join(permissions, ",")}).Error
+}
+
+func GetRoleByName(db *gorm.DB, ctx context.Context, name string) (role Role, err error) {
+	err = db.WithContext(ctx).Where(&Role{Name: name}).First(&role).Error
+	return
+}
+
+func GetUserRoles(db *gorm.DB, ctx context.Context, userId int) (roles []Role, err error) {
+	err = db.WithContext(ctx).Model(&User{Base: Base{ID: userId}}).Association("Roles").Find(&roles)
+	return
+}
+
+// GetGrants returns the names of the user's roles and the permissions they grant, sorted.
+func GetGrants(db *gorm.DB, ctx context.Context, userId int) (roles, permissions []string, err error) {
+	rs, err := GetUserRoles(db, ctx, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+	seen := map[string]bool{}
+	for _, r := range rs {
+		roles = append(roles, r.Name)
+		for _, p := range r.PermissionList() {
+			if !seen[p] {
+				seen[p] = true
+				permissions = append(permissions, p)
+			}
+		}
+	}
+	sort.Strings(roles)
+	sort.Strings(permissions)
+	return roles, permissions, nil
+}
+
+func AddUserRole(db *gorm.DB, ctx context.Context, userId int, role *Role) error {
+	return db.WithContext(ctx).Model(&User{Base: Base{ID: userId}}).Association("Roles").Append(role)
+}
+
+func RemoveUserRole(db *gorm.DB, ctx context.Context, userId int, role *Role) error {
+	return db.WithContext(ctx).Model(&User{Base: Base{ID: userId}}).Association("Roles").Delete(role)
+}
+
+// CountRoleUsers counts the users that have the role, locking their rows until the
+// transaction ends.
+func CountRoleUsers(tx *gorm.DB, ctx context.Context, roleId int) (int, error) {
+	var userIds []int
+	err := tx.WithContext(ctx).Table("user_role").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("role_id = ?", roleId).Pluck("user_id", &userIds).Error
+	return len(userIds), err
+}
+
+func CreateRoleAudit(db *gorm.DB, ctx context.Context, a *RoleAudit) error {
+	return db.WithContext(ctx).Create(a).Error
+}
+
+// ListRoleAudit returns the latest entries, of the user unless targetUserId is 0.
+func ListRoleAudit(db *gorm.DB, ctx context.Context, targetUserId uint32, limit int) (entries []RoleAudit, err error) {
+	q := db.WithContext(ctx).Order("id DESC").Limit(limit)
+	if targetUserId != 0 {
+		q = q.Where("target_user_id = ?", targetUserId)
+	}
+	err = q.Find(&entries).Error
+	return
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Session is a login on one device. It lasts as long as its refresh tokens are used.
+type Session struct {
+	Base
+	Sid        string `gorm:"uniqueIndex;size:36"`
+	UserId     uint32 `gorm:"index"`
+	UserAgent  string `gorm:"size:512"`
+	IP         string `gorm:"size:64"`
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+}
+
+func (s Session) TableName() string {
+	return "session"
+}
+
+func (s Session) Active(now time.Time) bool {
+	return s.RevokedAt == nil && s.ExpiresAt.After(now)
+}
+
+// RefreshToken is one refresh token of a session, only its hash is stored. Used tokens
+// are kept until the session ends to notice their reuse.
+type RefreshToken struct {
+	Base
+	SessionId int    `gorm:"index"`
+	TokenHash string `gorm:"uniqueIndex;size:64"`
+	UsedAt    *time.Time
+	ExpiresAt time.Time
+}
+
+func (t RefreshToken) TableName() string {
+	return "refresh_token"
+}
+
+func CreateSession(db *gorm.DB, ctx context.Context, s *Session) error {
+	return db.WithContext(ctx).Create(s).Error
+}
+
+func CreateRefreshToken(db *gorm.DB, ctx context.Context, t *RefreshToken) error {
+	return db.WithContext(ctx).Create(t).Error
+}
+
+func GetRefreshTokenForUpdate(tx *gorm.DB, ctx context.Context, hash string) (t RefreshToken, err error) {
+	err = tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where(&RefreshToken{TokenHash: hash}).First(&t).Error
+	return
+}
+
+func GetRefreshToken(db *gorm.DB, ctx context.Context, hash string) (t RefreshToken, err error) {
+	err = db.WithContext(ctx).Where(&RefreshToken{TokenHash: hash}).First(&t).Error
+	return
+}
+
+func MarkRefreshTokenUsed(db *gorm.DB, ctx context.Context, id int, at time.Time) error {
+	return db.WithContext(ctx).Model(&RefreshToken{}).Where("id = ?", id).Update("used_at", at).Error
+}
+
+func GetSessionForUpdate(tx *gorm.DB, ctx context.Context, id int) (s Session, err error) {
+	err = tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&s, id).Error
+	return
+}
+
+func GetSession(db *gorm.DB, ctx context.Context, id int) (s Session, err error) {
+	err = db.WithContext(ctx).First(&s, id).Error
+	return
+}
+
+func UpdateSession(db *gorm.DB, ctx context.Context, id int, updates map[string]any) error {
+	return db.WithContext(ctx).Model(&Session{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// ListActiveSessions returns the sessions of the user that were not revoked and have not
+// expired, the most recently used first.
+func ListActiveSessions(db *gorm.DB, ctx context.Context, userId uint32, now time.Time) (sessions []Session, err error) {
+	err = db.WithContext(ctx).Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userId, now).
+		Order("last_used_at DESC").Find(&sessions).Error
+	return
+}
+
+// ListSessions returns every session of the user, ended ones too, the newest first.
+func ListSessions(db *gorm.DB, ctx context.Context, userId uint32) (sessions []Session, err error) {
+	err = db.WithContext(ctx).Where("user_id = ?", userId).Order("id DESC").Find(&sessions).Error
+	return
+}
+
+// RevokeSessions ends the active sessions of the user, only the ones of sids unless it is
+// empty. It returns how many ended.
+func RevokeSessions(db *gorm.DB, ctx context.Context, userId uint32, sids []string, now time.Time) (int64, error) {
+	q := db.WithContext(ctx).Model(&Session{}).Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userId, now)
+	if len(sids) > 0 {
+		q = q.Where("sid IN ?", sids)
+	}
+	res := q.Update("revoked_at", now)
+	return res.RowsAffected, res.Error
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SigningKey is a generated key of the token signing keyring, PrivateKey is PKCS #8 PEM.
+type SigningKey struct {
+	Base
+	Kid        string `gorm:"uniqueIndex;size:64"`
+	Algorithm  string `gorm:"size:16"`
+	PrivateKey string `gorm:"type:text"`
+	// ActiveAt is when the key starts signing, until then it is only published
+	ActiveAt time.Time
+	// ExpiresAt is when the last token of the key expires and the key is dropped, it is
+	// set once a newer key takes over
+	ExpiresAt *time.Time `gorm:"index"`
+}
+
+func (k SigningKey) TableName() string {
+	return "signing_key"
+}
+
+// ListSigningKeys returns the keys that have not expired, oldest first.
+func ListSigningKeys(db *gorm.DB, ctx context.Context, now time.Time) (keys []SigningKey, err error) {
+	err = db.WithContext(ctx).Where("expires_at IS NULL OR expires_at > ?", now).Order("active_at").Find(&keys).Error
+	return
+}
+
+// LockSigningKeys is ListSigningKeys holding the rows until the transaction ends.
+func LockSigningKeys(tx *gorm.DB, ctx context.Context, now time.Time) (keys []SigningKey, err error) {
+	err = tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("expires_at IS NULL OR expires_at > ?", now).Order("active_at").Find(&keys).Error
+	return
+}
+
+func CreateSigningKey(db *gorm.DB, ctx context.Context, k *SigningKey) error {
+	return db.WithContext(ctx).Create(k).Error
+}
+
+func ExpireSigningKeys(db *gorm.DB, ctx context.Context, ids []int, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Model(&SigningKey{}).Where("id IN ?", ids).Update("expires_at", at).Error
+}
+
+func DeleteExpiredSigningKeys(db *gorm.DB, ctx context.Context, now time.Time) error {
+	return db.WithContext(ctx).Where("expires_at <= ?", now).Delete(&SigningKey{}).Error
+}
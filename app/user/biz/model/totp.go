@@ -0,0 +1,114 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UserTotp is the authenticator app of a user. It is pending until ConfirmedAt is set, then
+// sign-ins need a code from it.
+type UserTotp struct {
+	Base
+	UserId      int    `gorm:"uniqueIndex"`
+	Secret      string `gorm:"size:64"`
+	ConfirmedAt *time.Time
+	// LastStep is the time step of the last code accepted, so a code works once
+	LastStep int64
+}
+
+func (t UserTotp) TableName() string {
+	return "user_totp"
+}
+
+func (t UserTotp) Enabled() bool {
+	return t.ConfirmedAt != nil
+}
+
+// RecoveryCode stands in for a code of the app once, only its hash is stored.
+type RecoveryCode struct {
+	Base
+	UserId   int    `gorm:"index"`
+	CodeHash string `gorm:"uniqueIndex;size:64"`
+	UsedAt   *time.Time
+}
+
+func (c RecoveryCode) TableName() string {
+	return "recovery_code"
+}
+
+func GetUserTotp(db *gorm.DB, ctx context.Context, userId int) (t UserTotp, err error) {
+	err = db.WithContext(ctx).Where(&UserTotp{UserId: userId}).First(&t).Error
+	return
+}
+
+func GetUserTotpForUpdate(tx *gorm.DB, ctx context.Context, userId int) (t UserTotp, err error) {
+	err = tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where(&UserTotp{UserId: userId}).First(&t).Error
+	return
+}
+
+// SaveUserTotp starts the enrollment of the user over with secret.
+func SaveUserTotp(tx *gorm.DB, ctx context.Context, userId int, secret string) error {
+	return tx.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.Assignments(map[string]any{"secret": secret, "confirmed_at": nil, "last_step": 0, "updated_at": time.Now()}),
+	}).Create(&UserTotp{UserId: userId, Secret: secret}).Error
+}
+
+func ConfirmUserTotp(tx *gorm.DB, ctx context.Context, id int, step int64, at time.Time) error {
+	return tx.WithContext(ctx).Model(&UserTotp{}).Where("id = ?", id).
+		Updates(map[string]any{"confirmed_at": at, "last_step": step}).Error
+}
+
+func UseTotpStep(tx *gorm.DB, ctx context.Context, id int, step int64) error {
+	return tx.WithContext(ctx).Model(&UserTotp{}).Where("id = ?", id).Update("last_step", step).Error
+}
+
+// DeleteUserTotp turns two-factor authentication off and drops the recovery codes.
+func DeleteUserTotp(tx *gorm.DB, ctx context.Context, userId int) error {
+	if err := tx.WithContext(ctx).Where("user_id = ?", userId).Delete(&UserTotp{}).Error; err != nil {
+		return err
+	}
+	return tx.WithContext(ctx).Where("user_id = ?", userId).Delete(&RecoveryCode{}).Error
+}
+
+// ReplaceRecoveryCodes stores the hashes as the user's recovery codes, the old ones are dropped.
+func ReplaceRecoveryCodes(tx *gorm.DB, ctx context.Context, userId int, hashes []string) error {
+	if err := tx.WithContext(ctx).Where("user_id = ?", userId).Delete(&RecoveryCode{}).Error; err != nil {
+		return err
+	}
+	codes := make([]RecoveryCode, len(hashes))
+	for i, h := range hashes {
+		codes[i] = RecoveryCode{UserId: userId, CodeHash: h}
+	}
+	return tx.WithContext(ctx).Create(&codes).Error
+}
+
+// UseRecoveryCode marks the unused code of the hash as used, it tells whether there was one.
+func UseRecoveryCode(tx *gorm.DB, ctx context.Context, userId int, hash string, at time.Time) (bool, error) {
+	res := tx.WithContext(ctx).Model(&RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userId, hash).
+		Update("used_at", at)
+	return res.RowsAffected == 1, res.Error
+}
+
+func CountRecoveryCodes(db *gorm.DB, ctx context.Context, userId int) (n int64, err error) {
+	err = db.WithContext(ctx).Model(&RecoveryCode{}).Where("user_id = ? AND used_at IS NULL", userId).Count(&n).Error
+	return
+}
@@ -16,14 +16,23 @@ package model
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type User struct {
 	Base
 	Email          string `gorm:"unique"`
 	PasswordHashed string
+	// EmailVerifiedAt is when the user proved to own the email, nil until then
+	EmailVerifiedAt *time.Time
+	Roles           []Role `gorm:"many2many:user_role"`
+	Name            string
+	Phone           string `gorm:"size:32"`
+	Locale          string `gorm:"size:16"`
+	Currency        string `gorm:"size:3"`
 }
 
 func (u User) TableName() string {
@@ -35,6 +44,31 @@ func GetByEmail(db *gorm.DB, ctx context.Context, email string) (user *User, err
 	return
 }
 
+func GetById(db *gorm.DB, ctx context.Context, id int) (user *User, err error) {
+	err = db.WithContext(ctx).Model(&User{}).Where("id = ?", id).First(&user).Error
+	return
+}
+
 func Create(db *gorm.DB, ctx context.Context, user *User) error {
 	return db.WithContext(ctx).Create(user).Error
 }
+
+func MarkEmailVerified(db *gorm.DB, ctx context.Context, id int, at time.Time) error {
+	return db.WithContext(ctx).Model(&User{}).Where("id = ? AND email_verified_at IS NULL", id).Update("email_verified_at", at).Error
+}
+
+func UpdatePassword(db *gorm.DB, ctx context.Context, id int, passwordHashed string) error {
+	return db.WithContext(ctx).Model(&User{}).Where("id = ?", id).Update("password_hashed", passwordHashed).Error
+}
+
+// GetByIdForUpdate locks the user until the transaction tx ends, for changes spread over
+// rows of the user that have to agree, like which address is the default.
+func GetByIdForUpdate(tx *gorm.DB, ctx context.Context, id int) (user *User, err error) {
+	err = tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Model(&User{}).Where("id = ?", id).First(&user).Error
+	return
+}
+
+func UpdateProfile(db *gorm.DB, ctx context.Context, id int, name, phone, locale, currency string) error {
+	return db.WithContext(ctx).Model(&User{}).Where("id = ?", id).
+		Updates(map[string]any{"name": name, "phone": phone, "locale": locale, "currency": currency}).Error
+}
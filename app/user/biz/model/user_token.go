@@ -0,0 +1,78 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// The purposes of user tokens.
+const (
+	PurposeVerifyEmail   = "verify_email"
+	PurposeResetPassword = "reset_password"
+	PurposeUnlockAccount = "unlock_account"
+	PurposeDeleteAccount = "delete_account"
+	// a sign-in waiting for the second factor
+	PurposeLoginChallenge = "login_challenge"
+)
+
+// UserToken is a single use token, mostly emailed to the user, only its hash is stored.
+type UserToken struct {
+	Base
+	UserId    int    `gorm:"index"`
+	Purpose   string `gorm:"size:32"`
+	TokenHash string `gorm:"uniqueIndex;size:64"`
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
+
+func (t UserToken) TableName() string {
+	return "user_token"
+}
+
+func (t UserToken) Valid(purpose string, now time.Time) bool {
+	return t.Purpose == purpose && t.UsedAt == nil && t.ExpiresAt.After(now)
+}
+
+// CreateUserToken stores the token and retires the unused tokens the user has for its
+// purpose, so only the latest link works.
+func CreateUserToken(tx *gorm.DB, ctx context.Context, t *UserToken) error {
+	err := tx.WithContext(ctx).Model(&UserToken{}).
+		Where("user_id = ? AND purpose = ? AND used_at IS NULL", t.UserId, t.Purpose).
+		Update("used_at", time.Now()).Error
+	if err != nil {
+		return err
+	}
+	return tx.WithContext(ctx).Create(t).Error
+}
+
+// LatestUserToken returns the token last created for the user's purpose.
+func LatestUserToken(db *gorm.DB, ctx context.Context, userId int, purpose string) (t UserToken, err error) {
+	err = db.WithContext(ctx).Where(&UserToken{UserId: userId, Purpose: purpose}).Order("id DESC").First(&t).Error
+	return
+}
+
+func GetUserTokenForUpdate(tx *gorm.DB, ctx context.Context, hash string) (t UserToken, err error) {
+	err = tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where(&UserToken{TokenHash: hash}).First(&t).Error
+	return
+}
+
+func MarkUserTokenUsed(tx *gorm.DB, ctx context.Context, id int, at time.Time) error {
+	return tx.WithContext(ctx).Model(&UserToken{}).Where("id = ?", id).Update("used_at", at).Error
+}
@@ -0,0 +1,284 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package oidc signs users in with OpenID Connect providers: the authorization code flow
+// with PKCE, and the ID token checked against the provider's published keys.
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/common/jwks"
+	"github.com/golang-jwt/jwt/v4"
+	"golang.org/x/sync/singleflight"
+)
+
+// metadataTTL is how long the discovery document and the keys are cached
+const metadataTTL = time.Hour
+
+// Config is a provider the shop accepts sign-ins from.
+type Config struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+}
+
+// Metadata is the part of the discovery document the flow needs.
+type Metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+// Claims are the claims of an ID token the shop uses.
+type Claims struct {
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name,omitempty"`
+	Nonce         string `json:"nonce"`
+	jwt.RegisteredClaims
+}
+
+// Client talks to one provider. The discovery document and the keys are fetched when first
+// needed and cached, the keys again when a token names one they do not have.
+type Client struct {
+	cfg  Config
+	http *http.Client
+
+	group   singleflight.Group
+	mu      sync.Mutex
+	meta    *Metadata
+	keys    map[string]jwks.Key
+	fetched time.Time
+}
+
+func NewClient(cfg Config, hc *http.Client) *Client {
+	if hc == nil {
+		hc = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Client{cfg: cfg, http: hc}
+}
+
+// RandomString is a value for state, nonce or the PKCE verifier.
+func RandomString() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Challenge is the S256 PKCE challenge of verifier.
+func Challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// AuthURL is where the browser goes to sign in at the provider.
+func (c *Client) AuthURL(ctx context.Context, redirectURI, state, nonce, verifier string) (string, error) {
+	meta, err := c.metadata(ctx)
+	if err != nil {
+		return "", err
+	}
+	scopes := c.cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "email", "profile"}
+	}
+	v := url.Values{}
+	v.Set("response_type", "code")
+	v.Set("client_id", c.cfg.ClientID)
+	v.Set("redirect_uri", redirectURI)
+	v.Set("scope", strings.Join(scopes, " "))
+	v.Set("state", state)
+	v.Set("nonce", nonce)
+	v.Set("code_challenge", Challenge(verifier))
+	v.Set("code_challenge_method", "S256")
+	sep := "?"
+	if strings.Contains(meta.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return meta.AuthorizationEndpoint + sep + v.Encode(), nil
+}
+
+// Exchange trades the code of the callback for the ID token and checks it.
+func (c *Client) Exchange(ctx context.Context, code, redirectURI, verifier, nonce string) (*Claims, error) {
+	meta, err := c.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", redirectURI)
+	form.Set("code_verifier", verifier)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(c.cfg.ClientID), url.QueryEscape(c.cfg.ClientSecret))
+	var token struct {
+		IDToken string `json:"id_token"`
+		Error   string `json:"error"`
+	}
+	if err = c.do(req, &token); err != nil {
+		return nil, fmt.Errorf("oidc: token request: %w", err)
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("oidc: no id_token in the token response")
+	}
+	return c.Verify(ctx, token.IDToken, nonce)
+}
+
+// Verify checks the signature, issuer, audience, expiry and nonce of an ID token.
+func (c *Client) Verify(ctx context.Context, raw, nonce string) (*Claims, error) {
+	meta, err := c.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	claims := &Claims{}
+	_, err = jwt.ParseWithClaims(raw, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		k, err := c.key(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if k.Alg != "" && k.Alg != t.Method.Alg() {
+			return nil, fmt.Errorf("oidc: key %s is for %s, not %s", kid, k.Alg, t.Method.Alg())
+		}
+		return k.PublicKey()
+	}, jwt.WithValidMethods([]string{jwks.RS256, jwks.ES256}))
+	if err != nil {
+		return nil, fmt.Errorf("oidc: %w", err)
+	}
+	switch {
+	case !claims.VerifyIssuer(meta.Issuer, true):
+		return nil, fmt.Errorf("oidc: token issued by %q", claims.Issuer)
+	case !claims.VerifyAudience(c.cfg.ClientID, true):
+		return nil, errors.New("oidc: token is not for this client")
+	case claims.ExpiresAt == nil:
+		return nil, errors.New("oidc: token does not expire")
+	case nonce == "" || claims.Nonce != nonce:
+		return nil, errors.New("oidc: nonce does not match")
+	case claims.Subject == "":
+		return nil, errors.New("oidc: token has no subject")
+	}
+	return claims, nil
+}
+
+func (c *Client) metadata(ctx context.Context) (*Metadata, error) {
+	c.mu.Lock()
+	meta, fetched := c.meta, c.fetched
+	c.mu.Unlock()
+	if meta != nil && time.Since(fetched) < metadataTTL {
+		return meta, nil
+	}
+	if err := c.fetch(ctx); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.meta, nil
+}
+
+func (c *Client) key(ctx context.Context, kid string) (jwks.Key, error) {
+	c.mu.Lock()
+	k, ok := c.keys[kid]
+	fetched := c.fetched
+	c.mu.Unlock()
+	if ok {
+		return k, nil
+	}
+	// a new key of the provider, or a bogus kid; the keys are fetched once per minute at most
+	if time.Since(fetched) > time.Minute {
+		if err := c.fetch(ctx); err != nil {
+			return jwks.Key{}, err
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if k, ok := c.keys[kid]; ok {
+		return k, nil
+	}
+	return jwks.Key{}, fmt.Errorf("%w %q", jwks.ErrUnknownKey, kid)
+}
+
+// fetch loads the discovery document and the keys. The requests run without c.mu so a slow
+// provider does not hold up the sign-ins that have what they need, concurrent callers share
+// one fetch.
+func (c *Client) fetch(ctx context.Context) error {
+	_, err, _ := c.group.Do("fetch", func() (any, error) {
+		return nil, c.load(ctx)
+	})
+	return err
+}
+
+func (c *Client) load(ctx context.Context) error {
+	issuer := strings.TrimRight(c.cfg.Issuer, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return err
+	}
+	var meta Metadata
+	if err = c.do(req, &meta); err != nil {
+		return fmt.Errorf("oidc: discovery: %w", err)
+	}
+	if meta.Issuer != issuer {
+		return fmt.Errorf("oidc: discovery names issuer %q, want %q", meta.Issuer, issuer)
+	}
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, meta.JwksURI, nil)
+	if err != nil {
+		return err
+	}
+	var set jwks.Set
+	if err = c.do(req, &set); err != nil {
+		return fmt.Errorf("oidc: keys: %w", err)
+	}
+	keys := make(map[string]jwks.Key, len(set.Keys))
+	for _, k := range set.Keys {
+		keys[k.Kid] = k
+	}
+	c.mu.Lock()
+	c.meta, c.keys, c.fetched = &meta, keys, time.Now()
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *Client) do(req *http.Request, v any) error {
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return json.Unmarshal(body, v)
+}
@@ -0,0 +1,162 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/oidc/oidctest"
+)
+
+const redirectURI = "http://localhost:8080/auth/oidc/local/callback"
+
+// authorize follows the sign-in at the provider as the browser would and returns the
+// callback query.
+func authorize(t *testing.T, authURL, email string) url.Values {
+	t.Helper()
+	noRedirect := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	page, err := noRedirect.Get(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page.Body.Close()
+	if page.StatusCode != http.StatusOK {
+		t.Fatalf("sign-in page: %s", page.Status)
+	}
+	resp, err := noRedirect.Get(authURL + "&email=" + url.QueryEscape(email) + "&email_verified=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	loc, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil || resp.StatusCode != http.StatusFound || !strings.HasPrefix(loc.String(), redirectURI+"?") {
+		t.Fatalf("callback %s %q", resp.Status, resp.Header.Get("Location"))
+	}
+	return loc.Query()
+}
+
+func TestCodeFlow(t *testing.T) {
+	p, srv, err := oidctest.NewServer("gomall", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+	ctx := context.Background()
+	c := NewClient(Config{Issuer: p.Issuer, ClientID: "gomall", ClientSecret: "secret"}, nil)
+
+	state, nonce, verifier := RandomString(), RandomString(), RandomString()
+	authURL, err := c.AuthURL(ctx, redirectURI, state, nonce, verifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := authorize(t, authURL, "alice@example.com")
+	if q.Get("state") != state {
+		t.Fatalf("state %q", q.Get("state"))
+	}
+	claims, err := c.Exchange(ctx, q.Get("code"), redirectURI, verifier, nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.Email != "alice@example.com" || !claims.EmailVerified || claims.Subject != oidctest.Subject("alice@example.com") {
+		t.Errorf("claims %+v", claims)
+	}
+	if _, err = c.Exchange(ctx, q.Get("code"), redirectURI, verifier, nonce); err == nil {
+		t.Error("code used twice")
+	}
+}
+
+func TestCodeFlowRejects(t *testing.T) {
+	p, srv, err := oidctest.NewServer("gomall", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+	ctx := context.Background()
+	c := NewClient(Config{Issuer: p.Issuer, ClientID: "gomall", ClientSecret: "secret"}, nil)
+
+	tests := []struct {
+		name   string
+		client *Client
+		mutate func(code, verifier, nonce *string)
+	}{
+		{"wrong verifier", c, func(_, verifier, _ *string) { *verifier = RandomString() }},
+		{"wrong nonce", c, func(_, _, nonce *string) { *nonce = RandomString() }},
+		{"unknown code", c, func(code, _, _ *string) { *code = RandomString() }},
+		{"wrong secret", NewClient(Config{Issuer: p.Issuer, ClientID: "gomall", ClientSecret: "guess"}, nil), func(_, _, _ *string) {}},
+	}
+	for _, tt := range tests {
+		nonce, verifier := RandomString(), RandomString()
+		authURL, err := tt.client.AuthURL(ctx, redirectURI, "state", nonce, verifier)
+		if err != nil {
+			t.Fatal(err)
+		}
+		code := authorize(t, authURL, "bob@example.com").Get("code")
+		tt.mutate(&code, &verifier, &nonce)
+		if _, err = tt.client.Exchange(ctx, code, redirectURI, verifier, nonce); err == nil {
+			t.Errorf("%s: accepted", tt.name)
+		}
+	}
+}
+
+func TestVerifyOtherClient(t *testing.T) {
+	p, srv, err := oidctest.NewServer("other", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+	ctx := context.Background()
+	other := NewClient(Config{Issuer: p.Issuer, ClientID: "other", ClientSecret: "secret"}, nil)
+	nonce, verifier := RandomString(), RandomString()
+	authURL, err := other.AuthURL(ctx, redirectURI, "state", nonce, verifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := authorize(t, authURL, "carol@example.com").Get("code")
+	if _, err = other.Exchange(ctx, code, redirectURI, verifier, nonce); err != nil {
+		t.Fatal(err)
+	}
+	// an ID token the provider issued to another client must not sign in here
+	c := NewClient(Config{Issuer: p.Issuer, ClientID: "gomall"}, nil)
+	nonce, verifier = RandomString(), RandomString()
+	authURL, _ = other.AuthURL(ctx, redirectURI, "state", nonce, verifier)
+	code = authorize(t, authURL, "carol@example.com").Get("code")
+	form := url.Values{"grant_type": {"authorization_code"}, "code": {code}, "redirect_uri": {redirectURI},
+		"code_verifier": {verifier}, "client_id": {"other"}, "client_secret": {"secret"}}
+	resp, err := http.PostForm(p.Issuer+"/token", form)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var token struct {
+		IDToken string `json:"id_token"`
+	}
+	if err = decode(resp, &token); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.Verify(ctx, token.IDToken, nonce); err == nil || !strings.Contains(err.Error(), "not for this client") {
+		t.Errorf("verify: %v", err)
+	}
+}
+
+func decode(resp *http.Response, v any) error {
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+var errLastAdminDeletion = kerrors.NewBizStatusError(40000, "the last admin cannot delete their account")
+
+// lastAdmin tells whether the user is the only admin, whose account must stay. Counting locks
+// the admins until tx ends, like revoking the role does.
+func lastAdmin(tx *gorm.DB, ctx context.Context, userId int) (bool, error) {
+	role, err := model.GetRoleByName(tx, ctx, authn.RoleAdmin)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	holders, err := model.CountRoleUsers(tx, ctx, role.ID)
+	if err != nil || holders != 1 {
+		return false, err
+	}
+	roles, _, err := model.GetGrants(tx, ctx, userId)
+	return contains(roles, authn.RoleAdmin), err
+}
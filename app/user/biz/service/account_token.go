@@ -0,0 +1,138 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/user/conf"
+	"github.com/cloudwego/biz-demo/gomall/common/outbox"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+const (
+	subjectEmail = "email"
+	// resendInterval keeps the same link from being emailed over and over
+	resendInterval = time.Minute
+)
+
+var errInvalidAccountToken = kerrors.NewBizStatusError(40100, "the link is invalid or has expired")
+
+func verifyTTL() time.Duration {
+	return time.Duration(conf.GetConf().Account.VerifyTTLHours) * time.Hour
+}
+
+func resetTTL() time.Duration {
+	return time.Duration(conf.GetConf().Account.ResetTTLMinutes) * time.Minute
+}
+
+func deleteTTL() time.Duration {
+	return time.Duration(conf.GetConf().Account.DeleteTTLMinutes) * time.Minute
+}
+
+func accountURL(path, token string) string {
+	return strings.TrimRight(conf.GetConf().Account.BaseURL, "/") + path + "?token=" + url.QueryEscape(token)
+}
+
+// issueAccountToken creates a token of purpose for the user and queues the email with its
+// link in the transaction tx, so the link is sent only if the token is stored.
+func issueAccountToken(tx *gorm.DB, ctx context.Context, u *model.User, purpose string, ttl time.Duration) error {
+	token := randomToken()
+	err := model.CreateUserToken(tx, ctx, &model.UserToken{
+		UserId:    u.ID,
+		Purpose:   purpose,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return err
+	}
+	req := &email.EmailReq{To: u.Email, ContentType: "text/plain", Locale: u.Locale}
+	var data map[string]any
+	switch purpose {
+	case model.PurposeVerifyEmail:
+		link := accountURL("/verify-email", token)
+		req.Template, req.Subject = "verify_email", "Verify your email"
+		req.Content = "Open this link to verify your email: " + link
+		data = map[string]any{"email": u.Email, "verify_url": link, "expires_in_hours": int(ttl / time.Hour)}
+	case model.PurposeResetPassword:
+		link := accountURL("/reset-password", token)
+		req.Template, req.Subject = "password_reset", "Reset your password"
+		req.Content = "Open this link to reset your password: " + link
+		data = map[string]any{"reset_url": link, "expires_in_minutes": int(ttl / time.Minute)}
+	case model.PurposeUnlockAccount:
+		link := accountURL("/unlock-account", token)
+		req.Template, req.Subject = "account_locked", "Your account has been locked"
+		req.Content = "Your account was locked after too many failed sign-ins. Open this link to unlock it: " + link
+		data = map[string]any{"unlock_url": link, "expires_in_minutes": int(ttl / time.Minute)}
+	case model.PurposeDeleteAccount:
+		link := accountURL("/delete-account", token)
+		req.Template, req.Subject = "delete_account", "Confirm the deletion of your account"
+		req.Content = "Open this link to delete your account: " + link
+		data = map[string]any{"confirm_url": link, "expires_in_minutes": int(ttl / time.Minute)}
+	default:
+		return fmt.Errorf("unknown token purpose %s", purpose)
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	req.TemplateData = string(b)
+	payload, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	return outbox.Add(tx, ctx, subjectEmail, payload)
+}
+
+// recentlySent tells whether a link of purpose was sent to the user within resendInterval.
+func recentlySent(db *gorm.DB, ctx context.Context, userId int, purpose string, now time.Time) (bool, error) {
+	t, err := model.LatestUserToken(db, ctx, userId, purpose)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return now.Sub(t.CreatedAt) < resendInterval, nil
+}
+
+// useAccountToken uses up the token of purpose, the token row stays locked until tx ends.
+func useAccountToken(tx *gorm.DB, ctx context.Context, token, purpose string, now time.Time) (model.UserToken, error) {
+	if token == "" {
+		return model.UserToken{}, errInvalidAccountToken
+	}
+	t, err := model.GetUserTokenForUpdate(tx, ctx, hashToken(token))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return t, errInvalidAccountToken
+	}
+	if err != nil {
+		return t, err
+	}
+	if !t.Valid(purpose, now) {
+		return t, errInvalidAccountToken
+	}
+	return t, model.MarkUserTokenUsed(tx, ctx, t.ID, now)
+}
@@ -2,19 +2,15 @@ package service
 
 import (
 	"context"
-	"fmt"
-	"time"
 
-	jwt "github.com/golang-jwt/jwt/v4"
-	//"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
-	//"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/signing"
+	"github.com/cloudwego/biz-demo/gomall/common/jwks"
 	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
 	"github.com/cloudwego/kitex/pkg/klog"
 )
 
-// Secret key used to sign the JWT token
-var jwtSecret = []byte("your_secret_key")
-
 type AuthService struct {
 	ctx context.Context
 }
@@ -24,51 +20,45 @@ func NewAuthService(ctx context.Context) *AuthService {
 }
 
 // Claims represents the claims structure in the JWT token
-type Claims struct {
-	UserID int `json:"user_id"`
-	jwt.RegisteredClaims
-}
+type Claims = jwks.Claims
 
-// GenerateToken generates a new JWT token for the given user ID
-func (s *AuthService) GenerateToken(userID int) (string, error) {
-	claims := Claims{
-		UserID: userID,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)), // Token expires in 24 hours
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
-		},
+// GenerateToken generates a new JWT token for the given user ID and session, signed with the active key.
+// The token carries the user's roles and whether the email is verified.
+func (s *AuthService) GenerateToken(userID int, sid string) (string, *Claims, error) {
+	u, err := model.GetById(mysql.DB, s.ctx, userID)
+	if err != nil {
+		klog.Errorf("Failed to load user: %v", err)
+		return "", nil, err
 	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	ss, err := token.SignedString(jwtSecret)
+	roles, permissions, err := model.GetGrants(mysql.DB, s.ctx, userID)
+	if err != nil {
+		klog.Errorf("Failed to load roles: %v", err)
+		return "", nil, err
+	}
+	ss, claims, err := signing.Default.Sign(Claims{
+		UserID:        userID,
+		SessionID:     sid,
+		EmailVerified: u.EmailVerifiedAt != nil,
+		Roles:         roles,
+		Permissions:   permissions,
+	})
 	if err != nil {
 		klog.Errorf("Failed to generate token: %v", err)
-		return "", err
+		return "", nil, err
 	}
 
-	return ss, nil
+	return ss, claims, nil
 }
 
-// ValidateToken validates the given JWT token and returns the user ID if valid
+// ValidateToken validates the given JWT token and returns its claims if valid
 func (s *AuthService) ValidateToken(tokenStr string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenStr, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return jwtSecret, nil
-	})
-
+	claims, err := signing.Default.Verify(tokenStr)
 	if err != nil {
 		klog.Errorf("Failed to validate token: %v", err)
 		return nil, err
 	}
 
-	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
-		return claims, nil
-	}
-
-	return nil, fmt.Errorf("invalid token")
+	return claims, nil
 }
 
 // LoginWithToken combines login and token generation
@@ -79,10 +69,5 @@ func (s *AuthService) LoginWithToken(req *user.LoginReq) (resp *user.LoginResp,
 		return nil, "", err
 	}
 
-	token, err = s.GenerateToken(int(loginResp.UserId))
-	if err != nil {
-		return nil, "", err
-	}
-
-	return loginResp, token, nil
+	return loginResp, loginResp.Token, nil
 }
@@ -0,0 +1,79 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/deletion"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ConfirmAccountDeletionService struct {
+	ctx context.Context
+} // NewConfirmAccountDeletionService new ConfirmAccountDeletionService
+func NewConfirmAccountDeletionService(ctx context.Context) *ConfirmAccountDeletionService {
+	return &ConfirmAccountDeletionService{ctx: ctx}
+}
+
+// Run deletes the user with every row kept for them here, which ends their sessions, and
+// creates the job erasing them in the other services. Access tokens already issued work
+// until they expire.
+func (s *ConfirmAccountDeletionService) Run(req *user.ConfirmAccountDeletionReq) (resp *user.ConfirmAccountDeletionResp, err error) {
+	var job model.AccountDeletion
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		t, err := useAccountToken(tx, s.ctx, req.Token, model.PurposeDeleteAccount, now)
+		if err != nil {
+			return err
+		}
+		u, err := model.GetByIdForUpdate(tx, s.ctx, t.UserId)
+		if err != nil {
+			return err
+		}
+		last, err := lastAdmin(tx, s.ctx, u.ID)
+		if err != nil {
+			return err
+		}
+		if last {
+			return errLastAdminDeletion
+		}
+		job = model.AccountDeletion{
+			JobId:         uuid.NewString(),
+			UserId:        u.ID,
+			Email:         u.Email,
+			State:         model.DeletionPending,
+			Steps:         deletion.StepUser,
+			NextAttemptAt: now,
+		}
+		if err = model.CreateAccountDeletion(tx, s.ctx, &job); err != nil {
+			return err
+		}
+		return model.DeleteUserData(tx, s.ctx, u.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = newLoginGuard(s.ctx).Unlock(job.Email); err != nil {
+		klog.CtxWarnf(s.ctx, "forget failed sign-ins of deleted user %d: %v", job.UserId, err)
+	}
+	return &user.ConfirmAccountDeletionResp{JobId: job.JobId, UserId: uint32(job.UserId)}, nil
+}
@@ -0,0 +1,35 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"testing"
+)
+
+func TestConfirmAccountDeletion_Run(t *testing.T) {
+	// ctx := context.Background()
+	// s := NewConfirmAccountDeletionService(ctx)
+	// // init req and assert value
+
+	// req := &user.ConfirmAccountDeletionReq{}
+	// resp, err := s.Run(req)
+	// if err != nil {
+	// 	t.Errorf("unexpected error: %v", err)
+	// }
+	// if resp == nil {
+	// 	t.Errorf("unexpected nil response")
+	// }
+	// // todo: edit your unit test
+}
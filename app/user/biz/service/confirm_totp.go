@@ -0,0 +1,72 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/totp"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type ConfirmTotpService struct {
+	ctx context.Context
+} // NewConfirmTotpService new ConfirmTotpService
+func NewConfirmTotpService(ctx context.Context) *ConfirmTotpService {
+	return &ConfirmTotpService{ctx: ctx}
+}
+
+// Run turns two-factor authentication on once a code shows the app has the secret. The
+// recovery codes are returned this once.
+func (s *ConfirmTotpService) Run(req *user.ConfirmTotpReq) (resp *user.ConfirmTotpResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		t, err := model.GetUserTotpForUpdate(tx, s.ctx, int(req.UserId))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return kerrors.NewBizStatusError(40000, "two-factor authentication has not been set up")
+		}
+		if err != nil {
+			return err
+		}
+		if t.Enabled() {
+			return errTotpEnabled
+		}
+		now := time.Now()
+		step, ok := totp.Validate(t.Secret, req.Code, now, 0)
+		if !ok {
+			return errInvalidCode
+		}
+		if err = model.ConfirmUserTotp(tx, s.ctx, t.ID, step, now); err != nil {
+			return err
+		}
+		return model.ReplaceRecoveryCodes(tx, s.ctx, t.UserId, hashes)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user.ConfirmTotpResp{RecoveryCodes: codes}, nil
+}
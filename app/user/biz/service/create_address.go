@@ -0,0 +1,68 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type CreateAddressService struct {
+	ctx context.Context
+} // NewCreateAddressService new CreateAddressService
+func NewCreateAddressService(ctx context.Context) *CreateAddressService {
+	return &CreateAddressService{ctx: ctx}
+}
+
+// Run adds the address to the address book, as the default if it is the first one.
+func (s *CreateAddressService) Run(req *user.CreateAddressReq) (resp *user.CreateAddressResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	if err = validateAddress(req.Address); err != nil {
+		return nil, kerrors.NewBizStatusError(40000, err.Error())
+	}
+	a := model.Address{UserId: int(req.UserId)}
+	fromAddress(&a, req.Address)
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		_, err := model.GetByIdForUpdate(tx, s.ctx, a.UserId)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return kerrors.NewBizStatusError(40004, "user not found")
+		}
+		if err != nil {
+			return err
+		}
+		n, err := model.CountAddresses(tx, s.ctx, a.UserId)
+		if err != nil {
+			return err
+		}
+		if n >= maxAddresses {
+			return kerrors.NewBizStatusError(40000, fmt.Sprintf("the address book is full, it holds %d addresses", maxAddresses))
+		}
+		a.IsDefault = n == 0
+		return model.CreateAddress(tx, s.ctx, &a)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user.CreateAddressResp{Address: toAddress(a)}, nil
+}
@@ -0,0 +1,201 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"gorm.io/gorm"
+)
+
+// exportTimeout bounds how long each service may take to export the user's data.
+const exportTimeout = 10 * time.Second
+
+type exportedUser struct {
+	Email           string     `json:"email"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+	Name            string     `json:"name"`
+	Phone           string     `json:"phone"`
+	Locale          string     `json:"locale"`
+	Currency        string     `json:"currency"`
+	CreatedAt       time.Time  `json:"created_at"`
+	Roles           []string   `json:"roles"`
+
+	Addresses     []exportedAddress     `json:"addresses"`
+	Identities    []exportedIdentity    `json:"identities"`
+	Sessions      []exportedSession     `json:"sessions"`
+	TwoFactor     exportedTwoFactor     `json:"two_factor"`
+	Notifications exportedNotifications `json:"notifications"`
+}
+
+type exportedAddress struct {
+	Label     string `json:"label"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Street    string `json:"street"`
+	City      string `json:"city"`
+	Province  string `json:"province"`
+	Country   string `json:"country"`
+	ZipCode   string `json:"zip_code"`
+	Phone     string `json:"phone"`
+	IsDefault bool   `json:"is_default"`
+}
+
+type exportedIdentity struct {
+	Provider string    `json:"provider"`
+	Subject  string    `json:"subject"`
+	Email    string    `json:"email"`
+	LinkedAt time.Time `json:"linked_at"`
+}
+
+type exportedSession struct {
+	UserAgent  string     `json:"user_agent"`
+	IP         string     `json:"ip"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt time.Time  `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
+
+type exportedTwoFactor struct {
+	Enabled       bool  `json:"enabled"`
+	RecoveryCodes int64 `json:"recovery_codes_left"`
+}
+
+type exportedNotifications struct {
+	Phone       string                 `json:"phone"`
+	WebhookURL  string                 `json:"webhook_url"`
+	QuietStart  int                    `json:"quiet_start"`
+	QuietEnd    int                    `json:"quiet_end"`
+	Timezone    string                 `json:"timezone"`
+	Preferences []exportedNotification `json:"preferences"`
+}
+
+type exportedNotification struct {
+	Category string `json:"category"`
+	Channel  string `json:"channel"`
+	Enabled  bool   `json:"enabled"`
+}
+
+// exportUser collects what this service keeps about the user. Secrets, the password hash,
+// the TOTP secret, token hashes and the webhook secret, are left out.
+func exportUser(db *gorm.DB, ctx context.Context, u *model.User) ([]byte, error) {
+	e := exportedUser{
+		Email:           u.Email,
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		Name:            u.Name,
+		Phone:           u.Phone,
+		Locale:          u.Locale,
+		Currency:        u.Currency,
+		CreatedAt:       u.CreatedAt,
+		Addresses:       []exportedAddress{},
+		Identities:      []exportedIdentity{},
+		Sessions:        []exportedSession{},
+	}
+	var err error
+	if e.Roles, _, err = model.GetGrants(db, ctx, u.ID); err != nil {
+		return nil, err
+	}
+	addresses, err := model.ListAddresses(db, ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range addresses {
+		e.Addresses = append(e.Addresses, exportedAddress{
+			Label: a.Label, FirstName: a.FirstName, LastName: a.LastName, Street: a.Street, City: a.City,
+			Province: a.Province, Country: a.Country, ZipCode: a.ZipCode, Phone: a.Phone, IsDefault: a.IsDefault,
+		})
+	}
+	identities, err := model.ListUserIdentities(db, ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range identities {
+		e.Identities = append(e.Identities, exportedIdentity{Provider: i.Provider, Subject: i.Subject, Email: i.Email, LinkedAt: i.CreatedAt})
+	}
+	sessions, err := model.ListSessions(db, ctx, uint32(u.ID))
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range sessions {
+		e.Sessions = append(e.Sessions, exportedSession{UserAgent: s.UserAgent, IP: s.IP, CreatedAt: s.CreatedAt, LastUsedAt: s.LastUsedAt, RevokedAt: s.RevokedAt})
+	}
+	t, err := model.GetUserTotp(db, ctx, u.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	e.TwoFactor.Enabled = err == nil && t.Enabled()
+	if e.TwoFactor.Enabled {
+		if e.TwoFactor.RecoveryCodes, err = model.CountRecoveryCodes(db, ctx, u.ID); err != nil {
+			return nil, err
+		}
+	}
+	settings, err := model.GetNotificationSettings(db, ctx, uint32(u.ID))
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	e.Notifications = exportedNotifications{
+		Phone:       settings.Phone,
+		WebhookURL:  settings.WebhookURL,
+		QuietStart:  settings.QuietStart,
+		QuietEnd:    settings.QuietEnd,
+		Timezone:    settings.Timezone,
+		Preferences: []exportedNotification{},
+	}
+	prefs, err := model.ListNotificationPreferences(db, ctx, uint32(u.ID))
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range prefs {
+		e.Notifications.Preferences = append(e.Notifications.Preferences, exportedNotification{Category: p.Category, Channel: p.Channel, Enabled: p.Enabled})
+	}
+	return json.Marshal(e)
+}
+
+// zipFiles packs the JSON files into a zip, in the order of their names and indented to be
+// read by people.
+func zipFiles(files map[string][]byte) ([]byte, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, name := range names {
+		f, err := w.Create(name)
+		if err != nil {
+			return nil, err
+		}
+		var indented bytes.Buffer
+		if err = json.Indent(&indented, files[name], "", "  "); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if _, err = indented.WriteTo(f); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
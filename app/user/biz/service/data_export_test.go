@@ -0,0 +1,60 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestZipFiles(t *testing.T) {
+	data, err := zipFiles(map[string][]byte{
+		"user.json": []byte(`{"email":"a@example.com"}`),
+		"cart.json": []byte(`{"items":[]}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct{ name, content string }{
+		{"cart.json", "{\n  \"items\": []\n}"},
+		{"user.json", "{\n  \"email\": \"a@example.com\"\n}"},
+	}
+	if len(r.File) != len(want) {
+		t.Fatalf("got %d files, want %d", len(r.File), len(want))
+	}
+	for i, f := range r.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, _ := io.ReadAll(rc)
+		rc.Close()
+		if f.Name != want[i].name || string(content) != want[i].content {
+			t.Errorf("file %d is %s %q, want %s %q", i, f.Name, content, want[i].name, want[i].content)
+		}
+	}
+}
+
+func TestZipFilesRejectsInvalidJSON(t *testing.T) {
+	if _, err := zipFiles(map[string][]byte{"order.json": []byte("{")}); err == nil {
+		t.Error("invalid JSON was zipped")
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+type DeleteAccountService struct {
+	ctx context.Context
+} // NewDeleteAccountService new DeleteAccountService
+func NewDeleteAccountService(ctx context.Context) *DeleteAccountService {
+	return &DeleteAccountService{ctx: ctx}
+}
+
+// Run emails a link confirming the deletion of the account. A stolen session is not enough
+// to delete it, it takes the password and the mailbox, or only the mailbox for accounts
+// without a password.
+func (s *DeleteAccountService) Run(req *user.DeleteAccountReq) (resp *user.DeleteAccountResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	u, err := model.GetById(mysql.DB, s.ctx, int(req.UserId))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if u.PasswordHashed != "" && bcrypt.CompareHashAndPassword([]byte(u.PasswordHashed), []byte(req.Password)) != nil {
+		return nil, kerrors.NewBizStatusError(40100, "the password is wrong")
+	}
+	if last, err := lastAdmin(mysql.DB, s.ctx, u.ID); err != nil || last {
+		if err == nil {
+			err = errLastAdminDeletion
+		}
+		return nil, err
+	}
+	resp = &user.DeleteAccountResp{}
+	recent, err := recentlySent(mysql.DB, s.ctx, u.ID, model.PurposeDeleteAccount, time.Now())
+	if err != nil || recent {
+		return resp, err
+	}
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		return issueAccountToken(tx, s.ctx, u, model.PurposeDeleteAccount, deleteTTL())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type DeleteAddressService struct {
+	ctx context.Context
+} // NewDeleteAddressService new DeleteAddressService
+func NewDeleteAddressService(ctx context.Context) *DeleteAddressService {
+	return &DeleteAddressService{ctx: ctx}
+}
+
+// Run deletes the address, the oldest address left becomes the default if it was.
+func (s *DeleteAddressService) Run(req *user.DeleteAddressReq) (resp *user.DeleteAddressResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	userId := int(req.UserId)
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		_, err := model.GetByIdForUpdate(tx, s.ctx, userId)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errAddressNotFound
+		}
+		if err != nil {
+			return err
+		}
+		a, err := model.GetAddress(tx, s.ctx, userId, int(req.AddressId))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errAddressNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if err = model.DeleteAddress(tx, s.ctx, userId, a.ID); err != nil {
+			return err
+		}
+		if !a.IsDefault {
+			return nil
+		}
+		left, err := model.ListAddresses(tx, s.ctx, userId)
+		if err != nil || len(left) == 0 {
+			return err
+		}
+		return model.SetDefaultAddress(tx, s.ctx, userId, left[0].ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user.DeleteAddressResp{}, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+type DisableTotpService struct {
+	ctx context.Context
+} // NewDisableTotpService new DisableTotpService
+func NewDisableTotpService(ctx context.Context) *DisableTotpService {
+	return &DisableTotpService{ctx: ctx}
+}
+
+// Run turns two-factor authentication off. A stolen session is not enough for that, it takes
+// the password and a code of the app or a recovery code, or only the code for accounts
+// without a password. Wrong passwords and codes count as failed sign-ins, so they cannot be
+// guessed here past the sign-in limits.
+func (s *DisableTotpService) Run(req *user.DisableTotpReq) (resp *user.DisableTotpResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	u, err := model.GetById(mysql.DB, s.ctx, int(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	guard := newLoginGuard(s.ctx)
+	if err = checkLoginAllowed(s.ctx, guard, u.Email, req.Ip); err != nil {
+		return nil, err
+	}
+	if u.PasswordHashed != "" && bcrypt.CompareHashAndPassword([]byte(u.PasswordHashed), []byte(req.Password)) != nil {
+		recordLoginFailure(s.ctx, guard, u.Email, req.Ip, u, "wrong_password")
+		return nil, kerrors.NewBizStatusError(40100, "the password is wrong")
+	}
+	wrongCode := false
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		t, err := model.GetUserTotpForUpdate(tx, s.ctx, u.ID)
+		if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && !t.Enabled()) {
+			return errTotpNotEnabled
+		}
+		if err != nil {
+			return err
+		}
+		ok, err := useSecondFactor(tx, s.ctx, t, req.Code, time.Now())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			wrongCode = true
+			return errInvalidCode
+		}
+		return model.DeleteUserTotp(tx, s.ctx, u.ID)
+	})
+	if wrongCode {
+		recordLoginFailure(s.ctx, guard, u.Email, req.Ip, u, "wrong_code")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user.DisableTotpResp{}, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/totp"
+	"github.com/cloudwego/biz-demo/gomall/app/user/conf"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type EnrollTotpService struct {
+	ctx context.Context
+} // NewEnrollTotpService new EnrollTotpService
+func NewEnrollTotpService(ctx context.Context) *EnrollTotpService {
+	return &EnrollTotpService{ctx: ctx}
+}
+
+// Run hands out a new secret for the app, a pending enrollment starts over.
+func (s *EnrollTotpService) Run(req *user.EnrollTotpReq) (resp *user.EnrollTotpResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	u, err := model.GetById(mysql.DB, s.ctx, int(req.UserId))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	secret, err := totp.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		t, err := model.GetUserTotpForUpdate(tx, s.ctx, u.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if t.Enabled() {
+			return errTotpEnabled
+		}
+		return model.SaveUserTotp(tx, s.ctx, u.ID, secret)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user.EnrollTotpResp{
+		Secret:          secret,
+		ProvisioningUri: totp.URI(conf.GetConf().TOTP.Issuer, u.Email, secret),
+	}, nil
+}
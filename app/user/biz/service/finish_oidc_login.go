@@ -0,0 +1,96 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/klog"
+	goredis "github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+type FinishOidcLoginService struct {
+	ctx context.Context
+} // NewFinishOidcLoginService new FinishOidcLoginService
+func NewFinishOidcLoginService(ctx context.Context) *FinishOidcLoginService {
+	return &FinishOidcLoginService{ctx: ctx}
+}
+
+func (s *FinishOidcLoginService) Run(req *user.FinishOidcLoginReq) (resp *user.LoginResp, err error) {
+	if req.State == "" || req.Code == "" {
+		return nil, errInvalidOidcState
+	}
+	p, c, err := oidcProvider(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	st, err := model.TakeOidcState(s.ctx, redis.RedisClient, hashToken(req.State))
+	if errors.Is(err, goredis.Nil) || (err == nil && st.Provider != p.Name) {
+		return nil, errInvalidOidcState
+	}
+	if err != nil {
+		return nil, err
+	}
+	claims, err := c.Exchange(s.ctx, req.Code, oidcRedirectURI(p.Name), st.Verifier, st.Nonce)
+	if err != nil {
+		klog.CtxWarnf(s.ctx, "oidc sign-in with %s: %v", p.Name, err)
+		return nil, errOidcFailed
+	}
+
+	var u *model.User
+	err = mysql.DB.Transaction(func(tx *gorm.DB) (err error) {
+		u, err = oidcUser(tx, s.ctx, p.Name, claims, time.Now())
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	guard := newLoginGuard(s.ctx)
+	if err = checkLoginAllowed(s.ctx, guard, u.Email, req.Ip); err != nil {
+		return nil, err
+	}
+	otp, err := model.GetUserTotp(mysql.DB, s.ctx, u.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if otp.Enabled() {
+		return loginChallenge(s.ctx, u)
+	}
+	loginSucceeded(s.ctx, guard, u.Email)
+	var t tokens
+	err = mysql.DB.Transaction(func(tx *gorm.DB) (err error) {
+		t, err = startSession(tx, s.ctx, uint32(u.ID), req.UserAgent, req.Ip)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.LoginResp{
+		UserId:        int32(u.ID),
+		Token:         t.access,
+		RefreshToken:  t.refresh,
+		ExpiresIn:     int64(t.accessExpiry / time.Second),
+		SessionId:     t.sid,
+		EmailVerified: t.emailVerified,
+	}, nil
+}
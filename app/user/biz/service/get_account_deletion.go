@@ -0,0 +1,57 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type GetAccountDeletionService struct {
+	ctx context.Context
+} // NewGetAccountDeletionService new GetAccountDeletionService
+func NewGetAccountDeletionService(ctx context.Context) *GetAccountDeletionService {
+	return &GetAccountDeletionService{ctx: ctx}
+}
+
+// Run tells how far the deletion job got. The job id is hard to guess and the answer names
+// no one, so it needs no sign-in, the user is gone anyway.
+func (s *GetAccountDeletionService) Run(req *user.GetAccountDeletionReq) (resp *user.GetAccountDeletionResp, err error) {
+	if req.JobId == "" {
+		return nil, kerrors.NewBizStatusError(40000, "job id is required")
+	}
+	d, err := model.GetAccountDeletion(mysql.DB, s.ctx, req.JobId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "deletion job not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp = &user.GetAccountDeletionResp{
+		State:     d.State,
+		Steps:     d.StepList(),
+		CreatedAt: d.CreatedAt.Unix(),
+	}
+	if d.CompletedAt != nil {
+		resp.CompletedAt = d.CompletedAt.Unix()
+	}
+	return resp, nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/signing"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+)
+
+type GetJwksService struct {
+	ctx context.Context
+} // NewGetJwksService new GetJwksService
+func NewGetJwksService(ctx context.Context) *GetJwksService {
+	return &GetJwksService{ctx: ctx}
+}
+
+// Run returns the public signing keys as a JWKS document
+func (s *GetJwksService) Run(req *user.GetJwksReq) (resp *user.GetJwksResp, err error) {
+	set, err := signing.Default.JWKS()
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(set)
+	if err != nil {
+		return nil, err
+	}
+	return &user.GetJwksResp{Jwks: string(b)}, nil
+}
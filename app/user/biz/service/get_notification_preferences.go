@@ -0,0 +1,68 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type GetNotificationPreferencesService struct {
+	ctx context.Context
+} // NewGetNotificationPreferencesService new GetNotificationPreferencesService
+func NewGetNotificationPreferencesService(ctx context.Context) *GetNotificationPreferencesService {
+	return &GetNotificationPreferencesService{ctx: ctx}
+}
+
+// Run returns the notification preferences of the user with the defaults filled in
+func (s *GetNotificationPreferencesService) Run(req *user.GetNotificationPreferencesReq) (resp *user.GetNotificationPreferencesResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	u, err := model.GetById(mysql.DB, s.ctx, int(req.UserId))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	settings, err := getNotificationSettings(mysql.DB, s.ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	prefs, err := model.ListNotificationPreferences(mysql.DB, s.ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &user.GetNotificationPreferencesResp{
+		Email:       u.Email,
+		Preferences: preferenceMatrix(prefs),
+		Settings: &user.NotificationSettings{
+			Phone:         settings.Phone,
+			WebhookUrl:    settings.WebhookURL,
+			WebhookSecret: settings.WebhookSecret,
+			QuietStart:    int32(settings.QuietStart),
+			QuietEnd:      int32(settings.QuietEnd),
+			Timezone:      settings.Timezone,
+		},
+		UnsubscribeToken: settings.UnsubscribeToken,
+	}, nil
+}
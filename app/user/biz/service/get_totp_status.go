@@ -0,0 +1,61 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type GetTotpStatusService struct {
+	ctx context.Context
+} // NewGetTotpStatusService new GetTotpStatusService
+func NewGetTotpStatusService(ctx context.Context) *GetTotpStatusService {
+	return &GetTotpStatusService{ctx: ctx}
+}
+
+// Run tells whether two-factor authentication is on and how many recovery codes are left.
+func (s *GetTotpStatusService) Run(req *user.GetTotpStatusReq) (resp *user.GetTotpStatusResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	u, err := model.GetById(mysql.DB, s.ctx, int(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	resp = &user.GetTotpStatusResp{HasPassword: u.PasswordHashed != ""}
+	t, err := model.GetUserTotp(mysql.DB, s.ctx, int(req.UserId))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return resp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !t.Enabled() {
+		return resp, nil
+	}
+	left, err := model.CountRecoveryCodes(mysql.DB, s.ctx, t.UserId)
+	if err != nil {
+		return nil, err
+	}
+	resp.Enabled, resp.RecoveryCodesLeft = true, int32(left)
+	return resp, nil
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type ListAddressesService struct {
+	ctx context.Context
+} // NewListAddressesService new ListAddressesService
+func NewListAddressesService(ctx context.Context) *ListAddressesService {
+	return &ListAddressesService{ctx: ctx}
+}
+
+func (s *ListAddressesService) Run(req *user.ListAddressesReq) (resp *user.ListAddressesResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	addresses, err := model.ListAddresses(mysql.DB, s.ctx, int(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	resp = &user.ListAddressesResp{}
+	for _, a := range addresses {
+		resp.Addresses = append(resp.Addresses, toAddress(a))
+	}
+	return resp, nil
+}
@@ -0,0 +1,37 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/conf"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+)
+
+type ListOidcProvidersService struct {
+	ctx context.Context
+} // NewListOidcProvidersService new ListOidcProvidersService
+func NewListOidcProvidersService(ctx context.Context) *ListOidcProvidersService {
+	return &ListOidcProvidersService{ctx: ctx}
+}
+
+func (s *ListOidcProvidersService) Run(req *user.ListOidcProvidersReq) (resp *user.ListOidcProvidersResp, err error) {
+	resp = &user.ListOidcProvidersResp{}
+	for _, p := range conf.GetConf().OIDC.Providers {
+		resp.Providers = append(resp.Providers, &user.OidcProvider{Name: p.Name, DisplayName: p.DisplayName})
+	}
+	return resp, nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+)
+
+type ListRoleAuditService struct {
+	ctx context.Context
+} // NewListRoleAuditService new ListRoleAuditService
+func NewListRoleAuditService(ctx context.Context) *ListRoleAuditService {
+	return &ListRoleAuditService{ctx: ctx}
+}
+
+// Run returns the latest role changes.
+func (s *ListRoleAuditService) Run(req *user.ListRoleAuditReq) (resp *user.ListRoleAuditResp, err error) {
+	if err = authn.Require(s.ctx, authn.PermRolesManage); err != nil {
+		return nil, err
+	}
+	limit := int(req.Limit)
+	if limit <= 0 || limit > 500 {
+		limit = 50
+	}
+	entries, err := model.ListRoleAudit(mysql.DB, s.ctx, req.TargetUserId, limit)
+	if err != nil {
+		return nil, err
+	}
+	resp = &user.ListRoleAuditResp{}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, &user.RoleAuditEntry{
+			ActorUserId:  e.ActorUserId,
+			ActorService: e.ActorService,
+			TargetUserId: e.TargetUserId,
+			Role:         e.Role,
+			Action:       e.Action,
+			Reason:       e.Reason,
+			CreatedAt:    e.CreatedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
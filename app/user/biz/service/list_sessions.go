@@ -0,0 +1,55 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type ListSessionsService struct {
+	ctx context.Context
+} // NewListSessionsService new ListSessionsService
+func NewListSessionsService(ctx context.Context) *ListSessionsService {
+	return &ListSessionsService{ctx: ctx}
+}
+
+// Run lists the active sessions of the user
+func (s *ListSessionsService) Run(req *user.ListSessionsReq) (resp *user.ListSessionsResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	sessions, err := model.ListActiveSessions(mysql.DB, s.ctx, req.UserId, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	resp = &user.ListSessionsResp{}
+	for _, v := range sessions {
+		resp.Sessions = append(resp.Sessions, &user.Session{
+			SessionId:  v.Sid,
+			UserAgent:  v.UserAgent,
+			Ip:         v.IP,
+			CreatedAt:  v.CreatedAt.Unix(),
+			LastUsedAt: v.LastUsedAt.Unix(),
+			ExpiresAt:  v.ExpiresAt.Unix(),
+		})
+	}
+	return
+}
@@ -16,12 +16,16 @@ package service
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
 	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/redact"
 	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
 	"github.com/cloudwego/kitex/pkg/klog"
 	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
 )
 
 type LoginService struct {
@@ -34,30 +38,70 @@ func NewLoginService(ctx context.Context) *LoginService {
 // Run create note info
 func (s *LoginService) Run(req *user.LoginReq) (resp *user.LoginResp, err error) {
 	// Finish your business logic.
-	klog.Infof("LoginReq:%+v", req)
+	klog.CtxInfof(s.ctx, "LoginReq:%v", redact.Message(req))
+	guard := newLoginGuard(s.ctx)
+	if err = checkLoginAllowed(s.ctx, guard, req.Email, req.Ip); err != nil {
+		return nil, err
+	}
+
 	userRow, err := model.GetByEmail(mysql.DB, s.ctx, req.Email)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		recordLoginFailure(s.ctx, guard, req.Email, req.Ip, nil, "unknown_email")
+		return nil, errInvalidCredentials
+	}
 	if err != nil {
 		return
 	}
 	err = bcrypt.CompareHashAndPassword([]byte(userRow.PasswordHashed), []byte(req.Password))
 	if err != nil {
-		return
+		recordLoginFailure(s.ctx, guard, req.Email, req.Ip, userRow, "wrong_password")
+		return nil, errInvalidCredentials
+	}
+	// with two-factor authentication the failures are forgotten only once the code matches,
+	// or knowing the password would allow guessing codes forever
+	otp, err := model.GetUserTotp(mysql.DB, s.ctx, userRow.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
 	}
-	// 初始化认证服务并生成JWT令牌
-	authService := NewAuthService(s.ctx)
-	token, err := authService.GenerateToken(int(userRow.ID))
+	if otp.Enabled() {
+		return loginChallenge(s.ctx, userRow)
+	}
+	loginSucceeded(s.ctx, guard, req.Email)
+	// 登录即开启一个会话, 返回访问令牌和刷新令牌
+	var t tokens
+	err = mysql.DB.Transaction(func(tx *gorm.DB) (err error) {
+		t, err = startSession(tx, s.ctx, uint32(userRow.ID), req.UserAgent, req.Ip)
+		return
+	})
 	if err != nil {
 		return nil, err
 	}
-	// 输出 JWT 令牌到日志
-	klog.Infof("Generated JWT Token: %s", token)
 
-	// 返回登录响应和JWT令牌
 	resp = &user.LoginResp{
-		UserId: int32(userRow.ID),
-		Token:  token, // 假设 LoginResp 结构中有 Token 字段
+		UserId:        int32(userRow.ID),
+		Token:         t.access,
+		RefreshToken:  t.refresh,
+		ExpiresIn:     int64(t.accessExpiry / time.Second),
+		SessionId:     t.sid,
+		EmailVerified: t.emailVerified,
 	}
 
 	return resp, nil
 	//return &user.LoginResp{UserId: int32(userRow.ID)}, nil
 }
+
+// loginChallenge answers a sign-in of a user with two-factor authentication with a challenge
+// token, which VerifyTwoFactor trades for the tokens together with a code.
+func loginChallenge(ctx context.Context, u *model.User) (*user.LoginResp, error) {
+	token := randomToken()
+	err := model.CreateUserToken(mysql.DB, ctx, &model.UserToken{
+		UserId:    u.ID,
+		Purpose:   model.PurposeLoginChallenge,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(challengeTTL()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user.LoginResp{TwoFactorRequired: true, ChallengeToken: token}, nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/user/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/user/infra/metric"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+var errInvalidCredentials = kerrors.NewBizStatusError(40100, "invalid email or password")
+
+func loginPolicy() model.LoginPolicy {
+	c := conf.GetConf().Login
+	return model.LoginPolicy{
+		Window:       time.Duration(c.WindowMinutes) * time.Minute,
+		BackoffAfter: c.BackoffAfter,
+		BackoffBase:  time.Duration(c.BackoffBaseSeconds) * time.Second,
+		BackoffMax:   time.Duration(c.BackoffMaxSeconds) * time.Second,
+		LockAfter:    c.LockAfter,
+		LockFor:      time.Duration(c.LockMinutes) * time.Minute,
+		IPBlockAfter: c.IPBlockAfter,
+		IPBlockFor:   time.Duration(c.IPBlockMinutes) * time.Minute,
+	}
+}
+
+func newLoginGuard(ctx context.Context) model.LoginGuard {
+	return model.NewLoginGuard(ctx, redis.RedisClient, loginPolicy())
+}
+
+// blockedError tells the caller how long to wait, the same way for known and unknown emails.
+func blockedError(b model.LoginBlock) error {
+	wait := b.Wait.Round(time.Second)
+	if wait < time.Second {
+		wait = time.Second
+	}
+	if b.Locked {
+		return kerrors.NewBizStatusError(42900, fmt.Sprintf("too many failed sign-ins, sign-in is locked for %s", wait))
+	}
+	return kerrors.NewBizStatusError(42900, fmt.Sprintf("too many failed sign-ins, try again in %s", wait))
+}
+
+// checkLoginAllowed refuses sign-ins while the account or address waits or is locked.
+func checkLoginAllowed(ctx context.Context, guard model.LoginGuard, email, ip string) error {
+	block, err := guard.Check(email, ip)
+	if err != nil {
+		// a Redis outage must not keep everyone out
+		klog.CtxWarnf(ctx, "login guard unavailable: %v", err)
+		return nil
+	}
+	if block.Wait <= 0 {
+		return nil
+	}
+	reason := "backoff"
+	if block.Locked {
+		reason = "locked_" + block.Scope
+	}
+	metric.LoginFailed.WithLabelValues(reason).Inc()
+	return blockedError(block)
+}
+
+func loginSucceeded(ctx context.Context, guard model.LoginGuard, email string) {
+	if err := guard.Succeed(email); err != nil {
+		klog.CtxWarnf(ctx, "login guard unavailable: %v", err)
+	}
+}
+
+// recordLoginFailure counts the failed sign-in and, when it locks the account, emails the
+// owner u a link to unlock it. Unknown emails are counted too, so locks do not tell which
+// accounts exist.
+func recordLoginFailure(ctx context.Context, guard model.LoginGuard, email, ip string, u *model.User, reason string) {
+	metric.LoginFailed.WithLabelValues(reason).Inc()
+	locked, err := guard.Fail(email, ip)
+	if err != nil {
+		klog.CtxWarnf(ctx, "login guard unavailable: %v", err)
+	}
+	for _, scope := range locked {
+		metric.LoginLockouts.WithLabelValues(scope).Inc()
+		if scope == model.LockScopeIP {
+			klog.CtxWarnf(ctx, "sign-ins from %s blocked after too many failures", ip)
+			continue
+		}
+		if u == nil {
+			continue
+		}
+		klog.CtxWarnf(ctx, "user %d locked after too many failed sign-ins", u.ID)
+		err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+			return issueAccountToken(tx, ctx, u, model.PurposeUnlockAccount, loginPolicy().LockFor)
+		})
+		if err != nil {
+			klog.CtxErrorf(ctx, "email unlock link to user %d failed: %v", u.ID, err)
+		}
+	}
+}
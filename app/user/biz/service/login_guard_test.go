@@ -0,0 +1,46 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+)
+
+func TestLoginBackoff(t *testing.T) {
+	p := model.LoginPolicy{BackoffAfter: 3, BackoffBase: time.Second, BackoffMax: time.Minute}
+	tests := []struct {
+		failures int
+		wait     time.Duration
+	}{
+		{0, 0},
+		{2, 0},
+		{3, time.Second},
+		{4, 2 * time.Second},
+		{6, 8 * time.Second},
+		{9, time.Minute},
+		{1000, time.Minute},
+	}
+	for _, tt := range tests {
+		if got := p.Backoff(tt.failures); got != tt.wait {
+			t.Errorf("Backoff(%d) = %v, want %v", tt.failures, got, tt.wait)
+		}
+	}
+	if got := (model.LoginPolicy{}).Backoff(100); got != 0 {
+		t.Errorf("backoff without a policy = %v", got)
+	}
+}
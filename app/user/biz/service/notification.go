@@ -0,0 +1,122 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"gorm.io/gorm"
+)
+
+var phonePattern = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+func newToken() string {
+	b := make([]byte, 24)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func contains(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// getNotificationSettings loads the settings of the user, creating them with new tokens
+// on first use.
+func getNotificationSettings(db *gorm.DB, ctx context.Context, userId uint32) (model.NotificationSettings, error) {
+	s, err := model.GetNotificationSettings(db, ctx, userId)
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return s, err
+	}
+	err = model.CreateNotificationSettings(db, ctx, &model.NotificationSettings{
+		UserId:           userId,
+		WebhookSecret:    newToken(),
+		UnsubscribeToken: newToken(),
+	})
+	if err != nil {
+		return s, err
+	}
+	return model.GetNotificationSettings(db, ctx, userId)
+}
+
+// preferenceMatrix lists every category and channel, the stored choices over the defaults.
+func preferenceMatrix(stored []model.NotificationPreference) (prefs []*user.NotificationPreference) {
+	enabled := map[[2]string]bool{}
+	for _, p := range stored {
+		enabled[[2]string{p.Category, p.Channel}] = p.Enabled
+	}
+	for _, category := range model.Categories {
+		for _, channel := range model.Channels {
+			on, ok := enabled[[2]string{category, channel}]
+			if !ok {
+				on = model.DefaultEnabled(category, channel)
+			}
+			prefs = append(prefs, &user.NotificationPreference{Category: category, Channel: channel, Enabled: on})
+		}
+	}
+	return
+}
+
+// validateSettings checks the settings a user may change.
+func validateSettings(s *user.NotificationSettings) error {
+	if s.Phone != "" && !phonePattern.MatchString(s.Phone) {
+		return fmt.Errorf("phone must be in international format, e.g. +8613800000000")
+	}
+	if s.WebhookUrl != "" {
+		u, err := url.Parse(s.WebhookUrl)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return fmt.Errorf("webhook url must be an http or https url")
+		}
+	}
+	if s.QuietStart < 0 || s.QuietStart >= 24*60 || s.QuietEnd < 0 || s.QuietEnd >= 24*60 {
+		return fmt.Errorf("quiet hours must be between 00:00 and 23:59")
+	}
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return fmt.Errorf("unknown timezone %q", s.Timezone)
+	}
+	return nil
+}
+
+// validatePreferences checks that every enabled channel can be delivered to.
+func validatePreferences(prefs []*user.NotificationPreference, s model.NotificationSettings) error {
+	for _, p := range prefs {
+		if !contains(model.Categories, p.Category) || !contains(model.Channels, p.Channel) {
+			return fmt.Errorf("unknown notification %s by %s", p.Category, p.Channel)
+		}
+		if !p.Enabled {
+			continue
+		}
+		if p.Channel == model.ChannelSMS && s.Phone == "" {
+			return fmt.Errorf("a phone number is needed for SMS")
+		}
+		if p.Channel == model.ChannelWebhook && s.WebhookURL == "" {
+			return fmt.Errorf("a webhook url is needed for webhooks")
+		}
+	}
+	return nil
+}
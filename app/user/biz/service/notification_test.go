@@ -0,0 +1,78 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+)
+
+func TestPreferenceMatrix(t *testing.T) {
+	prefs := preferenceMatrix([]model.NotificationPreference{
+		{Category: model.CategoryOrder, Channel: model.ChannelEmail, Enabled: false},
+		{Category: model.CategoryMarketing, Channel: model.ChannelSMS, Enabled: true},
+	})
+	if len(prefs) != len(model.Categories)*len(model.Channels) {
+		t.Fatalf("got %d preferences", len(prefs))
+	}
+	want := map[string]bool{
+		"order/email":     false,
+		"account/email":   true,
+		"marketing/email": false,
+		"marketing/sms":   true,
+		"order/webhook":   false,
+	}
+	for _, p := range prefs {
+		if on, ok := want[p.Category+"/"+p.Channel]; ok && p.Enabled != on {
+			t.Errorf("%s/%s enabled = %v, want %v", p.Category, p.Channel, p.Enabled, on)
+		}
+	}
+}
+
+func TestValidateSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       *user.NotificationSettings
+		wantErr bool
+	}{
+		{"empty", &user.NotificationSettings{}, false},
+		{"valid", &user.NotificationSettings{Phone: "+8613800000000", WebhookUrl: "https://example.com/hook", QuietStart: 22 * 60, QuietEnd: 7 * 60, Timezone: "Asia/Shanghai"}, false},
+		{"local phone", &user.NotificationSettings{Phone: "13800000000"}, true},
+		{"webhook scheme", &user.NotificationSettings{WebhookUrl: "ftp://example.com"}, true},
+		{"quiet hours", &user.NotificationSettings{QuietEnd: 24 * 60}, true},
+		{"timezone", &user.NotificationSettings{Timezone: "Mars/Olympus"}, true},
+	}
+	for _, tt := range tests {
+		if err := validateSettings(tt.s); (err != nil) != tt.wantErr {
+			t.Errorf("%s: err = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestValidatePreferences(t *testing.T) {
+	sms := []*user.NotificationPreference{{Category: model.CategoryOrder, Channel: model.ChannelSMS, Enabled: true}}
+	if err := validatePreferences(sms, model.NotificationSettings{}); err == nil {
+		t.Error("SMS without a phone number was accepted")
+	}
+	if err := validatePreferences(sms, model.NotificationSettings{Phone: "+8613800000000"}); err != nil {
+		t.Error(err)
+	}
+	unknown := []*user.NotificationPreference{{Category: "news", Channel: model.ChannelEmail}}
+	if err := validatePreferences(unknown, model.NotificationSettings{}); err == nil {
+		t.Error("unknown category was accepted")
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/oidc"
+	"github.com/cloudwego/biz-demo/gomall/app/user/conf"
+	"github.com/cloudwego/biz-demo/gomall/common/eventbus"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/events"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+var (
+	errUnknownProvider    = kerrors.NewBizStatusError(40004, "unknown sign-in provider")
+	errInvalidOidcState   = kerrors.NewBizStatusError(40100, "the sign-in has expired, please try again")
+	errOidcFailed         = kerrors.NewBizStatusError(40100, "the provider did not confirm the sign-in")
+	errOidcEmailNotShared = kerrors.NewBizStatusError(40300, "the provider did not confirm an email address for the account")
+	errOidcLinkUnverified = kerrors.NewBizStatusError(40900, "an account with this email exists, sign in with its password or reset it to link the provider")
+)
+
+// the clients are kept for the life of the service so they cache the provider keys
+var (
+	oidcOnce    sync.Once
+	oidcClients map[string]*oidc.Client
+)
+
+func oidcProvider(name string) (conf.OIDCProvider, *oidc.Client, error) {
+	oidcOnce.Do(func() {
+		oidcClients = make(map[string]*oidc.Client)
+		for _, p := range conf.GetConf().OIDC.Providers {
+			oidcClients[p.Name] = oidc.NewClient(oidc.Config{
+				Issuer:       p.Issuer,
+				ClientID:     p.ClientID,
+				ClientSecret: p.ClientSecret,
+				Scopes:       p.Scopes,
+			}, nil)
+		}
+	})
+	for _, p := range conf.GetConf().OIDC.Providers {
+		if p.Name == name {
+			return p, oidcClients[name], nil
+		}
+	}
+	return conf.OIDCProvider{}, nil, errUnknownProvider
+}
+
+func oidcStateTTL() time.Duration {
+	return time.Duration(conf.GetConf().OIDC.StateTTLMinutes) * time.Minute
+}
+
+// oidcRedirectURI is the frontend callback the provider sends the browser back to, it has
+// to be registered with the provider as is.
+func oidcRedirectURI(provider string) string {
+	return strings.TrimRight(conf.GetConf().Account.BaseURL, "/") + "/auth/oidc/" + provider + "/callback"
+}
+
+// oidcUser finds the user the provider account c belongs to. An account seen before
+// signs in its linked user. Otherwise the email the provider verified links it to the user
+// with that email, if the user verified it too, or to a new user.
+func oidcUser(tx *gorm.DB, ctx context.Context, provider string, c *oidc.Claims, now time.Time) (*model.User, error) {
+	ident, err := model.GetUserIdentity(tx, ctx, provider, c.Subject)
+	if err == nil {
+		if c.Email != "" && c.Email != ident.Email {
+			if err = model.UpdateUserIdentityEmail(tx, ctx, ident.ID, c.Email); err != nil {
+				return nil, err
+			}
+		}
+		return model.GetById(tx, ctx, ident.UserId)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if c.Email == "" || !c.EmailVerified {
+		return nil, errOidcEmailNotShared
+	}
+	u, err := model.GetByEmail(tx, ctx, c.Email)
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		// without a password, one can be set with a password reset
+		u = &model.User{Email: c.Email, EmailVerifiedAt: &now}
+		if err = model.Create(tx, ctx, u); err != nil {
+			return nil, err
+		}
+		err = eventbus.AddToOutbox(tx, ctx, &events.UserRegistered{
+			UserId:       uint32(u.ID),
+			Email:        u.Email,
+			RegisteredAt: u.CreatedAt.Unix(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case u.EmailVerifiedAt == nil:
+		// whoever registered the email never proved to own it, linking would hand their
+		// account to the provider account or the other way round
+		return nil, errOidcLinkUnverified
+	}
+	err = model.CreateUserIdentity(tx, ctx, &model.UserIdentity{
+		UserId:   u.ID,
+		Provider: provider,
+		Subject:  c.Subject,
+		Email:    c.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
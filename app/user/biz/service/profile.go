@@ -0,0 +1,140 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+const (
+	// maxAddresses keeps the address book to a size a form can list
+	maxAddresses = 20
+	maxFieldLen  = 128
+)
+
+var (
+	// locales are the ones the shop has emails in, currencies the ones it shows prices in
+	locales    = []string{"en", "zh"}
+	currencies = []string{"USD", "CNY", "EUR"}
+
+	errAddressNotFound = kerrors.NewBizStatusError(40004, "address not found")
+)
+
+func toProfile(u *model.User) *user.Profile {
+	return &user.Profile{
+		UserId:      uint32(u.ID),
+		Email:       u.Email,
+		Name:        u.Name,
+		Phone:       u.Phone,
+		Locale:      u.Locale,
+		Currency:    u.Currency,
+		HasPassword: u.PasswordHashed != "",
+	}
+}
+
+func toAddress(a model.Address) *user.Address {
+	return &user.Address{
+		Id:        uint32(a.ID),
+		Label:     a.Label,
+		FirstName: a.FirstName,
+		LastName:  a.LastName,
+		Street:    a.Street,
+		City:      a.City,
+		Province:  a.Province,
+		Country:   a.Country,
+		ZipCode:   a.ZipCode,
+		Phone:     a.Phone,
+		IsDefault: a.IsDefault,
+	}
+}
+
+// fromAddress copies the fields of a a user may change into m.
+func fromAddress(m *model.Address, a *user.Address) {
+	m.Label = a.Label
+	m.FirstName = a.FirstName
+	m.LastName = a.LastName
+	m.Street = a.Street
+	m.City = a.City
+	m.Province = a.Province
+	m.Country = a.Country
+	m.ZipCode = a.ZipCode
+	m.Phone = a.Phone
+}
+
+// validateProfile trims the profile and checks it, an empty locale or currency leaves the
+// choice to the shop.
+func validateProfile(req *user.UpdateProfileReq) error {
+	req.Name = strings.TrimSpace(req.Name)
+	req.Phone = strings.TrimSpace(req.Phone)
+	req.Locale = strings.ToLower(strings.TrimSpace(req.Locale))
+	req.Currency = strings.ToUpper(strings.TrimSpace(req.Currency))
+	if utf8.RuneCountInString(req.Name) > maxFieldLen {
+		return fmt.Errorf("name must be at most %d characters", maxFieldLen)
+	}
+	if req.Phone != "" && !phonePattern.MatchString(req.Phone) {
+		return fmt.Errorf("phone must be in international format, e.g. +8613800000000")
+	}
+	if req.Locale != "" && !contains(locales, req.Locale) {
+		return fmt.Errorf("locale must be one of %s", strings.Join(locales, ", "))
+	}
+	if req.Currency != "" && !contains(currencies, req.Currency) {
+		return fmt.Errorf("currency must be one of %s", strings.Join(currencies, ", "))
+	}
+	return nil
+}
+
+// validateAddress trims the address and checks it has what a delivery needs.
+func validateAddress(a *user.Address) error {
+	if a == nil {
+		return fmt.Errorf("address is required")
+	}
+	fields := []struct {
+		name     string
+		v        *string
+		required bool
+	}{
+		{"label", &a.Label, false},
+		{"first name", &a.FirstName, true},
+		{"last name", &a.LastName, true},
+		{"street", &a.Street, true},
+		{"city", &a.City, true},
+		{"province", &a.Province, false},
+		{"country", &a.Country, true},
+		{"zip code", &a.ZipCode, true},
+		{"phone", &a.Phone, false},
+	}
+	for _, f := range fields {
+		*f.v = strings.TrimSpace(*f.v)
+		if f.required && *f.v == "" {
+			return fmt.Errorf("%s is required", f.name)
+		}
+		if utf8.RuneCountInString(*f.v) > maxFieldLen {
+			return fmt.Errorf("%s must be at most %d characters", f.name, maxFieldLen)
+		}
+	}
+	if len(a.ZipCode) > 32 {
+		return fmt.Errorf("zip code must be at most 32 characters")
+	}
+	if a.Phone != "" && !phonePattern.MatchString(a.Phone) {
+		return fmt.Errorf("phone must be in international format, e.g. +8613800000000")
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+)
+
+func TestValidateProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *user.UpdateProfileReq
+		wantErr bool
+	}{
+		{"empty", &user.UpdateProfileReq{}, false},
+		{"valid", &user.UpdateProfileReq{Name: "Li Lei", Phone: "+8613800000000", Locale: "zh", Currency: "CNY"}, false},
+		{"local phone", &user.UpdateProfileReq{Phone: "13800000000"}, true},
+		{"locale", &user.UpdateProfileReq{Locale: "fr"}, true},
+		{"currency", &user.UpdateProfileReq{Currency: "XYZ"}, true},
+	}
+	for _, tt := range tests {
+		if err := validateProfile(tt.req); (err != nil) != tt.wantErr {
+			t.Errorf("%s: err = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+
+	req := &user.UpdateProfileReq{Name: " Li Lei ", Locale: "ZH", Currency: "usd"}
+	if err := validateProfile(req); err != nil {
+		t.Fatal(err)
+	}
+	if req.Name != "Li Lei" || req.Locale != "zh" || req.Currency != "USD" {
+		t.Errorf("not normalized: %+v", req)
+	}
+}
+
+func TestValidateAddress(t *testing.T) {
+	valid := func() *user.Address {
+		return &user.Address{FirstName: "Lei", LastName: "Li", Street: "7th street", City: "Hangzhou", Country: "China", ZipCode: "310000"}
+	}
+	if err := validateAddress(valid()); err != nil {
+		t.Fatal(err)
+	}
+	a := valid()
+	a.Street = "   "
+	if err := validateAddress(a); err == nil {
+		t.Error("blank street was accepted")
+	}
+	a = valid()
+	a.Phone = "0571"
+	if err := validateAddress(a); err == nil {
+		t.Error("local phone was accepted")
+	}
+	if err := validateAddress(nil); err == nil {
+		t.Error("no address was accepted")
+	}
+}
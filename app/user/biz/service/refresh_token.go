@@ -0,0 +1,112 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+// reuseGrace is how long a used refresh token is answered with errRefreshInProgress instead
+// of revoking the session, as concurrent requests of one client refresh at the same time.
+const reuseGrace = 30 * time.Second
+
+var errRefreshInProgress = kerrors.NewBizStatusError(40900, "refresh token was just used")
+
+type RefreshTokenService struct {
+	ctx context.Context
+} // NewRefreshTokenService new RefreshTokenService
+func NewRefreshTokenService(ctx context.Context) *RefreshTokenService {
+	return &RefreshTokenService{ctx: ctx}
+}
+
+// Run swaps the refresh token for a new one and a new access token. A refresh token that
+// was swapped before, longer than reuseGrace ago, has leaked: the session is revoked so
+// neither copy works anymore.
+func (s *RefreshTokenService) Run(req *user.RefreshTokenReq) (resp *user.RefreshTokenResp, err error) {
+	if req.RefreshToken == "" {
+		return nil, kerrors.NewBizStatusError(40000, "refresh token is required")
+	}
+	var (
+		t       tokens
+		userId  uint32
+		reused  bool
+		revoked *model.Session
+	)
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		rt, err := model.GetRefreshTokenForUpdate(tx, s.ctx, hashToken(req.RefreshToken))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errInvalidRefreshToken
+		}
+		if err != nil {
+			return err
+		}
+		session, err := model.GetSessionForUpdate(tx, s.ctx, rt.SessionId)
+		if err != nil {
+			return err
+		}
+		if !session.Active(now) || !rt.ExpiresAt.After(now) {
+			return errInvalidRefreshToken
+		}
+		if rt.UsedAt != nil && now.Sub(*rt.UsedAt) < reuseGrace {
+			return errRefreshInProgress
+		}
+		if rt.UsedAt != nil {
+			reused, revoked = true, &session
+			return model.UpdateSession(tx, s.ctx, session.ID, map[string]any{"revoked_at": now})
+		}
+		if err = model.MarkRefreshTokenUsed(tx, s.ctx, rt.ID, now); err != nil {
+			return err
+		}
+		session.LastUsedAt = now
+		session.ExpiresAt = now.Add(refreshTTL())
+		err = model.UpdateSession(tx, s.ctx, session.ID, map[string]any{
+			"last_used_at": session.LastUsedAt,
+			"expires_at":   session.ExpiresAt,
+			"user_agent":   truncate(req.UserAgent, 512),
+			"ip":           truncate(req.Ip, 64),
+		})
+		if err != nil {
+			return err
+		}
+		userId = session.UserId
+		t, err = issueTokens(tx, s.ctx, &session, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if reused {
+		klog.CtxWarnf(s.ctx, "refresh token of session %s of user %d reused, session revoked", revoked.Sid, revoked.UserId)
+		return nil, errInvalidRefreshToken
+	}
+	return &user.RefreshTokenResp{
+		UserId:        int32(userId),
+		Token:         t.access,
+		RefreshToken:  t.refresh,
+		ExpiresIn:     int64(t.accessExpiry / time.Second),
+		SessionId:     t.sid,
+		EmailVerified: t.emailVerified,
+	}, nil
+}
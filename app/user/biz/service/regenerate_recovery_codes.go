@@ -0,0 +1,66 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type RegenerateRecoveryCodesService struct {
+	ctx context.Context
+} // NewRegenerateRecoveryCodesService new RegenerateRecoveryCodesService
+func NewRegenerateRecoveryCodesService(ctx context.Context) *RegenerateRecoveryCodesService {
+	return &RegenerateRecoveryCodesService{ctx: ctx}
+}
+
+// Run replaces the recovery codes after a code of the app, the new ones are returned this once.
+func (s *RegenerateRecoveryCodesService) Run(req *user.RegenerateRecoveryCodesReq) (resp *user.RegenerateRecoveryCodesResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		t, err := model.GetUserTotpForUpdate(tx, s.ctx, int(req.UserId))
+		if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && !t.Enabled()) {
+			return errTotpNotEnabled
+		}
+		if err != nil {
+			return err
+		}
+		ok, err := useSecondFactor(tx, s.ctx, t, req.Code, time.Now())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errInvalidCode
+		}
+		return model.ReplaceRecoveryCodes(tx, s.ctx, t.UserId, hashes)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user.RegenerateRecoveryCodesResp{RecoveryCodes: codes}, nil
+}
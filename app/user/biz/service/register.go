@@ -20,8 +20,11 @@ import (
 
 	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
 	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/eventbus"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/events"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
 	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
 )
 
 type RegisterService struct {
@@ -46,7 +49,21 @@ func (s *RegisterService) Run(req *user.RegisterReq) (resp *user.RegisterResp, e
 		Email:          req.Email,
 		PasswordHashed: string(hashedPassword),
 	}
-	if err = model.Create(mysql.DB, s.ctx, newUser); err != nil {
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		if err := model.Create(tx, s.ctx, newUser); err != nil {
+			return err
+		}
+		err := eventbus.AddToOutbox(tx, s.ctx, &events.UserRegistered{
+			UserId:       uint32(newUser.ID),
+			Email:        newUser.Email,
+			RegisteredAt: newUser.CreatedAt.Unix(),
+		})
+		if err != nil {
+			return err
+		}
+		return issueAccountToken(tx, s.ctx, newUser, model.PurposeVerifyEmail, verifyTTL())
+	})
+	if err != nil {
 		return
 	}
 
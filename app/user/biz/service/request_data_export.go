@@ -0,0 +1,116 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/user/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type RequestDataExportService struct {
+	ctx context.Context
+} // NewRequestDataExportService new RequestDataExportService
+func NewRequestDataExportService(ctx context.Context) *RequestDataExportService {
+	return &RequestDataExportService{ctx: ctx}
+}
+
+// Run asks every service for the user's data at once and zips the answers, one JSON file
+// per service. An export missing a service would look complete, so any failure fails it.
+func (s *RequestDataExportService) Run(req *user.RequestDataExportReq) (resp *user.RequestDataExportResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	u, err := model.GetById(mysql.DB, s.ctx, int(req.UserId))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	exports := map[string]func(ctx context.Context) ([]byte, error){
+		"user": func(ctx context.Context) ([]byte, error) {
+			return exportUser(mysql.DB, ctx, u)
+		},
+		"cart": func(ctx context.Context) ([]byte, error) {
+			r, err := rpc.CartClient.ExportUserData(ctx, &cart.ExportUserDataReq{UserId: req.UserId})
+			return r.GetData(), err
+		},
+		"order": func(ctx context.Context) ([]byte, error) {
+			r, err := rpc.OrderClient.ExportUserData(ctx, &order.ExportUserDataReq{UserId: req.UserId})
+			return r.GetData(), err
+		},
+		"payment": func(ctx context.Context) ([]byte, error) {
+			r, err := rpc.PaymentClient.ExportUserData(ctx, &payment.ExportUserDataReq{UserId: req.UserId})
+			return r.GetData(), err
+		},
+		"shipping": func(ctx context.Context) ([]byte, error) {
+			r, err := rpc.ShippingClient.ExportUserData(ctx, &shipping.ExportUserDataReq{UserId: req.UserId})
+			return r.GetData(), err
+		},
+		"email": func(ctx context.Context) ([]byte, error) {
+			r, err := rpc.EmailClient.ExportUserData(ctx, &email.ExportUserDataReq{UserId: req.UserId, Email: u.Email})
+			return r.GetData(), err
+		},
+	}
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		files = map[string][]byte{}
+		errs  []error
+	)
+	for name, export := range exports {
+		wg.Add(1)
+		go func(name string, export func(ctx context.Context) ([]byte, error)) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(s.ctx, exportTimeout)
+			defer cancel()
+			data, err := export(ctx)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("export %s: %w", name, err))
+				return
+			}
+			files[name+".json"] = data
+		}(name, export)
+	}
+	wg.Wait()
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	data, err := zipFiles(files)
+	if err != nil {
+		return nil, err
+	}
+	return &user.RequestDataExportResp{
+		Data:     data,
+		Filename: fmt.Sprintf("gomall-data-%d-%s.zip", u.ID, time.Now().Format("20060102")),
+	}, nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type RequestPasswordResetService struct {
+	ctx context.Context
+} // NewRequestPasswordResetService new RequestPasswordResetService
+func NewRequestPasswordResetService(ctx context.Context) *RequestPasswordResetService {
+	return &RequestPasswordResetService{ctx: ctx}
+}
+
+// Run emails a reset link to the account of the email. Unknown emails get the same answer
+// and nothing is sent.
+func (s *RequestPasswordResetService) Run(req *user.RequestPasswordResetReq) (resp *user.RequestPasswordResetResp, err error) {
+	if req.Email == "" {
+		return nil, kerrors.NewBizStatusError(40000, "email is required")
+	}
+	resp = &user.RequestPasswordResetResp{}
+	u, err := model.GetByEmail(mysql.DB, s.ctx, req.Email)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return resp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	recent, err := recentlySent(mysql.DB, s.ctx, u.ID, model.PurposeResetPassword, time.Now())
+	if err != nil || recent {
+		return resp, err
+	}
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		return issueAccountToken(tx, s.ctx, u, model.PurposeResetPassword, resetTTL())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+type ResetPasswordService struct {
+	ctx context.Context
+} // NewResetPasswordService new ResetPasswordService
+func NewResetPasswordService(ctx context.Context) *ResetPasswordService {
+	return &ResetPasswordService{ctx: ctx}
+}
+
+// Run sets the new password and ends every session, whoever knew the old password is
+// signed out. The link reached the inbox, so the email counts as verified too, and the
+// account is unlocked if failed sign-ins locked it.
+func (s *ResetPasswordService) Run(req *user.ResetPasswordReq) (resp *user.ResetPasswordResp, err error) {
+	if req.Password == "" {
+		return nil, kerrors.NewBizStatusError(40000, "password is required")
+	}
+	if req.Password != req.ConfirmPassword {
+		return nil, kerrors.NewBizStatusError(40000, "Password must be the same as ConfirmPassword")
+	}
+	hashed, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	var u *model.User
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		t, err := useAccountToken(tx, s.ctx, req.Token, model.PurposeResetPassword, now)
+		if err != nil {
+			return err
+		}
+		userId := t.UserId
+		if u, err = model.GetById(tx, s.ctx, userId); err != nil {
+			return err
+		}
+		if err = model.UpdatePassword(tx, s.ctx, userId, string(hashed)); err != nil {
+			return err
+		}
+		if err = model.MarkEmailVerified(tx, s.ctx, userId, now); err != nil {
+			return err
+		}
+		_, err = model.RevokeSessions(tx, s.ctx, uint32(userId), nil, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = newLoginGuard(s.ctx).Unlock(u.Email); err != nil {
+		klog.CtxWarnf(s.ctx, "unlock user %d after password reset: %v", u.ID, err)
+	}
+	return &user.ResetPasswordResp{UserId: uint32(u.ID)}, nil
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+)
+
+type RevokeRoleService struct {
+	ctx context.Context
+} // NewRevokeRoleService new RevokeRoleService
+func NewRevokeRoleService(ctx context.Context) *RevokeRoleService {
+	return &RevokeRoleService{ctx: ctx}
+}
+
+// Run revokes the role. Access tokens already issued keep it until they expire.
+func (s *RevokeRoleService) Run(req *user.RevokeRoleReq) (resp *user.RevokeRoleResp, err error) {
+	if err = authn.Require(s.ctx, authn.PermRolesManage); err != nil {
+		return nil, err
+	}
+	roles, changed, err := ChangeRole(mysql.DB, s.ctx, actorFromContext(s.ctx), req.TargetUserId, req.Role, false, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &user.RevokeRoleResp{Roles: roles, Changed: changed}, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type RevokeTokenService struct {
+	ctx context.Context
+} // NewRevokeTokenService new RevokeTokenService
+func NewRevokeTokenService(ctx context.Context) *RevokeTokenService {
+	return &RevokeTokenService{ctx: ctx}
+}
+
+// Run ends sessions. Their access tokens stay valid until they expire, which is soon.
+func (s *RevokeTokenService) Run(req *user.RevokeTokenReq) (resp *user.RevokeTokenResp, err error) {
+	userId := req.UserId
+	var sids []string
+	if req.RefreshToken != "" {
+		rt, err := model.GetRefreshToken(mysql.DB, s.ctx, hashToken(req.RefreshToken))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errInvalidRefreshToken
+		}
+		if err != nil {
+			return nil, err
+		}
+		session, err := model.GetSession(mysql.DB, s.ctx, rt.SessionId)
+		if err != nil {
+			return nil, err
+		}
+		if userId != 0 && userId != session.UserId {
+			return nil, errInvalidRefreshToken
+		}
+		userId = session.UserId
+		sids = []string{session.Sid}
+	}
+	if req.SessionId != "" {
+		sids = append(sids, req.SessionId)
+	}
+	if userId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "refresh token or user id is required")
+	}
+	if req.All {
+		sids = nil
+	} else if len(sids) == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "no session given")
+	}
+	n, err := model.RevokeSessions(mysql.DB, s.ctx, userId, sids, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &user.RevokeTokenResp{Revoked: int32(n)}, nil
+}
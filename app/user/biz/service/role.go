@@ -0,0 +1,105 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+// Actor is who changes a role, for the audit log.
+type Actor struct {
+	UserId  uint32
+	Service string
+}
+
+func actorFromContext(ctx context.Context) Actor {
+	p, _ := authn.FromContext(ctx)
+	if p == nil {
+		return Actor{}
+	}
+	return Actor{UserId: p.UserId, Service: p.Service}
+}
+
+// ChangeRole grants or revokes the role of the user and audits it. It tells whether the
+// user's roles changed, which are returned.
+func ChangeRole(db *gorm.DB, ctx context.Context, actor Actor, userId uint32, roleName string, grant bool, reason string) (roles []string, changed bool, err error) {
+	if userId == 0 || roleName == "" {
+		return nil, false, kerrors.NewBizStatusError(40000, "user and role are required")
+	}
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if _, err := model.GetById(tx, ctx, int(userId)); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return kerrors.NewBizStatusError(40004, "user not found")
+			}
+			return err
+		}
+		role, err := model.GetRoleByName(tx, ctx, roleName)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return kerrors.NewBizStatusError(40004, fmt.Sprintf("role %s not found", roleName))
+		}
+		if err != nil {
+			return err
+		}
+		// counting locks the role's users, so two revocations cannot remove the last admin
+		holders, err := model.CountRoleUsers(tx, ctx, role.ID)
+		if err != nil {
+			return err
+		}
+		before, _, err := model.GetGrants(tx, ctx, int(userId))
+		if err != nil {
+			return err
+		}
+		had := contains(before, role.Name)
+		action := model.RoleGranted
+		switch {
+		case grant && had, !grant && !had:
+			roles = before
+			return nil
+		case grant:
+			err = model.AddUserRole(tx, ctx, int(userId), &role)
+		default:
+			if role.Name == authn.RoleAdmin && holders == 1 {
+				return kerrors.NewBizStatusError(40000, "the last admin cannot be revoked")
+			}
+			action = model.RoleRevoked
+			err = model.RemoveUserRole(tx, ctx, int(userId), &role)
+		}
+		if err != nil {
+			return err
+		}
+		err = model.CreateRoleAudit(tx, ctx, &model.RoleAudit{
+			ActorUserId:  actor.UserId,
+			ActorService: actor.Service,
+			TargetUserId: userId,
+			Role:         role.Name,
+			Action:       action,
+			Reason:       truncate(reason, 512),
+		})
+		if err != nil {
+			return err
+		}
+		changed = true
+		roles, _, err = model.GetGrants(tx, ctx, int(userId))
+		return err
+	})
+	return roles, changed, err
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type SendVerificationEmailService struct {
+	ctx context.Context
+} // NewSendVerificationEmailService new SendVerificationEmailService
+func NewSendVerificationEmailService(ctx context.Context) *SendVerificationEmailService {
+	return &SendVerificationEmailService{ctx: ctx}
+}
+
+// Run emails a new verification link, unless one was sent a moment ago.
+func (s *SendVerificationEmailService) Run(req *user.SendVerificationEmailReq) (resp *user.SendVerificationEmailResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	u, err := model.GetById(mysql.DB, s.ctx, int(req.UserId))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if u.EmailVerifiedAt != nil {
+		return &user.SendVerificationEmailResp{AlreadyVerified: true}, nil
+	}
+	recent, err := recentlySent(mysql.DB, s.ctx, u.ID, model.PurposeVerifyEmail, time.Now())
+	if err != nil || recent {
+		return &user.SendVerificationEmailResp{}, err
+	}
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		return issueAccountToken(tx, s.ctx, u, model.PurposeVerifyEmail, verifyTTL())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user.SendVerificationEmailResp{}, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/user/conf"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var errInvalidRefreshToken = kerrors.NewBizStatusError(40100, "refresh token is invalid or expired")
+
+// tokens are the credentials of a session handed to the client.
+type tokens struct {
+	sid          string
+	access       string
+	refresh      string
+	accessExpiry time.Duration
+	// emailVerified is the user's, as in the access token
+	emailVerified bool
+}
+
+func accessTTL() time.Duration {
+	return time.Duration(conf.GetConf().JWT.TokenTTLMinutes) * time.Minute
+}
+
+func refreshTTL() time.Duration {
+	return time.Duration(conf.GetConf().JWT.RefreshTTLHours) * time.Hour
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// randomToken is a secret for links and refresh tokens, only its hash is stored.
+func randomToken() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// startSession logs the user in on a new device.
+func startSession(tx *gorm.DB, ctx context.Context, userId uint32, userAgent, ip string) (t tokens, err error) {
+	now := time.Now()
+	s := &model.Session{
+		Sid:        uuid.NewString(),
+		UserId:     userId,
+		UserAgent:  truncate(userAgent, 512),
+		IP:         truncate(ip, 64),
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(refreshTTL()),
+	}
+	if err = model.CreateSession(tx, ctx, s); err != nil {
+		return
+	}
+	return issueTokens(tx, ctx, s, now)
+}
+
+// issueTokens adds a refresh token to the session and signs an access token for it.
+func issueTokens(tx *gorm.DB, ctx context.Context, s *model.Session, now time.Time) (t tokens, err error) {
+	t.sid = s.Sid
+	t.refresh = randomToken()
+	err = model.CreateRefreshToken(tx, ctx, &model.RefreshToken{
+		SessionId: s.ID,
+		TokenHash: hashToken(t.refresh),
+		ExpiresAt: s.ExpiresAt,
+	})
+	if err != nil {
+		return
+	}
+	access, claims, err := NewAuthService(ctx).GenerateToken(int(s.UserId), s.Sid)
+	if err != nil {
+		return
+	}
+	t.access, t.emailVerified = access, claims.EmailVerified
+	t.accessExpiry = accessTTL()
+	return
+}
+
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+)
+
+func TestRefreshTokensAreStoredHashed(t *testing.T) {
+	a, b := randomToken(), randomToken()
+	if a == b || len(a) != 43 {
+		t.Fatalf("tokens %q, %q", a, b)
+	}
+	if h := hashToken(a); h == a || len(h) != 64 || h != hashToken(a) {
+		t.Errorf("hash %q", h)
+	}
+}
+
+func TestSessionActive(t *testing.T) {
+	now := time.Now()
+	revokedAt := now.Add(-time.Minute)
+	tests := []struct {
+		s      model.Session
+		active bool
+	}{
+		{model.Session{ExpiresAt: now.Add(time.Hour)}, true},
+		{model.Session{ExpiresAt: now.Add(-time.Second)}, false},
+		{model.Session{ExpiresAt: now.Add(time.Hour), RevokedAt: &revokedAt}, false},
+	}
+	for i, tt := range tests {
+		if got := tt.s.Active(now); got != tt.active {
+			t.Errorf("%d: active = %v", i, got)
+		}
+	}
+}
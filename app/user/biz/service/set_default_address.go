@@ -0,0 +1,61 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type SetDefaultAddressService struct {
+	ctx context.Context
+} // NewSetDefaultAddressService new SetDefaultAddressService
+func NewSetDefaultAddressService(ctx context.Context) *SetDefaultAddressService {
+	return &SetDefaultAddressService{ctx: ctx}
+}
+
+func (s *SetDefaultAddressService) Run(req *user.SetDefaultAddressReq) (resp *user.SetDefaultAddressResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	userId := int(req.UserId)
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		_, err := model.GetByIdForUpdate(tx, s.ctx, userId)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errAddressNotFound
+		}
+		if err != nil {
+			return err
+		}
+		_, err = model.GetAddress(tx, s.ctx, userId, int(req.AddressId))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errAddressNotFound
+		}
+		if err != nil {
+			return err
+		}
+		return model.SetDefaultAddress(tx, s.ctx, userId, int(req.AddressId))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user.SetDefaultAddressResp{}, nil
+}
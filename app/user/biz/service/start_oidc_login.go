@@ -0,0 +1,56 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/oidc"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+type StartOidcLoginService struct {
+	ctx context.Context
+} // NewStartOidcLoginService new StartOidcLoginService
+func NewStartOidcLoginService(ctx context.Context) *StartOidcLoginService {
+	return &StartOidcLoginService{ctx: ctx}
+}
+
+func (s *StartOidcLoginService) Run(req *user.StartOidcLoginReq) (resp *user.StartOidcLoginResp, err error) {
+	p, c, err := oidcProvider(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	// the state ties the callback to this sign-in, the verifier (PKCE) and the nonce tie the
+	// code and the ID token to it
+	state, nonce, verifier := oidc.RandomString(), oidc.RandomString(), oidc.RandomString()
+	authURL, err := c.AuthURL(s.ctx, oidcRedirectURI(p.Name), state, nonce, verifier)
+	if err != nil {
+		klog.CtxErrorf(s.ctx, "oidc provider %s: %v", p.Name, err)
+		return nil, err
+	}
+	err = model.SaveOidcState(s.ctx, redis.RedisClient, hashToken(state), model.OidcState{
+		Provider: p.Name,
+		Verifier: verifier,
+		Nonce:    nonce,
+	}, oidcStateTTL())
+	if err != nil {
+		return nil, err
+	}
+	return &user.StartOidcLoginResp{AuthorizationUrl: authURL, State: state}, nil
+}
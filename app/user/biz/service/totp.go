@@ -0,0 +1,76 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/totp"
+	"github.com/cloudwego/biz-demo/gomall/app/user/conf"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+// recovery codes are 10 characters of this alphabet, shown as two groups of 5
+const recoveryAlphabet = "abcdefghijkmnpqrstuvwxyz23456789"
+
+var (
+	errInvalidCode      = kerrors.NewBizStatusError(40100, "the code is invalid")
+	errTotpNotEnabled   = kerrors.NewBizStatusError(40000, "two-factor authentication is not enabled")
+	errTotpEnabled      = kerrors.NewBizStatusError(40000, "two-factor authentication is already enabled")
+	errInvalidChallenge = kerrors.NewBizStatusError(40100, "the sign-in has expired, please sign in again")
+)
+
+func challengeTTL() time.Duration {
+	return time.Duration(conf.GetConf().TOTP.ChallengeMinutes) * time.Minute
+}
+
+// newRecoveryCodes returns conf.TOTP.RecoveryCodes codes to show once and the hashes to store.
+func newRecoveryCodes() (codes, hashes []string, err error) {
+	for i := 0; i < conf.GetConf().TOTP.RecoveryCodes; i++ {
+		b := make([]byte, 10)
+		if _, err = rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		for j := range b {
+			b[j] = recoveryAlphabet[int(b[j])%len(recoveryAlphabet)]
+		}
+		codes = append(codes, string(b[:5])+"-"+string(b[5:]))
+		hashes = append(hashes, hashToken(string(b)))
+	}
+	return codes, hashes, nil
+}
+
+// normalizeRecoveryCode lets the codes be typed in either case, with or without the dash.
+func normalizeRecoveryCode(code string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(strings.ToLower(code))
+}
+
+// useSecondFactor accepts a code of the user's app or one of their recovery codes, either
+// works once. t must be locked in tx.
+func useSecondFactor(tx *gorm.DB, ctx context.Context, t model.UserTotp, code string, now time.Time) (bool, error) {
+	if step, ok := totp.Validate(t.Secret, code, now, t.LastStep); ok {
+		return true, model.UseTotpStep(tx, ctx, t.ID, step)
+	}
+	code = normalizeRecoveryCode(code)
+	if len(code) != 10 {
+		return false, nil
+	}
+	return model.UseRecoveryCode(tx, ctx, t.UserId, hashToken(code), now)
+}
@@ -0,0 +1,31 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeRecoveryCode(t *testing.T) {
+	for _, code := range []string{"abcde-23456", "ABCDE-23456", "abcde23456", " abcde 23456"} {
+		if got := normalizeRecoveryCode(code); got != "abcde23456" {
+			t.Errorf("normalizeRecoveryCode(%q) = %q", code, got)
+		}
+	}
+	if strings.ContainsAny(recoveryAlphabet, "lo01") {
+		t.Error("the alphabet has characters that are easy to mistake")
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/user/infra/metric"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"gorm.io/gorm"
+)
+
+type UnlockAccountService struct {
+	ctx context.Context
+} // NewUnlockAccountService new UnlockAccountService
+func NewUnlockAccountService(ctx context.Context) *UnlockAccountService {
+	return &UnlockAccountService{ctx: ctx}
+}
+
+// Run lifts the sign-in lock of the account the link was emailed to. Blocks of client
+// addresses stay, they are not the account owner's to lift.
+func (s *UnlockAccountService) Run(req *user.UnlockAccountReq) (resp *user.UnlockAccountResp, err error) {
+	var userId int
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		t, err := useAccountToken(tx, s.ctx, req.Token, model.PurposeUnlockAccount, time.Now())
+		if err != nil {
+			return err
+		}
+		u, err := model.GetById(tx, s.ctx, t.UserId)
+		if err != nil {
+			return err
+		}
+		userId = u.ID
+		// unlocked before commit, so the link still works when Redis fails
+		return newLoginGuard(s.ctx).Unlock(u.Email)
+	})
+	if err != nil {
+		return nil, err
+	}
+	metric.AccountUnlocks.Inc()
+	return &user.UnlockAccountResp{UserId: uint32(userId)}, nil
+}
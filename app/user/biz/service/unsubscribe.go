@@ -0,0 +1,70 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type UnsubscribeService struct {
+	ctx context.Context
+} // NewUnsubscribeService new UnsubscribeService
+func NewUnsubscribeService(ctx context.Context) *UnsubscribeService {
+	return &UnsubscribeService{ctx: ctx}
+}
+
+// Run turns email off for the category, or for all categories when none is given
+func (s *UnsubscribeService) Run(req *user.UnsubscribeReq) (resp *user.UnsubscribeResp, err error) {
+	if req.Token == "" {
+		return nil, kerrors.NewBizStatusError(40000, "token is required")
+	}
+	categories := model.Categories
+	if req.Category != "" {
+		if !contains(model.Categories, req.Category) {
+			return nil, kerrors.NewBizStatusError(40000, "unknown category")
+		}
+		categories = []string{req.Category}
+	}
+	settings, err := model.GetNotificationSettingsByToken(mysql.DB, s.ctx, req.Token)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "unsubscribe link is invalid")
+	}
+	if err != nil {
+		return nil, err
+	}
+	u, err := model.GetById(mysql.DB, s.ctx, int(settings.UserId))
+	if err != nil {
+		return nil, err
+	}
+	var prefs []model.NotificationPreference
+	for _, category := range categories {
+		prefs = append(prefs, model.NotificationPreference{
+			UserId:   settings.UserId,
+			Category: category,
+			Channel:  model.ChannelEmail,
+		})
+	}
+	if err = model.SaveNotificationPreferences(mysql.DB, s.ctx, prefs); err != nil {
+		return nil, err
+	}
+	return &user.UnsubscribeResp{Email: u.Email, Categories: categories}, nil
+}
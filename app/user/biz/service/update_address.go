@@ -0,0 +1,58 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type UpdateAddressService struct {
+	ctx context.Context
+} // NewUpdateAddressService new UpdateAddressService
+func NewUpdateAddressService(ctx context.Context) *UpdateAddressService {
+	return &UpdateAddressService{ctx: ctx}
+}
+
+func (s *UpdateAddressService) Run(req *user.UpdateAddressReq) (resp *user.UpdateAddressResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	if err = validateAddress(req.Address); err != nil {
+		return nil, kerrors.NewBizStatusError(40000, err.Error())
+	}
+	var a model.Address
+	err = mysql.DB.Transaction(func(tx *gorm.DB) (err error) {
+		a, err = model.GetAddress(tx, s.ctx, int(req.UserId), int(req.Address.Id))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errAddressNotFound
+		}
+		if err != nil {
+			return err
+		}
+		fromAddress(&a, req.Address)
+		return model.SaveAddress(tx, s.ctx, &a)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user.UpdateAddressResp{Address: toAddress(a)}, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type UpdateNotificationPreferencesService struct {
+	ctx context.Context
+} // NewUpdateNotificationPreferencesService new UpdateNotificationPreferencesService
+func NewUpdateNotificationPreferencesService(ctx context.Context) *UpdateNotificationPreferencesService {
+	return &UpdateNotificationPreferencesService{ctx: ctx}
+}
+
+// Run stores the given preferences and, when set, the settings. Preferences that are not
+// given keep their value.
+func (s *UpdateNotificationPreferencesService) Run(req *user.UpdateNotificationPreferencesReq) (resp *user.UpdateNotificationPreferencesResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	if req.Settings != nil {
+		if err := validateSettings(req.Settings); err != nil {
+			return nil, kerrors.NewBizStatusError(40000, err.Error())
+		}
+	}
+	if _, err = model.GetById(mysql.DB, s.ctx, int(req.UserId)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, kerrors.NewBizStatusError(40004, "user not found")
+		}
+		return nil, err
+	}
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		settings, err := getNotificationSettings(tx, s.ctx, req.UserId)
+		if err != nil {
+			return err
+		}
+		if req.Settings != nil {
+			settings.Phone = req.Settings.Phone
+			settings.WebhookURL = req.Settings.WebhookUrl
+			settings.QuietStart = int(req.Settings.QuietStart)
+			settings.QuietEnd = int(req.Settings.QuietEnd)
+			settings.Timezone = req.Settings.Timezone
+			err = model.UpdateNotificationSettings(tx, s.ctx, req.UserId, map[string]any{
+				"phone":       settings.Phone,
+				"webhook_url": settings.WebhookURL,
+				"quiet_start": settings.QuietStart,
+				"quiet_end":   settings.QuietEnd,
+				"timezone":    settings.Timezone,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		stored, err := model.ListNotificationPreferences(tx, s.ctx, req.UserId)
+		if err != nil {
+			return err
+		}
+		// check the result, so that clearing the phone also needs SMS turned off
+		merged := preferenceMatrix(stored)
+		for _, p := range merged {
+			for _, v := range req.Preferences {
+				if v.Category == p.Category && v.Channel == p.Channel {
+					p.Enabled = v.Enabled
+				}
+			}
+		}
+		if err := validatePreferences(append(req.Preferences, merged...), settings); err != nil {
+			return kerrors.NewBizStatusError(40000, err.Error())
+		}
+		var prefs []model.NotificationPreference
+		for _, v := range req.Preferences {
+			prefs = append(prefs, model.NotificationPreference{
+				UserId:   req.UserId,
+				Category: v.Category,
+				Channel:  v.Channel,
+				Enabled:  v.Enabled,
+			})
+		}
+		return model.SaveNotificationPreferences(tx, s.ctx, prefs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user.UpdateNotificationPreferencesResp{}, nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type UpdateProfileService struct {
+	ctx context.Context
+} // NewUpdateProfileService new UpdateProfileService
+func NewUpdateProfileService(ctx context.Context) *UpdateProfileService {
+	return &UpdateProfileService{ctx: ctx}
+}
+
+func (s *UpdateProfileService) Run(req *user.UpdateProfileReq) (resp *user.UpdateProfileResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user id is required")
+	}
+	if err = validateProfile(req); err != nil {
+		return nil, kerrors.NewBizStatusError(40000, err.Error())
+	}
+	var u *model.User
+	err = mysql.DB.Transaction(func(tx *gorm.DB) (err error) {
+		u, err = model.GetByIdForUpdate(tx, s.ctx, int(req.UserId))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return kerrors.NewBizStatusError(40004, "user not found")
+		}
+		if err != nil {
+			return err
+		}
+		u.Name, u.Phone, u.Locale, u.Currency = req.Name, req.Phone, req.Locale, req.Currency
+		return model.UpdateProfile(tx, s.ctx, u.ID, u.Name, u.Phone, u.Locale, u.Currency)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user.UpdateProfileResp{Profile: toProfile(u)}, nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"gorm.io/gorm"
+)
+
+type VerifyEmailService struct {
+	ctx context.Context
+} // NewVerifyEmailService new VerifyEmailService
+func NewVerifyEmailService(ctx context.Context) *VerifyEmailService {
+	return &VerifyEmailService{ctx: ctx}
+}
+
+// Run marks the email of the link's user verified. Tokens say so once they are refreshed.
+func (s *VerifyEmailService) Run(req *user.VerifyEmailReq) (resp *user.VerifyEmailResp, err error) {
+	var u *model.User
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		t, err := useAccountToken(tx, s.ctx, req.Token, model.PurposeVerifyEmail, now)
+		if err != nil {
+			return err
+		}
+		if u, err = model.GetById(tx, s.ctx, t.UserId); err != nil {
+			return err
+		}
+		return model.MarkEmailVerified(tx, s.ctx, u.ID, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user.VerifyEmailResp{UserId: uint32(u.ID), Email: u.Email}, nil
+}
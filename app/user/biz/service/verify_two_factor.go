@@ -0,0 +1,102 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"gorm.io/gorm"
+)
+
+type VerifyTwoFactorService struct {
+	ctx context.Context
+} // NewVerifyTwoFactorService new VerifyTwoFactorService
+func NewVerifyTwoFactorService(ctx context.Context) *VerifyTwoFactorService {
+	return &VerifyTwoFactorService{ctx: ctx}
+}
+
+// Run finishes a sign-in that was challenged for the second factor. Wrong codes count as
+// failed sign-ins, so they lead to the same backoff and lockout as wrong passwords.
+func (s *VerifyTwoFactorService) Run(req *user.VerifyTwoFactorReq) (resp *user.LoginResp, err error) {
+	if req.ChallengeToken == "" {
+		return nil, errInvalidChallenge
+	}
+	guard := newLoginGuard(s.ctx)
+	var (
+		u         *model.User
+		t         tokens
+		wrongCode bool
+	)
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		c, err := model.GetUserTokenForUpdate(tx, s.ctx, hashToken(req.ChallengeToken))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errInvalidChallenge
+		}
+		if err != nil {
+			return err
+		}
+		if !c.Valid(model.PurposeLoginChallenge, now) {
+			return errInvalidChallenge
+		}
+		if u, err = model.GetById(tx, s.ctx, c.UserId); err != nil {
+			return err
+		}
+		if err = checkLoginAllowed(s.ctx, guard, u.Email, req.Ip); err != nil {
+			return err
+		}
+		otp, err := model.GetUserTotpForUpdate(tx, s.ctx, u.ID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errInvalidChallenge
+		}
+		if err != nil {
+			return err
+		}
+		ok, err := useSecondFactor(tx, s.ctx, otp, req.Code, now)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			wrongCode = true
+			return errInvalidCode
+		}
+		if err = model.MarkUserTokenUsed(tx, s.ctx, c.ID, now); err != nil {
+			return err
+		}
+		t, err = startSession(tx, s.ctx, uint32(u.ID), req.UserAgent, req.Ip)
+		return err
+	})
+	if wrongCode {
+		recordLoginFailure(s.ctx, guard, u.Email, req.Ip, u, "wrong_code")
+	}
+	if err != nil {
+		return nil, err
+	}
+	loginSucceeded(s.ctx, guard, u.Email)
+
+	return &user.LoginResp{
+		UserId:        int32(u.ID),
+		Token:         t.access,
+		RefreshToken:  t.refresh,
+		ExpiresIn:     int64(t.accessExpiry / time.Second),
+		SessionId:     t.sid,
+		EmailVerified: t.emailVerified,
+	}, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/conf"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/server"
+)
+
+var Default *Keyring
+
+// Init loads the signing keys. Keys from the database are rotated and reloaded in the
+// background until the server stops.
+func Init() {
+	c := conf.GetConf().JWT
+	Default = NewKeyring(c.Issuer, time.Duration(c.TokenTTLMinutes)*time.Minute)
+	switch c.KeySource {
+	case "file":
+		keys, err := LoadKeys(c.Keys, c.ActiveKid, time.Now())
+		if err != nil {
+			panic(err)
+		}
+		Default.Set(keys)
+	case "database":
+		o := RotationOptions{
+			Algorithm: c.Algorithm,
+			Period:    time.Duration(c.RotationHours) * time.Hour,
+			Lead:      time.Duration(c.PublishLeadMinutes) * time.Minute,
+			TokenTTL:  time.Duration(c.TokenTTLMinutes) * time.Minute,
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		if err := refresh(ctx, o); err != nil {
+			panic(err)
+		}
+		go func() {
+			ticker := time.NewTicker(time.Duration(c.ReloadSeconds) * time.Second)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if err := refresh(ctx, o); err != nil {
+						klog.Errorf("signing keys: %v", err)
+					}
+				}
+			}
+		}()
+		server.RegisterShutdownHook(cancel)
+	default:
+		panic(fmt.Sprintf("unknown jwt key source %q", c.KeySource))
+	}
+}
+
+func refresh(ctx context.Context, o RotationOptions) error {
+	now := time.Now()
+	if err := Rotate(mysql.DB, ctx, now, o); err != nil {
+		return err
+	}
+	keys, err := Load(mysql.DB, ctx, now)
+	if err != nil {
+		return err
+	}
+	Default.Set(keys)
+	return nil
+}
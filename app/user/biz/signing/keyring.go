@@ -0,0 +1,166 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package signing holds the keys the user service signs its tokens with. Keys carry a kid
+// header so that after a rotation the tokens of older keys keep verifying until they
+// expire, and the public halves are published as a JWKS for other services.
+package signing
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/common/jwks"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+)
+
+var ErrNoKey = errors.New("signing: no active key")
+
+type Key struct {
+	Kid     string
+	Alg     string
+	Private crypto.Signer
+	// ActiveAt is when the key starts signing, the latest active key signs
+	ActiveAt time.Time
+	// ExpiresAt is when the key is dropped, zero when it has no end
+	ExpiresAt time.Time
+}
+
+type Keyring struct {
+	issuer string
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu   sync.RWMutex
+	keys []Key
+}
+
+func NewKeyring(issuer string, ttl time.Duration) *Keyring {
+	return &Keyring{issuer: issuer, ttl: ttl, now: time.Now}
+}
+
+// Set replaces the keys.
+func (r *Keyring) Set(keys []Key) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.keys = keys
+}
+
+func (r *Keyring) valid(k Key, now time.Time) bool {
+	return k.ExpiresAt.IsZero() || k.ExpiresAt.After(now)
+}
+
+func (r *Keyring) signingKey() (Key, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	now := r.now()
+	var active *Key
+	for i, k := range r.keys {
+		if !r.valid(k, now) || k.ActiveAt.After(now) {
+			continue
+		}
+		if active == nil || k.ActiveAt.After(active.ActiveAt) {
+			active = &r.keys[i]
+		}
+	}
+	if active == nil {
+		return Key{}, ErrNoKey
+	}
+	return *active, nil
+}
+
+// Sign issues a token with the active key for the user of c, filling in its registered
+// claims.
+func (r *Keyring) Sign(c jwks.Claims) (string, *jwks.Claims, error) {
+	k, err := r.signingKey()
+	if err != nil {
+		return "", nil, err
+	}
+	now := r.now()
+	claims := &c
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ID:        uuid.NewString(),
+		Issuer:    r.issuer,
+		Subject:   strconv.Itoa(c.UserID),
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(r.ttl)),
+	}
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(k.Alg), claims)
+	token.Header["kid"] = k.Kid
+	s, err := token.SignedString(k.Private)
+	if err != nil {
+		return "", nil, fmt.Errorf("sign with key %s: %w", k.Kid, err)
+	}
+	return s, claims, nil
+}
+
+// Verify checks a token against the keys of the ring.
+func (r *Keyring) Verify(token string) (*jwks.Claims, error) {
+	claims := &jwks.Claims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		k, ok := r.lookup(kid)
+		if !ok {
+			return nil, fmt.Errorf("%w %q", jwks.ErrUnknownKey, kid)
+		}
+		if t.Method.Alg() != k.Alg {
+			return nil, fmt.Errorf("key %s is for %s, not %s", kid, k.Alg, t.Method.Alg())
+		}
+		return k.Private.Public(), nil
+	}, jwt.WithValidMethods([]string{jwks.RS256, jwks.ES256}))
+	if err != nil {
+		return nil, err
+	}
+	if !claims.VerifyIssuer(r.issuer, true) {
+		return nil, fmt.Errorf("token issued by %q", claims.Issuer)
+	}
+	return claims, nil
+}
+
+func (r *Keyring) lookup(kid string) (Key, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	now := r.now()
+	for _, k := range r.keys {
+		if k.Kid == kid && r.valid(k, now) {
+			return k, true
+		}
+	}
+	return Key{}, false
+}
+
+// JWKS lists the public keys, including the ones not signing yet or anymore.
+func (r *Keyring) JWKS() (*jwks.Set, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	now := r.now()
+	set := &jwks.Set{Keys: []jwks.Key{}}
+	for _, k := range r.keys {
+		if !r.valid(k, now) {
+			continue
+		}
+		jk, err := jwks.NewKey(k.Kid, k.Alg, k.Private.Public())
+		if err != nil {
+			return nil, err
+		}
+		set.Keys = append(set.Keys, jk)
+	}
+	return set, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/conf"
+	"github.com/cloudwego/biz-demo/gomall/common/jwks"
+)
+
+// ParsePrivateKey reads a PKCS #8, PKCS #1 (RSA) or SEC 1 (EC) PEM key and the algorithm
+// it signs with.
+func ParsePrivateKey(data []byte) (crypto.Signer, string, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, "", fmt.Errorf("no PEM block found")
+	}
+	var (
+		key any
+		err error
+	)
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		if key.N.BitLen() < 2048 {
+			return nil, "", fmt.Errorf("RSA keys need at least 2048 bits")
+		}
+		return key, jwks.RS256, nil
+	case *ecdsa.PrivateKey:
+		if key.Curve != elliptic.P256() {
+			return nil, "", fmt.Errorf("EC keys must be on P-256")
+		}
+		return key, jwks.ES256, nil
+	}
+	return nil, "", fmt.Errorf("unsupported key type %T", key)
+}
+
+func GenerateKey(alg string) (crypto.Signer, error) {
+	switch alg {
+	case jwks.RS256:
+		return rsa.GenerateKey(rand.Reader, 2048)
+	case jwks.ES256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	}
+	return nil, fmt.Errorf("unsupported algorithm %q", alg)
+}
+
+func MarshalPrivateKey(key crypto.Signer) (string, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), nil
+}
+
+// LoadKeys reads the configured keys. The active one signs from now on, the others only
+// verify, which keeps the tokens of a replaced key valid while it stays configured.
+func LoadKeys(keys []conf.JWTKey, activeKid string, now time.Time) ([]Key, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no jwt keys configured")
+	}
+	if activeKid == "" {
+		activeKid = keys[0].Kid
+	}
+	var (
+		out    []Key
+		active bool
+	)
+	for _, c := range keys {
+		data := []byte(c.PEM)
+		if c.File != "" {
+			var err error
+			if data, err = os.ReadFile(c.File); err != nil {
+				return nil, fmt.Errorf("jwt key %s: %w", c.Kid, err)
+			}
+		}
+		priv, alg, err := ParsePrivateKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %s: %w", c.Kid, err)
+		}
+		k := Key{Kid: c.Kid, Alg: alg, Private: priv}
+		if c.Kid == activeKid {
+			k.ActiveAt = now
+			active = true
+		}
+		out = append(out, k)
+	}
+	if !active {
+		return nil, fmt.Errorf("active jwt key %s is not configured", activeKid)
+	}
+	return out, nil
+}
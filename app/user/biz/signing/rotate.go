@@ -0,0 +1,127 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type RotationOptions struct {
+	Algorithm string
+	// Period is how long a key signs before the next one takes over
+	Period time.Duration
+	// Lead is how long a new key is published before it signs
+	Lead time.Duration
+	// TokenTTL is how long a replaced key keeps verifying
+	TokenTTL time.Duration
+}
+
+// plan decides if a new key is due and when it starts signing, and when the keys that
+// got a successor expire. keys are ordered by ActiveAt.
+func plan(keys []model.SigningKey, now time.Time, o RotationOptions) (next time.Time, create bool, expire map[int]time.Time) {
+	var activations []time.Time
+	for _, k := range keys {
+		activations = append(activations, k.ActiveAt)
+	}
+	switch {
+	case len(keys) == 0:
+		// there are no tokens to verify yet, the first key signs right away
+		next, create = now, true
+	case keys[len(keys)-1].ActiveAt.After(now):
+		// the next key is already published
+	case !now.Before(keys[len(keys)-1].ActiveAt.Add(o.Period - o.Lead)):
+		next, create = keys[len(keys)-1].ActiveAt.Add(o.Period), true
+		if earliest := now.Add(o.Lead); next.Before(earliest) {
+			next = earliest
+		}
+	}
+	if create {
+		activations = append(activations, next)
+	}
+	expire = map[int]time.Time{}
+	for i, k := range keys {
+		if k.ExpiresAt == nil && i+1 < len(activations) {
+			expire[k.ID] = activations[i+1].Add(o.TokenTTL)
+		}
+	}
+	return
+}
+
+// Rotate adds the next key when one is due and drops the expired ones. Instances
+// rotating at the same time wait for each other on the key rows.
+func Rotate(db *gorm.DB, ctx context.Context, now time.Time, o RotationOptions) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		keys, err := model.LockSigningKeys(tx, ctx, now)
+		if err != nil {
+			return err
+		}
+		next, create, expire := plan(keys, now, o)
+		if create {
+			priv, err := GenerateKey(o.Algorithm)
+			if err != nil {
+				return err
+			}
+			pem, err := MarshalPrivateKey(priv)
+			if err != nil {
+				return err
+			}
+			err = model.CreateSigningKey(tx, ctx, &model.SigningKey{
+				Kid:        next.UTC().Format("20060102") + "-" + strings.Split(uuid.NewString(), "-")[0],
+				Algorithm:  o.Algorithm,
+				PrivateKey: pem,
+				ActiveAt:   next,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		for id, at := range expire {
+			if err = model.ExpireSigningKeys(tx, ctx, []int{id}, at); err != nil {
+				return err
+			}
+		}
+		return model.DeleteExpiredSigningKeys(tx, ctx, now)
+	})
+}
+
+// Load reads the keys that have not expired.
+func Load(db *gorm.DB, ctx context.Context, now time.Time) ([]Key, error) {
+	rows, err := model.ListSigningKeys(db, ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].ActiveAt.Before(rows[j].ActiveAt) })
+	keys := make([]Key, 0, len(rows))
+	for _, row := range rows {
+		priv, alg, err := ParsePrivateKey([]byte(row.PrivateKey))
+		if err != nil {
+			return nil, fmt.Errorf("signing key %s: %w", row.Kid, err)
+		}
+		k := Key{Kid: row.Kid, Alg: alg, Private: priv, ActiveAt: row.ActiveAt}
+		if row.ExpiresAt != nil {
+			k.ExpiresAt = *row.ExpiresAt
+		}
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/user/conf"
+	"github.com/cloudwego/biz-demo/gomall/common/jwks"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func newKey(t *testing.T, kid, alg string, activeAt time.Time) Key {
+	t.Helper()
+	priv, err := GenerateKey(alg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Key{Kid: kid, Alg: alg, Private: priv, ActiveAt: activeAt}
+}
+
+func kidOf(t *testing.T, token string) string {
+	t.Helper()
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &jwks.Claims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed.Header["kid"].(string)
+}
+
+func TestSignAndVerify(t *testing.T) {
+	for _, alg := range []string{jwks.RS256, jwks.ES256} {
+		r := NewKeyring("gomall", time.Hour)
+		r.Set([]Key{newKey(t, "k1", alg, time.Now().Add(-time.Minute))})
+		token, _, err := r.Sign(jwks.Claims{UserID: 42, SessionID: "s1", Roles: []string{"admin"}, Permissions: []string{"roles:manage"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if kid := kidOf(t, token); kid != "k1" {
+			t.Errorf("kid = %s", kid)
+		}
+		claims, err := r.Verify(token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if claims.UserID != 42 || claims.Subject != "42" || claims.Issuer != "gomall" || claims.ID == "" ||
+			len(claims.Roles) != 1 || !claims.HasPermission("roles:manage") {
+			t.Errorf("claims = %+v", claims)
+		}
+	}
+}
+
+func TestRotationKeepsOldTokensValid(t *testing.T) {
+	now := time.Now()
+	old := newKey(t, "old", jwks.ES256, now.Add(-48*time.Hour))
+	next := newKey(t, "next", jwks.RS256, now.Add(time.Hour))
+	r := NewKeyring("gomall", time.Hour)
+	r.now = func() time.Time { return now }
+	r.Set([]Key{old, next})
+
+	oldToken, _, err := r.Sign(jwks.Claims{UserID: 1, SessionID: "s1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kidOf(t, oldToken) != "old" {
+		t.Fatal("the published key signs before its time")
+	}
+	set, _ := r.JWKS()
+	if len(set.Keys) != 2 {
+		t.Errorf("published %d keys, want the upcoming one too", len(set.Keys))
+	}
+
+	now = now.Add(2 * time.Hour)
+	old.ExpiresAt = now.Add(time.Hour)
+	r.Set([]Key{old, next})
+	newToken, _, _ := r.Sign(jwks.Claims{UserID: 1, SessionID: "s1"})
+	if kidOf(t, newToken) != "next" {
+		t.Error("the next key does not sign after its activation")
+	}
+	if _, err := r.Verify(oldToken); err != nil {
+		t.Errorf("token of the replaced key rejected: %v", err)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if _, ok := r.lookup("old"); ok {
+		t.Error("expired key still accepted")
+	}
+	set, _ = r.JWKS()
+	if len(set.Keys) != 1 || set.Keys[0].Kid != "next" {
+		t.Errorf("published %+v", set.Keys)
+	}
+}
+
+func TestVerifyRejectsUnknownKeys(t *testing.T) {
+	r := NewKeyring("gomall", time.Hour)
+	r.Set([]Key{newKey(t, "k1", jwks.ES256, time.Now().Add(-time.Minute))})
+	other := NewKeyring("gomall", time.Hour)
+	other.Set([]Key{newKey(t, "k2", jwks.ES256, time.Now().Add(-time.Minute))})
+	token, _, _ := other.Sign(jwks.Claims{UserID: 1, SessionID: "s1"})
+	if _, err := r.Verify(token); !errors.Is(err, jwks.ErrUnknownKey) {
+		t.Errorf("err = %v", err)
+	}
+	if _, _, err := NewKeyring("gomall", time.Hour).Sign(jwks.Claims{UserID: 1, SessionID: "s1"}); !errors.Is(err, ErrNoKey) {
+		t.Errorf("signed without keys: %v", err)
+	}
+}
+
+func TestPlan(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	o := RotationOptions{Algorithm: jwks.ES256, Period: 30 * 24 * time.Hour, Lead: 10 * time.Minute, TokenTTL: 24 * time.Hour}
+	key := func(id int, activeAt time.Time) model.SigningKey {
+		return model.SigningKey{Base: model.Base{ID: id}, ActiveAt: activeAt}
+	}
+
+	next, create, _ := plan(nil, now, o)
+	if !create || !next.Equal(now) {
+		t.Errorf("first key: create %v at %s", create, next)
+	}
+
+	fresh := []model.SigningKey{key(1, now.Add(-24*time.Hour))}
+	if _, create, expire := plan(fresh, now, o); create || len(expire) != 0 {
+		t.Errorf("rotated a fresh key")
+	}
+
+	// the period ends within the lead time, the next key is published now and signs then
+	due := []model.SigningKey{key(1, now.Add(-o.Period+o.Lead))}
+	next, create, expire := plan(due, now, o)
+	if !create || !next.Equal(now.Add(o.Lead)) {
+		t.Errorf("due key: create %v at %s", create, next)
+	}
+	if !expire[1].Equal(next.Add(o.TokenTTL)) {
+		t.Errorf("old key expires at %s", expire[1])
+	}
+
+	// the service was down past the rotation, the new key still gets its lead time
+	late := []model.SigningKey{key(1, now.Add(-2*o.Period))}
+	if next, _, _ := plan(late, now, o); !next.Equal(now.Add(o.Lead)) {
+		t.Errorf("late key signs at %s", next)
+	}
+
+	pending := []model.SigningKey{key(1, now.Add(-o.Period)), key(2, now.Add(time.Minute))}
+	if _, create, expire := plan(pending, now, o); create || !expire[1].Equal(now.Add(time.Minute).Add(o.TokenTTL)) {
+		t.Errorf("pending key: create %v, expire %v", create, expire)
+	}
+}
+
+func TestLoadKeys(t *testing.T) {
+	dir := t.TempDir()
+	ec, _ := GenerateKey(jwks.ES256)
+	ecPEM, _ := MarshalPrivateKey(ec)
+	rsaKey, _ := GenerateKey(jwks.RS256)
+	pkcs1 := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey.(*rsa.PrivateKey))})
+	file := filepath.Join(dir, "rsa.pem")
+	if err := os.WriteFile(file, pkcs1, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	keys, err := LoadKeys([]conf.JWTKey{{Kid: "ec", PEM: ecPEM}, {Kid: "rsa", File: file}}, "rsa", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keys[0].Alg != jwks.ES256 || keys[1].Alg != jwks.RS256 {
+		t.Errorf("algorithms %s, %s", keys[0].Alg, keys[1].Alg)
+	}
+	r := NewKeyring("gomall", time.Hour)
+	r.Set(keys)
+	token, _, _ := r.Sign(jwks.Claims{UserID: 1, SessionID: "s1"})
+	if kidOf(t, token) != "rsa" {
+		t.Error("the active key does not sign")
+	}
+	b, _ := json.Marshal(must(r.JWKS()))
+	if !strings.Contains(string(b), `"kid":"ec"`) {
+		t.Errorf("jwks %s lacks the verifying key", b)
+	}
+
+	if _, err := LoadKeys([]conf.JWTKey{{Kid: "ec", PEM: ecPEM}}, "missing", now); err == nil {
+		t.Error("missing active key accepted")
+	}
+}
+
+func must(set *jwks.Set, err error) *jwks.Set {
+	if err != nil {
+		panic(err)
+	}
+	return set
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command admin grants a role to a user, to bootstrap the first admin who then grants roles
+// over RPC. Run it from app/user so it finds the conf:
+//
+//	go run ./cmd/admin -email ops@example.com
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/service"
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	"github.com/joho/godotenv"
+	"gorm.io/gorm"
+)
+
+func main() {
+	email := flag.String("email", "", "email of the user")
+	role := flag.String("role", authn.RoleAdmin, "role to grant")
+	revoke := flag.Bool("revoke", false, "revoke the role instead")
+	reason := flag.String("reason", "bootstrap", "reason for the audit log")
+	flag.Parse()
+	if *email == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(*email, *role, !*revoke, *reason); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(email, role string, grant bool, reason string) error {
+	_ = godotenv.Load()
+	mysql.Init()
+	ctx := context.Background()
+	if err := model.EnsureRole(mysql.DB, ctx, authn.RoleAdmin, authn.AllPermissions); err != nil {
+		return err
+	}
+	u, err := model.GetByEmail(mysql.DB, ctx, email)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("no user with email %s, register first", email)
+	}
+	if err != nil {
+		return err
+	}
+	roles, changed, err := service.ChangeRole(mysql.DB, ctx, service.Actor{Service: "bootstrap"}, uint32(u.ID), role, grant, reason)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		fmt.Println("nothing to change")
+	}
+	fmt.Printf("%s has roles %v, effective from the next token refresh\n", email, roles)
+	return nil
+}
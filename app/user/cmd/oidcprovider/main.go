@@ -0,0 +1,49 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command oidcprovider serves the test identity provider, to try the OIDC sign-in without an
+// account anywhere. It signs in any email typed into its form, keys and codes live in memory:
+//
+//	go run ./cmd/oidcprovider -addr :9900
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/oidc/oidctest"
+)
+
+func main() {
+	addr := flag.String("addr", ":9900", "address to listen on")
+	issuer := flag.String("issuer", "", "issuer URL, http://localhost<addr> by default")
+	clientID := flag.String("client-id", "gomall", "client id of the shop")
+	clientSecret := flag.String("client-secret", "gomall-dev", "client secret of the shop")
+	flag.Parse()
+	if *issuer == "" {
+		*issuer = "http://localhost" + *addr
+		if !strings.HasPrefix(*addr, ":") {
+			*issuer = "http://" + *addr
+		}
+	}
+	p, err := oidctest.New(*issuer, *clientID, *clientSecret)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("test identity provider %s, client %s\n", p.Issuer, *clientID)
+	log.Fatal(http.ListenAndServe(*addr, p.Handler()))
+}
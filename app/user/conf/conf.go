@@ -36,6 +36,106 @@ type Config struct {
 	MySQL    MySQL    `yaml:"mysql"`
 	Redis    Redis    `yaml:"redis"`
 	Registry Registry `yaml:"registry"`
+	Outbox   Outbox   `yaml:"outbox"`
+	JWT      JWT      `yaml:"jwt"`
+	Account  Account  `yaml:"account"`
+	Login    Login    `yaml:"login"`
+	TOTP     TOTP     `yaml:"totp"`
+	OIDC     OIDC     `yaml:"oidc"`
+	Deletion Deletion `yaml:"deletion"`
+}
+
+// Deletion configures the job erasing deleted accounts in the other services. Jobs are
+// looked for every IntervalSeconds, each service has StepTimeoutSeconds to answer and a
+// failed job is retried after a backoff doubling up to RetryMaxMinutes.
+type Deletion struct {
+	IntervalSeconds    int `yaml:"interval_seconds"`
+	StepTimeoutSeconds int `yaml:"step_timeout_seconds"`
+	RetryMaxMinutes    int `yaml:"retry_max_minutes"`
+}
+
+// OIDC lists the identity providers users may sign in with. A sign-in started at a provider
+// has StateTTLMinutes to come back, and the provider redirects to
+// <account.base_url>/auth/oidc/<name>/callback.
+type OIDC struct {
+	StateTTLMinutes int            `yaml:"state_ttl_minutes"`
+	Providers       []OIDCProvider `yaml:"providers"`
+}
+
+type OIDCProvider struct {
+	Name         string   `yaml:"name"`
+	DisplayName  string   `yaml:"display_name"`
+	Issuer       string   `yaml:"issuer"`
+	ClientID     string   `yaml:"client_id"`
+	ClientSecret string   `yaml:"client_secret"`
+	Scopes       []string `yaml:"scopes"`
+}
+
+// TOTP configures two-factor authentication: apps list the account under Issuer, a sign-in
+// waits ChallengeMinutes for the code and RecoveryCodes codes are handed out at a time.
+type TOTP struct {
+	Issuer           string `yaml:"issuer"`
+	ChallengeMinutes int    `yaml:"challenge_minutes"`
+	RecoveryCodes    int    `yaml:"recovery_codes"`
+}
+
+// Login limits failed sign-ins, counted for WindowMinutes. From BackoffAfter failures of an
+// account on, each try waits twice as long as the one before, from BackoffBaseSeconds up to
+// BackoffMaxSeconds. LockAfter failures lock the account for LockMinutes and email an unlock
+// link, IPBlockAfter failures from one address block it for IPBlockMinutes.
+type Login struct {
+	WindowMinutes      int `yaml:"window_minutes"`
+	BackoffAfter       int `yaml:"backoff_after"`
+	BackoffBaseSeconds int `yaml:"backoff_base_seconds"`
+	BackoffMaxSeconds  int `yaml:"backoff_max_seconds"`
+	LockAfter          int `yaml:"lock_after"`
+	LockMinutes        int `yaml:"lock_minutes"`
+	IPBlockAfter       int `yaml:"ip_block_after"`
+	IPBlockMinutes     int `yaml:"ip_block_minutes"`
+}
+
+// Account configures the emailed account links: they point to the frontend at BaseURL,
+// verification links last VerifyTTLHours, password reset links ResetTTLMinutes and links
+// confirming an account deletion DeleteTTLMinutes.
+type Account struct {
+	BaseURL          string `yaml:"base_url"`
+	VerifyTTLHours   int    `yaml:"verify_ttl_hours"`
+	ResetTTLMinutes  int    `yaml:"reset_ttl_minutes"`
+	DeleteTTLMinutes int    `yaml:"delete_ttl_minutes"`
+}
+
+// JWT configures the tokens: access tokens live TokenTTLMinutes, a session ends when its
+// refresh token is not used for RefreshTTLHours. With KeySource file the Keys are used as
+// they are and ActiveKid signs; with database keys of Algorithm are generated and rotated
+// every RotationHours, each published PublishLeadMinutes before it signs so verifiers know it.
+type JWT struct {
+	Issuer             string   `yaml:"issuer"`
+	TokenTTLMinutes    int      `yaml:"token_ttl_minutes"`
+	RefreshTTLHours    int      `yaml:"refresh_ttl_hours"`
+	KeySource          string   `yaml:"key_source"`
+	Algorithm          string   `yaml:"algorithm"`
+	RotationHours      int      `yaml:"rotation_hours"`
+	PublishLeadMinutes int      `yaml:"publish_lead_minutes"`
+	ReloadSeconds      int      `yaml:"reload_seconds"`
+	ActiveKid          string   `yaml:"active_kid"`
+	Keys               []JWTKey `yaml:"keys"`
+}
+
+// JWTKey is a PEM private key, given inline or as a file.
+type JWTKey struct {
+	Kid  string `yaml:"kid"`
+	File string `yaml:"file"`
+	PEM  string `yaml:"pem"`
+}
+
+// Outbox configures the relay publishing the outbox table to NATS. Stream is created for
+// Subjects, the user events.
+type Outbox struct {
+	Stream         string   `yaml:"stream"`
+	Subjects       []string `yaml:"subjects"`
+	IntervalMs     int      `yaml:"interval_ms"`
+	BatchSize      int      `yaml:"batch_size"`
+	RetentionHours int      `yaml:"retention_hours"`
 }
 
 type MySQL struct {
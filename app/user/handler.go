@@ -37,3 +37,248 @@ func (s *UserServiceImpl) Login(ctx context.Context, req *user.LoginReq) (resp *
 
 	return resp, err
 }
+
+// GetNotificationPreferences implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetNotificationPreferences(ctx context.Context, req *user.GetNotificationPreferencesReq) (resp *user.GetNotificationPreferencesResp, err error) {
+	resp, err = service.NewGetNotificationPreferencesService(ctx).Run(req)
+
+	return resp, err
+}
+
+// UpdateNotificationPreferences implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UpdateNotificationPreferences(ctx context.Context, req *user.UpdateNotificationPreferencesReq) (resp *user.UpdateNotificationPreferencesResp, err error) {
+	resp, err = service.NewUpdateNotificationPreferencesService(ctx).Run(req)
+
+	return resp, err
+}
+
+// Unsubscribe implements the UserServiceImpl interface.
+func (s *UserServiceImpl) Unsubscribe(ctx context.Context, req *user.UnsubscribeReq) (resp *user.UnsubscribeResp, err error) {
+	resp, err = service.NewUnsubscribeService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetJwks implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetJwks(ctx context.Context, req *user.GetJwksReq) (resp *user.GetJwksResp, err error) {
+	resp, err = service.NewGetJwksService(ctx).Run(req)
+
+	return resp, err
+}
+
+// RefreshToken implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RefreshToken(ctx context.Context, req *user.RefreshTokenReq) (resp *user.RefreshTokenResp, err error) {
+	resp, err = service.NewRefreshTokenService(ctx).Run(req)
+
+	return resp, err
+}
+
+// RevokeToken implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RevokeToken(ctx context.Context, req *user.RevokeTokenReq) (resp *user.RevokeTokenResp, err error) {
+	resp, err = service.NewRevokeTokenService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListSessions implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ListSessions(ctx context.Context, req *user.ListSessionsReq) (resp *user.ListSessionsResp, err error) {
+	resp, err = service.NewListSessionsService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GrantRole implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GrantRole(ctx context.Context, req *user.GrantRoleReq) (resp *user.GrantRoleResp, err error) {
+	resp, err = service.NewGrantRoleService(ctx).Run(req)
+
+	return resp, err
+}
+
+// RevokeRole implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RevokeRole(ctx context.Context, req *user.RevokeRoleReq) (resp *user.RevokeRoleResp, err error) {
+	resp, err = service.NewRevokeRoleService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListRoleAudit implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ListRoleAudit(ctx context.Context, req *user.ListRoleAuditReq) (resp *user.ListRoleAuditResp, err error) {
+	resp, err = service.NewListRoleAuditService(ctx).Run(req)
+
+	return resp, err
+}
+
+// SendVerificationEmail implements the UserServiceImpl interface.
+func (s *UserServiceImpl) SendVerificationEmail(ctx context.Context, req *user.SendVerificationEmailReq) (resp *user.SendVerificationEmailResp, err error) {
+	resp, err = service.NewSendVerificationEmailService(ctx).Run(req)
+
+	return resp, err
+}
+
+// VerifyEmail implements the UserServiceImpl interface.
+func (s *UserServiceImpl) VerifyEmail(ctx context.Context, req *user.VerifyEmailReq) (resp *user.VerifyEmailResp, err error) {
+	resp, err = service.NewVerifyEmailService(ctx).Run(req)
+
+	return resp, err
+}
+
+// RequestPasswordReset implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RequestPasswordReset(ctx context.Context, req *user.RequestPasswordResetReq) (resp *user.RequestPasswordResetResp, err error) {
+	resp, err = service.NewRequestPasswordResetService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ResetPassword implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ResetPassword(ctx context.Context, req *user.ResetPasswordReq) (resp *user.ResetPasswordResp, err error) {
+	resp, err = service.NewResetPasswordService(ctx).Run(req)
+
+	return resp, err
+}
+
+// UnlockAccount implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UnlockAccount(ctx context.Context, req *user.UnlockAccountReq) (resp *user.UnlockAccountResp, err error) {
+	resp, err = service.NewUnlockAccountService(ctx).Run(req)
+
+	return resp, err
+}
+
+// EnrollTotp implements the UserServiceImpl interface.
+func (s *UserServiceImpl) EnrollTotp(ctx context.Context, req *user.EnrollTotpReq) (resp *user.EnrollTotpResp, err error) {
+	resp, err = service.NewEnrollTotpService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ConfirmTotp implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ConfirmTotp(ctx context.Context, req *user.ConfirmTotpReq) (resp *user.ConfirmTotpResp, err error) {
+	resp, err = service.NewConfirmTotpService(ctx).Run(req)
+
+	return resp, err
+}
+
+// DisableTotp implements the UserServiceImpl interface.
+func (s *UserServiceImpl) DisableTotp(ctx context.Context, req *user.DisableTotpReq) (resp *user.DisableTotpResp, err error) {
+	resp, err = service.NewDisableTotpService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetTotpStatus implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetTotpStatus(ctx context.Context, req *user.GetTotpStatusReq) (resp *user.GetTotpStatusResp, err error) {
+	resp, err = service.NewGetTotpStatusService(ctx).Run(req)
+
+	return resp, err
+}
+
+// RegenerateRecoveryCodes implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RegenerateRecoveryCodes(ctx context.Context, req *user.RegenerateRecoveryCodesReq) (resp *user.RegenerateRecoveryCodesResp, err error) {
+	resp, err = service.NewRegenerateRecoveryCodesService(ctx).Run(req)
+
+	return resp, err
+}
+
+// VerifyTwoFactor implements the UserServiceImpl interface.
+func (s *UserServiceImpl) VerifyTwoFactor(ctx context.Context, req *user.VerifyTwoFactorReq) (resp *user.LoginResp, err error) {
+	resp, err = service.NewVerifyTwoFactorService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListOidcProviders implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ListOidcProviders(ctx context.Context, req *user.ListOidcProvidersReq) (resp *user.ListOidcProvidersResp, err error) {
+	resp, err = service.NewListOidcProvidersService(ctx).Run(req)
+
+	return resp, err
+}
+
+// StartOidcLogin implements the UserServiceImpl interface.
+func (s *UserServiceImpl) StartOidcLogin(ctx context.Context, req *user.StartOidcLoginReq) (resp *user.StartOidcLoginResp, err error) {
+	resp, err = service.NewStartOidcLoginService(ctx).Run(req)
+
+	return resp, err
+}
+
+// FinishOidcLogin implements the UserServiceImpl interface.
+func (s *UserServiceImpl) FinishOidcLogin(ctx context.Context, req *user.FinishOidcLoginReq) (resp *user.LoginResp, err error) {
+	resp, err = service.NewFinishOidcLoginService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetProfile implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetProfile(ctx context.Context, req *user.GetProfileReq) (resp *user.GetProfileResp, err error) {
+	resp, err = service.NewGetProfileService(ctx).Run(req)
+
+	return resp, err
+}
+
+// UpdateProfile implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UpdateProfile(ctx context.Context, req *user.UpdateProfileReq) (resp *user.UpdateProfileResp, err error) {
+	resp, err = service.NewUpdateProfileService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListAddresses implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ListAddresses(ctx context.Context, req *user.ListAddressesReq) (resp *user.ListAddressesResp, err error) {
+	resp, err = service.NewListAddressesService(ctx).Run(req)
+
+	return resp, err
+}
+
+// CreateAddress implements the UserServiceImpl interface.
+func (s *UserServiceImpl) CreateAddress(ctx context.Context, req *user.CreateAddressReq) (resp *user.CreateAddressResp, err error) {
+	resp, err = service.NewCreateAddressService(ctx).Run(req)
+
+	return resp, err
+}
+
+// UpdateAddress implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UpdateAddress(ctx context.Context, req *user.UpdateAddressReq) (resp *user.UpdateAddressResp, err error) {
+	resp, err = service.NewUpdateAddressService(ctx).Run(req)
+
+	return resp, err
+}
+
+// DeleteAddress implements the UserServiceImpl interface.
+func (s *UserServiceImpl) DeleteAddress(ctx context.Context, req *user.DeleteAddressReq) (resp *user.DeleteAddressResp, err error) {
+	resp, err = service.NewDeleteAddressService(ctx).Run(req)
+
+	return resp, err
+}
+
+// SetDefaultAddress implements the UserServiceImpl interface.
+func (s *UserServiceImpl) SetDefaultAddress(ctx context.Context, req *user.SetDefaultAddressReq) (resp *user.SetDefaultAddressResp, err error) {
+	resp, err = service.NewSetDefaultAddressService(ctx).Run(req)
+
+	return resp, err
+}
+
+// RequestDataExport implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RequestDataExport(ctx context.Context, req *user.RequestDataExportReq) (resp *user.RequestDataExportResp, err error) {
+	resp, err = service.NewRequestDataExportService(ctx).Run(req)
+
+	return resp, err
+}
+
+// DeleteAccount implements the UserServiceImpl interface.
+func (s *UserServiceImpl) DeleteAccount(ctx context.Context, req *user.DeleteAccountReq) (resp *user.DeleteAccountResp, err error) {
+	resp, err = service.NewDeleteAccountService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ConfirmAccountDeletion implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ConfirmAccountDeletion(ctx context.Context, req *user.ConfirmAccountDeletionReq) (resp *user.ConfirmAccountDeletionResp, err error) {
+	resp, err = service.NewConfirmAccountDeletionService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetAccountDeletion implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetAccountDeletion(ctx context.Context, req *user.GetAccountDeletionReq) (resp *user.GetAccountDeletionResp, err error) {
+	resp, err = service.NewGetAccountDeletionService(ctx).Run(req)
+
+	return resp, err
+}
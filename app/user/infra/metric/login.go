@@ -0,0 +1,41 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"github.com/cloudwego/biz-demo/gomall/common/mtl"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	LoginFailed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "user_login_failed_total",
+		Help: "Sign-ins that were refused, by reason.",
+	}, []string{"reason"})
+
+	LoginLockouts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "user_login_lockouts_total",
+		Help: "Accounts and client addresses locked after too many failed sign-ins.",
+	}, []string{"scope"})
+
+	AccountUnlocks = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "user_account_unlocks_total",
+		Help: "Locked accounts unlocked with the emailed link.",
+	})
+)
+
+func Init() {
+	mtl.Registry.MustRegister(LoginFailed, LoginLockouts, AccountUnlocks)
+}
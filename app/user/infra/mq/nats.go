@@ -0,0 +1,37 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+var (
+	Nc  *nats.Conn
+	JS  jetstream.JetStream
+	err error
+)
+
+func Init() {
+	Nc, err = nats.Connect(nats.DefaultURL)
+	if err != nil {
+		panic(err)
+	}
+	JS, err = jetstream.New(Nc)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"sync"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/conf"
+	userutils "github.com/cloudwego/biz-demo/gomall/app/user/utils"
+	"github.com/cloudwego/biz-demo/gomall/common/clientsuite"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart/cartservice"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email/emailservice"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order/orderservice"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment/paymentservice"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping/shippingservice"
+	"github.com/cloudwego/kitex/client"
+)
+
+var (
+	OrderClient    orderservice.Client
+	CartClient     cartservice.Client
+	PaymentClient  paymentservice.Client
+	ShippingClient shippingservice.Client
+	EmailClient    emailservice.Client
+	once           sync.Once
+	err            error
+	registryAddr   string
+	serviceName    string
+	commonSuite    client.Option
+)
+
+func InitClient() {
+	once.Do(func() {
+		registryAddr = conf.GetConf().Registry.RegistryAddress[0]
+		serviceName = conf.GetConf().Kitex.Service
+		commonSuite = client.WithSuite(clientsuite.CommonGrpcClientSuite{
+			CurrentServiceName: serviceName,
+			RegistryAddr:       registryAddr,
+		})
+		initOrderClient()
+		initCartClient()
+		initPaymentClient()
+		initShippingClient()
+		initEmailClient()
+	})
+}
+
+func initOrderClient() {
+	OrderClient, err = orderservice.NewClient("order", commonSuite)
+	userutils.MustHandleError(err)
+}
+
+func initCartClient() {
+	CartClient, err = cartservice.NewClient("cart", commonSuite)
+	userutils.MustHandleError(err)
+}
+
+func initPaymentClient() {
+	PaymentClient, err = paymentservice.NewClient("payment", commonSuite)
+	userutils.MustHandleError(err)
+}
+
+func initShippingClient() {
+	ShippingClient, err = shippingservice.NewClient("shipping", commonSuite)
+	userutils.MustHandleError(err)
+}
+
+func initEmailClient() {
+	EmailClient, err = emailservice.NewClient("email", commonSuite)
+	userutils.MustHandleError(err)
+}
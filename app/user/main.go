@@ -15,11 +15,20 @@
 package main
 
 import (
+	"context"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/deletion"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/relay"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/signing"
 	"github.com/cloudwego/biz-demo/gomall/app/user/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/user/infra/metric"
+	"github.com/cloudwego/biz-demo/gomall/app/user/infra/mq"
+	"github.com/cloudwego/biz-demo/gomall/app/user/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/common/jwks"
 	"github.com/cloudwego/biz-demo/gomall/common/mtl"
 	"github.com/cloudwego/biz-demo/gomall/common/serversuite"
 	"github.com/cloudwego/biz-demo/gomall/common/utils"
@@ -43,7 +52,13 @@ func main() {
 	})
 	mtl.InitTracing(serviceName)
 	mtl.InitMetric(serviceName, conf.GetConf().Kitex.MetricsPort, conf.GetConf().Registry.RegistryAddress[0])
+	metric.Init()
 	dal.Init()
+	signing.Init()
+	mq.Init()
+	relay.Init()
+	rpc.InitClient()
+	deletion.Init()
 	opts := kitexInit()
 
 	svr := userservice.NewServer(new(UserServiceImpl), opts...)
@@ -66,6 +81,13 @@ func kitexInit() (opts []server.Option) {
 		panic(err)
 	}
 
-	opts = append(opts, server.WithServiceAddr(addr), server.WithSuite(serversuite.CommonServerSuite{CurrentServiceName: serviceName, RegistryAddr: conf.GetConf().Registry.RegistryAddress[0]}))
+	opts = append(opts, server.WithServiceAddr(addr), server.WithSuite(serversuite.CommonServerSuite{
+		CurrentServiceName: serviceName,
+		RegistryAddr:       conf.GetConf().Registry.RegistryAddress[0],
+		// the keys are at hand, no need to ask ourselves for them
+		Verifier: jwks.NewVerifier(func(context.Context) (*jwks.Set, error) {
+			return signing.Default.JWKS()
+		}, conf.GetConf().JWT.Issuer, time.Minute),
+	}))
 	return
 }
@@ -0,0 +1,66 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package authn carries the caller's identity across RPC calls. The frontend forwards the
+// user's access token as persistent metainfo so it follows the whole call chain, backend
+// services add a signed service credential to each call, and servers check that the
+// user_id of a request belongs to the caller.
+package authn
+
+import (
+	"context"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+	"github.com/cloudwego/biz-demo/gomall/common/jwks"
+)
+
+const (
+	// MetaToken is the user's access token, a persistent value
+	MetaToken = "GOMALL_AUTH_TOKEN"
+	// MetaService is the calling service's credential, a transient value
+	MetaService = "GOMALL_SERVICE_AUTH"
+)
+
+// Principal is who made a request: a user, a trusted service, or both when a service
+// calls on behalf of a user.
+type Principal struct {
+	UserId  uint32
+	Claims  *jwks.Claims
+	Service string
+}
+
+type principalKey struct{}
+
+// WithToken sends the access token with the RPC calls made with ctx and the calls they cause.
+func WithToken(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+	return metainfo.WithPersistentValue(ctx, MetaToken, token)
+}
+
+func Token(ctx context.Context) string {
+	v, _ := metainfo.GetPersistentValue(ctx, MetaToken)
+	return v
+}
+
+// FromContext returns the principal the server middleware authenticated.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
+
+func withPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
@@ -0,0 +1,263 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authn
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+	"github.com/cloudwego/biz-demo/gomall/common/jwks"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func TestServiceCredential(t *testing.T) {
+	now := time.Now()
+	cred := SignService("secret", "checkout", now)
+	service, err := VerifyService("secret", cred, now.Add(time.Minute))
+	if err != nil || service != "checkout" {
+		t.Fatalf("got %q, %v", service, err)
+	}
+	if _, err = VerifyService("other", cred, now); err == nil {
+		t.Error("accepted a credential signed with another secret")
+	}
+	if _, err = VerifyService("secret", strings.Replace(cred, "checkout", "payment", 1), now); err == nil {
+		t.Error("accepted a tampered credential")
+	}
+	if _, err = VerifyService("secret", cred, now.Add(time.Hour)); err == nil {
+		t.Error("accepted an expired credential")
+	}
+	if _, err = VerifyService("", SignService("", "checkout", now), now); err == nil {
+		t.Error("accepted a credential without a secret")
+	}
+}
+
+type request struct{ userId uint32 }
+
+func (r *request) GetUserId() uint32 { return r.userId }
+
+type args struct{ req *request }
+
+func (a *args) GetFirstArgument() interface{} { return a.req }
+
+type fixture struct {
+	verifier *jwks.Verifier
+	token    func(userId int, perms ...string) string
+}
+
+func newFixture(t *testing.T) fixture {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := jwks.NewKey("k1", jwks.ES256, &priv.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := &jwks.Set{Keys: []jwks.Key{key}}
+	return fixture{
+		verifier: jwks.NewVerifier(func(context.Context) (*jwks.Set, error) { return set, nil }, "gomall", time.Minute),
+		token: func(userId int, perms ...string) string {
+			token := jwt.NewWithClaims(jwt.SigningMethodES256, jwks.Claims{UserID: userId, Permissions: perms, RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    "gomall",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			}})
+			token.Header["kid"] = "k1"
+			s, err := token.SignedString(priv)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return s
+		},
+	}
+}
+
+func call(ctx context.Context, o ServerOptions, userId uint32) (int32, *Principal, bool) {
+	ri := rpcinfo.NewRPCInfo(nil, nil, rpcinfo.NewInvocation("svc", "Run"), nil, nil)
+	ctx = rpcinfo.NewCtxWithRPCInfo(ctx, ri)
+	var (
+		principal *Principal
+		called    bool
+	)
+	next := func(ctx context.Context, req, resp interface{}) error {
+		principal, _ = FromContext(ctx)
+		called = true
+		return nil
+	}
+	if err := ServerMiddleware(o)(next)(ctx, &args{&request{userId}}, nil); err != nil {
+		return -1, nil, called
+	}
+	if bizErr := ri.Invocation().BizStatusErr(); bizErr != nil {
+		return bizErr.BizStatusCode(), principal, called
+	}
+	return 0, principal, called
+}
+
+func TestServerMiddleware(t *testing.T) {
+	f := newFixture(t)
+	o := ServerOptions{Mode: ModeEnforce, Verifier: f.verifier, Secret: "secret", TrustedServices: []string{"checkout"}}
+	user := func(id int) context.Context { return WithToken(context.Background(), f.token(id)) }
+	service := func(ctx context.Context, name string) context.Context {
+		return metainfo.WithValue(ctx, MetaService, SignService("secret", name, time.Now()))
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		userId uint32
+		code   int32
+	}{
+		{"no user in the request", context.Background(), 0, 0},
+		{"anonymous", context.Background(), 7, 40100},
+		{"own user", user(7), 7, 0},
+		{"other user", user(7), 8, 40300},
+		{"invalid token", WithToken(context.Background(), "garbage"), 7, 40100},
+		{"trusted service", service(context.Background(), "checkout"), 7, 0},
+		{"untrusted service", service(context.Background(), "frontend"), 7, 40100},
+		{"forged service", metainfo.WithValue(context.Background(), MetaService, SignService("guess", "checkout", time.Now())), 7, 40100},
+		{"service forwarding another user's token", service(user(8), "checkout"), 7, 40300},
+		{"operator acting for a user", WithToken(context.Background(), f.token(1, PermActForUsers)), 7, 0},
+		{"service with an expired user token", service(WithToken(context.Background(), "garbage"), "checkout"), 7, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, _, called := call(tt.ctx, o, tt.userId)
+			if code != tt.code {
+				t.Errorf("got code %d, want %d", code, tt.code)
+			}
+			if called != (tt.code == 0) {
+				t.Errorf("handler called: %v", called)
+			}
+		})
+	}
+
+	code, p, _ := call(service(user(7), "checkout"), o, 7)
+	if code != 0 || p == nil || p.UserId != 7 || p.Service != "checkout" || p.Claims == nil {
+		t.Errorf("got principal %+v, code %d", p, code)
+	}
+}
+
+func TestServerMiddlewareModes(t *testing.T) {
+	f := newFixture(t)
+	for _, mode := range []string{ModePermissive, ModeOff} {
+		code, _, called := call(context.Background(), ServerOptions{Mode: mode, Verifier: f.verifier}, 7)
+		if code != 0 || !called {
+			t.Errorf("%s: got code %d, called %v", mode, code, called)
+		}
+	}
+}
+
+func TestClientMiddleware(t *testing.T) {
+	var credential string
+	next := func(ctx context.Context, req, resp interface{}) error {
+		credential, _ = metainfo.GetValue(ctx, MetaService)
+		return nil
+	}
+	_ = ClientMiddleware("frontend", "")(next)(context.Background(), nil, nil)
+	if credential != "" {
+		t.Errorf("sent credential %q without a secret", credential)
+	}
+	_ = ClientMiddleware("checkout", "secret")(next)(context.Background(), nil, nil)
+	if service, err := VerifyService("secret", credential, time.Now()); err != nil || service != "checkout" {
+		t.Errorf("got %q, %v", service, err)
+	}
+}
+
+func TestRequire(t *testing.T) {
+	f := newFixture(t)
+	o := ServerOptions{Mode: ModeEnforce, Verifier: f.verifier, Secret: "secret"}
+	tests := []struct {
+		name string
+		ctx  context.Context
+		mode string
+		code int32
+	}{
+		{"granted", WithToken(context.Background(), f.token(1, PermRolesManage)), ModeEnforce, 0},
+		{"not granted", WithToken(context.Background(), f.token(1, PermCatalogWrite)), ModeEnforce, 40300},
+		{"service", metainfo.WithValue(context.Background(), MetaService, SignService("secret", "order", time.Now())), ModeEnforce, 0},
+		{"service forwarding a user", metainfo.WithValue(WithToken(context.Background(), f.token(1)), MetaService, SignService("secret", "order", time.Now())), ModeEnforce, 40300},
+		{"anonymous", context.Background(), ModeEnforce, 40100},
+		{"permissive", context.Background(), ModePermissive, 0},
+		{"off", context.Background(), ModeOff, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o.Mode = tt.mode
+			var err error
+			next := func(ctx context.Context, req, resp interface{}) error {
+				err = Require(ctx, PermRolesManage)
+				return nil
+			}
+			ctx := rpcinfo.NewCtxWithRPCInfo(tt.ctx, rpcinfo.NewRPCInfo(nil, nil, rpcinfo.NewInvocation("user", "GrantRole"), nil, nil))
+			_ = ServerMiddleware(o)(next)(ctx, &args{&request{}}, nil)
+			var code int32
+			if bizErr, ok := kerrors.FromBizStatusError(err); ok {
+				code = bizErr.BizStatusCode()
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if code != tt.code {
+				t.Errorf("got code %d, want %d", code, tt.code)
+			}
+		})
+	}
+}
+
+func TestRequireService(t *testing.T) {
+	f := newFixture(t)
+	o := ServerOptions{Verifier: f.verifier, Secret: "secret"}
+	service := SignService("secret", "user", time.Now())
+	tests := []struct {
+		name string
+		ctx  context.Context
+		mode string
+		code int32
+	}{
+		{"service", metainfo.WithValue(context.Background(), MetaService, service), ModeEnforce, 0},
+		{"service forwarding a user", metainfo.WithValue(WithToken(context.Background(), f.token(1)), MetaService, service), ModeEnforce, 0},
+		{"user", WithToken(context.Background(), f.token(1, PermUsersErase)), ModeEnforce, 40300},
+		{"anonymous", context.Background(), ModeEnforce, 40300},
+		{"permissive", context.Background(), ModePermissive, 0},
+		{"off", context.Background(), ModeOff, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o.Mode = tt.mode
+			var err error
+			next := func(ctx context.Context, req, resp interface{}) error {
+				err = RequireService(ctx)
+				return nil
+			}
+			ctx := rpcinfo.NewCtxWithRPCInfo(tt.ctx, rpcinfo.NewRPCInfo(nil, nil, rpcinfo.NewInvocation("email", "ExportUserData"), nil, nil))
+			_ = ServerMiddleware(o)(next)(ctx, &args{&request{}}, nil)
+			var code int32
+			if bizErr, ok := kerrors.FromBizStatusError(err); ok {
+				code = bizErr.BizStatusCode()
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if code != tt.code {
+				t.Errorf("got code %d, want %d", code, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package authntest makes the contexts the authn server middleware hands to handlers, to
+// test the permission checks of handlers without running a server.
+package authntest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	"github.com/cloudwego/biz-demo/gomall/common/jwks"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// Token signs an access token of the user granting perms, and returns it with a verifier
+// that accepts it.
+func Token(t testing.TB, userId int, perms ...string) (string, *jwks.Verifier) {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := jwks.NewKey("test", jwks.ES256, &priv.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := &jwks.Set{Keys: []jwks.Key{key}}
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwks.Claims{UserID: userId, Permissions: perms, RegisteredClaims: jwt.RegisteredClaims{
+		Issuer:    "gomall",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}})
+	token.Header["kid"] = "test"
+	signed, err := token.SignedString(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed, jwks.NewVerifier(func(context.Context) (*jwks.Set, error) { return set, nil }, "gomall", time.Hour)
+}
+
+// UserContext is the context of a request made with a valid token of the user granting
+// perms, in the enforce mode.
+func UserContext(t testing.TB, userId int, perms ...string) context.Context {
+	t.Helper()
+	signed, verifier := Token(t, userId, perms...)
+	o := authn.ServerOptions{Mode: authn.ModeEnforce, Verifier: verifier}
+	var handlerCtx context.Context
+	next := func(ctx context.Context, req, resp any) error {
+		handlerCtx = ctx
+		return nil
+	}
+	ri := rpcinfo.NewRPCInfo(nil, nil, rpcinfo.NewInvocation("test", "Run"), nil, nil)
+	ctx := rpcinfo.NewCtxWithRPCInfo(authn.WithToken(context.Background(), signed), ri)
+	if err := authn.ServerMiddleware(o)(next)(ctx, nil, nil); err != nil || handlerCtx == nil {
+		t.Fatalf("authn rejected the token: %v %v", err, ri.Invocation().BizStatusErr())
+	}
+	return handlerCtx
+}
@@ -0,0 +1,195 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authn
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+	"github.com/cloudwego/biz-demo/gomall/common/jwks"
+	"github.com/cloudwego/kitex/pkg/endpoint"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/pkg/utils"
+)
+
+// Modes of the server middleware.
+const (
+	// ModeEnforce rejects requests for a user the caller is not
+	ModeEnforce = "enforce"
+	// ModePermissive only logs them, to roll out authentication
+	ModePermissive = "permissive"
+	ModeOff        = "off"
+)
+
+type ServerOptions struct {
+	Mode     string
+	Verifier *jwks.Verifier
+	// Secret verifies service credentials, services holding one may act for any user
+	Secret string
+	// TrustedServices limits which services are trusted, any service when empty
+	TrustedServices []string
+}
+
+// ClientMiddleware adds the credential of service to every call, unless secret is empty.
+func ClientMiddleware(service, secret string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, req, resp any) error {
+			if secret != "" {
+				ctx = metainfo.WithValue(ctx, MetaService, SignService(secret, service, time.Now()))
+			}
+			return next(ctx, req, resp)
+		}
+	}
+}
+
+// ServerMiddleware authenticates the caller and checks the user_id of the request
+// against it: a request for a user needs that user's token or a trusted service.
+// Requests without a user_id pass, they do not act for a user.
+func ServerMiddleware(o ServerOptions) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		if o.Mode == ModeOff {
+			return func(ctx context.Context, req, resp any) error {
+				return next(withMode(ctx, o.Mode), req, resp)
+			}
+		}
+		return func(ctx context.Context, req, resp any) error {
+			ctx = withMode(ctx, o.Mode)
+			p, authErr := authenticate(ctx, o)
+			err := authorize(p, requestUserId(req))
+			if err != nil && authErr != nil && p == nil {
+				// tell why the caller is unknown
+				err = authErr
+			}
+			if err != nil {
+				if o.Mode != ModePermissive {
+					return reject(ctx, err)
+				}
+				klog.CtxWarnf(ctx, "authn: %s: %v", method(ctx), err)
+			}
+			if p != nil {
+				ctx = withPrincipal(ctx, p)
+			}
+			return next(ctx, req, resp)
+		}
+	}
+}
+
+type authError struct {
+	code int32
+	msg  string
+}
+
+func (e *authError) Error() string {
+	return e.msg
+}
+
+// authenticate finds out who calls, from the token and the service credential. A bad
+// token or credential only matters if the request needs a principal.
+func authenticate(ctx context.Context, o ServerOptions) (p *Principal, err error) {
+	if token := Token(ctx); token != "" {
+		if o.Verifier == nil {
+			err = &authError{40100, "user tokens cannot be verified"}
+		} else if claims, verr := o.Verifier.Verify(ctx, token); verr != nil {
+			err = &authError{40100, fmt.Sprintf("invalid token: %v", verr)}
+		} else {
+			p = &Principal{UserId: uint32(claims.UserID), Claims: claims}
+		}
+	}
+	if credential, ok := metainfo.GetValue(ctx, MetaService); ok {
+		service, verr := VerifyService(o.Secret, credential, time.Now())
+		if verr != nil {
+			return p, &authError{40100, verr.Error()}
+		}
+		if trusted(o.TrustedServices, service) {
+			if p == nil {
+				p = &Principal{}
+			}
+			p.Service = service
+		}
+	}
+	return p, err
+}
+
+func authorize(p *Principal, userId uint32) error {
+	switch {
+	case userId == 0:
+		return nil
+	case p == nil:
+		return &authError{40100, "authentication required"}
+	case p.UserId != 0:
+		// a user's token decides even when a service forwards it
+		if p.UserId != userId && !p.Can(PermActForUsers) {
+			return &authError{40300, fmt.Sprintf("user %d may not act for user %d", p.UserId, userId)}
+		}
+		return nil
+	case p.Service != "":
+		return nil
+	}
+	return &authError{40100, "authentication required"}
+}
+
+func trusted(services []string, service string) bool {
+	if len(services) == 0 {
+		return true
+	}
+	for _, s := range services {
+		if s == service {
+			return true
+		}
+	}
+	return false
+}
+
+type userIdGetter interface {
+	GetUserId() uint32
+}
+
+func requestUserId(req any) uint32 {
+	args, ok := req.(utils.KitexArgs)
+	if !ok {
+		return 0
+	}
+	if r, ok := args.GetFirstArgument().(userIdGetter); ok {
+		return r.GetUserId()
+	}
+	return 0
+}
+
+// reject answers with a business error, the way kitex does for errors of handlers.
+func reject(ctx context.Context, err error) error {
+	ae, ok := err.(*authError)
+	if !ok {
+		return err
+	}
+	bizErr := kerrors.NewBizStatusError(ae.code, ae.msg)
+	if ri := rpcinfo.GetRPCInfo(ctx); ri != nil {
+		if setter, ok := ri.Invocation().(rpcinfo.InvocationSetter); ok {
+			setter.SetBizStatusErr(bizErr)
+			return nil
+		}
+	}
+	return bizErr
+}
+
+func method(ctx context.Context) string {
+	if ri := rpcinfo.GetRPCInfo(ctx); ri != nil {
+		return ri.Invocation().MethodName()
+	}
+	return ""
+}
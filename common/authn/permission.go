@@ -0,0 +1,105 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// The permissions of operators, roles grant them and tokens carry them.
+const (
+	PermCatalogWrite        = "catalog:write"
+	PermOrdersManage        = "orders:manage"
+	PermPaymentsRefund      = "payments:refund"
+	PermNotificationsManage = "notifications:manage"
+	PermRolesManage         = "roles:manage"
+	// PermActForUsers lets a user make requests for other users, e.g. refund their orders
+	PermActForUsers = "users:act_for"
+	// PermUsersErase lets a user erase the personal data of users in every service, the
+	// account deletion runs as the user service on its own
+	PermUsersErase = "users:erase"
+)
+
+// RoleAdmin has every permission.
+const RoleAdmin = "admin"
+
+var AllPermissions = []string{
+	PermCatalogWrite,
+	PermOrdersManage,
+	PermPaymentsRefund,
+	PermNotificationsManage,
+	PermRolesManage,
+	PermActForUsers,
+	PermUsersErase,
+}
+
+type modeKey struct{}
+
+func withMode(ctx context.Context, mode string) context.Context {
+	return context.WithValue(ctx, modeKey{}, mode)
+}
+
+// Can tells whether the principal's user token grants perm.
+func (p *Principal) Can(perm string) bool {
+	return p != nil && p.Claims != nil && p.Claims.HasPermission(perm)
+}
+
+// Require checks in a handler that the caller has perm. A user's token must grant it,
+// a trusted service calling on its own is allowed. Like the middleware, the permissive
+// mode only logs and the off mode allows everything.
+func Require(ctx context.Context, perm string) error {
+	mode, _ := ctx.Value(modeKey{}).(string)
+	if mode == ModeOff {
+		return nil
+	}
+	p, _ := FromContext(ctx)
+	var err error
+	switch {
+	case p != nil && p.UserId != 0:
+		if !p.Can(perm) {
+			err = kerrors.NewBizStatusError(40300, fmt.Sprintf("user %d lacks permission %s", p.UserId, perm))
+		}
+	case p != nil && p.Service != "":
+	default:
+		err = kerrors.NewBizStatusError(40100, "authentication required")
+	}
+	if err != nil && mode == ModePermissive {
+		klog.CtxWarnf(ctx, "authn: %s: %v", method(ctx), err)
+		return nil
+	}
+	return err
+}
+
+// RequireService checks in a handler that a trusted service calls, for requests users
+// cannot be trusted with even for themselves. Modes apply as for Require.
+func RequireService(ctx context.Context) error {
+	mode, _ := ctx.Value(modeKey{}).(string)
+	if mode == ModeOff {
+		return nil
+	}
+	var err error
+	if p, _ := FromContext(ctx); p == nil || p.Service == "" {
+		err = kerrors.NewBizStatusError(40300, "only services may call "+method(ctx))
+	}
+	if err != nil && mode == ModePermissive {
+		klog.CtxWarnf(ctx, "authn: %s: %v", method(ctx), err)
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authn
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// serviceSkew is how old a service credential may be, and how far in the future.
+const serviceSkew = 5 * time.Minute
+
+var errBadCredential = errors.New("invalid service credential")
+
+// SignService makes the credential of a service: its name and the time, signed with the
+// secret all backend services share.
+func SignService(secret, service string, now time.Time) string {
+	ts := strconv.FormatInt(now.Unix(), 10)
+	return service + "." + ts + "." + serviceMAC(secret, service, ts)
+}
+
+// VerifyService checks a credential and returns the service it names.
+func VerifyService(secret, credential string, now time.Time) (string, error) {
+	parts := strings.Split(credential, ".")
+	if secret == "" || len(parts) != 3 {
+		return "", errBadCredential
+	}
+	service, ts, mac := parts[0], parts[1], parts[2]
+	if !hmac.Equal([]byte(mac), []byte(serviceMAC(secret, service, ts))) {
+		return "", errBadCredential
+	}
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return "", errBadCredential
+	}
+	if d := now.Sub(time.Unix(unix, 0)); d > serviceSkew || d < -serviceSkew {
+		return "", fmt.Errorf("service credential of %s expired", service)
+	}
+	return service, nil
+}
+
+func serviceMAC(secret, service, ts string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(service + "." + ts))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -15,6 +15,9 @@
 package clientsuite
 
 import (
+	"os"
+
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
 	"github.com/cloudwego/kitex/client"
 	"github.com/cloudwego/kitex/pkg/rpcinfo"
 	"github.com/cloudwego/kitex/pkg/transmeta"
@@ -44,6 +47,8 @@ func (s CommonGrpcClientSuite) Options() []client.Option {
 			ServiceName: s.CurrentServiceName,
 		}),
 		client.WithSuite(tracing.NewClientSuite()),
+		// the frontend has no secret and calls only with the user's token
+		client.WithMiddleware(authn.ClientMiddleware(s.CurrentServiceName, os.Getenv("SERVICE_AUTH_SECRET"))),
 	)
 
 	return opts
@@ -0,0 +1,114 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package eventbus publishes and consumes the domain events of idl/events.proto on NATS
+// JetStream. It names the subjects, carries the trace context and the schema version in
+// the message header and rejects events of another version than the subscriber's.
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/cloudwego/biz-demo/gomall/common/outbox"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+const (
+	// HeaderType is the full protobuf name of the event, e.g. events.v1.OrderPaid
+	HeaderType    = "Event-Type"
+	HeaderVersion = "Event-Version"
+)
+
+// Subject is the subject events like e are published on: the first word of the message
+// name is the domain, the rest the event, e.g. events.v1.OrderPaid is order.paid.v1.
+func Subject(e proto.Message) string {
+	desc := e.ProtoReflect().Descriptor()
+	words := splitWords(string(desc.Name()))
+	return fmt.Sprintf("%s.%s.v%d", words[0], strings.Join(words[1:], "_"), Version(e))
+}
+
+// Version is the schema version of e, taken from the vN suffix of its protobuf package.
+// It is 0 for messages outside a versioned package.
+func Version(e proto.Message) int {
+	pkg := string(e.ProtoReflect().Descriptor().ParentFile().Package())
+	last := pkg[strings.LastIndex(pkg, ".")+1:]
+	if !strings.HasPrefix(last, "v") {
+		return 0
+	}
+	v, _ := strconv.Atoi(last[1:])
+	return v
+}
+
+func splitWords(name string) (words []string) {
+	start := 0
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			words = append(words, strings.ToLower(name[start:i]))
+			start = i
+		}
+	}
+	return append(words, strings.ToLower(name[start:]))
+}
+
+// NewMsg encodes e with its type, version and the trace context of ctx in the header.
+func NewMsg(ctx context.Context, e proto.Message) (*nats.Msg, error) {
+	data, err := proto.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	msg := &nats.Msg{Subject: Subject(e), Data: data, Header: make(nats.Header)}
+	msg.Header.Set(HeaderType, string(e.ProtoReflect().Descriptor().FullName()))
+	msg.Header.Set(HeaderVersion, strconv.Itoa(Version(e)))
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(msg.Header))
+	return msg, nil
+}
+
+// AddToOutbox queues e in the outbox of the transaction tx, so it is published only if
+// the transaction commits. See common/outbox.
+func AddToOutbox(tx *gorm.DB, ctx context.Context, e proto.Message) error {
+	msg, err := NewMsg(ctx, e)
+	if err != nil {
+		return err
+	}
+	return outbox.AddMsg(tx, ctx, msg)
+}
+
+// Publisher publishes events straight to JetStream, for events that do not go with a
+// database change.
+type Publisher struct {
+	js jetstream.JetStream
+}
+
+func NewPublisher(js jetstream.JetStream) *Publisher {
+	return &Publisher{js: js}
+}
+
+func (p *Publisher) Publish(ctx context.Context, e proto.Message) error {
+	msg, err := NewMsg(ctx, e)
+	if err != nil {
+		return err
+	}
+	_, err = p.js.PublishMsg(ctx, msg, jetstream.WithMsgID(uuid.NewString()))
+	return err
+}
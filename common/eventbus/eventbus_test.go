@@ -0,0 +1,200 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/events"
+	natsserver "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSubject(t *testing.T) {
+	tests := []struct {
+		e    proto.Message
+		want string
+	}{
+		{&events.OrderPlaced{}, "order.placed.v1"},
+		{&events.OrderPaid{}, "order.paid.v1"},
+		{&events.PaymentCharged{}, "payment.charged.v1"},
+		{&events.PaymentRefunded{}, "payment.refunded.v1"},
+		{&events.UserRegistered{}, "user.registered.v1"},
+	}
+	for _, tt := range tests {
+		if got := Subject(tt.e); got != tt.want {
+			t.Errorf("Subject(%T) = %q, want %q", tt.e, got, tt.want)
+		}
+	}
+	if got := splitWords("OrderItemShipped"); len(got) != 3 || got[2] != "shipped" {
+		t.Errorf("splitWords = %v", got)
+	}
+}
+
+func startJetStream(t *testing.T) (*nats.Conn, jetstream.JetStream) {
+	t.Helper()
+	s, err := natsserver.NewServer(&natsserver.Options{Host: "127.0.0.1", Port: -1, JetStream: true, StoreDir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Start()
+	if !s.ReadyForConnections(5 * time.Second) {
+		t.Fatal("nats server did not start")
+	}
+	t.Cleanup(s.Shutdown)
+	nc, err := nats.Connect(s.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(nc.Close)
+	js, err := jetstream.New(nc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return nc, js
+}
+
+type received struct {
+	mu     sync.Mutex
+	orders map[string][]string
+	fail   map[string]int
+}
+
+func (r *received) handler(name string) func(ctx context.Context, e *events.OrderPaid) error {
+	return func(ctx context.Context, e *events.OrderPaid) error {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.fail[e.OrderId] > 0 {
+			r.fail[e.OrderId]--
+			return errors.New("try again")
+		}
+		r.orders[name] = append(r.orders[name], e.OrderId)
+		return nil
+	}
+}
+
+func (r *received) count(names ...string) (n int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, name := range names {
+		n += len(r.orders[name])
+	}
+	return
+}
+
+func TestSubscribe(t *testing.T) {
+	ctx := context.Background()
+	nc, js := startJetStream(t)
+	if _, err := js.CreateStream(ctx, jetstream.StreamConfig{Name: "ORDER", Subjects: []string{"order.>"}}); err != nil {
+		t.Fatal(err)
+	}
+	r := &received{orders: map[string][]string{}, fail: map[string]int{"o2": 1}}
+	opts := Options{MaxDeliver: 3, Backoff: 10 * time.Millisecond, BackoffMax: 20 * time.Millisecond}
+	subscribe := func(group, name string) {
+		opts := opts
+		opts.Group = group
+		sub, err := Subscribe(ctx, js, opts, r.handler(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(sub.Stop)
+	}
+	subscribe("shipping", "shipping-1")
+	subscribe("shipping", "shipping-2")
+	subscribe("email", "email")
+
+	p := NewPublisher(js)
+	for _, id := range []string{"o1", "o2", "o3"} {
+		if err := p.Publish(ctx, &events.OrderPaid{OrderId: id, UserId: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// a producer on a newer schema must not reach v1 subscribers
+	msg, err := NewMsg(ctx, &events.OrderPaid{OrderId: "o4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg.Header.Set(HeaderVersion, "2")
+	if err := nc.PublishMsg(msg); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for (r.count("shipping-1", "shipping-2") < 3 || r.count("email") < 3) && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	// give the dead lettered event time to show up if it was delivered
+	time.Sleep(100 * time.Millisecond)
+	if n := r.count("shipping-1", "shipping-2"); n != 3 {
+		t.Errorf("shipping group handled %d events, want 3", n)
+	}
+	if n := r.count("email"); n != 3 {
+		t.Errorf("email group handled %d events, want 3", n)
+	}
+}
+
+func TestSubscribeDeadLetter(t *testing.T) {
+	ctx := context.Background()
+	_, js := startJetStream(t)
+	if _, err := js.CreateStream(ctx, jetstream.StreamConfig{Name: "ORDER", Subjects: []string{"order.>"}}); err != nil {
+		t.Fatal(err)
+	}
+	r := &received{orders: map[string][]string{}, fail: map[string]int{"o1": 100}}
+	opts := Options{Group: "email", MaxDeliver: 3, Backoff: 10 * time.Millisecond, BackoffMax: 20 * time.Millisecond}
+	sub, err := Subscribe(ctx, js, opts, r.handler("email"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(sub.Stop)
+	if err := NewPublisher(js).Publish(ctx, &events.OrderPaid{OrderId: "o1", UserId: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	dlq, err := js.Stream(ctx, DeadLetterStream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject := DeadLetterSubject("email", "order.paid.v1")
+	var dead *jetstream.RawStreamMsg
+	deadline := time.Now().Add(5 * time.Second)
+	for dead == nil && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+		dead, _ = dlq.GetLastMsgForSubject(ctx, subject)
+	}
+	if dead == nil {
+		t.Fatalf("no dead letter on %s", subject)
+	}
+	if got := dead.Header.Get(HeaderDeadReason); got != "try again" {
+		t.Errorf("reason = %q, want %q", got, "try again")
+	}
+	if got := dead.Header.Get(HeaderDeadDeliveries); got != "3" {
+		t.Errorf("deliveries = %q, want 3", got)
+	}
+	e := &events.OrderPaid{}
+	if err := proto.Unmarshal(dead.Data, e); err != nil || e.OrderId != "o1" {
+		t.Errorf("dead letter = %v, %v", e, err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if n := r.fail["o1"]; n != 97 {
+		t.Errorf("handled %d times, want 3", 100-n)
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+var ErrSchemaMismatch = errors.New("event schema mismatch")
+
+// DeadLetterStream keeps the events a group gave up on, on DeadLetterSubject, with the
+// reason and the attempts in the header.
+const DeadLetterStream = "EVENTS_DLQ"
+
+const (
+	HeaderDeadReason     = "Event-Dead-Reason"
+	HeaderDeadDeliveries = "Event-Dead-Deliveries"
+	HeaderDeadStreamSeq  = "Event-Dead-Stream-Seq"
+	HeaderDeadAt         = "Event-Dead-At"
+
+	deadLetterMaxAge = 30 * 24 * time.Hour
+)
+
+// DeadLetterSubject is the subject the events of subject a group gave up on are kept on,
+// e.g. dlq.shipping.order.paid.v1.
+func DeadLetterSubject(group, subject string) string {
+	return "dlq." + group + "." + subject
+}
+
+type Options struct {
+	// Group is the consumer group. The subscribers of a group share its events and every
+	// group gets each event, new groups start with the events published after they join.
+	Group      string
+	MaxDeliver int
+	// A failed event is redelivered after Backoff, doubled for every further attempt up
+	// to BackoffMax.
+	Backoff    time.Duration
+	BackoffMax time.Duration
+}
+
+func (o Options) backoff(delivered uint64) time.Duration {
+	d := o.Backoff
+	for i := uint64(1); i < delivered && d < o.BackoffMax; i++ {
+		d *= 2
+	}
+	return min(d, o.BackoffMax)
+}
+
+type Subscription struct {
+	cc jetstream.ConsumeContext
+}
+
+func (s *Subscription) Stop() {
+	s.cc.Stop()
+}
+
+// Subscribe calls handle for the events of type E of the group. The subject must belong to
+// a stream. An event is acked when handle returns nil and retried otherwise. Events that
+// still fail after MaxDeliver attempts, cannot be decoded or have another schema version are
+// moved to the dead letter stream; they are redelivered until that succeeds.
+func Subscribe[E any, PE interface {
+	*E
+	proto.Message
+}](ctx context.Context, js jetstream.JetStream, opts Options, handle func(ctx context.Context, e PE) error) (*Subscription, error) {
+	if opts.Group == "" {
+		return nil, errors.New("eventbus: consumer group is required")
+	}
+	if opts.MaxDeliver <= 0 {
+		opts.MaxDeliver = 5
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = time.Second
+	}
+	if opts.BackoffMax < opts.Backoff {
+		opts.BackoffMax = opts.Backoff
+	}
+	subject := Subject(PE(new(E)))
+	stream, err := js.StreamNameBySubject(ctx, subject)
+	if err != nil {
+		return nil, fmt.Errorf("eventbus: stream of %s: %w", subject, err)
+	}
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     DeadLetterStream,
+		Subjects: []string{DeadLetterSubject("*", ">")},
+		MaxAge:   deadLetterMaxAge,
+		Storage:  jetstream.FileStorage,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("eventbus: stream %s: %w", DeadLetterStream, err)
+	}
+	consumer, err := js.CreateOrUpdateConsumer(ctx, stream, jetstream.ConsumerConfig{
+		Durable:       opts.Group + "_" + strings.ReplaceAll(subject, ".", "_"),
+		FilterSubject: subject,
+		DeliverPolicy: jetstream.DeliverNewPolicy,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		// unlimited, dispatch stops handling the event after MaxDeliver attempts itself
+		MaxDeliver: -1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("eventbus: consumer %s of %s: %w", opts.Group, subject, err)
+	}
+	cc, err := consumer.Consume(func(msg jetstream.Msg) {
+		dispatch(js, msg, opts, handle)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Subscription{cc: cc}, nil
+}
+
+func dispatch[E any, PE interface {
+	*E
+	proto.Message
+}](js jetstream.JetStream, msg jetstream.Msg, opts Options, handle func(ctx context.Context, e PE) error) {
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.HeaderCarrier(msg.Headers()))
+	ctx, span := otel.Tracer("eventbus").Start(ctx, msg.Subject()+" process", trace.WithSpanKind(trace.SpanKindConsumer))
+	defer span.End()
+
+	meta, err := msg.Metadata()
+	if err != nil {
+		_ = msg.Nak()
+		return
+	}
+	if int(meta.NumDelivered) > opts.MaxDeliver {
+		// the last attempt ended without a result, e.g. the service stopped while handling it
+		// or the dead letter could not be stored
+		deadLetter(ctx, js, msg, meta, opts, "no result after the last attempt")
+		return
+	}
+	e := PE(new(E))
+	if err := checkSchema(msg, e); err != nil {
+		deadLetter(ctx, js, msg, meta, opts, err.Error())
+		return
+	}
+	if err := proto.Unmarshal(msg.Data(), e); err != nil {
+		deadLetter(ctx, js, msg, meta, opts, err.Error())
+		return
+	}
+	err = handle(ctx, e)
+	if err == nil {
+		_ = msg.Ack()
+		return
+	}
+	if int(meta.NumDelivered) >= opts.MaxDeliver {
+		deadLetter(ctx, js, msg, meta, opts, err.Error())
+		return
+	}
+	klog.CtxWarnf(ctx, "eventbus: %s %d failed for %s on attempt %d, retrying: %v", msg.Subject(), meta.Sequence.Stream, opts.Group, meta.NumDelivered, err)
+	_ = msg.NakWithDelay(opts.backoff(meta.NumDelivered))
+}
+
+// deadLetter moves the event to the dead letter stream and terminates it, or has it
+// redelivered when the dead letter cannot be stored.
+func deadLetter(ctx context.Context, js jetstream.JetStream, msg jetstream.Msg, meta *jetstream.MsgMetadata, opts Options, reason string) {
+	dead := nats.NewMsg(DeadLetterSubject(opts.Group, msg.Subject()))
+	dead.Data = msg.Data()
+	for k, v := range msg.Headers() {
+		dead.Header[k] = v
+	}
+	dead.Header.Del(jetstream.MsgIDHeader)
+	dead.Header.Set(HeaderDeadReason, reason)
+	dead.Header.Set(HeaderDeadDeliveries, strconv.FormatUint(meta.NumDelivered, 10))
+	dead.Header.Set(HeaderDeadStreamSeq, strconv.FormatUint(meta.Sequence.Stream, 10))
+	dead.Header.Set(HeaderDeadAt, time.Now().UTC().Format(time.RFC3339))
+	msgId := fmt.Sprintf("dead-%s-%s-%d", opts.Group, meta.Stream, meta.Sequence.Stream)
+	if _, err := js.PublishMsg(ctx, dead, jetstream.WithMsgID(msgId)); err != nil {
+		klog.CtxErrorf(ctx, "eventbus: dead letter %s %d for %s failed, retrying: %v", msg.Subject(), meta.Sequence.Stream, opts.Group, err)
+		_ = msg.NakWithDelay(opts.backoff(meta.NumDelivered))
+		return
+	}
+	klog.CtxErrorf(ctx, "eventbus: %s %d moved to %s for %s after %d attempts: %s", msg.Subject(), meta.Sequence.Stream, DeadLetterStream, opts.Group, meta.NumDelivered, reason)
+	_ = msg.Term()
+}
+
+func checkSchema(msg jetstream.Msg, e proto.Message) error {
+	want := string(e.ProtoReflect().Descriptor().FullName())
+	if got := msg.Headers().Get(HeaderType); got != want {
+		return fmt.Errorf("%w: type %q, want %q", ErrSchemaMismatch, got, want)
+	}
+	if got := msg.Headers().Get(HeaderVersion); got != strconv.Itoa(Version(e)) {
+		return fmt.Errorf("%w: version %q, want %d", ErrSchemaMismatch, got, Version(e))
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jwks publishes the public keys the user service signs tokens with as a JSON Web
+// Key Set (RFC 7517) and verifies those tokens with them, so services can check a token
+// without calling the user service.
+package jwks
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	RS256 = "RS256"
+	ES256 = "ES256"
+)
+
+// Claims are the claims of the tokens the user service issues.
+type Claims struct {
+	UserID int `json:"user_id"`
+	// SessionID is the login session of the token, revoking the session stops its refresh
+	SessionID     string `json:"sid,omitempty"`
+	EmailVerified bool   `json:"email_verified,omitempty"`
+	// Roles and Permissions are the user's when the token was issued
+	Roles       []string `json:"roles,omitempty"`
+	Permissions []string `json:"perms,omitempty"`
+	jwt.RegisteredClaims
+}
+
+func (c *Claims) HasPermission(perm string) bool {
+	for _, p := range c.Permissions {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// Key is a public key in JWK form.
+type Key struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// EC
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type Set struct {
+	Keys []Key `json:"keys"`
+}
+
+var b64 = base64.RawURLEncoding
+
+// NewKey describes the public key pub for alg, RS256 needs an RSA and ES256 a P-256 key.
+func NewKey(kid, alg string, pub crypto.PublicKey) (Key, error) {
+	k := Key{Kid: kid, Alg: alg, Use: "sig"}
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		if alg != RS256 {
+			return k, fmt.Errorf("key %s: RSA key for %s", kid, alg)
+		}
+		k.Kty = "RSA"
+		k.N = b64.EncodeToString(pub.N.Bytes())
+		k.E = b64.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case *ecdsa.PublicKey:
+		if alg != ES256 || pub.Curve != elliptic.P256() {
+			return k, fmt.Errorf("key %s: EC key for %s must be on P-256", kid, alg)
+		}
+		k.Kty = "EC"
+		k.Crv = "P-256"
+		k.X = b64.EncodeToString(pub.X.FillBytes(make([]byte, 32)))
+		k.Y = b64.EncodeToString(pub.Y.FillBytes(make([]byte, 32)))
+	default:
+		return k, fmt.Errorf("key %s: unsupported key type %T", kid, pub)
+	}
+	return k, nil
+}
+
+// PublicKey decodes the key.
+func (k Key) PublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := b64.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", k.Kid, err)
+		}
+		e, err := b64.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", k.Kid, err)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("key %s: unsupported curve %s", k.Kid, k.Crv)
+		}
+		x, err := b64.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", k.Kid, err)
+		}
+		y, err := b64.DecodeString(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", k.Kid, err)
+		}
+		pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, fmt.Errorf("key %s: point is not on the curve", k.Kid)
+		}
+		return pub, nil
+	}
+	return nil, fmt.Errorf("key %s: unsupported key type %s", k.Kid, k.Kty)
+}
+
+func Parse(data []byte) (*Set, error) {
+	var s Set
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("parse jwks: %w", err)
+	}
+	return &s, nil
+}
@@ -0,0 +1,207 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func sign(t *testing.T, kid, alg string, priv crypto.Signer, claims Claims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(alg), claims)
+	token.Header["kid"] = kid
+	s, err := token.SignedString(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func claims(issuer string) Claims {
+	return Claims{UserID: 7, RegisteredClaims: jwt.RegisteredClaims{
+		Issuer:    issuer,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}}
+}
+
+func TestKeyRoundTrip(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tests := []struct {
+		alg string
+		pub crypto.PublicKey
+	}{
+		{RS256, &rsaKey.PublicKey},
+		{ES256, &ecKey.PublicKey},
+	}
+	for _, tt := range tests {
+		k, err := NewKey("k1", tt.alg, tt.pub)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := json.Marshal(Set{Keys: []Key{k}})
+		set, err := Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pub, err := set.Keys[0].PublicKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !pub.(interface{ Equal(crypto.PublicKey) bool }).Equal(tt.pub) {
+			t.Errorf("%s key changed in the round trip", tt.alg)
+		}
+	}
+	if _, err := NewKey("k1", ES256, &rsaKey.PublicKey); err == nil {
+		t.Error("RSA key accepted for ES256")
+	}
+}
+
+func TestVerifierRotation(t *testing.T) {
+	old, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	next, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	oldKey, _ := NewKey("old", ES256, &old.PublicKey)
+	nextKey, _ := NewKey("next", ES256, &next.PublicKey)
+
+	set := &Set{Keys: []Key{oldKey}}
+	fetches := 0
+	v := NewVerifier(func(ctx context.Context) (*Set, error) {
+		fetches++
+		return set, nil
+	}, "gomall", time.Hour)
+	ctx := context.Background()
+
+	if _, err := v.Verify(ctx, sign(t, "old", ES256, old, claims("gomall"))); err != nil {
+		t.Fatal(err)
+	}
+	// the user service rotated, the token of the new key makes the verifier fetch again
+	v.minInterval = 0
+	set = &Set{Keys: []Key{oldKey, nextKey}}
+	c, err := v.Verify(ctx, sign(t, "next", ES256, next, claims("gomall")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.UserID != 7 || fetches != 2 {
+		t.Errorf("user %d after %d fetches", c.UserID, fetches)
+	}
+	if _, err := v.Verify(ctx, sign(t, "old", ES256, old, claims("gomall"))); err != nil {
+		t.Errorf("old key no longer accepted: %v", err)
+	}
+
+	// unknown keys do not fetch again right away
+	v.minInterval = time.Hour
+	_, err = v.Verify(ctx, sign(t, "bogus", ES256, next, claims("gomall")))
+	if !errors.Is(err, ErrUnknownKey) || fetches != 2 {
+		t.Errorf("err = %v after %d fetches", err, fetches)
+	}
+}
+
+func TestVerifierSourceDown(t *testing.T) {
+	priv, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	k, _ := NewKey("k1", ES256, &priv.PublicKey)
+	var (
+		mu      sync.Mutex
+		fetches int
+		down    bool
+	)
+	release := make(chan struct{})
+	v := NewVerifier(func(ctx context.Context) (*Set, error) {
+		mu.Lock()
+		fetches++
+		failing := down
+		mu.Unlock()
+		if failing {
+			return nil, errors.New("unavailable")
+		}
+		<-release
+		return &Set{Keys: []Key{k}}, nil
+	}, "gomall", time.Hour)
+	ctx := context.Background()
+	token := sign(t, "k1", ES256, priv, claims("gomall"))
+
+	// concurrent requests share one fetch
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := v.Verify(ctx, token); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// a stale cache with the source down fetches once per minimum interval
+	v.refresh = 0
+	v.minInterval = 0
+	mu.Lock()
+	down = true
+	mu.Unlock()
+	if _, err := v.Verify(ctx, token); err != nil {
+		t.Errorf("cached key not used while the source is down: %v", err)
+	}
+	v.minInterval = time.Hour
+	for i := 0; i < 5; i++ {
+		if _, err := v.Verify(ctx, token); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if fetches != 2 {
+		t.Errorf("%d fetches, want 2", fetches)
+	}
+}
+
+func TestVerifierRejects(t *testing.T) {
+	priv, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	other, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	k, _ := NewKey("k1", ES256, &priv.PublicKey)
+	v := NewVerifier(func(ctx context.Context) (*Set, error) {
+		return &Set{Keys: []Key{k}}, nil
+	}, "gomall", time.Hour)
+	ctx := context.Background()
+
+	expired := claims("gomall")
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	hs := jwt.NewWithClaims(jwt.SigningMethodHS256, claims("gomall"))
+	hs.Header["kid"] = "k1"
+	hsToken, _ := hs.SignedString([]byte("secret"))
+
+	tokens := map[string]string{
+		"wrong issuer":    sign(t, "k1", ES256, priv, claims("someone")),
+		"expired":         sign(t, "k1", ES256, priv, expired),
+		"wrong signature": sign(t, "k1", ES256, other, claims("gomall")),
+		"hmac":            hsToken,
+	}
+	for name, token := range tokens {
+		if _, err := v.Verify(ctx, token); err == nil {
+			t.Errorf("%s token accepted", name)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"golang.org/x/sync/singleflight"
+)
+
+var ErrUnknownKey = errors.New("jwks: unknown key")
+
+// Source fetches the current key set, e.g. from the user service.
+type Source func(ctx context.Context) (*Set, error)
+
+// IssuerFromEnv returns the issuer of the user service's tokens, AUTH_TOKEN_ISSUER or the
+// gomall of the shipped configuration.
+func IssuerFromEnv() string {
+	if v := os.Getenv("AUTH_TOKEN_ISSUER"); v != "" {
+		return v
+	}
+	return "gomall"
+}
+
+// Verifier checks tokens against a cached key set. The set is fetched again when it is
+// older than the refresh interval, or when a token names a key it does not know yet,
+// which happens right after a key rotation. The set is fetched at most once per minimum
+// interval so bogus tokens or a source that is down cannot flood it.
+type Verifier struct {
+	source      Source
+	issuer      string
+	refresh     time.Duration
+	minInterval time.Duration
+
+	group     singleflight.Group
+	mu        sync.Mutex
+	keys      map[string]key
+	fetched   time.Time
+	attempted time.Time
+}
+
+type key struct {
+	alg string
+	pub any
+}
+
+func NewVerifier(source Source, issuer string, refresh time.Duration) *Verifier {
+	return &Verifier{source: source, issuer: issuer, refresh: refresh, minInterval: 10 * time.Second}
+}
+
+// Verify checks the signature, expiry and issuer of the token and returns its claims.
+func (v *Verifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		k, err := v.key(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if t.Method.Alg() != k.alg {
+			return nil, fmt.Errorf("jwks: key %s is for %s, not %s", kid, k.alg, t.Method.Alg())
+		}
+		return k.pub, nil
+	}, jwt.WithValidMethods([]string{RS256, ES256}))
+	if err != nil {
+		return nil, err
+	}
+	if v.issuer != "" && !claims.VerifyIssuer(v.issuer, true) {
+		return nil, fmt.Errorf("jwks: token issued by %q", claims.Issuer)
+	}
+	return claims, nil
+}
+
+func (v *Verifier) key(ctx context.Context, kid string) (key, error) {
+	v.mu.Lock()
+	k, ok := v.keys[kid]
+	fresh := time.Since(v.fetched) < v.refresh
+	v.mu.Unlock()
+	if ok && fresh {
+		return k, nil
+	}
+	if err := v.fetch(ctx); err != nil {
+		if ok {
+			// keep verifying with the cached key while the source is down
+			return k, nil
+		}
+		return key{}, err
+	}
+	v.mu.Lock()
+	k, ok = v.keys[kid]
+	v.mu.Unlock()
+	if !ok {
+		return key{}, fmt.Errorf("%w %q", ErrUnknownKey, kid)
+	}
+	return k, nil
+}
+
+// fetch refreshes the keys unless they were fetched less than minInterval ago. It runs
+// without the lock, concurrent callers share one fetch.
+func (v *Verifier) fetch(ctx context.Context) error {
+	_, err, _ := v.group.Do("keys", func() (any, error) {
+		v.mu.Lock()
+		recent := time.Since(v.attempted) < v.minInterval
+		if !recent {
+			v.attempted = time.Now()
+		}
+		v.mu.Unlock()
+		if recent {
+			return nil, nil
+		}
+		set, err := v.source(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("jwks: fetch keys: %w", err)
+		}
+		keys := make(map[string]key, len(set.Keys))
+		for _, k := range set.Keys {
+			pub, err := k.PublicKey()
+			if err != nil {
+				return nil, err
+			}
+			keys[k.Kid] = key{alg: k.Alg, pub: pub}
+		}
+		v.mu.Lock()
+		v.keys = keys
+		v.fetched = time.Now()
+		v.mu.Unlock()
+		return nil, nil
+	})
+	return err
+}
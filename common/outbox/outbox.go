@@ -0,0 +1,84 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package outbox implements the transactional outbox: messages are written to a table in
+// the same transaction as the change they announce and published to NATS by a Relay
+// after commit, so they are neither lost nor sent for rolled back transactions.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"gorm.io/gorm"
+)
+
+// Message is a row of the outbox table. MsgId is sent as Nats-Msg-Id, so JetStream drops
+// the copies a relay publishes again after a crash or a lost ack. A message that fails is
+// tried again at NextAttemptAt, and parked with FailedAt set after the last attempt; clear
+// FailedAt to send it again.
+type Message struct {
+	ID            uint64 `gorm:"primaryKey"`
+	MsgId         string `gorm:"uniqueIndex;size:64"`
+	Subject       string `gorm:"size:256"`
+	Header        string `gorm:"type:text"`
+	Payload       []byte
+	CreatedAt     time.Time
+	PublishedAt   *time.Time `gorm:"index;index:idx_outbox_due,priority:1"`
+	Attempts      int
+	LastError     string     `gorm:"size:1024"`
+	NextAttemptAt *time.Time `gorm:"index:idx_outbox_due,priority:3"`
+	FailedAt      *time.Time `gorm:"index:idx_outbox_due,priority:2"`
+}
+
+func (m Message) TableName() string {
+	return "outbox"
+}
+
+// Add queues a message in the transaction tx. The trace context of ctx goes with it.
+func Add(tx *gorm.DB, ctx context.Context, subject string, payload []byte) error {
+	msg := &nats.Msg{Subject: subject, Data: payload, Header: make(nats.Header)}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(msg.Header))
+	return AddMsg(tx, ctx, msg)
+}
+
+// AddMsg queues msg with its header as is in the transaction tx.
+func AddMsg(tx *gorm.DB, ctx context.Context, msg *nats.Msg) error {
+	h, err := json.Marshal(msg.Header)
+	if err != nil {
+		return err
+	}
+	return tx.WithContext(ctx).Create(&Message{
+		MsgId:   uuid.NewString(),
+		Subject: msg.Subject,
+		Header:  string(h),
+		Payload: msg.Data,
+	}).Error
+}
+
+func (m Message) natsMsg() (*nats.Msg, error) {
+	msg := &nats.Msg{Subject: m.Subject, Data: m.Payload, Header: make(nats.Header)}
+	if m.Header != "" {
+		if err := json.Unmarshal([]byte(m.Header), &msg.Header); err != nil {
+			return nil, err
+		}
+	}
+	msg.Header.Set(nats.MsgIdHdr, m.MsgId)
+	return msg, nil
+}
@@ -0,0 +1,206 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/nats-io/nats.go/jetstream"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Options struct {
+	// Stream, when set, is created for Subjects on Setup. Subjects owned by other services
+	// must already be bound to a stream, the relay retries until they are.
+	Stream   string
+	Subjects []string
+	// Interval is the pause between polls when the outbox is drained
+	Interval  time.Duration
+	BatchSize int
+	// Retention is how long published rows are kept, zero keeps them forever
+	Retention time.Duration
+	// A message that fails to publish is tried again after Backoff, doubled for every
+	// further attempt up to BackoffMax, and parked as failed after MaxAttempts.
+	MaxAttempts int
+	Backoff     time.Duration
+	BackoffMax  time.Duration
+}
+
+func (o Options) backoff(attempts int) time.Duration {
+	d := o.Backoff
+	for i := 1; i < attempts && d < o.BackoffMax; i++ {
+		d *= 2
+	}
+	return min(d, o.BackoffMax)
+}
+
+// Relay publishes the outbox to JetStream. Delivery is at least once: a row is marked
+// published only after the server acked it, and JetStream drops duplicates by message id
+// within the stream's duplicate window. Several relays may share a table.
+type Relay struct {
+	db        *gorm.DB
+	js        jetstream.JetStream
+	opts      Options
+	lastPurge time.Time
+}
+
+func NewRelay(db *gorm.DB, js jetstream.JetStream, opts Options) *Relay {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 100
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = time.Second
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 20
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = time.Second
+	}
+	if opts.BackoffMax <= 0 {
+		opts.BackoffMax = 10 * time.Minute
+	}
+	if opts.BackoffMax < opts.Backoff {
+		opts.BackoffMax = opts.Backoff
+	}
+	return &Relay{db: db, js: js, opts: opts}
+}
+
+// Setup creates or updates the stream of the relay's own subjects.
+func (r *Relay) Setup(ctx context.Context) error {
+	if r.opts.Stream == "" {
+		return nil
+	}
+	_, err := r.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     r.opts.Stream,
+		Subjects: r.opts.Subjects,
+		Storage:  jetstream.FileStorage,
+	})
+	if err != nil {
+		return fmt.Errorf("create stream %s: %w", r.opts.Stream, err)
+	}
+	return nil
+}
+
+// Run publishes pending messages until ctx is done.
+func (r *Relay) Run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+		n, err := r.Flush(ctx)
+		if err != nil {
+			klog.CtxWarnf(ctx, "outbox relay: %v", err)
+		}
+		r.purge(ctx)
+		if n == r.opts.BatchSize {
+			timer.Reset(0)
+		} else {
+			timer.Reset(r.opts.Interval)
+		}
+	}
+}
+
+// Flush publishes the oldest batch of due messages and returns how many were sent. The
+// rows are locked with SKIP LOCKED so that relays do not publish the same batch. A message
+// that fails does not hold up the others: it is tried again after a backoff and parked as
+// failed after MaxAttempts, e.g. when its subject has no stream or it is too large.
+func (r *Relay) Flush(ctx context.Context) (n int, err error) {
+	var errs []error
+	txErr := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		var msgs []Message
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("published_at IS NULL AND failed_at IS NULL").
+			Where("next_attempt_at IS NULL OR next_attempt_at <= ?", now).
+			Order("id").
+			Limit(r.opts.BatchSize).
+			Find(&msgs).Error; err != nil {
+			return err
+		}
+		for _, m := range msgs {
+			if pubErr := r.publish(ctx, m); pubErr != nil {
+				errs = append(errs, fmt.Errorf("publish %s to %s: %w", m.MsgId, m.Subject, pubErr))
+				if err := tx.Model(&m).Updates(r.failure(m, pubErr, now)).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			if err := tx.Model(&m).Update("published_at", time.Now()).Error; err != nil {
+				return err
+			}
+			n++
+		}
+		return nil
+	})
+	if txErr != nil {
+		return 0, txErr
+	}
+	return n, errors.Join(errs...)
+}
+
+// failure returns the updates recording a failed attempt to publish m.
+func (r *Relay) failure(m Message, err error, now time.Time) map[string]any {
+	attempts := m.Attempts + 1
+	updates := map[string]any{
+		"attempts":        attempts,
+		"last_error":      truncate(err.Error(), 1024),
+		"next_attempt_at": now.Add(r.opts.backoff(attempts)),
+	}
+	if attempts >= r.opts.MaxAttempts {
+		klog.Errorf("outbox relay: %s to %s failed after %d attempts, parked: %v", m.MsgId, m.Subject, attempts, err)
+		updates["failed_at"] = now
+	}
+	return updates
+}
+
+func (r *Relay) publish(ctx context.Context, m Message) error {
+	msg, err := m.natsMsg()
+	if err != nil {
+		return err
+	}
+	_, err = r.js.PublishMsg(ctx, msg)
+	return err
+}
+
+// purge deletes the rows published before the retention period, at most once an hour.
+func (r *Relay) purge(ctx context.Context) {
+	if r.opts.Retention <= 0 || time.Since(r.lastPurge) < time.Hour {
+		return
+	}
+	r.lastPurge = time.Now()
+	err := r.db.WithContext(ctx).
+		Where("published_at < ?", time.Now().Add(-r.opts.Retention)).
+		Delete(&Message{}).Error
+	if err != nil {
+		klog.CtxWarnf(ctx, "outbox relay: purge: %v", err)
+	}
+}
+
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
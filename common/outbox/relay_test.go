@@ -0,0 +1,166 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outbox
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	natsserver "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func startJetStream(t *testing.T) jetstream.JetStream {
+	t.Helper()
+	s, err := natsserver.NewServer(&natsserver.Options{Host: "127.0.0.1", Port: -1, JetStream: true, StoreDir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Start()
+	if !s.ReadyForConnections(5 * time.Second) {
+		t.Fatal("nats server did not start")
+	}
+	t.Cleanup(s.Shutdown)
+	nc, err := nats.Connect(s.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(nc.Close)
+	js, err := jetstream.New(nc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return js
+}
+
+func TestRelay_publish(t *testing.T) {
+	ctx := context.Background()
+	js := startJetStream(t)
+	r := NewRelay(nil, js, Options{Stream: "ORDER", Subjects: []string{"order.>"}})
+	if err := r.Setup(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	m := Message{
+		MsgId:   "b1f0c2a4-0000-4000-8000-000000000001",
+		Subject: "order.paid",
+		Header:  `{"Traceparent":["00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"]}`,
+		Payload: []byte("paid"),
+	}
+	// a relay that crashed before marking the row publishes it again
+	for i := 0; i < 2; i++ {
+		if err := r.publish(ctx, m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stream, err := js.Stream(ctx, "ORDER")
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := stream.Info(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.State.Msgs != 1 {
+		t.Fatalf("stream has %d messages, want 1", info.State.Msgs)
+	}
+	got, err := stream.GetMsg(ctx, info.State.FirstSeq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got.Data) != "paid" || got.Subject != "order.paid" {
+		t.Errorf("got %s %q", got.Subject, got.Data)
+	}
+	if got.Header.Get(nats.MsgIdHdr) != m.MsgId {
+		t.Errorf("Nats-Msg-Id = %q", got.Header.Get(nats.MsgIdHdr))
+	}
+	if got.Header.Get("Traceparent") == "" {
+		t.Error("trace header was not published")
+	}
+
+	// subjects without a stream fail and are retried by the next flush
+	m.MsgId, m.Subject = "b1f0c2a4-0000-4000-8000-000000000002", "email"
+	if err := r.publish(ctx, m); err == nil {
+		t.Error("publish to a subject without stream succeeded")
+	}
+}
+
+func TestRelay_FlushParksFailingMessages(t *testing.T) {
+	ctx := context.Background()
+	js := startJetStream(t)
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "outbox.db")), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&Message{}); err != nil {
+		t.Fatal(err)
+	}
+	r := NewRelay(db, js, Options{
+		Stream:      "ORDER",
+		Subjects:    []string{"order.>"},
+		MaxAttempts: 3,
+		Backoff:     time.Millisecond,
+		BackoffMax:  time.Millisecond,
+	})
+	if err := r.Setup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// the first message has no stream and must not hold up the one behind it
+	for _, subject := range []string{"email", "order.paid"} {
+		if err := Add(db, ctx, subject, []byte(subject)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	n, err := r.Flush(ctx)
+	if n != 1 || err == nil {
+		t.Fatalf("first flush sent %d (err %v), want 1 and the failure", n, err)
+	}
+	for i := 0; i < 5; i++ {
+		time.Sleep(5 * time.Millisecond)
+		if _, err := r.Flush(ctx); err == nil {
+			break
+		}
+	}
+
+	var poison Message
+	if err := db.Where("subject = ?", "email").First(&poison).Error; err != nil {
+		t.Fatal(err)
+	}
+	if poison.Attempts != 3 || poison.FailedAt == nil || poison.PublishedAt != nil || poison.LastError == "" {
+		t.Errorf("got %d attempts, failed at %v, published at %v, error %q; want it parked after 3 attempts",
+			poison.Attempts, poison.FailedAt, poison.PublishedAt, poison.LastError)
+	}
+	// parked messages are not tried again
+	if n, err := r.Flush(ctx); n != 0 || err != nil {
+		t.Errorf("flush after parking sent %d (err %v), want nothing to do", n, err)
+	}
+	stream, err := js.Stream(ctx, "ORDER")
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := stream.Info(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.State.Msgs != 1 {
+		t.Errorf("stream has %d messages, want the order event", info.State.Msgs)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redact masks credentials before requests are logged or traced.
+package redact
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const Mask = "[REDACTED]"
+
+// sensitive are the endings of the names of fields and parameters holding credentials,
+// password covers confirm_password and token covers refresh_token.
+var sensitive = []string{"password", "token", "secret", "cvv", "card_number", "provisioning_uri", "recovery_codes"}
+
+// sensitiveNames are whole names, as endings code would match zip_code and state
+// order_state
+var sensitiveNames = map[string]bool{"code": true, "state": true}
+
+// Sensitive tells whether a field or query parameter called name holds a credential.
+func Sensitive(name string) bool {
+	name = strings.ToLower(name)
+	if sensitiveNames[name] {
+		return true
+	}
+	for _, s := range sensitive {
+		if strings.HasSuffix(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Message returns a copy of m with its sensitive fields masked, m itself is left as it is.
+// Strings read Mask and other values are cleared.
+func Message(m proto.Message) proto.Message {
+	if m == nil {
+		return nil
+	}
+	c := proto.Clone(m)
+	mask(c.ProtoReflect())
+	return c
+}
+
+func mask(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, e protoreflect.Value) bool {
+					mask(e.Message())
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				for i := 0; i < v.List().Len(); i++ {
+					mask(v.List().Get(i).Message())
+				}
+			} else if Sensitive(string(fd.Name())) {
+				m.Clear(fd)
+			}
+		case fd.Message() != nil:
+			mask(v.Message())
+		case Sensitive(string(fd.Name())):
+			if fd.Kind() == protoreflect.StringKind {
+				m.Set(fd, protoreflect.ValueOfString(Mask))
+			} else {
+				m.Clear(fd)
+			}
+		}
+		return true
+	})
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redact
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+)
+
+func TestSensitive(t *testing.T) {
+	for name, want := range map[string]bool{
+		"password":           true,
+		"confirm_password":   true,
+		"refresh_token":      true,
+		"Token":              true,
+		"webhook_secret":     true,
+		"credit_card_cvv":    true,
+		"credit_card_number": true,
+		"code":               true,
+		"recovery_codes":     true,
+		"zip_code":           false,
+		"state":              true,
+		"order_state":        false,
+		"email":              false,
+		"user_id":            false,
+		"next":               false,
+	} {
+		if got := Sensitive(name); got != want {
+			t.Errorf("Sensitive(%q) = %v", name, got)
+		}
+	}
+}
+
+func TestMessage(t *testing.T) {
+	req := &user.LoginReq{Email: "a@example.com", Password: "hunter2", Ip: "127.0.0.1"}
+	got := Message(req).(*user.LoginReq)
+	if got.Password != Mask || got.Email != req.Email || got.Ip != req.Ip {
+		t.Errorf("redacted %v", got)
+	}
+	if req.Password != "hunter2" {
+		t.Errorf("the request was changed: %v", req)
+	}
+	if s := got.String(); strings.Contains(s, "hunter2") {
+		t.Errorf("password logged: %s", s)
+	}
+
+	charge := &payment.ChargeReq{Amount: 10, CreditCard: &payment.CreditCardInfo{
+		CreditCardNumber:          "4242424242424242",
+		CreditCardCvv:             123,
+		CreditCardExpirationYear:  2030,
+		CreditCardExpirationMonth: 12,
+	}}
+	card := Message(charge).(*payment.ChargeReq).CreditCard
+	if card.CreditCardNumber != Mask || card.CreditCardCvv != 0 || card.CreditCardExpirationYear != 2030 {
+		t.Errorf("redacted card %v", card)
+	}
+
+	if Message(nil) != nil {
+		t.Error("nil message")
+	}
+}
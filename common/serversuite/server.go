@@ -15,9 +15,19 @@
 package serversuite
 
 import (
+	"context"
 	"os"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/cloudwego/biz-demo/gomall/common/authn"
+	"github.com/cloudwego/biz-demo/gomall/common/clientsuite"
+	"github.com/cloudwego/biz-demo/gomall/common/jwks"
 	"github.com/cloudwego/biz-demo/gomall/common/mtl"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user/userservice"
+	"github.com/cloudwego/kitex/client"
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/cloudwego/kitex/pkg/rpcinfo"
 	"github.com/cloudwego/kitex/pkg/transmeta"
@@ -33,6 +43,8 @@ import (
 type CommonServerSuite struct {
 	CurrentServiceName string
 	RegistryAddr       string
+	// Verifier checks user tokens, by default against the keys of the user service
+	Verifier *jwks.Verifier
 }
 
 func (s CommonServerSuite) Options() []server.Option {
@@ -67,7 +79,56 @@ func (s CommonServerSuite) Options() []server.Option {
 		}),
 		server.WithSuite(tracing.NewServerSuite()),
 		server.WithTracer(prometheus.NewServerTracer("", "", prometheus.WithDisableServer(true), prometheus.WithRegistry(mtl.Registry))),
+		server.WithMiddleware(authn.ServerMiddleware(s.authnOptions())),
 	)
 
 	return opts
 }
+
+// authnOptions reads the authentication settings: AUTH_MODE (enforce, permissive or off,
+// enforce by default), SERVICE_AUTH_SECRET shared by the backend services and
+// AUTH_TRUSTED_SERVICES, an optional comma separated list of the services trusted to act
+// for any user, and AUTH_TOKEN_ISSUER, the issuer of the user tokens (gomall by default).
+// The frontend must not get the secret.
+func (s CommonServerSuite) authnOptions() authn.ServerOptions {
+	o := authn.ServerOptions{
+		Mode:     os.Getenv("AUTH_MODE"),
+		Verifier: s.Verifier,
+		Secret:   os.Getenv("SERVICE_AUTH_SECRET"),
+	}
+	if o.Mode == "" {
+		o.Mode = authn.ModeEnforce
+	}
+	if v := os.Getenv("AUTH_TRUSTED_SERVICES"); v != "" {
+		o.TrustedServices = strings.Split(v, ",")
+	}
+	if o.Verifier == nil {
+		o.Verifier = jwks.NewVerifier(s.userKeys(), jwks.IssuerFromEnv(), 10*time.Minute)
+	}
+	return o
+}
+
+// userKeys fetches the token keys from the user service, its client is made on first use.
+func (s CommonServerSuite) userKeys() jwks.Source {
+	var (
+		once sync.Once
+		cli  userservice.Client
+		err  error
+	)
+	return func(ctx context.Context) (*jwks.Set, error) {
+		once.Do(func() {
+			cli, err = userservice.NewClient("user", client.WithSuite(clientsuite.CommonGrpcClientSuite{
+				CurrentServiceName: s.CurrentServiceName,
+				RegistryAddr:       s.RegistryAddr,
+			}))
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp, err := cli.GetJwks(ctx, &user.GetJwksReq{})
+		if err != nil {
+			return nil, err
+		}
+		return jwks.Parse([]byte(resp.Jwks))
+	}
+}
@@ -13,5 +13,8 @@ CREATE DATABASE IF NOT EXISTS `payment`
 CREATE DATABASE IF NOT EXISTS `product`
     DEFAULT CHARACTER SET = 'utf8mb4';
 
+CREATE DATABASE IF NOT EXISTS `shipping`
+    DEFAULT CHARACTER SET = 'utf8mb4';
+
 CREATE DATABASE IF NOT EXISTS `user`
     DEFAULT CHARACTER SET = 'utf8mb4';
\ No newline at end of file
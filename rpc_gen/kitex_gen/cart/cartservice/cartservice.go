@@ -21,9 +21,11 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "CartService"
 	handlerType := (*cart.CartService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"AddItem":   kitex.NewMethodInfo(addItemHandler, newAddItemArgs, newAddItemResult, false),
-		"GetCart":   kitex.NewMethodInfo(getCartHandler, newGetCartArgs, newGetCartResult, false),
-		"EmptyCart": kitex.NewMethodInfo(emptyCartHandler, newEmptyCartArgs, newEmptyCartResult, false),
+		"AddItem":        kitex.NewMethodInfo(addItemHandler, newAddItemArgs, newAddItemResult, false),
+		"GetCart":        kitex.NewMethodInfo(getCartHandler, newGetCartArgs, newGetCartResult, false),
+		"EmptyCart":      kitex.NewMethodInfo(emptyCartHandler, newEmptyCartArgs, newEmptyCartResult, false),
+		"ExportUserData": kitex.NewMethodInfo(exportUserDataHandler, newExportUserDataArgs, newExportUserDataResult, false),
+		"EraseUserData":  kitex.NewMethodInfo(eraseUserDataHandler, newEraseUserDataArgs, newEraseUserDataResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "cart",
@@ -499,6 +501,312 @@ func (p *EmptyCartResult) GetResult() interface{} {
 	return p.Success
 }
 
+func exportUserDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(cart.ExportUserDataReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(cart.CartService).ExportUserData(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ExportUserDataArgs:
+		success, err := handler.(cart.CartService).ExportUserData(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ExportUserDataResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newExportUserDataArgs() interface{} {
+	return &ExportUserDataArgs{}
+}
+
+func newExportUserDataResult() interface{} {
+	return &ExportUserDataResult{}
+}
+
+type ExportUserDataArgs struct {
+	Req *cart.ExportUserDataReq
+}
+
+func (p *ExportUserDataArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(cart.ExportUserDataReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ExportUserDataArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ExportUserDataArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ExportUserDataArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ExportUserDataArgs) Unmarshal(in []byte) error {
+	msg := new(cart.ExportUserDataReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ExportUserDataArgs_Req_DEFAULT *cart.ExportUserDataReq
+
+func (p *ExportUserDataArgs) GetReq() *cart.ExportUserDataReq {
+	if !p.IsSetReq() {
+		return ExportUserDataArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ExportUserDataArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ExportUserDataArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ExportUserDataResult struct {
+	Success *cart.ExportUserDataResp
+}
+
+var ExportUserDataResult_Success_DEFAULT *cart.ExportUserDataResp
+
+func (p *ExportUserDataResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(cart.ExportUserDataResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ExportUserDataResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ExportUserDataResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ExportUserDataResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ExportUserDataResult) Unmarshal(in []byte) error {
+	msg := new(cart.ExportUserDataResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ExportUserDataResult) GetSuccess() *cart.ExportUserDataResp {
+	if !p.IsSetSuccess() {
+		return ExportUserDataResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ExportUserDataResult) SetSuccess(x interface{}) {
+	p.Success = x.(*cart.ExportUserDataResp)
+}
+
+func (p *ExportUserDataResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ExportUserDataResult) GetResult() interface{} {
+	return p.Success
+}
+
+func eraseUserDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(cart.EraseUserDataReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(cart.CartService).EraseUserData(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *EraseUserDataArgs:
+		success, err := handler.(cart.CartService).EraseUserData(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*EraseUserDataResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newEraseUserDataArgs() interface{} {
+	return &EraseUserDataArgs{}
+}
+
+func newEraseUserDataResult() interface{} {
+	return &EraseUserDataResult{}
+}
+
+type EraseUserDataArgs struct {
+	Req *cart.EraseUserDataReq
+}
+
+func (p *EraseUserDataArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(cart.EraseUserDataReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *EraseUserDataArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *EraseUserDataArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *EraseUserDataArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *EraseUserDataArgs) Unmarshal(in []byte) error {
+	msg := new(cart.EraseUserDataReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var EraseUserDataArgs_Req_DEFAULT *cart.EraseUserDataReq
+
+func (p *EraseUserDataArgs) GetReq() *cart.EraseUserDataReq {
+	if !p.IsSetReq() {
+		return EraseUserDataArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *EraseUserDataArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *EraseUserDataArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type EraseUserDataResult struct {
+	Success *cart.EraseUserDataResp
+}
+
+var EraseUserDataResult_Success_DEFAULT *cart.EraseUserDataResp
+
+func (p *EraseUserDataResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(cart.EraseUserDataResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *EraseUserDataResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *EraseUserDataResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *EraseUserDataResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *EraseUserDataResult) Unmarshal(in []byte) error {
+	msg := new(cart.EraseUserDataResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *EraseUserDataResult) GetSuccess() *cart.EraseUserDataResp {
+	if !p.IsSetSuccess() {
+		return EraseUserDataResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *EraseUserDataResult) SetSuccess(x interface{}) {
+	p.Success = x.(*cart.EraseUserDataResp)
+}
+
+func (p *EraseUserDataResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *EraseUserDataResult) GetResult() interface{} {
+	return p.Success
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -538,3 +846,23 @@ func (p *kClient) EmptyCart(ctx context.Context, Req *cart.EmptyCartReq) (r *car
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) ExportUserData(ctx context.Context, Req *cart.ExportUserDataReq) (r *cart.ExportUserDataResp, err error) {
+	var _args ExportUserDataArgs
+	_args.Req = Req
+	var _result ExportUserDataResult
+	if err = p.c.Call(ctx, "ExportUserData", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EraseUserData(ctx context.Context, Req *cart.EraseUserDataReq) (r *cart.EraseUserDataResp, err error) {
+	var _args EraseUserDataArgs
+	_args.Req = Req
+	var _result EraseUserDataResult
+	if err = p.c.Call(ctx, "EraseUserData", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
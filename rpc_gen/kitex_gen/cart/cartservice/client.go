@@ -14,6 +14,8 @@ type Client interface {
 	AddItem(ctx context.Context, Req *cart.AddItemReq, callOptions ...callopt.Option) (r *cart.AddItemResp, err error)
 	GetCart(ctx context.Context, Req *cart.GetCartReq, callOptions ...callopt.Option) (r *cart.GetCartResp, err error)
 	EmptyCart(ctx context.Context, Req *cart.EmptyCartReq, callOptions ...callopt.Option) (r *cart.EmptyCartResp, err error)
+	ExportUserData(ctx context.Context, Req *cart.ExportUserDataReq, callOptions ...callopt.Option) (r *cart.ExportUserDataResp, err error)
+	EraseUserData(ctx context.Context, Req *cart.EraseUserDataReq, callOptions ...callopt.Option) (r *cart.EraseUserDataResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -59,3 +61,13 @@ func (p *kCartServiceClient) EmptyCart(ctx context.Context, Req *cart.EmptyCartR
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.EmptyCart(ctx, Req)
 }
+
+func (p *kCartServiceClient) ExportUserData(ctx context.Context, Req *cart.ExportUserDataReq, callOptions ...callopt.Option) (r *cart.ExportUserDataResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportUserData(ctx, Req)
+}
+
+func (p *kCartServiceClient) EraseUserData(ctx context.Context, Req *cart.EraseUserDataReq, callOptions ...callopt.Option) (r *cart.EraseUserDataResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EraseUserData(ctx, Req)
+}
@@ -110,6 +110,11 @@ func (x *CheckoutReq) FastRead(buf []byte, _type int8, number int32) (offset int
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -163,6 +168,11 @@ func (x *CheckoutReq) fastReadField6(buf []byte, _type int8) (offset int, err er
 	return offset, nil
 }
 
+func (x *CheckoutReq) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.ShippingMethod, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
 func (x *CheckoutResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -181,227 +191,1883 @@ func (x *CheckoutResp) FastRead(buf []byte, _type int8, number int32) (offset in
 			goto SkipFieldError
 		}
 	}
-	return offset, nil
-SkipFieldError:
-	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
-ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CheckoutResp[number], err)
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CheckoutResp[number], err)
+}
+
+func (x *CheckoutResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *SubmitCheckoutResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SubmitCheckoutResp[number], err)
+}
+
+func (x *SubmitCheckoutResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.CheckoutId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutJob) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CheckoutJob[number], err)
+}
+
+func (x *CheckoutJob) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.CheckoutId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutJob) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v CheckoutReq
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Req = &v
+	return offset, nil
+}
+
+func (x *CheckoutJob) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.CardToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetCheckoutStatusReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetCheckoutStatusReq[number], err)
+}
+
+func (x *GetCheckoutStatusReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *GetCheckoutStatusReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.CheckoutId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetCheckoutStatusResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetCheckoutStatusResp[number], err)
+}
+
+func (x *GetCheckoutStatusResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Status, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetCheckoutStatusResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Step, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetCheckoutStatusResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetCheckoutStatusResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetCheckoutStatusResp) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_QuoteReq[number], err)
+}
+
+func (x *QuoteReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Address = &v
+	return offset, nil
+}
+
+func (x *QuoteReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.ShippingMethod, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *TaxLine) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_TaxLine[number], err)
+}
+
+func (x *TaxLine) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *TaxLine) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Rate, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *TaxLine) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Amount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_QuoteResp[number], err)
+}
+
+func (x *QuoteResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Subtotal, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.ShippingMethod, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.ShippingCost, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	var v TaxLine
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.TaxLines = append(x.TaxLines, &v)
+	return offset, nil
+}
+
+func (x *QuoteResp) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Tax, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteResp) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.PricesIncludeTax, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteResp) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Total, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *FlashSaleCheckoutReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_FlashSaleCheckoutReq[number], err)
+}
+
+func (x *FlashSaleCheckoutReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *FlashSaleCheckoutReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *FlashSaleCheckoutReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Firstname, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *FlashSaleCheckoutReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Lastname, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *FlashSaleCheckoutReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Email, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *FlashSaleCheckoutReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Address = &v
+	return offset, nil
+}
+
+func (x *FlashSaleCheckoutReq) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	var v payment.CreditCardInfo
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.CreditCard = &v
+	return offset, nil
+}
+
+func (x *FlashSaleCheckoutReq) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.ShippingMethod, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *FlashSaleJob) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_FlashSaleJob[number], err)
+}
+
+func (x *FlashSaleJob) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v FlashSaleCheckoutReq
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Req = &v
+	return offset, nil
+}
+
+func (x *FlashSaleJob) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.CardToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *FlashSaleCheckoutResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_FlashSaleCheckoutResp[number], err)
+}
+
+func (x *FlashSaleCheckoutResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Accepted, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *FlashSaleCheckoutResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetFlashSaleStatusReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetFlashSaleStatusReq[number], err)
+}
+
+func (x *GetFlashSaleStatusReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *GetFlashSaleStatusReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *GetFlashSaleStatusResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetFlashSaleStatusResp[number], err)
+}
+
+func (x *GetFlashSaleStatusResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OnSale, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *GetFlashSaleStatusResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Stock, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *GetFlashSaleStatusResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Status, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetFlashSaleStatusResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetFlashSaleStatusResp) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetFlashSaleStatusResp) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Address) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *Address) fastWriteField1(buf []byte) (offset int) {
+	if x.StreetAddress == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetStreetAddress())
+	return offset
+}
+
+func (x *Address) fastWriteField2(buf []byte) (offset int) {
+	if x.City == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCity())
+	return offset
+}
+
+func (x *Address) fastWriteField3(buf []byte) (offset int) {
+	if x.State == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetState())
+	return offset
+}
+
+func (x *Address) fastWriteField4(buf []byte) (offset int) {
+	if x.Country == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetCountry())
+	return offset
+}
+
+func (x *Address) fastWriteField5(buf []byte) (offset int) {
+	if x.ZipCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetZipCode())
+	return offset
+}
+
+func (x *CheckoutReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Firstname == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetFirstname())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Lastname == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetLastname())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetEmail())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField5(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 5, x.GetAddress())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField6(buf []byte) (offset int) {
+	if x.CreditCard == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 6, x.GetCreditCard())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField7(buf []byte) (offset int) {
+	if x.ShippingMethod == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 7, x.GetShippingMethod())
+	return offset
+}
+
+func (x *CheckoutResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *CheckoutResp) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *CheckoutResp) fastWriteField2(buf []byte) (offset int) {
+	if x.TransactionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetTransactionId())
+	return offset
+}
+
+func (x *SubmitCheckoutResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *SubmitCheckoutResp) fastWriteField1(buf []byte) (offset int) {
+	if x.CheckoutId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetCheckoutId())
+	return offset
+}
+
+func (x *CheckoutJob) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *CheckoutJob) fastWriteField1(buf []byte) (offset int) {
+	if x.CheckoutId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetCheckoutId())
+	return offset
+}
+
+func (x *CheckoutJob) fastWriteField2(buf []byte) (offset int) {
+	if x.Req == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetReq())
+	return offset
+}
+
+func (x *CheckoutJob) fastWriteField3(buf []byte) (offset int) {
+	if x.CardToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetCardToken())
+	return offset
+}
+
+func (x *GetCheckoutStatusReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *GetCheckoutStatusReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *GetCheckoutStatusReq) fastWriteField2(buf []byte) (offset int) {
+	if x.CheckoutId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCheckoutId())
+	return offset
+}
+
+func (x *GetCheckoutStatusResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *GetCheckoutStatusResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Status == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetStatus())
+	return offset
+}
+
+func (x *GetCheckoutStatusResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Step == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetStep())
+	return offset
+}
+
+func (x *GetCheckoutStatusResp) fastWriteField3(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetOrderId())
+	return offset
+}
+
+func (x *GetCheckoutStatusResp) fastWriteField4(buf []byte) (offset int) {
+	if x.TransactionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetTransactionId())
+	return offset
+}
+
+func (x *GetCheckoutStatusResp) fastWriteField5(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetReason())
+	return offset
+}
+
+func (x *QuoteReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *QuoteReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *QuoteReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetAddress())
+	return offset
+}
+
+func (x *QuoteReq) fastWriteField3(buf []byte) (offset int) {
+	if x.ShippingMethod == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetShippingMethod())
+	return offset
+}
+
+func (x *QuoteReq) fastWriteField4(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 4, x.GetProductId())
+	return offset
+}
+
+func (x *TaxLine) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *TaxLine) fastWriteField1(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetName())
+	return offset
+}
+
+func (x *TaxLine) fastWriteField2(buf []byte) (offset int) {
+	if x.Rate == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 2, x.GetRate())
+	return offset
+}
+
+func (x *TaxLine) fastWriteField3(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 3, x.GetAmount())
+	return offset
+}
+
+func (x *QuoteResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	return offset
+}
+
+func (x *QuoteResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Subtotal == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 1, x.GetSubtotal())
+	return offset
+}
+
+func (x *QuoteResp) fastWriteField2(buf []byte) (offset int) {
+	if x.ShippingMethod == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetShippingMethod())
+	return offset
+}
+
+func (x *QuoteResp) fastWriteField3(buf []byte) (offset int) {
+	if x.ShippingCost == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 3, x.GetShippingCost())
+	return offset
+}
+
+func (x *QuoteResp) fastWriteField4(buf []byte) (offset int) {
+	if x.TaxLines == nil {
+		return offset
+	}
+	for i := range x.GetTaxLines() {
+		offset += fastpb.WriteMessage(buf[offset:], 4, x.GetTaxLines()[i])
+	}
+	return offset
+}
+
+func (x *QuoteResp) fastWriteField5(buf []byte) (offset int) {
+	if x.Tax == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 5, x.GetTax())
+	return offset
+}
+
+func (x *QuoteResp) fastWriteField6(buf []byte) (offset int) {
+	if !x.PricesIncludeTax {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 6, x.GetPricesIncludeTax())
+	return offset
+}
+
+func (x *QuoteResp) fastWriteField7(buf []byte) (offset int) {
+	if x.Total == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 7, x.GetTotal())
+	return offset
+}
+
+func (x *FlashSaleCheckoutReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	return offset
+}
+
+func (x *FlashSaleCheckoutReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *FlashSaleCheckoutReq) fastWriteField2(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 2, x.GetProductId())
+	return offset
+}
+
+func (x *FlashSaleCheckoutReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Firstname == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetFirstname())
+	return offset
+}
+
+func (x *FlashSaleCheckoutReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Lastname == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetLastname())
+	return offset
+}
+
+func (x *FlashSaleCheckoutReq) fastWriteField5(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetEmail())
+	return offset
+}
+
+func (x *FlashSaleCheckoutReq) fastWriteField6(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 6, x.GetAddress())
+	return offset
+}
+
+func (x *FlashSaleCheckoutReq) fastWriteField7(buf []byte) (offset int) {
+	if x.CreditCard == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 7, x.GetCreditCard())
+	return offset
+}
+
+func (x *FlashSaleCheckoutReq) fastWriteField8(buf []byte) (offset int) {
+	if x.ShippingMethod == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetShippingMethod())
+	return offset
+}
+
+func (x *FlashSaleJob) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *FlashSaleJob) fastWriteField1(buf []byte) (offset int) {
+	if x.Req == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetReq())
+	return offset
+}
+
+func (x *FlashSaleJob) fastWriteField2(buf []byte) (offset int) {
+	if x.CardToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCardToken())
+	return offset
+}
+
+func (x *FlashSaleCheckoutResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *FlashSaleCheckoutResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Accepted {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetAccepted())
+	return offset
+}
+
+func (x *FlashSaleCheckoutResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetReason())
+	return offset
+}
+
+func (x *GetFlashSaleStatusReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *GetFlashSaleStatusReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *GetFlashSaleStatusReq) fastWriteField2(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 2, x.GetProductId())
+	return offset
+}
+
+func (x *GetFlashSaleStatusResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	return offset
+}
+
+func (x *GetFlashSaleStatusResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.OnSale {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetOnSale())
+	return offset
+}
+
+func (x *GetFlashSaleStatusResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Stock == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetStock())
+	return offset
+}
+
+func (x *GetFlashSaleStatusResp) fastWriteField3(buf []byte) (offset int) {
+	if x.Status == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetStatus())
+	return offset
+}
+
+func (x *GetFlashSaleStatusResp) fastWriteField4(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetOrderId())
+	return offset
+}
+
+func (x *GetFlashSaleStatusResp) fastWriteField5(buf []byte) (offset int) {
+	if x.TransactionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetTransactionId())
+	return offset
+}
+
+func (x *GetFlashSaleStatusResp) fastWriteField6(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetReason())
+	return offset
+}
+
+func (x *Address) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *Address) sizeField1() (n int) {
+	if x.StreetAddress == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetStreetAddress())
+	return n
+}
+
+func (x *Address) sizeField2() (n int) {
+	if x.City == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCity())
+	return n
+}
+
+func (x *Address) sizeField3() (n int) {
+	if x.State == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetState())
+	return n
+}
+
+func (x *Address) sizeField4() (n int) {
+	if x.Country == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetCountry())
+	return n
+}
+
+func (x *Address) sizeField5() (n int) {
+	if x.ZipCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetZipCode())
+	return n
+}
+
+func (x *CheckoutReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	return n
+}
+
+func (x *CheckoutReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *CheckoutReq) sizeField2() (n int) {
+	if x.Firstname == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetFirstname())
+	return n
+}
+
+func (x *CheckoutReq) sizeField3() (n int) {
+	if x.Lastname == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetLastname())
+	return n
+}
+
+func (x *CheckoutReq) sizeField4() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetEmail())
+	return n
+}
+
+func (x *CheckoutReq) sizeField5() (n int) {
+	if x.Address == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(5, x.GetAddress())
+	return n
+}
+
+func (x *CheckoutReq) sizeField6() (n int) {
+	if x.CreditCard == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(6, x.GetCreditCard())
+	return n
+}
+
+func (x *CheckoutReq) sizeField7() (n int) {
+	if x.ShippingMethod == "" {
+		return n
+	}
+	n += fastpb.SizeString(7, x.GetShippingMethod())
+	return n
+}
+
+func (x *CheckoutResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *CheckoutResp) sizeField1() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetOrderId())
+	return n
+}
+
+func (x *CheckoutResp) sizeField2() (n int) {
+	if x.TransactionId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetTransactionId())
+	return n
+}
+
+func (x *SubmitCheckoutResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *SubmitCheckoutResp) sizeField1() (n int) {
+	if x.CheckoutId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetCheckoutId())
+	return n
+}
+
+func (x *CheckoutJob) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *CheckoutJob) sizeField1() (n int) {
+	if x.CheckoutId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetCheckoutId())
+	return n
+}
+
+func (x *CheckoutJob) sizeField2() (n int) {
+	if x.Req == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetReq())
+	return n
+}
+
+func (x *CheckoutJob) sizeField3() (n int) {
+	if x.CardToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetCardToken())
+	return n
+}
+
+func (x *GetCheckoutStatusReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *GetCheckoutStatusReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *GetCheckoutStatusReq) sizeField2() (n int) {
+	if x.CheckoutId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCheckoutId())
+	return n
+}
+
+func (x *GetCheckoutStatusResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *GetCheckoutStatusResp) sizeField1() (n int) {
+	if x.Status == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetStatus())
+	return n
+}
+
+func (x *GetCheckoutStatusResp) sizeField2() (n int) {
+	if x.Step == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetStep())
+	return n
+}
+
+func (x *GetCheckoutStatusResp) sizeField3() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetOrderId())
+	return n
+}
+
+func (x *GetCheckoutStatusResp) sizeField4() (n int) {
+	if x.TransactionId == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetTransactionId())
+	return n
+}
+
+func (x *GetCheckoutStatusResp) sizeField5() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetReason())
+	return n
+}
+
+func (x *QuoteReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *QuoteReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *QuoteReq) sizeField2() (n int) {
+	if x.Address == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetAddress())
+	return n
+}
+
+func (x *QuoteReq) sizeField3() (n int) {
+	if x.ShippingMethod == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetShippingMethod())
+	return n
+}
+
+func (x *QuoteReq) sizeField4() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(4, x.GetProductId())
+	return n
+}
+
+func (x *TaxLine) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *TaxLine) sizeField1() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetName())
+	return n
 }
 
-func (x *CheckoutResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
-	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
-	return offset, err
+func (x *TaxLine) sizeField2() (n int) {
+	if x.Rate == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(2, x.GetRate())
+	return n
 }
 
-func (x *CheckoutResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
-	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
-	return offset, err
+func (x *TaxLine) sizeField3() (n int) {
+	if x.Amount == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(3, x.GetAmount())
+	return n
 }
 
-func (x *Address) FastWrite(buf []byte) (offset int) {
+func (x *QuoteResp) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	offset += x.fastWriteField5(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	return n
 }
 
-func (x *Address) fastWriteField1(buf []byte) (offset int) {
-	if x.StreetAddress == "" {
-		return offset
+func (x *QuoteResp) sizeField1() (n int) {
+	if x.Subtotal == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetStreetAddress())
-	return offset
+	n += fastpb.SizeFloat(1, x.GetSubtotal())
+	return n
 }
 
-func (x *Address) fastWriteField2(buf []byte) (offset int) {
-	if x.City == "" {
-		return offset
+func (x *QuoteResp) sizeField2() (n int) {
+	if x.ShippingMethod == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetCity())
-	return offset
+	n += fastpb.SizeString(2, x.GetShippingMethod())
+	return n
 }
 
-func (x *Address) fastWriteField3(buf []byte) (offset int) {
-	if x.State == "" {
-		return offset
+func (x *QuoteResp) sizeField3() (n int) {
+	if x.ShippingCost == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.GetState())
-	return offset
+	n += fastpb.SizeFloat(3, x.GetShippingCost())
+	return n
 }
 
-func (x *Address) fastWriteField4(buf []byte) (offset int) {
-	if x.Country == "" {
-		return offset
+func (x *QuoteResp) sizeField4() (n int) {
+	if x.TaxLines == nil {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.GetCountry())
-	return offset
+	for i := range x.GetTaxLines() {
+		n += fastpb.SizeMessage(4, x.GetTaxLines()[i])
+	}
+	return n
 }
 
-func (x *Address) fastWriteField5(buf []byte) (offset int) {
-	if x.ZipCode == "" {
-		return offset
+func (x *QuoteResp) sizeField5() (n int) {
+	if x.Tax == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 5, x.GetZipCode())
-	return offset
+	n += fastpb.SizeFloat(5, x.GetTax())
+	return n
 }
 
-func (x *CheckoutReq) FastWrite(buf []byte) (offset int) {
+func (x *QuoteResp) sizeField6() (n int) {
+	if !x.PricesIncludeTax {
+		return n
+	}
+	n += fastpb.SizeBool(6, x.GetPricesIncludeTax())
+	return n
+}
+
+func (x *QuoteResp) sizeField7() (n int) {
+	if x.Total == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(7, x.GetTotal())
+	return n
+}
+
+func (x *FlashSaleCheckoutReq) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	offset += x.fastWriteField5(buf[offset:])
-	offset += x.fastWriteField6(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	return n
 }
 
-func (x *CheckoutReq) fastWriteField1(buf []byte) (offset int) {
+func (x *FlashSaleCheckoutReq) sizeField1() (n int) {
 	if x.UserId == 0 {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
-	return offset
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
 }
 
-func (x *CheckoutReq) fastWriteField2(buf []byte) (offset int) {
+func (x *FlashSaleCheckoutReq) sizeField2() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(2, x.GetProductId())
+	return n
+}
+
+func (x *FlashSaleCheckoutReq) sizeField3() (n int) {
 	if x.Firstname == "" {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetFirstname())
-	return offset
+	n += fastpb.SizeString(3, x.GetFirstname())
+	return n
 }
 
-func (x *CheckoutReq) fastWriteField3(buf []byte) (offset int) {
+func (x *FlashSaleCheckoutReq) sizeField4() (n int) {
 	if x.Lastname == "" {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.GetLastname())
-	return offset
+	n += fastpb.SizeString(4, x.GetLastname())
+	return n
 }
 
-func (x *CheckoutReq) fastWriteField4(buf []byte) (offset int) {
+func (x *FlashSaleCheckoutReq) sizeField5() (n int) {
 	if x.Email == "" {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.GetEmail())
-	return offset
+	n += fastpb.SizeString(5, x.GetEmail())
+	return n
 }
 
-func (x *CheckoutReq) fastWriteField5(buf []byte) (offset int) {
+func (x *FlashSaleCheckoutReq) sizeField6() (n int) {
 	if x.Address == nil {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 5, x.GetAddress())
-	return offset
+	n += fastpb.SizeMessage(6, x.GetAddress())
+	return n
 }
 
-func (x *CheckoutReq) fastWriteField6(buf []byte) (offset int) {
+func (x *FlashSaleCheckoutReq) sizeField7() (n int) {
 	if x.CreditCard == nil {
-		return offset
-	}
-	offset += fastpb.WriteMessage(buf[offset:], 6, x.GetCreditCard())
-	return offset
-}
-
-func (x *CheckoutResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
-	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
-}
-
-func (x *CheckoutResp) fastWriteField1(buf []byte) (offset int) {
-	if x.OrderId == "" {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
-	return offset
+	n += fastpb.SizeMessage(7, x.GetCreditCard())
+	return n
 }
 
-func (x *CheckoutResp) fastWriteField2(buf []byte) (offset int) {
-	if x.TransactionId == "" {
-		return offset
+func (x *FlashSaleCheckoutReq) sizeField8() (n int) {
+	if x.ShippingMethod == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetTransactionId())
-	return offset
+	n += fastpb.SizeString(8, x.GetShippingMethod())
+	return n
 }
 
-func (x *Address) Size() (n int) {
+func (x *FlashSaleJob) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
-	n += x.sizeField3()
-	n += x.sizeField4()
-	n += x.sizeField5()
 	return n
 }
 
-func (x *Address) sizeField1() (n int) {
-	if x.StreetAddress == "" {
+func (x *FlashSaleJob) sizeField1() (n int) {
+	if x.Req == nil {
 		return n
 	}
-	n += fastpb.SizeString(1, x.GetStreetAddress())
+	n += fastpb.SizeMessage(1, x.GetReq())
 	return n
 }
 
-func (x *Address) sizeField2() (n int) {
-	if x.City == "" {
+func (x *FlashSaleJob) sizeField2() (n int) {
+	if x.CardToken == "" {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetCity())
+	n += fastpb.SizeString(2, x.GetCardToken())
 	return n
 }
 
-func (x *Address) sizeField3() (n int) {
-	if x.State == "" {
+func (x *FlashSaleCheckoutResp) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeString(3, x.GetState())
+	n += x.sizeField1()
+	n += x.sizeField2()
 	return n
 }
 
-func (x *Address) sizeField4() (n int) {
-	if x.Country == "" {
+func (x *FlashSaleCheckoutResp) sizeField1() (n int) {
+	if !x.Accepted {
 		return n
 	}
-	n += fastpb.SizeString(4, x.GetCountry())
+	n += fastpb.SizeBool(1, x.GetAccepted())
 	return n
 }
 
-func (x *Address) sizeField5() (n int) {
-	if x.ZipCode == "" {
+func (x *FlashSaleCheckoutResp) sizeField2() (n int) {
+	if x.Reason == "" {
 		return n
 	}
-	n += fastpb.SizeString(5, x.GetZipCode())
+	n += fastpb.SizeString(2, x.GetReason())
 	return n
 }
 
-func (x *CheckoutReq) Size() (n int) {
+func (x *GetFlashSaleStatusReq) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
-	n += x.sizeField3()
-	n += x.sizeField4()
-	n += x.sizeField5()
-	n += x.sizeField6()
 	return n
 }
 
-func (x *CheckoutReq) sizeField1() (n int) {
+func (x *GetFlashSaleStatusReq) sizeField1() (n int) {
 	if x.UserId == 0 {
 		return n
 	}
@@ -409,68 +2075,72 @@ func (x *CheckoutReq) sizeField1() (n int) {
 	return n
 }
 
-func (x *CheckoutReq) sizeField2() (n int) {
-	if x.Firstname == "" {
+func (x *GetFlashSaleStatusReq) sizeField2() (n int) {
+	if x.ProductId == 0 {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetFirstname())
+	n += fastpb.SizeUint32(2, x.GetProductId())
 	return n
 }
 
-func (x *CheckoutReq) sizeField3() (n int) {
-	if x.Lastname == "" {
+func (x *GetFlashSaleStatusResp) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeString(3, x.GetLastname())
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
 	return n
 }
 
-func (x *CheckoutReq) sizeField4() (n int) {
-	if x.Email == "" {
+func (x *GetFlashSaleStatusResp) sizeField1() (n int) {
+	if !x.OnSale {
 		return n
 	}
-	n += fastpb.SizeString(4, x.GetEmail())
+	n += fastpb.SizeBool(1, x.GetOnSale())
 	return n
 }
 
-func (x *CheckoutReq) sizeField5() (n int) {
-	if x.Address == nil {
+func (x *GetFlashSaleStatusResp) sizeField2() (n int) {
+	if x.Stock == 0 {
 		return n
 	}
-	n += fastpb.SizeMessage(5, x.GetAddress())
+	n += fastpb.SizeInt64(2, x.GetStock())
 	return n
 }
 
-func (x *CheckoutReq) sizeField6() (n int) {
-	if x.CreditCard == nil {
+func (x *GetFlashSaleStatusResp) sizeField3() (n int) {
+	if x.Status == "" {
 		return n
 	}
-	n += fastpb.SizeMessage(6, x.GetCreditCard())
+	n += fastpb.SizeString(3, x.GetStatus())
 	return n
 }
 
-func (x *CheckoutResp) Size() (n int) {
-	if x == nil {
+func (x *GetFlashSaleStatusResp) sizeField4() (n int) {
+	if x.OrderId == "" {
 		return n
 	}
-	n += x.sizeField1()
-	n += x.sizeField2()
+	n += fastpb.SizeString(4, x.GetOrderId())
 	return n
 }
 
-func (x *CheckoutResp) sizeField1() (n int) {
-	if x.OrderId == "" {
+func (x *GetFlashSaleStatusResp) sizeField5() (n int) {
+	if x.TransactionId == "" {
 		return n
 	}
-	n += fastpb.SizeString(1, x.GetOrderId())
+	n += fastpb.SizeString(5, x.GetTransactionId())
 	return n
 }
 
-func (x *CheckoutResp) sizeField2() (n int) {
-	if x.TransactionId == "" {
+func (x *GetFlashSaleStatusResp) sizeField6() (n int) {
+	if x.Reason == "" {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetTransactionId())
+	n += fastpb.SizeString(6, x.GetReason())
 	return n
 }
 
@@ -489,6 +2159,7 @@ var fieldIDToName_CheckoutReq = map[int32]string{
 	4: "Email",
 	5: "Address",
 	6: "CreditCard",
+	7: "ShippingMethod",
 }
 
 var fieldIDToName_CheckoutResp = map[int32]string{
@@ -496,4 +2167,85 @@ var fieldIDToName_CheckoutResp = map[int32]string{
 	2: "TransactionId",
 }
 
+var fieldIDToName_SubmitCheckoutResp = map[int32]string{
+	1: "CheckoutId",
+}
+
+var fieldIDToName_CheckoutJob = map[int32]string{
+	1: "CheckoutId",
+	2: "Req",
+	3: "CardToken",
+}
+
+var fieldIDToName_GetCheckoutStatusReq = map[int32]string{
+	1: "UserId",
+	2: "CheckoutId",
+}
+
+var fieldIDToName_GetCheckoutStatusResp = map[int32]string{
+	1: "Status",
+	2: "Step",
+	3: "OrderId",
+	4: "TransactionId",
+	5: "Reason",
+}
+
+var fieldIDToName_QuoteReq = map[int32]string{
+	1: "UserId",
+	2: "Address",
+	3: "ShippingMethod",
+	4: "ProductId",
+}
+
+var fieldIDToName_TaxLine = map[int32]string{
+	1: "Name",
+	2: "Rate",
+	3: "Amount",
+}
+
+var fieldIDToName_QuoteResp = map[int32]string{
+	1: "Subtotal",
+	2: "ShippingMethod",
+	3: "ShippingCost",
+	4: "TaxLines",
+	5: "Tax",
+	6: "PricesIncludeTax",
+	7: "Total",
+}
+
+var fieldIDToName_FlashSaleCheckoutReq = map[int32]string{
+	1: "UserId",
+	2: "ProductId",
+	3: "Firstname",
+	4: "Lastname",
+	5: "Email",
+	6: "Address",
+	7: "CreditCard",
+	8: "ShippingMethod",
+}
+
+var fieldIDToName_FlashSaleJob = map[int32]string{
+	1: "Req",
+	2: "CardToken",
+}
+
+var fieldIDToName_FlashSaleCheckoutResp = map[int32]string{
+	1: "Accepted",
+	2: "Reason",
+}
+
+var fieldIDToName_GetFlashSaleStatusReq = map[int32]string{
+	1: "UserId",
+	2: "ProductId",
+}
+
+var fieldIDToName_GetFlashSaleStatusResp = map[int32]string{
+	1: "OnSale",
+	2: "Stock",
+	3: "Status",
+	4: "OrderId",
+	5: "TransactionId",
+	6: "Reason",
+}
+
 var _ = payment.File_payment_proto
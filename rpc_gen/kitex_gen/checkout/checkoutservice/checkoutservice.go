@@ -21,7 +21,12 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "CheckoutService"
 	handlerType := (*checkout.CheckoutService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"Checkout": kitex.NewMethodInfo(checkoutHandler, newCheckoutArgs, newCheckoutResult, false),
+		"Checkout":           kitex.NewMethodInfo(checkoutHandler, newCheckoutArgs, newCheckoutResult, false),
+		"FlashSaleCheckout":  kitex.NewMethodInfo(flashSaleCheckoutHandler, newFlashSaleCheckoutArgs, newFlashSaleCheckoutResult, false),
+		"GetFlashSaleStatus": kitex.NewMethodInfo(getFlashSaleStatusHandler, newGetFlashSaleStatusArgs, newGetFlashSaleStatusResult, false),
+		"SubmitCheckout":     kitex.NewMethodInfo(submitCheckoutHandler, newSubmitCheckoutArgs, newSubmitCheckoutResult, false),
+		"GetCheckoutStatus":  kitex.NewMethodInfo(getCheckoutStatusHandler, newGetCheckoutStatusArgs, newGetCheckoutStatusResult, false),
+		"Quote":              kitex.NewMethodInfo(quoteHandler, newQuoteArgs, newQuoteResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "checkout",
@@ -191,6 +196,771 @@ func (p *CheckoutResult) GetResult() interface{} {
 	return p.Success
 }
 
+func flashSaleCheckoutHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(checkout.FlashSaleCheckoutReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(checkout.CheckoutService).FlashSaleCheckout(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *FlashSaleCheckoutArgs:
+		success, err := handler.(checkout.CheckoutService).FlashSaleCheckout(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*FlashSaleCheckoutResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newFlashSaleCheckoutArgs() interface{} {
+	return &FlashSaleCheckoutArgs{}
+}
+
+func newFlashSaleCheckoutResult() interface{} {
+	return &FlashSaleCheckoutResult{}
+}
+
+type FlashSaleCheckoutArgs struct {
+	Req *checkout.FlashSaleCheckoutReq
+}
+
+func (p *FlashSaleCheckoutArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(checkout.FlashSaleCheckoutReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *FlashSaleCheckoutArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *FlashSaleCheckoutArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *FlashSaleCheckoutArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *FlashSaleCheckoutArgs) Unmarshal(in []byte) error {
+	msg := new(checkout.FlashSaleCheckoutReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var FlashSaleCheckoutArgs_Req_DEFAULT *checkout.FlashSaleCheckoutReq
+
+func (p *FlashSaleCheckoutArgs) GetReq() *checkout.FlashSaleCheckoutReq {
+	if !p.IsSetReq() {
+		return FlashSaleCheckoutArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *FlashSaleCheckoutArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FlashSaleCheckoutArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type FlashSaleCheckoutResult struct {
+	Success *checkout.FlashSaleCheckoutResp
+}
+
+var FlashSaleCheckoutResult_Success_DEFAULT *checkout.FlashSaleCheckoutResp
+
+func (p *FlashSaleCheckoutResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(checkout.FlashSaleCheckoutResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *FlashSaleCheckoutResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *FlashSaleCheckoutResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *FlashSaleCheckoutResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *FlashSaleCheckoutResult) Unmarshal(in []byte) error {
+	msg := new(checkout.FlashSaleCheckoutResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *FlashSaleCheckoutResult) GetSuccess() *checkout.FlashSaleCheckoutResp {
+	if !p.IsSetSuccess() {
+		return FlashSaleCheckoutResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *FlashSaleCheckoutResult) SetSuccess(x interface{}) {
+	p.Success = x.(*checkout.FlashSaleCheckoutResp)
+}
+
+func (p *FlashSaleCheckoutResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FlashSaleCheckoutResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getFlashSaleStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(checkout.GetFlashSaleStatusReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(checkout.CheckoutService).GetFlashSaleStatus(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GetFlashSaleStatusArgs:
+		success, err := handler.(checkout.CheckoutService).GetFlashSaleStatus(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetFlashSaleStatusResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGetFlashSaleStatusArgs() interface{} {
+	return &GetFlashSaleStatusArgs{}
+}
+
+func newGetFlashSaleStatusResult() interface{} {
+	return &GetFlashSaleStatusResult{}
+}
+
+type GetFlashSaleStatusArgs struct {
+	Req *checkout.GetFlashSaleStatusReq
+}
+
+func (p *GetFlashSaleStatusArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(checkout.GetFlashSaleStatusReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetFlashSaleStatusArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetFlashSaleStatusArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetFlashSaleStatusArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetFlashSaleStatusArgs) Unmarshal(in []byte) error {
+	msg := new(checkout.GetFlashSaleStatusReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetFlashSaleStatusArgs_Req_DEFAULT *checkout.GetFlashSaleStatusReq
+
+func (p *GetFlashSaleStatusArgs) GetReq() *checkout.GetFlashSaleStatusReq {
+	if !p.IsSetReq() {
+		return GetFlashSaleStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetFlashSaleStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetFlashSaleStatusArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetFlashSaleStatusResult struct {
+	Success *checkout.GetFlashSaleStatusResp
+}
+
+var GetFlashSaleStatusResult_Success_DEFAULT *checkout.GetFlashSaleStatusResp
+
+func (p *GetFlashSaleStatusResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(checkout.GetFlashSaleStatusResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetFlashSaleStatusResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetFlashSaleStatusResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetFlashSaleStatusResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetFlashSaleStatusResult) Unmarshal(in []byte) error {
+	msg := new(checkout.GetFlashSaleStatusResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetFlashSaleStatusResult) GetSuccess() *checkout.GetFlashSaleStatusResp {
+	if !p.IsSetSuccess() {
+		return GetFlashSaleStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetFlashSaleStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*checkout.GetFlashSaleStatusResp)
+}
+
+func (p *GetFlashSaleStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetFlashSaleStatusResult) GetResult() interface{} {
+	return p.Success
+}
+
+func submitCheckoutHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(checkout.CheckoutReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(checkout.CheckoutService).SubmitCheckout(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *SubmitCheckoutArgs:
+		success, err := handler.(checkout.CheckoutService).SubmitCheckout(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SubmitCheckoutResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newSubmitCheckoutArgs() interface{} {
+	return &SubmitCheckoutArgs{}
+}
+
+func newSubmitCheckoutResult() interface{} {
+	return &SubmitCheckoutResult{}
+}
+
+type SubmitCheckoutArgs struct {
+	Req *checkout.CheckoutReq
+}
+
+func (p *SubmitCheckoutArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(checkout.CheckoutReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *SubmitCheckoutArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *SubmitCheckoutArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *SubmitCheckoutArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SubmitCheckoutArgs) Unmarshal(in []byte) error {
+	msg := new(checkout.CheckoutReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SubmitCheckoutArgs_Req_DEFAULT *checkout.CheckoutReq
+
+func (p *SubmitCheckoutArgs) GetReq() *checkout.CheckoutReq {
+	if !p.IsSetReq() {
+		return SubmitCheckoutArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SubmitCheckoutArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SubmitCheckoutArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SubmitCheckoutResult struct {
+	Success *checkout.SubmitCheckoutResp
+}
+
+var SubmitCheckoutResult_Success_DEFAULT *checkout.SubmitCheckoutResp
+
+func (p *SubmitCheckoutResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(checkout.SubmitCheckoutResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *SubmitCheckoutResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *SubmitCheckoutResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *SubmitCheckoutResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SubmitCheckoutResult) Unmarshal(in []byte) error {
+	msg := new(checkout.SubmitCheckoutResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SubmitCheckoutResult) GetSuccess() *checkout.SubmitCheckoutResp {
+	if !p.IsSetSuccess() {
+		return SubmitCheckoutResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SubmitCheckoutResult) SetSuccess(x interface{}) {
+	p.Success = x.(*checkout.SubmitCheckoutResp)
+}
+
+func (p *SubmitCheckoutResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SubmitCheckoutResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getCheckoutStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(checkout.GetCheckoutStatusReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(checkout.CheckoutService).GetCheckoutStatus(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GetCheckoutStatusArgs:
+		success, err := handler.(checkout.CheckoutService).GetCheckoutStatus(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetCheckoutStatusResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGetCheckoutStatusArgs() interface{} {
+	return &GetCheckoutStatusArgs{}
+}
+
+func newGetCheckoutStatusResult() interface{} {
+	return &GetCheckoutStatusResult{}
+}
+
+type GetCheckoutStatusArgs struct {
+	Req *checkout.GetCheckoutStatusReq
+}
+
+func (p *GetCheckoutStatusArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(checkout.GetCheckoutStatusReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetCheckoutStatusArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetCheckoutStatusArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetCheckoutStatusArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetCheckoutStatusArgs) Unmarshal(in []byte) error {
+	msg := new(checkout.GetCheckoutStatusReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetCheckoutStatusArgs_Req_DEFAULT *checkout.GetCheckoutStatusReq
+
+func (p *GetCheckoutStatusArgs) GetReq() *checkout.GetCheckoutStatusReq {
+	if !p.IsSetReq() {
+		return GetCheckoutStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetCheckoutStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetCheckoutStatusArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetCheckoutStatusResult struct {
+	Success *checkout.GetCheckoutStatusResp
+}
+
+var GetCheckoutStatusResult_Success_DEFAULT *checkout.GetCheckoutStatusResp
+
+func (p *GetCheckoutStatusResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(checkout.GetCheckoutStatusResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetCheckoutStatusResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetCheckoutStatusResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetCheckoutStatusResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetCheckoutStatusResult) Unmarshal(in []byte) error {
+	msg := new(checkout.GetCheckoutStatusResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetCheckoutStatusResult) GetSuccess() *checkout.GetCheckoutStatusResp {
+	if !p.IsSetSuccess() {
+		return GetCheckoutStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetCheckoutStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*checkout.GetCheckoutStatusResp)
+}
+
+func (p *GetCheckoutStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetCheckoutStatusResult) GetResult() interface{} {
+	return p.Success
+}
+
+func quoteHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(checkout.QuoteReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(checkout.CheckoutService).Quote(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *QuoteArgs:
+		success, err := handler.(checkout.CheckoutService).Quote(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*QuoteResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newQuoteArgs() interface{} {
+	return &QuoteArgs{}
+}
+
+func newQuoteResult() interface{} {
+	return &QuoteResult{}
+}
+
+type QuoteArgs struct {
+	Req *checkout.QuoteReq
+}
+
+func (p *QuoteArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(checkout.QuoteReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *QuoteArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *QuoteArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *QuoteArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *QuoteArgs) Unmarshal(in []byte) error {
+	msg := new(checkout.QuoteReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var QuoteArgs_Req_DEFAULT *checkout.QuoteReq
+
+func (p *QuoteArgs) GetReq() *checkout.QuoteReq {
+	if !p.IsSetReq() {
+		return QuoteArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *QuoteArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *QuoteArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type QuoteResult struct {
+	Success *checkout.QuoteResp
+}
+
+var QuoteResult_Success_DEFAULT *checkout.QuoteResp
+
+func (p *QuoteResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(checkout.QuoteResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *QuoteResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *QuoteResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *QuoteResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *QuoteResult) Unmarshal(in []byte) error {
+	msg := new(checkout.QuoteResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *QuoteResult) GetSuccess() *checkout.QuoteResp {
+	if !p.IsSetSuccess() {
+		return QuoteResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *QuoteResult) SetSuccess(x interface{}) {
+	p.Success = x.(*checkout.QuoteResp)
+}
+
+func (p *QuoteResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *QuoteResult) GetResult() interface{} {
+	return p.Success
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -210,3 +980,53 @@ func (p *kClient) Checkout(ctx context.Context, Req *checkout.CheckoutReq) (r *c
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) FlashSaleCheckout(ctx context.Context, Req *checkout.FlashSaleCheckoutReq) (r *checkout.FlashSaleCheckoutResp, err error) {
+	var _args FlashSaleCheckoutArgs
+	_args.Req = Req
+	var _result FlashSaleCheckoutResult
+	if err = p.c.Call(ctx, "FlashSaleCheckout", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetFlashSaleStatus(ctx context.Context, Req *checkout.GetFlashSaleStatusReq) (r *checkout.GetFlashSaleStatusResp, err error) {
+	var _args GetFlashSaleStatusArgs
+	_args.Req = Req
+	var _result GetFlashSaleStatusResult
+	if err = p.c.Call(ctx, "GetFlashSaleStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SubmitCheckout(ctx context.Context, Req *checkout.CheckoutReq) (r *checkout.SubmitCheckoutResp, err error) {
+	var _args SubmitCheckoutArgs
+	_args.Req = Req
+	var _result SubmitCheckoutResult
+	if err = p.c.Call(ctx, "SubmitCheckout", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCheckoutStatus(ctx context.Context, Req *checkout.GetCheckoutStatusReq) (r *checkout.GetCheckoutStatusResp, err error) {
+	var _args GetCheckoutStatusArgs
+	_args.Req = Req
+	var _result GetCheckoutStatusResult
+	if err = p.c.Call(ctx, "GetCheckoutStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Quote(ctx context.Context, Req *checkout.QuoteReq) (r *checkout.QuoteResp, err error) {
+	var _args QuoteArgs
+	_args.Req = Req
+	var _result QuoteResult
+	if err = p.c.Call(ctx, "Quote", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
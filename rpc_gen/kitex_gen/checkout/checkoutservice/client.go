@@ -12,6 +12,11 @@ import (
 // Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
 type Client interface {
 	Checkout(ctx context.Context, Req *checkout.CheckoutReq, callOptions ...callopt.Option) (r *checkout.CheckoutResp, err error)
+	FlashSaleCheckout(ctx context.Context, Req *checkout.FlashSaleCheckoutReq, callOptions ...callopt.Option) (r *checkout.FlashSaleCheckoutResp, err error)
+	GetFlashSaleStatus(ctx context.Context, Req *checkout.GetFlashSaleStatusReq, callOptions ...callopt.Option) (r *checkout.GetFlashSaleStatusResp, err error)
+	SubmitCheckout(ctx context.Context, Req *checkout.CheckoutReq, callOptions ...callopt.Option) (r *checkout.SubmitCheckoutResp, err error)
+	GetCheckoutStatus(ctx context.Context, Req *checkout.GetCheckoutStatusReq, callOptions ...callopt.Option) (r *checkout.GetCheckoutStatusResp, err error)
+	Quote(ctx context.Context, Req *checkout.QuoteReq, callOptions ...callopt.Option) (r *checkout.QuoteResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -47,3 +52,28 @@ func (p *kCheckoutServiceClient) Checkout(ctx context.Context, Req *checkout.Che
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.Checkout(ctx, Req)
 }
+
+func (p *kCheckoutServiceClient) FlashSaleCheckout(ctx context.Context, Req *checkout.FlashSaleCheckoutReq, callOptions ...callopt.Option) (r *checkout.FlashSaleCheckoutResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.FlashSaleCheckout(ctx, Req)
+}
+
+func (p *kCheckoutServiceClient) GetFlashSaleStatus(ctx context.Context, Req *checkout.GetFlashSaleStatusReq, callOptions ...callopt.Option) (r *checkout.GetFlashSaleStatusResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFlashSaleStatus(ctx, Req)
+}
+
+func (p *kCheckoutServiceClient) SubmitCheckout(ctx context.Context, Req *checkout.CheckoutReq, callOptions ...callopt.Option) (r *checkout.SubmitCheckoutResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SubmitCheckout(ctx, Req)
+}
+
+func (p *kCheckoutServiceClient) GetCheckoutStatus(ctx context.Context, Req *checkout.GetCheckoutStatusReq, callOptions ...callopt.Option) (r *checkout.GetCheckoutStatusResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCheckoutStatus(ctx, Req)
+}
+
+func (p *kCheckoutServiceClient) Quote(ctx context.Context, Req *checkout.QuoteReq, callOptions ...callopt.Option) (r *checkout.QuoteResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Quote(ctx, Req)
+}
@@ -39,6 +39,26 @@ func (x *EmailReq) FastRead(buf []byte, _type int8, number int32) (offset int, e
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -77,6 +97,76 @@ func (x *EmailReq) fastReadField5(buf []byte, _type int8) (offset int, err error
 	return offset, err
 }
 
+func (x *EmailReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	var v Attachment
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Attachments = append(x.Attachments, &v)
+	return offset, nil
+}
+
+func (x *EmailReq) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Template, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EmailReq) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.Locale, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EmailReq) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.TemplateData, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Attachment) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Attachment[number], err)
+}
+
+func (x *Attachment) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Filename, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Attachment) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.ContentType, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Attachment) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Content, offset, err = fastpb.ReadBytes(buf, _type)
+	return offset, err
+}
+
 func (x *EmailResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	default:
@@ -90,59 +180,737 @@ SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 }
 
-func (x *EmailReq) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *DeadLetter) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	offset += x.fastWriteField5(buf[offset:])
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeadLetter[number], err)
+}
+
+func (x *DeadLetter) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Seq, offset, err = fastpb.ReadUint64(buf, _type)
+	return offset, err
+}
+
+func (x *DeadLetter) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DeadLetter) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Deliveries, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *DeadLetter) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.DeadAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *DeadLetter) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.To, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DeadLetter) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.Subject, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DeadLetter) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Template, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListDeadLettersReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListDeadLettersReq[number], err)
+}
+
+func (x *ListDeadLettersReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StartSeq, offset, err = fastpb.ReadUint64(buf, _type)
+	return offset, err
+}
+
+func (x *ListDeadLettersReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Limit, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListDeadLettersResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListDeadLettersResp[number], err)
+}
+
+func (x *ListDeadLettersResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v DeadLetter
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Letters = append(x.Letters, &v)
+	return offset, nil
+}
+
+func (x *ListDeadLettersResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.NextSeq, offset, err = fastpb.ReadUint64(buf, _type)
+	return offset, err
+}
+
+func (x *ReplayDeadLettersReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ReplayDeadLettersReq[number], err)
+}
+
+func (x *ReplayDeadLettersReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	offset, err = fastpb.ReadList(buf, _type,
+		func(buf []byte, _type int8) (n int, err error) {
+			var v uint64
+			v, offset, err = fastpb.ReadUint64(buf, _type)
+			if err != nil {
+				return offset, err
+			}
+			x.Seqs = append(x.Seqs, v)
+			return offset, err
+		})
+	return offset, err
+}
+
+func (x *ReplayDeadLettersResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ReplayDeadLettersResp[number], err)
+}
+
+func (x *ReplayDeadLettersResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	offset, err = fastpb.ReadList(buf, _type,
+		func(buf []byte, _type int8) (n int, err error) {
+			var v uint64
+			v, offset, err = fastpb.ReadUint64(buf, _type)
+			if err != nil {
+				return offset, err
+			}
+			x.Replayed = append(x.Replayed, v)
+			return offset, err
+		})
+	return offset, err
+}
+
+func (x *ReplayDeadLettersResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	offset, err = fastpb.ReadList(buf, _type,
+		func(buf []byte, _type int8) (n int, err error) {
+			var v uint64
+			v, offset, err = fastpb.ReadUint64(buf, _type)
+			if err != nil {
+				return offset, err
+			}
+			x.NotFound = append(x.NotFound, v)
+			return offset, err
+		})
+	return offset, err
+}
+
+func (x *ExportUserDataReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ExportUserDataReq[number], err)
+}
+
+func (x *ExportUserDataReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *ExportUserDataReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Email, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ExportUserDataResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ExportUserDataResp[number], err)
+}
+
+func (x *ExportUserDataResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Data, offset, err = fastpb.ReadBytes(buf, _type)
+	return offset, err
+}
+
+func (x *EraseUserDataReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_EraseUserDataReq[number], err)
+}
+
+func (x *EraseUserDataReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *EraseUserDataReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Email, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EraseUserDataResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+}
+
+func (x *EmailReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	return offset
+}
+
+func (x *EmailReq) fastWriteField1(buf []byte) (offset int) {
+	if x.From == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetFrom())
+	return offset
+}
+
+func (x *EmailReq) fastWriteField2(buf []byte) (offset int) {
+	if x.To == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetTo())
+	return offset
+}
+
+func (x *EmailReq) fastWriteField3(buf []byte) (offset int) {
+	if x.ContentType == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetContentType())
+	return offset
+}
+
+func (x *EmailReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Subject == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetSubject())
+	return offset
+}
+
+func (x *EmailReq) fastWriteField5(buf []byte) (offset int) {
+	if x.Content == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetContent())
+	return offset
+}
+
+func (x *EmailReq) fastWriteField6(buf []byte) (offset int) {
+	if x.Attachments == nil {
+		return offset
+	}
+	for i := range x.GetAttachments() {
+		offset += fastpb.WriteMessage(buf[offset:], 6, x.GetAttachments()[i])
+	}
+	return offset
+}
+
+func (x *EmailReq) fastWriteField7(buf []byte) (offset int) {
+	if x.Template == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 7, x.GetTemplate())
+	return offset
+}
+
+func (x *EmailReq) fastWriteField8(buf []byte) (offset int) {
+	if x.Locale == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetLocale())
+	return offset
+}
+
+func (x *EmailReq) fastWriteField9(buf []byte) (offset int) {
+	if x.TemplateData == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 9, x.GetTemplateData())
+	return offset
+}
+
+func (x *Attachment) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *Attachment) fastWriteField1(buf []byte) (offset int) {
+	if x.Filename == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetFilename())
+	return offset
+}
+
+func (x *Attachment) fastWriteField2(buf []byte) (offset int) {
+	if x.ContentType == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetContentType())
+	return offset
+}
+
+func (x *Attachment) fastWriteField3(buf []byte) (offset int) {
+	if len(x.Content) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteBytes(buf[offset:], 3, x.GetContent())
+	return offset
+}
+
+func (x *EmailResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	return offset
+}
+
+func (x *DeadLetter) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	return offset
+}
+
+func (x *DeadLetter) fastWriteField1(buf []byte) (offset int) {
+	if x.Seq == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint64(buf[offset:], 1, x.GetSeq())
+	return offset
+}
+
+func (x *DeadLetter) fastWriteField2(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetReason())
+	return offset
+}
+
+func (x *DeadLetter) fastWriteField3(buf []byte) (offset int) {
+	if x.Deliveries == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, x.GetDeliveries())
+	return offset
+}
+
+func (x *DeadLetter) fastWriteField4(buf []byte) (offset int) {
+	if x.DeadAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.GetDeadAt())
+	return offset
+}
+
+func (x *DeadLetter) fastWriteField5(buf []byte) (offset int) {
+	if x.To == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetTo())
+	return offset
+}
+
+func (x *DeadLetter) fastWriteField6(buf []byte) (offset int) {
+	if x.Subject == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetSubject())
+	return offset
+}
+
+func (x *DeadLetter) fastWriteField7(buf []byte) (offset int) {
+	if x.Template == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 7, x.GetTemplate())
+	return offset
+}
+
+func (x *ListDeadLettersReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ListDeadLettersReq) fastWriteField1(buf []byte) (offset int) {
+	if x.StartSeq == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint64(buf[offset:], 1, x.GetStartSeq())
+	return offset
+}
+
+func (x *ListDeadLettersReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Limit == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetLimit())
+	return offset
+}
+
+func (x *ListDeadLettersResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ListDeadLettersResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Letters == nil {
+		return offset
+	}
+	for i := range x.GetLetters() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetLetters()[i])
+	}
+	return offset
+}
+
+func (x *ListDeadLettersResp) fastWriteField2(buf []byte) (offset int) {
+	if x.NextSeq == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint64(buf[offset:], 2, x.GetNextSeq())
+	return offset
+}
+
+func (x *ReplayDeadLettersReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ReplayDeadLettersReq) fastWriteField1(buf []byte) (offset int) {
+	if len(x.Seqs) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteListPacked(buf[offset:], 1, len(x.GetSeqs()),
+		func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+			offset := 0
+			offset += fastpb.WriteUint64(buf[offset:], numTagOrKey, x.GetSeqs()[numIdxOrVal])
+			return offset
+		})
+	return offset
+}
+
+func (x *ReplayDeadLettersResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ReplayDeadLettersResp) fastWriteField1(buf []byte) (offset int) {
+	if len(x.Replayed) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteListPacked(buf[offset:], 1, len(x.GetReplayed()),
+		func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+			offset := 0
+			offset += fastpb.WriteUint64(buf[offset:], numTagOrKey, x.GetReplayed()[numIdxOrVal])
+			return offset
+		})
+	return offset
+}
+
+func (x *ReplayDeadLettersResp) fastWriteField2(buf []byte) (offset int) {
+	if len(x.NotFound) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteListPacked(buf[offset:], 2, len(x.GetNotFound()),
+		func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+			offset := 0
+			offset += fastpb.WriteUint64(buf[offset:], numTagOrKey, x.GetNotFound()[numIdxOrVal])
+			return offset
+		})
+	return offset
+}
+
+func (x *ExportUserDataReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ExportUserDataReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *ExportUserDataReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetEmail())
 	return offset
 }
 
-func (x *EmailReq) fastWriteField1(buf []byte) (offset int) {
-	if x.From == "" {
+func (x *ExportUserDataResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetFrom())
+	offset += x.fastWriteField1(buf[offset:])
 	return offset
 }
 
-func (x *EmailReq) fastWriteField2(buf []byte) (offset int) {
-	if x.To == "" {
+func (x *ExportUserDataResp) fastWriteField1(buf []byte) (offset int) {
+	if len(x.Data) == 0 {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetTo())
+	offset += fastpb.WriteBytes(buf[offset:], 1, x.GetData())
 	return offset
 }
 
-func (x *EmailReq) fastWriteField3(buf []byte) (offset int) {
-	if x.ContentType == "" {
+func (x *EraseUserDataReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.GetContentType())
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
 	return offset
 }
 
-func (x *EmailReq) fastWriteField4(buf []byte) (offset int) {
-	if x.Subject == "" {
+func (x *EraseUserDataReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.GetSubject())
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
 	return offset
 }
 
-func (x *EmailReq) fastWriteField5(buf []byte) (offset int) {
-	if x.Content == "" {
+func (x *EraseUserDataReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Email == "" {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 5, x.GetContent())
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetEmail())
 	return offset
 }
 
-func (x *EmailResp) FastWrite(buf []byte) (offset int) {
+func (x *EraseUserDataResp) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
 	}
@@ -158,6 +926,10 @@ func (x *EmailReq) Size() (n int) {
 	n += x.sizeField3()
 	n += x.sizeField4()
 	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
 	return n
 }
 
@@ -201,6 +973,74 @@ func (x *EmailReq) sizeField5() (n int) {
 	return n
 }
 
+func (x *EmailReq) sizeField6() (n int) {
+	if x.Attachments == nil {
+		return n
+	}
+	for i := range x.GetAttachments() {
+		n += fastpb.SizeMessage(6, x.GetAttachments()[i])
+	}
+	return n
+}
+
+func (x *EmailReq) sizeField7() (n int) {
+	if x.Template == "" {
+		return n
+	}
+	n += fastpb.SizeString(7, x.GetTemplate())
+	return n
+}
+
+func (x *EmailReq) sizeField8() (n int) {
+	if x.Locale == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetLocale())
+	return n
+}
+
+func (x *EmailReq) sizeField9() (n int) {
+	if x.TemplateData == "" {
+		return n
+	}
+	n += fastpb.SizeString(9, x.GetTemplateData())
+	return n
+}
+
+func (x *Attachment) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *Attachment) sizeField1() (n int) {
+	if x.Filename == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetFilename())
+	return n
+}
+
+func (x *Attachment) sizeField2() (n int) {
+	if x.ContentType == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetContentType())
+	return n
+}
+
+func (x *Attachment) sizeField3() (n int) {
+	if len(x.Content) == 0 {
+		return n
+	}
+	n += fastpb.SizeBytes(3, x.GetContent())
+	return n
+}
+
 func (x *EmailResp) Size() (n int) {
 	if x == nil {
 		return n
@@ -208,12 +1048,318 @@ func (x *EmailResp) Size() (n int) {
 	return n
 }
 
+func (x *DeadLetter) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	return n
+}
+
+func (x *DeadLetter) sizeField1() (n int) {
+	if x.Seq == 0 {
+		return n
+	}
+	n += fastpb.SizeUint64(1, x.GetSeq())
+	return n
+}
+
+func (x *DeadLetter) sizeField2() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetReason())
+	return n
+}
+
+func (x *DeadLetter) sizeField3() (n int) {
+	if x.Deliveries == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, x.GetDeliveries())
+	return n
+}
+
+func (x *DeadLetter) sizeField4() (n int) {
+	if x.DeadAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(4, x.GetDeadAt())
+	return n
+}
+
+func (x *DeadLetter) sizeField5() (n int) {
+	if x.To == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetTo())
+	return n
+}
+
+func (x *DeadLetter) sizeField6() (n int) {
+	if x.Subject == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetSubject())
+	return n
+}
+
+func (x *DeadLetter) sizeField7() (n int) {
+	if x.Template == "" {
+		return n
+	}
+	n += fastpb.SizeString(7, x.GetTemplate())
+	return n
+}
+
+func (x *ListDeadLettersReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ListDeadLettersReq) sizeField1() (n int) {
+	if x.StartSeq == 0 {
+		return n
+	}
+	n += fastpb.SizeUint64(1, x.GetStartSeq())
+	return n
+}
+
+func (x *ListDeadLettersReq) sizeField2() (n int) {
+	if x.Limit == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetLimit())
+	return n
+}
+
+func (x *ListDeadLettersResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ListDeadLettersResp) sizeField1() (n int) {
+	if x.Letters == nil {
+		return n
+	}
+	for i := range x.GetLetters() {
+		n += fastpb.SizeMessage(1, x.GetLetters()[i])
+	}
+	return n
+}
+
+func (x *ListDeadLettersResp) sizeField2() (n int) {
+	if x.NextSeq == 0 {
+		return n
+	}
+	n += fastpb.SizeUint64(2, x.GetNextSeq())
+	return n
+}
+
+func (x *ReplayDeadLettersReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ReplayDeadLettersReq) sizeField1() (n int) {
+	if len(x.Seqs) == 0 {
+		return n
+	}
+	n += fastpb.SizeListPacked(1, len(x.GetSeqs()),
+		func(numTagOrKey, numIdxOrVal int32) int {
+			n := 0
+			n += fastpb.SizeUint64(numTagOrKey, x.GetSeqs()[numIdxOrVal])
+			return n
+		})
+	return n
+}
+
+func (x *ReplayDeadLettersResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ReplayDeadLettersResp) sizeField1() (n int) {
+	if len(x.Replayed) == 0 {
+		return n
+	}
+	n += fastpb.SizeListPacked(1, len(x.GetReplayed()),
+		func(numTagOrKey, numIdxOrVal int32) int {
+			n := 0
+			n += fastpb.SizeUint64(numTagOrKey, x.GetReplayed()[numIdxOrVal])
+			return n
+		})
+	return n
+}
+
+func (x *ReplayDeadLettersResp) sizeField2() (n int) {
+	if len(x.NotFound) == 0 {
+		return n
+	}
+	n += fastpb.SizeListPacked(2, len(x.GetNotFound()),
+		func(numTagOrKey, numIdxOrVal int32) int {
+			n := 0
+			n += fastpb.SizeUint64(numTagOrKey, x.GetNotFound()[numIdxOrVal])
+			return n
+		})
+	return n
+}
+
+func (x *ExportUserDataReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ExportUserDataReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *ExportUserDataReq) sizeField2() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetEmail())
+	return n
+}
+
+func (x *ExportUserDataResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ExportUserDataResp) sizeField1() (n int) {
+	if len(x.Data) == 0 {
+		return n
+	}
+	n += fastpb.SizeBytes(1, x.GetData())
+	return n
+}
+
+func (x *EraseUserDataReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *EraseUserDataReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *EraseUserDataReq) sizeField2() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetEmail())
+	return n
+}
+
+func (x *EraseUserDataResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	return n
+}
+
 var fieldIDToName_EmailReq = map[int32]string{
 	1: "From",
 	2: "To",
 	3: "ContentType",
 	4: "Subject",
 	5: "Content",
+	6: "Attachments",
+	7: "Template",
+	8: "Locale",
+	9: "TemplateData",
+}
+
+var fieldIDToName_Attachment = map[int32]string{
+	1: "Filename",
+	2: "ContentType",
+	3: "Content",
 }
 
 var fieldIDToName_EmailResp = map[int32]string{}
+
+var fieldIDToName_DeadLetter = map[int32]string{
+	1: "Seq",
+	2: "Reason",
+	3: "Deliveries",
+	4: "DeadAt",
+	5: "To",
+	6: "Subject",
+	7: "Template",
+}
+
+var fieldIDToName_ListDeadLettersReq = map[int32]string{
+	1: "StartSeq",
+	2: "Limit",
+}
+
+var fieldIDToName_ListDeadLettersResp = map[int32]string{
+	1: "Letters",
+	2: "NextSeq",
+}
+
+var fieldIDToName_ReplayDeadLettersReq = map[int32]string{
+	1: "Seqs",
+}
+
+var fieldIDToName_ReplayDeadLettersResp = map[int32]string{
+	1: "Replayed",
+	2: "NotFound",
+}
+
+var fieldIDToName_ExportUserDataReq = map[int32]string{
+	1: "UserId",
+	2: "Email",
+}
+
+var fieldIDToName_ExportUserDataResp = map[int32]string{
+	1: "Data",
+}
+
+var fieldIDToName_EraseUserDataReq = map[int32]string{
+	1: "UserId",
+	2: "Email",
+}
+
+var fieldIDToName_EraseUserDataResp = map[int32]string{}
@@ -12,6 +12,10 @@ import (
 // Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
 type Client interface {
 	Send(ctx context.Context, Req *email.EmailReq, callOptions ...callopt.Option) (r *email.EmailResp, err error)
+	ExportUserData(ctx context.Context, Req *email.ExportUserDataReq, callOptions ...callopt.Option) (r *email.ExportUserDataResp, err error)
+	EraseUserData(ctx context.Context, Req *email.EraseUserDataReq, callOptions ...callopt.Option) (r *email.EraseUserDataResp, err error)
+	ListDeadLetters(ctx context.Context, Req *email.ListDeadLettersReq, callOptions ...callopt.Option) (r *email.ListDeadLettersResp, err error)
+	ReplayDeadLetters(ctx context.Context, Req *email.ReplayDeadLettersReq, callOptions ...callopt.Option) (r *email.ReplayDeadLettersResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -47,3 +51,23 @@ func (p *kEmailServiceClient) Send(ctx context.Context, Req *email.EmailReq, cal
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.Send(ctx, Req)
 }
+
+func (p *kEmailServiceClient) ExportUserData(ctx context.Context, Req *email.ExportUserDataReq, callOptions ...callopt.Option) (r *email.ExportUserDataResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportUserData(ctx, Req)
+}
+
+func (p *kEmailServiceClient) EraseUserData(ctx context.Context, Req *email.EraseUserDataReq, callOptions ...callopt.Option) (r *email.EraseUserDataResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EraseUserData(ctx, Req)
+}
+
+func (p *kEmailServiceClient) ListDeadLetters(ctx context.Context, Req *email.ListDeadLettersReq, callOptions ...callopt.Option) (r *email.ListDeadLettersResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListDeadLetters(ctx, Req)
+}
+
+func (p *kEmailServiceClient) ReplayDeadLetters(ctx context.Context, Req *email.ReplayDeadLettersReq, callOptions ...callopt.Option) (r *email.ReplayDeadLettersResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReplayDeadLetters(ctx, Req)
+}
@@ -21,7 +21,11 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "EmailService"
 	handlerType := (*email.EmailService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"Send": kitex.NewMethodInfo(sendHandler, newSendArgs, newSendResult, false),
+		"Send":              kitex.NewMethodInfo(sendHandler, newSendArgs, newSendResult, false),
+		"ExportUserData":    kitex.NewMethodInfo(exportUserDataHandler, newExportUserDataArgs, newExportUserDataResult, false),
+		"EraseUserData":     kitex.NewMethodInfo(eraseUserDataHandler, newEraseUserDataArgs, newEraseUserDataResult, false),
+		"ListDeadLetters":   kitex.NewMethodInfo(listDeadLettersHandler, newListDeadLettersArgs, newListDeadLettersResult, false),
+		"ReplayDeadLetters": kitex.NewMethodInfo(replayDeadLettersHandler, newReplayDeadLettersArgs, newReplayDeadLettersResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "email",
@@ -191,6 +195,618 @@ func (p *SendResult) GetResult() interface{} {
 	return p.Success
 }
 
+func exportUserDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(email.ExportUserDataReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(email.EmailService).ExportUserData(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ExportUserDataArgs:
+		success, err := handler.(email.EmailService).ExportUserData(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ExportUserDataResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newExportUserDataArgs() interface{} {
+	return &ExportUserDataArgs{}
+}
+
+func newExportUserDataResult() interface{} {
+	return &ExportUserDataResult{}
+}
+
+type ExportUserDataArgs struct {
+	Req *email.ExportUserDataReq
+}
+
+func (p *ExportUserDataArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(email.ExportUserDataReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ExportUserDataArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ExportUserDataArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ExportUserDataArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ExportUserDataArgs) Unmarshal(in []byte) error {
+	msg := new(email.ExportUserDataReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ExportUserDataArgs_Req_DEFAULT *email.ExportUserDataReq
+
+func (p *ExportUserDataArgs) GetReq() *email.ExportUserDataReq {
+	if !p.IsSetReq() {
+		return ExportUserDataArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ExportUserDataArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ExportUserDataArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ExportUserDataResult struct {
+	Success *email.ExportUserDataResp
+}
+
+var ExportUserDataResult_Success_DEFAULT *email.ExportUserDataResp
+
+func (p *ExportUserDataResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(email.ExportUserDataResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ExportUserDataResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ExportUserDataResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ExportUserDataResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ExportUserDataResult) Unmarshal(in []byte) error {
+	msg := new(email.ExportUserDataResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ExportUserDataResult) GetSuccess() *email.ExportUserDataResp {
+	if !p.IsSetSuccess() {
+		return ExportUserDataResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ExportUserDataResult) SetSuccess(x interface{}) {
+	p.Success = x.(*email.ExportUserDataResp)
+}
+
+func (p *ExportUserDataResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ExportUserDataResult) GetResult() interface{} {
+	return p.Success
+}
+
+func eraseUserDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(email.EraseUserDataReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(email.EmailService).EraseUserData(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *EraseUserDataArgs:
+		success, err := handler.(email.EmailService).EraseUserData(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*EraseUserDataResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newEraseUserDataArgs() interface{} {
+	return &EraseUserDataArgs{}
+}
+
+func newEraseUserDataResult() interface{} {
+	return &EraseUserDataResult{}
+}
+
+type EraseUserDataArgs struct {
+	Req *email.EraseUserDataReq
+}
+
+func (p *EraseUserDataArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(email.EraseUserDataReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *EraseUserDataArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *EraseUserDataArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *EraseUserDataArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *EraseUserDataArgs) Unmarshal(in []byte) error {
+	msg := new(email.EraseUserDataReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var EraseUserDataArgs_Req_DEFAULT *email.EraseUserDataReq
+
+func (p *EraseUserDataArgs) GetReq() *email.EraseUserDataReq {
+	if !p.IsSetReq() {
+		return EraseUserDataArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *EraseUserDataArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *EraseUserDataArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type EraseUserDataResult struct {
+	Success *email.EraseUserDataResp
+}
+
+var EraseUserDataResult_Success_DEFAULT *email.EraseUserDataResp
+
+func (p *EraseUserDataResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(email.EraseUserDataResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *EraseUserDataResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *EraseUserDataResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *EraseUserDataResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *EraseUserDataResult) Unmarshal(in []byte) error {
+	msg := new(email.EraseUserDataResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *EraseUserDataResult) GetSuccess() *email.EraseUserDataResp {
+	if !p.IsSetSuccess() {
+		return EraseUserDataResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *EraseUserDataResult) SetSuccess(x interface{}) {
+	p.Success = x.(*email.EraseUserDataResp)
+}
+
+func (p *EraseUserDataResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *EraseUserDataResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listDeadLettersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(email.ListDeadLettersReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(email.EmailService).ListDeadLetters(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ListDeadLettersArgs:
+		success, err := handler.(email.EmailService).ListDeadLetters(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListDeadLettersResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newListDeadLettersArgs() interface{} {
+	return &ListDeadLettersArgs{}
+}
+
+func newListDeadLettersResult() interface{} {
+	return &ListDeadLettersResult{}
+}
+
+type ListDeadLettersArgs struct {
+	Req *email.ListDeadLettersReq
+}
+
+func (p *ListDeadLettersArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(email.ListDeadLettersReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListDeadLettersArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListDeadLettersArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListDeadLettersArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListDeadLettersArgs) Unmarshal(in []byte) error {
+	msg := new(email.ListDeadLettersReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListDeadLettersArgs_Req_DEFAULT *email.ListDeadLettersReq
+
+func (p *ListDeadLettersArgs) GetReq() *email.ListDeadLettersReq {
+	if !p.IsSetReq() {
+		return ListDeadLettersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListDeadLettersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListDeadLettersArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListDeadLettersResult struct {
+	Success *email.ListDeadLettersResp
+}
+
+var ListDeadLettersResult_Success_DEFAULT *email.ListDeadLettersResp
+
+func (p *ListDeadLettersResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(email.ListDeadLettersResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListDeadLettersResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListDeadLettersResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListDeadLettersResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListDeadLettersResult) Unmarshal(in []byte) error {
+	msg := new(email.ListDeadLettersResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListDeadLettersResult) GetSuccess() *email.ListDeadLettersResp {
+	if !p.IsSetSuccess() {
+		return ListDeadLettersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListDeadLettersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*email.ListDeadLettersResp)
+}
+
+func (p *ListDeadLettersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListDeadLettersResult) GetResult() interface{} {
+	return p.Success
+}
+
+func replayDeadLettersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(email.ReplayDeadLettersReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(email.EmailService).ReplayDeadLetters(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ReplayDeadLettersArgs:
+		success, err := handler.(email.EmailService).ReplayDeadLetters(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ReplayDeadLettersResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newReplayDeadLettersArgs() interface{} {
+	return &ReplayDeadLettersArgs{}
+}
+
+func newReplayDeadLettersResult() interface{} {
+	return &ReplayDeadLettersResult{}
+}
+
+type ReplayDeadLettersArgs struct {
+	Req *email.ReplayDeadLettersReq
+}
+
+func (p *ReplayDeadLettersArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(email.ReplayDeadLettersReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ReplayDeadLettersArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ReplayDeadLettersArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ReplayDeadLettersArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ReplayDeadLettersArgs) Unmarshal(in []byte) error {
+	msg := new(email.ReplayDeadLettersReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ReplayDeadLettersArgs_Req_DEFAULT *email.ReplayDeadLettersReq
+
+func (p *ReplayDeadLettersArgs) GetReq() *email.ReplayDeadLettersReq {
+	if !p.IsSetReq() {
+		return ReplayDeadLettersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ReplayDeadLettersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReplayDeadLettersArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ReplayDeadLettersResult struct {
+	Success *email.ReplayDeadLettersResp
+}
+
+var ReplayDeadLettersResult_Success_DEFAULT *email.ReplayDeadLettersResp
+
+func (p *ReplayDeadLettersResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(email.ReplayDeadLettersResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ReplayDeadLettersResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ReplayDeadLettersResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ReplayDeadLettersResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ReplayDeadLettersResult) Unmarshal(in []byte) error {
+	msg := new(email.ReplayDeadLettersResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ReplayDeadLettersResult) GetSuccess() *email.ReplayDeadLettersResp {
+	if !p.IsSetSuccess() {
+		return ReplayDeadLettersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ReplayDeadLettersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*email.ReplayDeadLettersResp)
+}
+
+func (p *ReplayDeadLettersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReplayDeadLettersResult) GetResult() interface{} {
+	return p.Success
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -210,3 +826,43 @@ func (p *kClient) Send(ctx context.Context, Req *email.EmailReq) (r *email.Email
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) ExportUserData(ctx context.Context, Req *email.ExportUserDataReq) (r *email.ExportUserDataResp, err error) {
+	var _args ExportUserDataArgs
+	_args.Req = Req
+	var _result ExportUserDataResult
+	if err = p.c.Call(ctx, "ExportUserData", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EraseUserData(ctx context.Context, Req *email.EraseUserDataReq) (r *email.EraseUserDataResp, err error) {
+	var _args EraseUserDataArgs
+	_args.Req = Req
+	var _result EraseUserDataResult
+	if err = p.c.Call(ctx, "EraseUserData", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListDeadLetters(ctx context.Context, Req *email.ListDeadLettersReq) (r *email.ListDeadLettersResp, err error) {
+	var _args ListDeadLettersArgs
+	_args.Req = Req
+	var _result ListDeadLettersResult
+	if err = p.c.Call(ctx, "ListDeadLetters", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReplayDeadLetters(ctx context.Context, Req *email.ReplayDeadLettersReq) (r *email.ReplayDeadLettersResp, err error) {
+	var _args ReplayDeadLettersArgs
+	_args.Req = Req
+	var _result ReplayDeadLettersResult
+	if err = p.c.Call(ctx, "ReplayDeadLetters", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -0,0 +1,1258 @@
+// Code generated by Fastpb v0.0.2. DO NOT EDIT.
+
+package events
+
+import (
+	fmt "fmt"
+	fastpb "github.com/cloudwego/fastpb"
+)
+
+var (
+	_ = fmt.Errorf
+	_ = fastpb.Skip
+)
+
+func (x *OrderItem) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_OrderItem[number], err)
+}
+
+func (x *OrderItem) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.ProductName, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Quantity, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Cost, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPlaced) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_OrderPlaced[number], err)
+}
+
+func (x *OrderPlaced) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPlaced) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPlaced) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Email, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPlaced) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Currency, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPlaced) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	var v OrderItem
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Items = append(x.Items, &v)
+	return offset, nil
+}
+
+func (x *OrderPlaced) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.ShippingCost, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPlaced) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Tax, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPlaced) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.Total, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPlaced) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.PlacedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPaid) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_OrderPaid[number], err)
+}
+
+func (x *OrderPaid) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPaid) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPaid) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Email, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPaid) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Currency, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPaid) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Total, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPaid) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPaid) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.PaidAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentCharged) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_PaymentCharged[number], err)
+}
+
+func (x *PaymentCharged) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentCharged) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentCharged) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentCharged) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Amount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentCharged) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.ChargedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentRefunded) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_PaymentRefunded[number], err)
+}
+
+func (x *PaymentRefunded) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.RefundId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentRefunded) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentRefunded) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentRefunded) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentRefunded) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Amount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentRefunded) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.RefundedTotal, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentRefunded) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentRefunded) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.RefundedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UserRegistered) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UserRegistered[number], err)
+}
+
+func (x *UserRegistered) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *UserRegistered) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Email, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UserRegistered) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.RegisteredAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *OrderItem) fastWriteField1(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetProductId())
+	return offset
+}
+
+func (x *OrderItem) fastWriteField2(buf []byte) (offset int) {
+	if x.ProductName == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetProductName())
+	return offset
+}
+
+func (x *OrderItem) fastWriteField3(buf []byte) (offset int) {
+	if x.Quantity == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, x.GetQuantity())
+	return offset
+}
+
+func (x *OrderItem) fastWriteField4(buf []byte) (offset int) {
+	if x.Cost == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 4, x.GetCost())
+	return offset
+}
+
+func (x *OrderPlaced) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	return offset
+}
+
+func (x *OrderPlaced) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *OrderPlaced) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *OrderPlaced) fastWriteField3(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetEmail())
+	return offset
+}
+
+func (x *OrderPlaced) fastWriteField4(buf []byte) (offset int) {
+	if x.Currency == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetCurrency())
+	return offset
+}
+
+func (x *OrderPlaced) fastWriteField5(buf []byte) (offset int) {
+	if x.Items == nil {
+		return offset
+	}
+	for i := range x.GetItems() {
+		offset += fastpb.WriteMessage(buf[offset:], 5, x.GetItems()[i])
+	}
+	return offset
+}
+
+func (x *OrderPlaced) fastWriteField6(buf []byte) (offset int) {
+	if x.ShippingCost == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 6, x.GetShippingCost())
+	return offset
+}
+
+func (x *OrderPlaced) fastWriteField7(buf []byte) (offset int) {
+	if x.Tax == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 7, x.GetTax())
+	return offset
+}
+
+func (x *OrderPlaced) fastWriteField8(buf []byte) (offset int) {
+	if x.Total == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 8, x.GetTotal())
+	return offset
+}
+
+func (x *OrderPlaced) fastWriteField9(buf []byte) (offset int) {
+	if x.PlacedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 9, x.GetPlacedAt())
+	return offset
+}
+
+func (x *OrderPaid) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	return offset
+}
+
+func (x *OrderPaid) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *OrderPaid) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *OrderPaid) fastWriteField3(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetEmail())
+	return offset
+}
+
+func (x *OrderPaid) fastWriteField4(buf []byte) (offset int) {
+	if x.Currency == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetCurrency())
+	return offset
+}
+
+func (x *OrderPaid) fastWriteField5(buf []byte) (offset int) {
+	if x.Total == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 5, x.GetTotal())
+	return offset
+}
+
+func (x *OrderPaid) fastWriteField6(buf []byte) (offset int) {
+	if x.TransactionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetTransactionId())
+	return offset
+}
+
+func (x *OrderPaid) fastWriteField7(buf []byte) (offset int) {
+	if x.PaidAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 7, x.GetPaidAt())
+	return offset
+}
+
+func (x *PaymentCharged) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *PaymentCharged) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *PaymentCharged) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	return offset
+}
+
+func (x *PaymentCharged) fastWriteField3(buf []byte) (offset int) {
+	if x.TransactionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetTransactionId())
+	return offset
+}
+
+func (x *PaymentCharged) fastWriteField4(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 4, x.GetAmount())
+	return offset
+}
+
+func (x *PaymentCharged) fastWriteField5(buf []byte) (offset int) {
+	if x.ChargedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 5, x.GetChargedAt())
+	return offset
+}
+
+func (x *PaymentRefunded) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	return offset
+}
+
+func (x *PaymentRefunded) fastWriteField1(buf []byte) (offset int) {
+	if x.RefundId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetRefundId())
+	return offset
+}
+
+func (x *PaymentRefunded) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *PaymentRefunded) fastWriteField3(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetOrderId())
+	return offset
+}
+
+func (x *PaymentRefunded) fastWriteField4(buf []byte) (offset int) {
+	if x.TransactionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetTransactionId())
+	return offset
+}
+
+func (x *PaymentRefunded) fastWriteField5(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 5, x.GetAmount())
+	return offset
+}
+
+func (x *PaymentRefunded) fastWriteField6(buf []byte) (offset int) {
+	if x.RefundedTotal == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 6, x.GetRefundedTotal())
+	return offset
+}
+
+func (x *PaymentRefunded) fastWriteField7(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 7, x.GetReason())
+	return offset
+}
+
+func (x *PaymentRefunded) fastWriteField8(buf []byte) (offset int) {
+	if x.RefundedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 8, x.GetRefundedAt())
+	return offset
+}
+
+func (x *UserRegistered) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *UserRegistered) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *UserRegistered) fastWriteField2(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetEmail())
+	return offset
+}
+
+func (x *UserRegistered) fastWriteField3(buf []byte) (offset int) {
+	if x.RegisteredAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetRegisteredAt())
+	return offset
+}
+
+func (x *OrderItem) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *OrderItem) sizeField1() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetProductId())
+	return n
+}
+
+func (x *OrderItem) sizeField2() (n int) {
+	if x.ProductName == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetProductName())
+	return n
+}
+
+func (x *OrderItem) sizeField3() (n int) {
+	if x.Quantity == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, x.GetQuantity())
+	return n
+}
+
+func (x *OrderItem) sizeField4() (n int) {
+	if x.Cost == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(4, x.GetCost())
+	return n
+}
+
+func (x *OrderPlaced) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	return n
+}
+
+func (x *OrderPlaced) sizeField1() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetOrderId())
+	return n
+}
+
+func (x *OrderPlaced) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(2, x.GetUserId())
+	return n
+}
+
+func (x *OrderPlaced) sizeField3() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetEmail())
+	return n
+}
+
+func (x *OrderPlaced) sizeField4() (n int) {
+	if x.Currency == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetCurrency())
+	return n
+}
+
+func (x *OrderPlaced) sizeField5() (n int) {
+	if x.Items == nil {
+		return n
+	}
+	for i := range x.GetItems() {
+		n += fastpb.SizeMessage(5, x.GetItems()[i])
+	}
+	return n
+}
+
+func (x *OrderPlaced) sizeField6() (n int) {
+	if x.ShippingCost == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(6, x.GetShippingCost())
+	return n
+}
+
+func (x *OrderPlaced) sizeField7() (n int) {
+	if x.Tax == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(7, x.GetTax())
+	return n
+}
+
+func (x *OrderPlaced) sizeField8() (n int) {
+	if x.Total == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(8, x.GetTotal())
+	return n
+}
+
+func (x *OrderPlaced) sizeField9() (n int) {
+	if x.PlacedAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(9, x.GetPlacedAt())
+	return n
+}
+
+func (x *OrderPaid) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	return n
+}
+
+func (x *OrderPaid) sizeField1() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetOrderId())
+	return n
+}
+
+func (x *OrderPaid) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(2, x.GetUserId())
+	return n
+}
+
+func (x *OrderPaid) sizeField3() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetEmail())
+	return n
+}
+
+func (x *OrderPaid) sizeField4() (n int) {
+	if x.Currency == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetCurrency())
+	return n
+}
+
+func (x *OrderPaid) sizeField5() (n int) {
+	if x.Total == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(5, x.GetTotal())
+	return n
+}
+
+func (x *OrderPaid) sizeField6() (n int) {
+	if x.TransactionId == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetTransactionId())
+	return n
+}
+
+func (x *OrderPaid) sizeField7() (n int) {
+	if x.PaidAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(7, x.GetPaidAt())
+	return n
+}
+
+func (x *PaymentCharged) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *PaymentCharged) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *PaymentCharged) sizeField2() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetOrderId())
+	return n
+}
+
+func (x *PaymentCharged) sizeField3() (n int) {
+	if x.TransactionId == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetTransactionId())
+	return n
+}
+
+func (x *PaymentCharged) sizeField4() (n int) {
+	if x.Amount == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(4, x.GetAmount())
+	return n
+}
+
+func (x *PaymentCharged) sizeField5() (n int) {
+	if x.ChargedAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(5, x.GetChargedAt())
+	return n
+}
+
+func (x *PaymentRefunded) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	return n
+}
+
+func (x *PaymentRefunded) sizeField1() (n int) {
+	if x.RefundId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetRefundId())
+	return n
+}
+
+func (x *PaymentRefunded) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(2, x.GetUserId())
+	return n
+}
+
+func (x *PaymentRefunded) sizeField3() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetOrderId())
+	return n
+}
+
+func (x *PaymentRefunded) sizeField4() (n int) {
+	if x.TransactionId == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetTransactionId())
+	return n
+}
+
+func (x *PaymentRefunded) sizeField5() (n int) {
+	if x.Amount == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(5, x.GetAmount())
+	return n
+}
+
+func (x *PaymentRefunded) sizeField6() (n int) {
+	if x.RefundedTotal == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(6, x.GetRefundedTotal())
+	return n
+}
+
+func (x *PaymentRefunded) sizeField7() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(7, x.GetReason())
+	return n
+}
+
+func (x *PaymentRefunded) sizeField8() (n int) {
+	if x.RefundedAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(8, x.GetRefundedAt())
+	return n
+}
+
+func (x *UserRegistered) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *UserRegistered) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *UserRegistered) sizeField2() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetEmail())
+	return n
+}
+
+func (x *UserRegistered) sizeField3() (n int) {
+	if x.RegisteredAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetRegisteredAt())
+	return n
+}
+
+var fieldIDToName_OrderItem = map[int32]string{
+	1: "ProductId",
+	2: "ProductName",
+	3: "Quantity",
+	4: "Cost",
+}
+
+var fieldIDToName_OrderPlaced = map[int32]string{
+	1: "OrderId",
+	2: "UserId",
+	3: "Email",
+	4: "Currency",
+	5: "Items",
+	6: "ShippingCost",
+	7: "Tax",
+	8: "Total",
+	9: "PlacedAt",
+}
+
+var fieldIDToName_OrderPaid = map[int32]string{
+	1: "OrderId",
+	2: "UserId",
+	3: "Email",
+	4: "Currency",
+	5: "Total",
+	6: "TransactionId",
+	7: "PaidAt",
+}
+
+var fieldIDToName_PaymentCharged = map[int32]string{
+	1: "UserId",
+	2: "OrderId",
+	3: "TransactionId",
+	4: "Amount",
+	5: "ChargedAt",
+}
+
+var fieldIDToName_PaymentRefunded = map[int32]string{
+	1: "RefundId",
+	2: "UserId",
+	3: "OrderId",
+	4: "TransactionId",
+	5: "Amount",
+	6: "RefundedTotal",
+	7: "Reason",
+	8: "RefundedAt",
+}
+
+var fieldIDToName_UserRegistered = map[int32]string{
+	1: "UserId",
+	2: "Email",
+	3: "RegisteredAt",
+}
@@ -105,6 +105,31 @@ func (x *PlaceOrderReq) FastRead(buf []byte, _type int8, number int32) (offset i
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -153,6 +178,81 @@ func (x *PlaceOrderReq) fastReadField5(buf []byte, _type int8) (offset int, err
 	return offset, nil
 }
 
+func (x *PlaceOrderReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.ShippingMethod, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PlaceOrderReq) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.ShippingCost, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *PlaceOrderReq) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	var v TaxLine
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.TaxLines = append(x.TaxLines, &v)
+	return offset, nil
+}
+
+func (x *PlaceOrderReq) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.Tax, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *PlaceOrderReq) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.PricesIncludeTax, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *TaxLine) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_TaxLine[number], err)
+}
+
+func (x *TaxLine) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *TaxLine) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Rate, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *TaxLine) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Amount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
 func (x *OrderItem) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -165,6 +265,16 @@ func (x *OrderItem) FastRead(buf []byte, _type int8, number int32) (offset int,
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -193,6 +303,16 @@ func (x *OrderItem) fastReadField2(buf []byte, _type int8) (offset int, err erro
 	return offset, err
 }
 
+func (x *OrderItem) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.UnitPrice, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.ProductName, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
 func (x *OrderResult) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -255,6 +375,31 @@ func (x *ListOrderReq) FastRead(buf []byte, _type int8, number int32) (offset in
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -273,6 +418,31 @@ func (x *ListOrderReq) fastReadField1(buf []byte, _type int8) (offset int, err e
 	return offset, err
 }
 
+func (x *ListOrderReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Cursor, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListOrderReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.PageSize, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListOrderReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.State, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListOrderReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.StartTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ListOrderReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.EndTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
 func (x *Order) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -310,6 +480,51 @@ func (x *Order) FastRead(buf []byte, _type int8, number int32) (offset int, err
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 12:
+		offset, err = x.fastReadField12(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 13:
+		offset, err = x.fastReadField13(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 14:
+		offset, err = x.fastReadField14(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 15:
+		offset, err = x.fastReadField15(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 16:
+		offset, err = x.fastReadField16(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -368,13 +583,73 @@ func (x *Order) fastReadField7(buf []byte, _type int8) (offset int, err error) {
 	return offset, err
 }
 
-func (x *ListOrderResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *Order) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.ShippingMethod, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.ShippingCost, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	var v TaxLine
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.TaxLines = append(x.TaxLines, &v)
+	return offset, nil
+}
+
+func (x *Order) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.Tax, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField12(buf []byte, _type int8) (offset int, err error) {
+	x.PricesIncludeTax, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField13(buf []byte, _type int8) (offset int, err error) {
+	x.OrderState, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField14(buf []byte, _type int8) (offset int, err error) {
+	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField15(buf []byte, _type int8) (offset int, err error) {
+	var v OrderStateChange
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.StateHistory = append(x.StateHistory, &v)
+	return offset, nil
+}
+
+func (x *Order) fastReadField16(buf []byte, _type int8) (offset int, err error) {
+	x.InvoiceNumber, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderStateChange) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
 		offset, err = x.fastReadField1(buf, _type)
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -385,20 +660,20 @@ func (x *ListOrderResp) FastRead(buf []byte, _type int8, number int32) (offset i
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListOrderResp[number], err)
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_OrderStateChange[number], err)
 }
 
-func (x *ListOrderResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
-	var v Order
-	offset, err = fastpb.ReadMessage(buf, _type, &v)
-	if err != nil {
-		return offset, err
-	}
-	x.Orders = append(x.Orders, &v)
-	return offset, nil
+func (x *OrderStateChange) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.State, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *MarkOrderPaidReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *OrderStateChange) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.CreatedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *GetOrderReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
 		offset, err = x.fastReadField1(buf, _type)
@@ -420,21 +695,26 @@ func (x *MarkOrderPaidReq) FastRead(buf []byte, _type int8, number int32) (offse
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_MarkOrderPaidReq[number], err)
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetOrderReq[number], err)
 }
 
-func (x *MarkOrderPaidReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+func (x *GetOrderReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
 	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
 	return offset, err
 }
 
-func (x *MarkOrderPaidReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+func (x *GetOrderReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
 	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *MarkOrderPaidResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *GetOrderResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -444,310 +724,3183 @@ func (x *MarkOrderPaidResp) FastRead(buf []byte, _type int8, number int32) (offs
 	return offset, nil
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetOrderResp[number], err)
 }
 
-func (x *Address) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
-	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	offset += x.fastWriteField5(buf[offset:])
-	return offset
-}
-
-func (x *Address) fastWriteField1(buf []byte) (offset int) {
-	if x.StreetAddress == "" {
-		return offset
-	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetStreetAddress())
-	return offset
-}
-
-func (x *Address) fastWriteField2(buf []byte) (offset int) {
-	if x.City == "" {
-		return offset
+func (x *GetOrderResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Order
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetCity())
-	return offset
+	x.Order = &v
+	return offset, nil
+}
+
+func (x *ListOrderResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListOrderResp[number], err)
+}
+
+func (x *ListOrderResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Order
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Orders = append(x.Orders, &v)
+	return offset, nil
+}
+
+func (x *ListOrderResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.NextCursor, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListOrderResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Total, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *GetInvoiceReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetInvoiceReq[number], err)
+}
+
+func (x *GetInvoiceReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *GetInvoiceReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetInvoiceResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetInvoiceResp[number], err)
+}
+
+func (x *GetInvoiceResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.InvoiceNumber, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetInvoiceResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.IssuedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *GetInvoiceResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.FileName, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetInvoiceResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Content, offset, err = fastpb.ReadBytes(buf, _type)
+	return offset, err
+}
+
+func (x *MarkOrderPaidReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_MarkOrderPaidReq[number], err)
+}
+
+func (x *MarkOrderPaidReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *MarkOrderPaidReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *MarkOrderPaidReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *MarkOrderPaidResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+}
+
+func (x *ReturnItem) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ReturnItem[number], err)
+}
+
+func (x *ReturnItem) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *ReturnItem) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Quantity, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ReturnItem) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ReturnItem) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.ProductName, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ReturnItem) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.UnitPrice, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *ReturnAddress) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ReturnAddress[number], err)
+}
+
+func (x *ReturnAddress) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ReturnAddress) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StreetAddress, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ReturnAddress) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.City, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ReturnAddress) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.State, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ReturnAddress) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Country, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ReturnAddress) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.ZipCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Return) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 12:
+		offset, err = x.fastReadField12(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 13:
+		offset, err = x.fastReadField13(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Return[number], err)
+}
+
+func (x *Return) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ReturnId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Return) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Return) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *Return) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.State, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Return) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	var v ReturnItem
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Items = append(x.Items, &v)
+	return offset, nil
+}
+
+func (x *Return) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.Comment, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Return) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.AdminNote, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Return) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.Carrier, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Return) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.TrackingNumber, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Return) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	var v ReturnAddress
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.ShipTo = &v
+	return offset, nil
+}
+
+func (x *Return) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.RefundAmount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Return) fastReadField12(buf []byte, _type int8) (offset int, err error) {
+	x.RefundId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Return) fastReadField13(buf []byte, _type int8) (offset int, err error) {
+	x.CreatedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *CreateReturnReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateReturnReq[number], err)
+}
+
+func (x *CreateReturnReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *CreateReturnReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateReturnReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v ReturnItem
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Items = append(x.Items, &v)
+	return offset, nil
+}
+
+func (x *CreateReturnReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Comment, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateReturnResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateReturnResp[number], err)
+}
+
+func (x *CreateReturnResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Return
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Return = &v
+	return offset, nil
+}
+
+func (x *ListReturnsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListReturnsReq[number], err)
+}
+
+func (x *ListReturnsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *ListReturnsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListReturnsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListReturnsResp[number], err)
+}
+
+func (x *ListReturnsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Return
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Returns = append(x.Returns, &v)
+	return offset, nil
+}
+
+func (x *AdminListReturnsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_AdminListReturnsReq[number], err)
+}
+
+func (x *AdminListReturnsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.State, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *AdminListReturnsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Limit, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *AdminListReturnsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_AdminListReturnsResp[number], err)
+}
+
+func (x *AdminListReturnsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Return
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Returns = append(x.Returns, &v)
+	return offset, nil
+}
+
+func (x *ApproveReturnReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ApproveReturnReq[number], err)
+}
+
+func (x *ApproveReturnReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ReturnId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ApproveReturnReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Note, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ApproveReturnResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ApproveReturnResp[number], err)
+}
+
+func (x *ApproveReturnResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Return
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Return = &v
+	return offset, nil
+}
+
+func (x *RejectReturnReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RejectReturnReq[number], err)
+}
+
+func (x *RejectReturnReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ReturnId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RejectReturnReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Note, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RejectReturnResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RejectReturnResp[number], err)
+}
+
+func (x *RejectReturnResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Return
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Return = &v
+	return offset, nil
+}
+
+func (x *ConfirmReturnReceivedReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ConfirmReturnReceivedReq[number], err)
+}
+
+func (x *ConfirmReturnReceivedReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ReturnId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ConfirmReturnReceivedResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ConfirmReturnReceivedResp[number], err)
+}
+
+func (x *ConfirmReturnReceivedResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Return
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Return = &v
+	return offset, nil
+}
+
+func (x *ExportUserDataReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ExportUserDataReq[number], err)
+}
+
+func (x *ExportUserDataReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *ExportUserDataResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ExportUserDataResp[number], err)
+}
+
+func (x *ExportUserDataResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Data, offset, err = fastpb.ReadBytes(buf, _type)
+	return offset, err
+}
+
+func (x *EraseUserDataReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_EraseUserDataReq[number], err)
+}
+
+func (x *EraseUserDataReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *EraseUserDataResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+}
+
+func (x *Address) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *Address) fastWriteField1(buf []byte) (offset int) {
+	if x.StreetAddress == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetStreetAddress())
+	return offset
+}
+
+func (x *Address) fastWriteField2(buf []byte) (offset int) {
+	if x.City == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCity())
+	return offset
 }
 
 func (x *Address) fastWriteField3(buf []byte) (offset int) {
 	if x.State == "" {
-		return offset
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetState())
+	return offset
+}
+
+func (x *Address) fastWriteField4(buf []byte) (offset int) {
+	if x.Country == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetCountry())
+	return offset
+}
+
+func (x *Address) fastWriteField5(buf []byte) (offset int) {
+	if x.ZipCode == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 5, x.GetZipCode())
+	return offset
+}
+
+func (x *PlaceOrderReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField2(buf []byte) (offset int) {
+	if x.UserCurrency == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetUserCurrency())
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 3, x.GetAddress())
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetEmail())
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField5(buf []byte) (offset int) {
+	if x.OrderItems == nil {
+		return offset
+	}
+	for i := range x.GetOrderItems() {
+		offset += fastpb.WriteMessage(buf[offset:], 5, x.GetOrderItems()[i])
+	}
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField6(buf []byte) (offset int) {
+	if x.ShippingMethod == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetShippingMethod())
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField7(buf []byte) (offset int) {
+	if x.ShippingCost == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 7, x.GetShippingCost())
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField8(buf []byte) (offset int) {
+	if x.TaxLines == nil {
+		return offset
+	}
+	for i := range x.GetTaxLines() {
+		offset += fastpb.WriteMessage(buf[offset:], 8, x.GetTaxLines()[i])
+	}
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField9(buf []byte) (offset int) {
+	if x.Tax == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 9, x.GetTax())
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField10(buf []byte) (offset int) {
+	if !x.PricesIncludeTax {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 10, x.GetPricesIncludeTax())
+	return offset
+}
+
+func (x *TaxLine) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *TaxLine) fastWriteField1(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetName())
+	return offset
+}
+
+func (x *TaxLine) fastWriteField2(buf []byte) (offset int) {
+	if x.Rate == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 2, x.GetRate())
+	return offset
+}
+
+func (x *TaxLine) fastWriteField3(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 3, x.GetAmount())
+	return offset
+}
+
+func (x *OrderItem) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *OrderItem) fastWriteField1(buf []byte) (offset int) {
+	if x.Item == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetItem())
+	return offset
+}
+
+func (x *OrderItem) fastWriteField2(buf []byte) (offset int) {
+	if x.Cost == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 2, x.GetCost())
+	return offset
+}
+
+func (x *OrderItem) fastWriteField3(buf []byte) (offset int) {
+	if x.UnitPrice == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 3, x.GetUnitPrice())
+	return offset
+}
+
+func (x *OrderItem) fastWriteField4(buf []byte) (offset int) {
+	if x.ProductName == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetProductName())
+	return offset
+}
+
+func (x *OrderResult) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *OrderResult) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *PlaceOrderResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *PlaceOrderResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Order == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetOrder())
+	return offset
+}
+
+func (x *ListOrderReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	return offset
+}
+
+func (x *ListOrderReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *ListOrderReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Cursor == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCursor())
+	return offset
+}
+
+func (x *ListOrderReq) fastWriteField3(buf []byte) (offset int) {
+	if x.PageSize == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, x.GetPageSize())
+	return offset
+}
+
+func (x *ListOrderReq) fastWriteField4(buf []byte) (offset int) {
+	if x.State == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetState())
+	return offset
+}
+
+func (x *ListOrderReq) fastWriteField5(buf []byte) (offset int) {
+	if x.StartTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 5, x.GetStartTime())
+	return offset
+}
+
+func (x *ListOrderReq) fastWriteField6(buf []byte) (offset int) {
+	if x.EndTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 6, x.GetEndTime())
+	return offset
+}
+
+func (x *Order) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	offset += x.fastWriteField12(buf[offset:])
+	offset += x.fastWriteField13(buf[offset:])
+	offset += x.fastWriteField14(buf[offset:])
+	offset += x.fastWriteField15(buf[offset:])
+	offset += x.fastWriteField16(buf[offset:])
+	return offset
+}
+
+func (x *Order) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderItems == nil {
+		return offset
+	}
+	for i := range x.GetOrderItems() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetOrderItems()[i])
+	}
+	return offset
+}
+
+func (x *Order) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	return offset
+}
+
+func (x *Order) fastWriteField3(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 3, x.GetUserId())
+	return offset
+}
+
+func (x *Order) fastWriteField4(buf []byte) (offset int) {
+	if x.UserCurrency == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetUserCurrency())
+	return offset
+}
+
+func (x *Order) fastWriteField5(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 5, x.GetAddress())
+	return offset
+}
+
+func (x *Order) fastWriteField6(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetEmail())
+	return offset
+}
+
+func (x *Order) fastWriteField7(buf []byte) (offset int) {
+	if x.CreatedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 7, x.GetCreatedAt())
+	return offset
+}
+
+func (x *Order) fastWriteField8(buf []byte) (offset int) {
+	if x.ShippingMethod == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetShippingMethod())
+	return offset
+}
+
+func (x *Order) fastWriteField9(buf []byte) (offset int) {
+	if x.ShippingCost == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 9, x.GetShippingCost())
+	return offset
+}
+
+func (x *Order) fastWriteField10(buf []byte) (offset int) {
+	if x.TaxLines == nil {
+		return offset
+	}
+	for i := range x.GetTaxLines() {
+		offset += fastpb.WriteMessage(buf[offset:], 10, x.GetTaxLines()[i])
+	}
+	return offset
+}
+
+func (x *Order) fastWriteField11(buf []byte) (offset int) {
+	if x.Tax == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 11, x.GetTax())
+	return offset
+}
+
+func (x *Order) fastWriteField12(buf []byte) (offset int) {
+	if !x.PricesIncludeTax {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 12, x.GetPricesIncludeTax())
+	return offset
+}
+
+func (x *Order) fastWriteField13(buf []byte) (offset int) {
+	if x.OrderState == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 13, x.GetOrderState())
+	return offset
+}
+
+func (x *Order) fastWriteField14(buf []byte) (offset int) {
+	if x.TransactionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 14, x.GetTransactionId())
+	return offset
+}
+
+func (x *Order) fastWriteField15(buf []byte) (offset int) {
+	if x.StateHistory == nil {
+		return offset
+	}
+	for i := range x.GetStateHistory() {
+		offset += fastpb.WriteMessage(buf[offset:], 15, x.GetStateHistory()[i])
+	}
+	return offset
+}
+
+func (x *Order) fastWriteField16(buf []byte) (offset int) {
+	if x.InvoiceNumber == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 16, x.GetInvoiceNumber())
+	return offset
+}
+
+func (x *OrderStateChange) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *OrderStateChange) fastWriteField1(buf []byte) (offset int) {
+	if x.State == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetState())
+	return offset
+}
+
+func (x *OrderStateChange) fastWriteField2(buf []byte) (offset int) {
+	if x.CreatedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetCreatedAt())
+	return offset
+}
+
+func (x *GetOrderReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *GetOrderReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *GetOrderReq) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	return offset
+}
+
+func (x *GetOrderResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *GetOrderResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Order == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetOrder())
+	return offset
+}
+
+func (x *ListOrderResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *ListOrderResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Orders == nil {
+		return offset
+	}
+	for i := range x.GetOrders() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetOrders()[i])
+	}
+	return offset
+}
+
+func (x *ListOrderResp) fastWriteField2(buf []byte) (offset int) {
+	if x.NextCursor == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetNextCursor())
+	return offset
+}
+
+func (x *ListOrderResp) fastWriteField3(buf []byte) (offset int) {
+	if x.Total == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetTotal())
+	return offset
+}
+
+func (x *GetInvoiceReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *GetInvoiceReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *GetInvoiceReq) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	return offset
+}
+
+func (x *GetInvoiceResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *GetInvoiceResp) fastWriteField1(buf []byte) (offset int) {
+	if x.InvoiceNumber == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetInvoiceNumber())
+	return offset
+}
+
+func (x *GetInvoiceResp) fastWriteField2(buf []byte) (offset int) {
+	if x.IssuedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetIssuedAt())
+	return offset
+}
+
+func (x *GetInvoiceResp) fastWriteField3(buf []byte) (offset int) {
+	if x.FileName == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetFileName())
+	return offset
+}
+
+func (x *GetInvoiceResp) fastWriteField4(buf []byte) (offset int) {
+	if len(x.Content) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteBytes(buf[offset:], 4, x.GetContent())
+	return offset
+}
+
+func (x *MarkOrderPaidReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *MarkOrderPaidReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *MarkOrderPaidReq) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	return offset
+}
+
+func (x *MarkOrderPaidReq) fastWriteField3(buf []byte) (offset int) {
+	if x.TransactionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetTransactionId())
+	return offset
+}
+
+func (x *MarkOrderPaidResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	return offset
+}
+
+func (x *ReturnItem) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *ReturnItem) fastWriteField1(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetProductId())
+	return offset
+}
+
+func (x *ReturnItem) fastWriteField2(buf []byte) (offset int) {
+	if x.Quantity == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetQuantity())
+	return offset
+}
+
+func (x *ReturnItem) fastWriteField3(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetReason())
+	return offset
+}
+
+func (x *ReturnItem) fastWriteField4(buf []byte) (offset int) {
+	if x.ProductName == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetProductName())
+	return offset
+}
+
+func (x *ReturnItem) fastWriteField5(buf []byte) (offset int) {
+	if x.UnitPrice == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 5, x.GetUnitPrice())
+	return offset
+}
+
+func (x *ReturnAddress) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	return offset
+}
+
+func (x *ReturnAddress) fastWriteField1(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetName())
+	return offset
+}
+
+func (x *ReturnAddress) fastWriteField2(buf []byte) (offset int) {
+	if x.StreetAddress == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetStreetAddress())
+	return offset
+}
+
+func (x *ReturnAddress) fastWriteField3(buf []byte) (offset int) {
+	if x.City == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetCity())
+	return offset
+}
+
+func (x *ReturnAddress) fastWriteField4(buf []byte) (offset int) {
+	if x.State == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetState())
+	return offset
+}
+
+func (x *ReturnAddress) fastWriteField5(buf []byte) (offset int) {
+	if x.Country == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetCountry())
+	return offset
+}
+
+func (x *ReturnAddress) fastWriteField6(buf []byte) (offset int) {
+	if x.ZipCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetZipCode())
+	return offset
+}
+
+func (x *Return) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	offset += x.fastWriteField12(buf[offset:])
+	offset += x.fastWriteField13(buf[offset:])
+	return offset
+}
+
+func (x *Return) fastWriteField1(buf []byte) (offset int) {
+	if x.ReturnId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetReturnId())
+	return offset
+}
+
+func (x *Return) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	return offset
+}
+
+func (x *Return) fastWriteField3(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 3, x.GetUserId())
+	return offset
+}
+
+func (x *Return) fastWriteField4(buf []byte) (offset int) {
+	if x.State == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetState())
+	return offset
+}
+
+func (x *Return) fastWriteField5(buf []byte) (offset int) {
+	if x.Items == nil {
+		return offset
+	}
+	for i := range x.GetItems() {
+		offset += fastpb.WriteMessage(buf[offset:], 5, x.GetItems()[i])
+	}
+	return offset
+}
+
+func (x *Return) fastWriteField6(buf []byte) (offset int) {
+	if x.Comment == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetComment())
+	return offset
+}
+
+func (x *Return) fastWriteField7(buf []byte) (offset int) {
+	if x.AdminNote == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 7, x.GetAdminNote())
+	return offset
+}
+
+func (x *Return) fastWriteField8(buf []byte) (offset int) {
+	if x.Carrier == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetCarrier())
+	return offset
+}
+
+func (x *Return) fastWriteField9(buf []byte) (offset int) {
+	if x.TrackingNumber == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 9, x.GetTrackingNumber())
+	return offset
+}
+
+func (x *Return) fastWriteField10(buf []byte) (offset int) {
+	if x.ShipTo == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 10, x.GetShipTo())
+	return offset
+}
+
+func (x *Return) fastWriteField11(buf []byte) (offset int) {
+	if x.RefundAmount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 11, x.GetRefundAmount())
+	return offset
+}
+
+func (x *Return) fastWriteField12(buf []byte) (offset int) {
+	if x.RefundId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 12, x.GetRefundId())
+	return offset
+}
+
+func (x *Return) fastWriteField13(buf []byte) (offset int) {
+	if x.CreatedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 13, x.GetCreatedAt())
+	return offset
+}
+
+func (x *CreateReturnReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *CreateReturnReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *CreateReturnReq) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	return offset
+}
+
+func (x *CreateReturnReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Items == nil {
+		return offset
+	}
+	for i := range x.GetItems() {
+		offset += fastpb.WriteMessage(buf[offset:], 3, x.GetItems()[i])
+	}
+	return offset
+}
+
+func (x *CreateReturnReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Comment == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetComment())
+	return offset
+}
+
+func (x *CreateReturnResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *CreateReturnResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Return == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetReturn())
+	return offset
+}
+
+func (x *ListReturnsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ListReturnsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *ListReturnsReq) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	return offset
+}
+
+func (x *ListReturnsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListReturnsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Returns == nil {
+		return offset
+	}
+	for i := range x.GetReturns() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetReturns()[i])
+	}
+	return offset
+}
+
+func (x *AdminListReturnsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *AdminListReturnsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.State == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetState())
+	return offset
+}
+
+func (x *AdminListReturnsReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Limit == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetLimit())
+	return offset
+}
+
+func (x *AdminListReturnsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *AdminListReturnsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Returns == nil {
+		return offset
+	}
+	for i := range x.GetReturns() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetReturns()[i])
+	}
+	return offset
+}
+
+func (x *ApproveReturnReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ApproveReturnReq) fastWriteField1(buf []byte) (offset int) {
+	if x.ReturnId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetReturnId())
+	return offset
+}
+
+func (x *ApproveReturnReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Note == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetNote())
+	return offset
+}
+
+func (x *ApproveReturnResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ApproveReturnResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Return == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetReturn())
+	return offset
+}
+
+func (x *RejectReturnReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RejectReturnReq) fastWriteField1(buf []byte) (offset int) {
+	if x.ReturnId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetReturnId())
+	return offset
+}
+
+func (x *RejectReturnReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Note == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetNote())
+	return offset
+}
+
+func (x *RejectReturnResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RejectReturnResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Return == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetReturn())
+	return offset
+}
+
+func (x *ConfirmReturnReceivedReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ConfirmReturnReceivedReq) fastWriteField1(buf []byte) (offset int) {
+	if x.ReturnId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetReturnId())
+	return offset
+}
+
+func (x *ConfirmReturnReceivedResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ConfirmReturnReceivedResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Return == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetReturn())
+	return offset
+}
+
+func (x *ExportUserDataReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ExportUserDataReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *ExportUserDataResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ExportUserDataResp) fastWriteField1(buf []byte) (offset int) {
+	if len(x.Data) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteBytes(buf[offset:], 1, x.GetData())
+	return offset
+}
+
+func (x *EraseUserDataReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *EraseUserDataReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *EraseUserDataResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	return offset
+}
+
+func (x *Address) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *Address) sizeField1() (n int) {
+	if x.StreetAddress == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetStreetAddress())
+	return n
+}
+
+func (x *Address) sizeField2() (n int) {
+	if x.City == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCity())
+	return n
+}
+
+func (x *Address) sizeField3() (n int) {
+	if x.State == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetState())
+	return n
+}
+
+func (x *Address) sizeField4() (n int) {
+	if x.Country == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetCountry())
+	return n
+}
+
+func (x *Address) sizeField5() (n int) {
+	if x.ZipCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(5, x.GetZipCode())
+	return n
+}
+
+func (x *PlaceOrderReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField2() (n int) {
+	if x.UserCurrency == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetUserCurrency())
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField3() (n int) {
+	if x.Address == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(3, x.GetAddress())
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField4() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetEmail())
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField5() (n int) {
+	if x.OrderItems == nil {
+		return n
+	}
+	for i := range x.GetOrderItems() {
+		n += fastpb.SizeMessage(5, x.GetOrderItems()[i])
+	}
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField6() (n int) {
+	if x.ShippingMethod == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetShippingMethod())
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField7() (n int) {
+	if x.ShippingCost == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(7, x.GetShippingCost())
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField8() (n int) {
+	if x.TaxLines == nil {
+		return n
+	}
+	for i := range x.GetTaxLines() {
+		n += fastpb.SizeMessage(8, x.GetTaxLines()[i])
+	}
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField9() (n int) {
+	if x.Tax == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(9, x.GetTax())
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField10() (n int) {
+	if !x.PricesIncludeTax {
+		return n
+	}
+	n += fastpb.SizeBool(10, x.GetPricesIncludeTax())
+	return n
+}
+
+func (x *TaxLine) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *TaxLine) sizeField1() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetName())
+	return n
+}
+
+func (x *TaxLine) sizeField2() (n int) {
+	if x.Rate == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(2, x.GetRate())
+	return n
+}
+
+func (x *TaxLine) sizeField3() (n int) {
+	if x.Amount == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(3, x.GetAmount())
+	return n
+}
+
+func (x *OrderItem) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *OrderItem) sizeField1() (n int) {
+	if x.Item == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetItem())
+	return n
+}
+
+func (x *OrderItem) sizeField2() (n int) {
+	if x.Cost == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(2, x.GetCost())
+	return n
+}
+
+func (x *OrderItem) sizeField3() (n int) {
+	if x.UnitPrice == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(3, x.GetUnitPrice())
+	return n
+}
+
+func (x *OrderItem) sizeField4() (n int) {
+	if x.ProductName == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetProductName())
+	return n
+}
+
+func (x *OrderResult) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *OrderResult) sizeField1() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetOrderId())
+	return n
+}
+
+func (x *PlaceOrderResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *PlaceOrderResp) sizeField1() (n int) {
+	if x.Order == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetOrder())
+	return n
+}
+
+func (x *ListOrderReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	return n
+}
+
+func (x *ListOrderReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *ListOrderReq) sizeField2() (n int) {
+	if x.Cursor == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCursor())
+	return n
+}
+
+func (x *ListOrderReq) sizeField3() (n int) {
+	if x.PageSize == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, x.GetPageSize())
+	return n
+}
+
+func (x *ListOrderReq) sizeField4() (n int) {
+	if x.State == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetState())
+	return n
+}
+
+func (x *ListOrderReq) sizeField5() (n int) {
+	if x.StartTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(5, x.GetStartTime())
+	return n
+}
+
+func (x *ListOrderReq) sizeField6() (n int) {
+	if x.EndTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(6, x.GetEndTime())
+	return n
+}
+
+func (x *Order) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	n += x.sizeField12()
+	n += x.sizeField13()
+	n += x.sizeField14()
+	n += x.sizeField15()
+	n += x.sizeField16()
+	return n
+}
+
+func (x *Order) sizeField1() (n int) {
+	if x.OrderItems == nil {
+		return n
+	}
+	for i := range x.GetOrderItems() {
+		n += fastpb.SizeMessage(1, x.GetOrderItems()[i])
+	}
+	return n
+}
+
+func (x *Order) sizeField2() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetOrderId())
+	return n
+}
+
+func (x *Order) sizeField3() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(3, x.GetUserId())
+	return n
+}
+
+func (x *Order) sizeField4() (n int) {
+	if x.UserCurrency == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetUserCurrency())
+	return n
+}
+
+func (x *Order) sizeField5() (n int) {
+	if x.Address == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(5, x.GetAddress())
+	return n
+}
+
+func (x *Order) sizeField6() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetEmail())
+	return n
+}
+
+func (x *Order) sizeField7() (n int) {
+	if x.CreatedAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(7, x.GetCreatedAt())
+	return n
+}
+
+func (x *Order) sizeField8() (n int) {
+	if x.ShippingMethod == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetShippingMethod())
+	return n
+}
+
+func (x *Order) sizeField9() (n int) {
+	if x.ShippingCost == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(9, x.GetShippingCost())
+	return n
+}
+
+func (x *Order) sizeField10() (n int) {
+	if x.TaxLines == nil {
+		return n
+	}
+	for i := range x.GetTaxLines() {
+		n += fastpb.SizeMessage(10, x.GetTaxLines()[i])
+	}
+	return n
+}
+
+func (x *Order) sizeField11() (n int) {
+	if x.Tax == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(11, x.GetTax())
+	return n
+}
+
+func (x *Order) sizeField12() (n int) {
+	if !x.PricesIncludeTax {
+		return n
+	}
+	n += fastpb.SizeBool(12, x.GetPricesIncludeTax())
+	return n
+}
+
+func (x *Order) sizeField13() (n int) {
+	if x.OrderState == "" {
+		return n
+	}
+	n += fastpb.SizeString(13, x.GetOrderState())
+	return n
+}
+
+func (x *Order) sizeField14() (n int) {
+	if x.TransactionId == "" {
+		return n
+	}
+	n += fastpb.SizeString(14, x.GetTransactionId())
+	return n
+}
+
+func (x *Order) sizeField15() (n int) {
+	if x.StateHistory == nil {
+		return n
+	}
+	for i := range x.GetStateHistory() {
+		n += fastpb.SizeMessage(15, x.GetStateHistory()[i])
+	}
+	return n
+}
+
+func (x *Order) sizeField16() (n int) {
+	if x.InvoiceNumber == "" {
+		return n
+	}
+	n += fastpb.SizeString(16, x.GetInvoiceNumber())
+	return n
+}
+
+func (x *OrderStateChange) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *OrderStateChange) sizeField1() (n int) {
+	if x.State == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetState())
+	return n
+}
+
+func (x *OrderStateChange) sizeField2() (n int) {
+	if x.CreatedAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetCreatedAt())
+	return n
+}
+
+func (x *GetOrderReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *GetOrderReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *GetOrderReq) sizeField2() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetOrderId())
+	return n
+}
+
+func (x *GetOrderResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *GetOrderResp) sizeField1() (n int) {
+	if x.Order == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetOrder())
+	return n
+}
+
+func (x *ListOrderResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *ListOrderResp) sizeField1() (n int) {
+	if x.Orders == nil {
+		return n
+	}
+	for i := range x.GetOrders() {
+		n += fastpb.SizeMessage(1, x.GetOrders()[i])
+	}
+	return n
+}
+
+func (x *ListOrderResp) sizeField2() (n int) {
+	if x.NextCursor == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetNextCursor())
+	return n
+}
+
+func (x *ListOrderResp) sizeField3() (n int) {
+	if x.Total == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetTotal())
+	return n
+}
+
+func (x *GetInvoiceReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *GetInvoiceReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *GetInvoiceReq) sizeField2() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetOrderId())
+	return n
+}
+
+func (x *GetInvoiceResp) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.GetState())
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
 }
 
-func (x *Address) fastWriteField4(buf []byte) (offset int) {
-	if x.Country == "" {
-		return offset
+func (x *GetInvoiceResp) sizeField1() (n int) {
+	if x.InvoiceNumber == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.GetCountry())
-	return offset
+	n += fastpb.SizeString(1, x.GetInvoiceNumber())
+	return n
 }
 
-func (x *Address) fastWriteField5(buf []byte) (offset int) {
-	if x.ZipCode == 0 {
-		return offset
+func (x *GetInvoiceResp) sizeField2() (n int) {
+	if x.IssuedAt == 0 {
+		return n
 	}
-	offset += fastpb.WriteInt32(buf[offset:], 5, x.GetZipCode())
-	return offset
+	n += fastpb.SizeInt64(2, x.GetIssuedAt())
+	return n
 }
 
-func (x *PlaceOrderReq) FastWrite(buf []byte) (offset int) {
+func (x *GetInvoiceResp) sizeField3() (n int) {
+	if x.FileName == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetFileName())
+	return n
+}
+
+func (x *GetInvoiceResp) sizeField4() (n int) {
+	if len(x.Content) == 0 {
+		return n
+	}
+	n += fastpb.SizeBytes(4, x.GetContent())
+	return n
+}
+
+func (x *MarkOrderPaidReq) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	offset += x.fastWriteField5(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
 }
 
-func (x *PlaceOrderReq) fastWriteField1(buf []byte) (offset int) {
+func (x *MarkOrderPaidReq) sizeField1() (n int) {
 	if x.UserId == 0 {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
-	return offset
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
 }
 
-func (x *PlaceOrderReq) fastWriteField2(buf []byte) (offset int) {
-	if x.UserCurrency == "" {
-		return offset
+func (x *MarkOrderPaidReq) sizeField2() (n int) {
+	if x.OrderId == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetUserCurrency())
-	return offset
+	n += fastpb.SizeString(2, x.GetOrderId())
+	return n
 }
 
-func (x *PlaceOrderReq) fastWriteField3(buf []byte) (offset int) {
-	if x.Address == nil {
-		return offset
+func (x *MarkOrderPaidReq) sizeField3() (n int) {
+	if x.TransactionId == "" {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 3, x.GetAddress())
-	return offset
+	n += fastpb.SizeString(3, x.GetTransactionId())
+	return n
 }
 
-func (x *PlaceOrderReq) fastWriteField4(buf []byte) (offset int) {
-	if x.Email == "" {
-		return offset
+func (x *MarkOrderPaidResp) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.GetEmail())
-	return offset
+	return n
 }
 
-func (x *PlaceOrderReq) fastWriteField5(buf []byte) (offset int) {
-	if x.OrderItems == nil {
-		return offset
+func (x *ReturnItem) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	for i := range x.GetOrderItems() {
-		offset += fastpb.WriteMessage(buf[offset:], 5, x.GetOrderItems()[i])
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *ReturnItem) sizeField1() (n int) {
+	if x.ProductId == 0 {
+		return n
 	}
-	return offset
+	n += fastpb.SizeUint32(1, x.GetProductId())
+	return n
 }
 
-func (x *OrderItem) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *ReturnItem) sizeField2() (n int) {
+	if x.Quantity == 0 {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += fastpb.SizeInt32(2, x.GetQuantity())
+	return n
 }
 
-func (x *OrderItem) fastWriteField1(buf []byte) (offset int) {
-	if x.Item == nil {
-		return offset
+func (x *ReturnItem) sizeField3() (n int) {
+	if x.Reason == "" {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetItem())
-	return offset
+	n += fastpb.SizeString(3, x.GetReason())
+	return n
 }
 
-func (x *OrderItem) fastWriteField2(buf []byte) (offset int) {
-	if x.Cost == 0 {
-		return offset
+func (x *ReturnItem) sizeField4() (n int) {
+	if x.ProductName == "" {
+		return n
 	}
-	offset += fastpb.WriteFloat(buf[offset:], 2, x.GetCost())
-	return offset
+	n += fastpb.SizeString(4, x.GetProductName())
+	return n
 }
 
-func (x *OrderResult) FastWrite(buf []byte) (offset int) {
+func (x *ReturnItem) sizeField5() (n int) {
+	if x.UnitPrice == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(5, x.GetUnitPrice())
+	return n
+}
+
+func (x *ReturnAddress) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	return n
 }
 
-func (x *OrderResult) fastWriteField1(buf []byte) (offset int) {
-	if x.OrderId == "" {
-		return offset
+func (x *ReturnAddress) sizeField1() (n int) {
+	if x.Name == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
-	return offset
+	n += fastpb.SizeString(1, x.GetName())
+	return n
 }
 
-func (x *PlaceOrderResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *ReturnAddress) sizeField2() (n int) {
+	if x.StreetAddress == "" {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	return offset
+	n += fastpb.SizeString(2, x.GetStreetAddress())
+	return n
 }
 
-func (x *PlaceOrderResp) fastWriteField1(buf []byte) (offset int) {
-	if x.Order == nil {
-		return offset
+func (x *ReturnAddress) sizeField3() (n int) {
+	if x.City == "" {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetOrder())
-	return offset
+	n += fastpb.SizeString(3, x.GetCity())
+	return n
 }
 
-func (x *ListOrderReq) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *ReturnAddress) sizeField4() (n int) {
+	if x.State == "" {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	return offset
+	n += fastpb.SizeString(4, x.GetState())
+	return n
 }
 
-func (x *ListOrderReq) fastWriteField1(buf []byte) (offset int) {
-	if x.UserId == 0 {
-		return offset
+func (x *ReturnAddress) sizeField5() (n int) {
+	if x.Country == "" {
+		return n
 	}
-	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
-	return offset
+	n += fastpb.SizeString(5, x.GetCountry())
+	return n
 }
 
-func (x *Order) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *ReturnAddress) sizeField6() (n int) {
+	if x.ZipCode == "" {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	offset += x.fastWriteField5(buf[offset:])
-	offset += x.fastWriteField6(buf[offset:])
-	offset += x.fastWriteField7(buf[offset:])
-	return offset
+	n += fastpb.SizeString(6, x.GetZipCode())
+	return n
 }
 
-func (x *Order) fastWriteField1(buf []byte) (offset int) {
-	if x.OrderItems == nil {
-		return offset
+func (x *Return) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	for i := range x.GetOrderItems() {
-		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetOrderItems()[i])
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	n += x.sizeField12()
+	n += x.sizeField13()
+	return n
+}
+
+func (x *Return) sizeField1() (n int) {
+	if x.ReturnId == "" {
+		return n
 	}
-	return offset
+	n += fastpb.SizeString(1, x.GetReturnId())
+	return n
 }
 
-func (x *Order) fastWriteField2(buf []byte) (offset int) {
+func (x *Return) sizeField2() (n int) {
 	if x.OrderId == "" {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
-	return offset
+	n += fastpb.SizeString(2, x.GetOrderId())
+	return n
 }
 
-func (x *Order) fastWriteField3(buf []byte) (offset int) {
+func (x *Return) sizeField3() (n int) {
 	if x.UserId == 0 {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteUint32(buf[offset:], 3, x.GetUserId())
-	return offset
+	n += fastpb.SizeUint32(3, x.GetUserId())
+	return n
 }
 
-func (x *Order) fastWriteField4(buf []byte) (offset int) {
-	if x.UserCurrency == "" {
-		return offset
+func (x *Return) sizeField4() (n int) {
+	if x.State == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.GetUserCurrency())
-	return offset
+	n += fastpb.SizeString(4, x.GetState())
+	return n
 }
 
-func (x *Order) fastWriteField5(buf []byte) (offset int) {
-	if x.Address == nil {
-		return offset
+func (x *Return) sizeField5() (n int) {
+	if x.Items == nil {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 5, x.GetAddress())
-	return offset
-}
-
-func (x *Order) fastWriteField6(buf []byte) (offset int) {
-	if x.Email == "" {
-		return offset
+	for i := range x.GetItems() {
+		n += fastpb.SizeMessage(5, x.GetItems()[i])
 	}
-	offset += fastpb.WriteString(buf[offset:], 6, x.GetEmail())
-	return offset
+	return n
 }
 
-func (x *Order) fastWriteField7(buf []byte) (offset int) {
-	if x.CreatedAt == 0 {
-		return offset
+func (x *Return) sizeField6() (n int) {
+	if x.Comment == "" {
+		return n
 	}
-	offset += fastpb.WriteInt32(buf[offset:], 7, x.GetCreatedAt())
-	return offset
+	n += fastpb.SizeString(6, x.GetComment())
+	return n
 }
 
-func (x *ListOrderResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *Return) sizeField7() (n int) {
+	if x.AdminNote == "" {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	return offset
+	n += fastpb.SizeString(7, x.GetAdminNote())
+	return n
 }
 
-func (x *ListOrderResp) fastWriteField1(buf []byte) (offset int) {
-	if x.Orders == nil {
-		return offset
+func (x *Return) sizeField8() (n int) {
+	if x.Carrier == "" {
+		return n
 	}
-	for i := range x.GetOrders() {
-		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetOrders()[i])
+	n += fastpb.SizeString(8, x.GetCarrier())
+	return n
+}
+
+func (x *Return) sizeField9() (n int) {
+	if x.TrackingNumber == "" {
+		return n
 	}
-	return offset
+	n += fastpb.SizeString(9, x.GetTrackingNumber())
+	return n
 }
 
-func (x *MarkOrderPaidReq) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *Return) sizeField10() (n int) {
+	if x.ShipTo == nil {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += fastpb.SizeMessage(10, x.GetShipTo())
+	return n
 }
 
-func (x *MarkOrderPaidReq) fastWriteField1(buf []byte) (offset int) {
-	if x.UserId == 0 {
-		return offset
+func (x *Return) sizeField11() (n int) {
+	if x.RefundAmount == 0 {
+		return n
 	}
-	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
-	return offset
+	n += fastpb.SizeFloat(11, x.GetRefundAmount())
+	return n
 }
 
-func (x *MarkOrderPaidReq) fastWriteField2(buf []byte) (offset int) {
-	if x.OrderId == "" {
-		return offset
+func (x *Return) sizeField12() (n int) {
+	if x.RefundId == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
-	return offset
+	n += fastpb.SizeString(12, x.GetRefundId())
+	return n
 }
 
-func (x *MarkOrderPaidResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *Return) sizeField13() (n int) {
+	if x.CreatedAt == 0 {
+		return n
 	}
-	return offset
+	n += fastpb.SizeInt64(13, x.GetCreatedAt())
+	return n
 }
 
-func (x *Address) Size() (n int) {
+func (x *CreateReturnReq) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -755,105 +3908,103 @@ func (x *Address) Size() (n int) {
 	n += x.sizeField2()
 	n += x.sizeField3()
 	n += x.sizeField4()
-	n += x.sizeField5()
 	return n
 }
 
-func (x *Address) sizeField1() (n int) {
-	if x.StreetAddress == "" {
+func (x *CreateReturnReq) sizeField1() (n int) {
+	if x.UserId == 0 {
 		return n
 	}
-	n += fastpb.SizeString(1, x.GetStreetAddress())
+	n += fastpb.SizeUint32(1, x.GetUserId())
 	return n
 }
 
-func (x *Address) sizeField2() (n int) {
-	if x.City == "" {
+func (x *CreateReturnReq) sizeField2() (n int) {
+	if x.OrderId == "" {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetCity())
+	n += fastpb.SizeString(2, x.GetOrderId())
 	return n
 }
 
-func (x *Address) sizeField3() (n int) {
-	if x.State == "" {
+func (x *CreateReturnReq) sizeField3() (n int) {
+	if x.Items == nil {
 		return n
 	}
-	n += fastpb.SizeString(3, x.GetState())
+	for i := range x.GetItems() {
+		n += fastpb.SizeMessage(3, x.GetItems()[i])
+	}
 	return n
 }
 
-func (x *Address) sizeField4() (n int) {
-	if x.Country == "" {
+func (x *CreateReturnReq) sizeField4() (n int) {
+	if x.Comment == "" {
 		return n
 	}
-	n += fastpb.SizeString(4, x.GetCountry())
+	n += fastpb.SizeString(4, x.GetComment())
 	return n
 }
 
-func (x *Address) sizeField5() (n int) {
-	if x.ZipCode == 0 {
+func (x *CreateReturnResp) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeInt32(5, x.GetZipCode())
+	n += x.sizeField1()
 	return n
 }
 
-func (x *PlaceOrderReq) Size() (n int) {
-	if x == nil {
+func (x *CreateReturnResp) sizeField1() (n int) {
+	if x.Return == nil {
 		return n
 	}
-	n += x.sizeField1()
-	n += x.sizeField2()
-	n += x.sizeField3()
-	n += x.sizeField4()
-	n += x.sizeField5()
+	n += fastpb.SizeMessage(1, x.GetReturn())
 	return n
 }
 
-func (x *PlaceOrderReq) sizeField1() (n int) {
-	if x.UserId == 0 {
+func (x *ListReturnsReq) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeUint32(1, x.GetUserId())
+	n += x.sizeField1()
+	n += x.sizeField2()
 	return n
 }
 
-func (x *PlaceOrderReq) sizeField2() (n int) {
-	if x.UserCurrency == "" {
+func (x *ListReturnsReq) sizeField1() (n int) {
+	if x.UserId == 0 {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetUserCurrency())
+	n += fastpb.SizeUint32(1, x.GetUserId())
 	return n
 }
 
-func (x *PlaceOrderReq) sizeField3() (n int) {
-	if x.Address == nil {
+func (x *ListReturnsReq) sizeField2() (n int) {
+	if x.OrderId == "" {
 		return n
 	}
-	n += fastpb.SizeMessage(3, x.GetAddress())
+	n += fastpb.SizeString(2, x.GetOrderId())
 	return n
 }
 
-func (x *PlaceOrderReq) sizeField4() (n int) {
-	if x.Email == "" {
+func (x *ListReturnsResp) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeString(4, x.GetEmail())
+	n += x.sizeField1()
 	return n
 }
 
-func (x *PlaceOrderReq) sizeField5() (n int) {
-	if x.OrderItems == nil {
+func (x *ListReturnsResp) sizeField1() (n int) {
+	if x.Returns == nil {
 		return n
 	}
-	for i := range x.GetOrderItems() {
-		n += fastpb.SizeMessage(5, x.GetOrderItems()[i])
+	for i := range x.GetReturns() {
+		n += fastpb.SizeMessage(1, x.GetReturns()[i])
 	}
 	return n
 }
 
-func (x *OrderItem) Size() (n int) {
+func (x *AdminListReturnsReq) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -862,23 +4013,23 @@ func (x *OrderItem) Size() (n int) {
 	return n
 }
 
-func (x *OrderItem) sizeField1() (n int) {
-	if x.Item == nil {
+func (x *AdminListReturnsReq) sizeField1() (n int) {
+	if x.State == "" {
 		return n
 	}
-	n += fastpb.SizeMessage(1, x.GetItem())
+	n += fastpb.SizeString(1, x.GetState())
 	return n
 }
 
-func (x *OrderItem) sizeField2() (n int) {
-	if x.Cost == 0 {
+func (x *AdminListReturnsReq) sizeField2() (n int) {
+	if x.Limit == 0 {
 		return n
 	}
-	n += fastpb.SizeFloat(2, x.GetCost())
+	n += fastpb.SizeInt32(2, x.GetLimit())
 	return n
 }
 
-func (x *OrderResult) Size() (n int) {
+func (x *AdminListReturnsResp) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -886,31 +4037,42 @@ func (x *OrderResult) Size() (n int) {
 	return n
 }
 
-func (x *OrderResult) sizeField1() (n int) {
-	if x.OrderId == "" {
+func (x *AdminListReturnsResp) sizeField1() (n int) {
+	if x.Returns == nil {
 		return n
 	}
-	n += fastpb.SizeString(1, x.GetOrderId())
+	for i := range x.GetReturns() {
+		n += fastpb.SizeMessage(1, x.GetReturns()[i])
+	}
 	return n
 }
 
-func (x *PlaceOrderResp) Size() (n int) {
+func (x *ApproveReturnReq) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
+	n += x.sizeField2()
 	return n
 }
 
-func (x *PlaceOrderResp) sizeField1() (n int) {
-	if x.Order == nil {
+func (x *ApproveReturnReq) sizeField1() (n int) {
+	if x.ReturnId == "" {
 		return n
 	}
-	n += fastpb.SizeMessage(1, x.GetOrder())
+	n += fastpb.SizeString(1, x.GetReturnId())
 	return n
 }
 
-func (x *ListOrderReq) Size() (n int) {
+func (x *ApproveReturnReq) sizeField2() (n int) {
+	if x.Note == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetNote())
+	return n
+}
+
+func (x *ApproveReturnResp) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -918,87 +4080,88 @@ func (x *ListOrderReq) Size() (n int) {
 	return n
 }
 
-func (x *ListOrderReq) sizeField1() (n int) {
-	if x.UserId == 0 {
+func (x *ApproveReturnResp) sizeField1() (n int) {
+	if x.Return == nil {
 		return n
 	}
-	n += fastpb.SizeUint32(1, x.GetUserId())
+	n += fastpb.SizeMessage(1, x.GetReturn())
 	return n
 }
 
-func (x *Order) Size() (n int) {
+func (x *RejectReturnReq) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
-	n += x.sizeField3()
-	n += x.sizeField4()
-	n += x.sizeField5()
-	n += x.sizeField6()
-	n += x.sizeField7()
 	return n
 }
 
-func (x *Order) sizeField1() (n int) {
-	if x.OrderItems == nil {
+func (x *RejectReturnReq) sizeField1() (n int) {
+	if x.ReturnId == "" {
 		return n
 	}
-	for i := range x.GetOrderItems() {
-		n += fastpb.SizeMessage(1, x.GetOrderItems()[i])
+	n += fastpb.SizeString(1, x.GetReturnId())
+	return n
+}
+
+func (x *RejectReturnReq) sizeField2() (n int) {
+	if x.Note == "" {
+		return n
 	}
+	n += fastpb.SizeString(2, x.GetNote())
 	return n
 }
 
-func (x *Order) sizeField2() (n int) {
-	if x.OrderId == "" {
+func (x *RejectReturnResp) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetOrderId())
+	n += x.sizeField1()
 	return n
 }
 
-func (x *Order) sizeField3() (n int) {
-	if x.UserId == 0 {
+func (x *RejectReturnResp) sizeField1() (n int) {
+	if x.Return == nil {
 		return n
 	}
-	n += fastpb.SizeUint32(3, x.GetUserId())
+	n += fastpb.SizeMessage(1, x.GetReturn())
 	return n
 }
 
-func (x *Order) sizeField4() (n int) {
-	if x.UserCurrency == "" {
+func (x *ConfirmReturnReceivedReq) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeString(4, x.GetUserCurrency())
+	n += x.sizeField1()
 	return n
 }
 
-func (x *Order) sizeField5() (n int) {
-	if x.Address == nil {
+func (x *ConfirmReturnReceivedReq) sizeField1() (n int) {
+	if x.ReturnId == "" {
 		return n
 	}
-	n += fastpb.SizeMessage(5, x.GetAddress())
+	n += fastpb.SizeString(1, x.GetReturnId())
 	return n
 }
 
-func (x *Order) sizeField6() (n int) {
-	if x.Email == "" {
+func (x *ConfirmReturnReceivedResp) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeString(6, x.GetEmail())
+	n += x.sizeField1()
 	return n
 }
 
-func (x *Order) sizeField7() (n int) {
-	if x.CreatedAt == 0 {
+func (x *ConfirmReturnReceivedResp) sizeField1() (n int) {
+	if x.Return == nil {
 		return n
 	}
-	n += fastpb.SizeInt32(7, x.GetCreatedAt())
+	n += fastpb.SizeMessage(1, x.GetReturn())
 	return n
 }
 
-func (x *ListOrderResp) Size() (n int) {
+func (x *ExportUserDataReq) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -1006,42 +4169,47 @@ func (x *ListOrderResp) Size() (n int) {
 	return n
 }
 
-func (x *ListOrderResp) sizeField1() (n int) {
-	if x.Orders == nil {
+func (x *ExportUserDataReq) sizeField1() (n int) {
+	if x.UserId == 0 {
 		return n
 	}
-	for i := range x.GetOrders() {
-		n += fastpb.SizeMessage(1, x.GetOrders()[i])
-	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
 	return n
 }
 
-func (x *MarkOrderPaidReq) Size() (n int) {
+func (x *ExportUserDataResp) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
-	n += x.sizeField2()
 	return n
 }
 
-func (x *MarkOrderPaidReq) sizeField1() (n int) {
-	if x.UserId == 0 {
+func (x *ExportUserDataResp) sizeField1() (n int) {
+	if len(x.Data) == 0 {
 		return n
 	}
-	n += fastpb.SizeUint32(1, x.GetUserId())
+	n += fastpb.SizeBytes(1, x.GetData())
 	return n
 }
 
-func (x *MarkOrderPaidReq) sizeField2() (n int) {
-	if x.OrderId == "" {
+func (x *EraseUserDataReq) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetOrderId())
+	n += x.sizeField1()
 	return n
 }
 
-func (x *MarkOrderPaidResp) Size() (n int) {
+func (x *EraseUserDataReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *EraseUserDataResp) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -1057,16 +4225,29 @@ var fieldIDToName_Address = map[int32]string{
 }
 
 var fieldIDToName_PlaceOrderReq = map[int32]string{
-	1: "UserId",
-	2: "UserCurrency",
-	3: "Address",
-	4: "Email",
-	5: "OrderItems",
+	1:  "UserId",
+	2:  "UserCurrency",
+	3:  "Address",
+	4:  "Email",
+	5:  "OrderItems",
+	6:  "ShippingMethod",
+	7:  "ShippingCost",
+	8:  "TaxLines",
+	9:  "Tax",
+	10: "PricesIncludeTax",
+}
+
+var fieldIDToName_TaxLine = map[int32]string{
+	1: "Name",
+	2: "Rate",
+	3: "Amount",
 }
 
 var fieldIDToName_OrderItem = map[int32]string{
 	1: "Item",
 	2: "Cost",
+	3: "UnitPrice",
+	4: "ProductName",
 }
 
 var fieldIDToName_OrderResult = map[int32]string{
@@ -1079,27 +4260,172 @@ var fieldIDToName_PlaceOrderResp = map[int32]string{
 
 var fieldIDToName_ListOrderReq = map[int32]string{
 	1: "UserId",
+	2: "Cursor",
+	3: "PageSize",
+	4: "State",
+	5: "StartTime",
+	6: "EndTime",
 }
 
 var fieldIDToName_Order = map[int32]string{
-	1: "OrderItems",
+	1:  "OrderItems",
+	2:  "OrderId",
+	3:  "UserId",
+	4:  "UserCurrency",
+	5:  "Address",
+	6:  "Email",
+	7:  "CreatedAt",
+	8:  "ShippingMethod",
+	9:  "ShippingCost",
+	10: "TaxLines",
+	11: "Tax",
+	12: "PricesIncludeTax",
+	13: "OrderState",
+	14: "TransactionId",
+	15: "StateHistory",
+	16: "InvoiceNumber",
+}
+
+var fieldIDToName_OrderStateChange = map[int32]string{
+	1: "State",
+	2: "CreatedAt",
+}
+
+var fieldIDToName_GetOrderReq = map[int32]string{
+	1: "UserId",
 	2: "OrderId",
-	3: "UserId",
-	4: "UserCurrency",
-	5: "Address",
-	6: "Email",
-	7: "CreatedAt",
+}
+
+var fieldIDToName_GetOrderResp = map[int32]string{
+	1: "Order",
 }
 
 var fieldIDToName_ListOrderResp = map[int32]string{
 	1: "Orders",
+	2: "NextCursor",
+	3: "Total",
+}
+
+var fieldIDToName_GetInvoiceReq = map[int32]string{
+	1: "UserId",
+	2: "OrderId",
+}
+
+var fieldIDToName_GetInvoiceResp = map[int32]string{
+	1: "InvoiceNumber",
+	2: "IssuedAt",
+	3: "FileName",
+	4: "Content",
 }
 
 var fieldIDToName_MarkOrderPaidReq = map[int32]string{
 	1: "UserId",
 	2: "OrderId",
+	3: "TransactionId",
 }
 
 var fieldIDToName_MarkOrderPaidResp = map[int32]string{}
 
+var fieldIDToName_ReturnItem = map[int32]string{
+	1: "ProductId",
+	2: "Quantity",
+	3: "Reason",
+	4: "ProductName",
+	5: "UnitPrice",
+}
+
+var fieldIDToName_ReturnAddress = map[int32]string{
+	1: "Name",
+	2: "StreetAddress",
+	3: "City",
+	4: "State",
+	5: "Country",
+	6: "ZipCode",
+}
+
+var fieldIDToName_Return = map[int32]string{
+	1:  "ReturnId",
+	2:  "OrderId",
+	3:  "UserId",
+	4:  "State",
+	5:  "Items",
+	6:  "Comment",
+	7:  "AdminNote",
+	8:  "Carrier",
+	9:  "TrackingNumber",
+	10: "ShipTo",
+	11: "RefundAmount",
+	12: "RefundId",
+	13: "CreatedAt",
+}
+
+var fieldIDToName_CreateReturnReq = map[int32]string{
+	1: "UserId",
+	2: "OrderId",
+	3: "Items",
+	4: "Comment",
+}
+
+var fieldIDToName_CreateReturnResp = map[int32]string{
+	1: "Return",
+}
+
+var fieldIDToName_ListReturnsReq = map[int32]string{
+	1: "UserId",
+	2: "OrderId",
+}
+
+var fieldIDToName_ListReturnsResp = map[int32]string{
+	1: "Returns",
+}
+
+var fieldIDToName_AdminListReturnsReq = map[int32]string{
+	1: "State",
+	2: "Limit",
+}
+
+var fieldIDToName_AdminListReturnsResp = map[int32]string{
+	1: "Returns",
+}
+
+var fieldIDToName_ApproveReturnReq = map[int32]string{
+	1: "ReturnId",
+	2: "Note",
+}
+
+var fieldIDToName_ApproveReturnResp = map[int32]string{
+	1: "Return",
+}
+
+var fieldIDToName_RejectReturnReq = map[int32]string{
+	1: "ReturnId",
+	2: "Note",
+}
+
+var fieldIDToName_RejectReturnResp = map[int32]string{
+	1: "Return",
+}
+
+var fieldIDToName_ConfirmReturnReceivedReq = map[int32]string{
+	1: "ReturnId",
+}
+
+var fieldIDToName_ConfirmReturnReceivedResp = map[int32]string{
+	1: "Return",
+}
+
+var fieldIDToName_ExportUserDataReq = map[int32]string{
+	1: "UserId",
+}
+
+var fieldIDToName_ExportUserDataResp = map[int32]string{
+	1: "Data",
+}
+
+var fieldIDToName_EraseUserDataReq = map[int32]string{
+	1: "UserId",
+}
+
+var fieldIDToName_EraseUserDataResp = map[int32]string{}
+
 var _ = cart.File_cart_proto
@@ -13,7 +13,17 @@ import (
 type Client interface {
 	PlaceOrder(ctx context.Context, Req *order.PlaceOrderReq, callOptions ...callopt.Option) (r *order.PlaceOrderResp, err error)
 	ListOrder(ctx context.Context, Req *order.ListOrderReq, callOptions ...callopt.Option) (r *order.ListOrderResp, err error)
+	GetOrder(ctx context.Context, Req *order.GetOrderReq, callOptions ...callopt.Option) (r *order.GetOrderResp, err error)
+	GetInvoice(ctx context.Context, Req *order.GetInvoiceReq, callOptions ...callopt.Option) (r *order.GetInvoiceResp, err error)
 	MarkOrderPaid(ctx context.Context, Req *order.MarkOrderPaidReq, callOptions ...callopt.Option) (r *order.MarkOrderPaidResp, err error)
+	CreateReturn(ctx context.Context, Req *order.CreateReturnReq, callOptions ...callopt.Option) (r *order.CreateReturnResp, err error)
+	ListReturns(ctx context.Context, Req *order.ListReturnsReq, callOptions ...callopt.Option) (r *order.ListReturnsResp, err error)
+	ExportUserData(ctx context.Context, Req *order.ExportUserDataReq, callOptions ...callopt.Option) (r *order.ExportUserDataResp, err error)
+	EraseUserData(ctx context.Context, Req *order.EraseUserDataReq, callOptions ...callopt.Option) (r *order.EraseUserDataResp, err error)
+	AdminListReturns(ctx context.Context, Req *order.AdminListReturnsReq, callOptions ...callopt.Option) (r *order.AdminListReturnsResp, err error)
+	ApproveReturn(ctx context.Context, Req *order.ApproveReturnReq, callOptions ...callopt.Option) (r *order.ApproveReturnResp, err error)
+	RejectReturn(ctx context.Context, Req *order.RejectReturnReq, callOptions ...callopt.Option) (r *order.RejectReturnResp, err error)
+	ConfirmReturnReceived(ctx context.Context, Req *order.ConfirmReturnReceivedReq, callOptions ...callopt.Option) (r *order.ConfirmReturnReceivedResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -55,7 +65,57 @@ func (p *kOrderServiceClient) ListOrder(ctx context.Context, Req *order.ListOrde
 	return p.kClient.ListOrder(ctx, Req)
 }
 
+func (p *kOrderServiceClient) GetOrder(ctx context.Context, Req *order.GetOrderReq, callOptions ...callopt.Option) (r *order.GetOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetOrder(ctx, Req)
+}
+
+func (p *kOrderServiceClient) GetInvoice(ctx context.Context, Req *order.GetInvoiceReq, callOptions ...callopt.Option) (r *order.GetInvoiceResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetInvoice(ctx, Req)
+}
+
 func (p *kOrderServiceClient) MarkOrderPaid(ctx context.Context, Req *order.MarkOrderPaidReq, callOptions ...callopt.Option) (r *order.MarkOrderPaidResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.MarkOrderPaid(ctx, Req)
 }
+
+func (p *kOrderServiceClient) CreateReturn(ctx context.Context, Req *order.CreateReturnReq, callOptions ...callopt.Option) (r *order.CreateReturnResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateReturn(ctx, Req)
+}
+
+func (p *kOrderServiceClient) ListReturns(ctx context.Context, Req *order.ListReturnsReq, callOptions ...callopt.Option) (r *order.ListReturnsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListReturns(ctx, Req)
+}
+
+func (p *kOrderServiceClient) ExportUserData(ctx context.Context, Req *order.ExportUserDataReq, callOptions ...callopt.Option) (r *order.ExportUserDataResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportUserData(ctx, Req)
+}
+
+func (p *kOrderServiceClient) EraseUserData(ctx context.Context, Req *order.EraseUserDataReq, callOptions ...callopt.Option) (r *order.EraseUserDataResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EraseUserData(ctx, Req)
+}
+
+func (p *kOrderServiceClient) AdminListReturns(ctx context.Context, Req *order.AdminListReturnsReq, callOptions ...callopt.Option) (r *order.AdminListReturnsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AdminListReturns(ctx, Req)
+}
+
+func (p *kOrderServiceClient) ApproveReturn(ctx context.Context, Req *order.ApproveReturnReq, callOptions ...callopt.Option) (r *order.ApproveReturnResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ApproveReturn(ctx, Req)
+}
+
+func (p *kOrderServiceClient) RejectReturn(ctx context.Context, Req *order.RejectReturnReq, callOptions ...callopt.Option) (r *order.RejectReturnResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RejectReturn(ctx, Req)
+}
+
+func (p *kOrderServiceClient) ConfirmReturnReceived(ctx context.Context, Req *order.ConfirmReturnReceivedReq, callOptions ...callopt.Option) (r *order.ConfirmReturnReceivedResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ConfirmReturnReceived(ctx, Req)
+}
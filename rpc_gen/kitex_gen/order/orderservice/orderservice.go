@@ -21,9 +21,19 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "OrderService"
 	handlerType := (*order.OrderService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"PlaceOrder":    kitex.NewMethodInfo(placeOrderHandler, newPlaceOrderArgs, newPlaceOrderResult, false),
-		"ListOrder":     kitex.NewMethodInfo(listOrderHandler, newListOrderArgs, newListOrderResult, false),
-		"MarkOrderPaid": kitex.NewMethodInfo(markOrderPaidHandler, newMarkOrderPaidArgs, newMarkOrderPaidResult, false),
+		"PlaceOrder":            kitex.NewMethodInfo(placeOrderHandler, newPlaceOrderArgs, newPlaceOrderResult, false),
+		"ListOrder":             kitex.NewMethodInfo(listOrderHandler, newListOrderArgs, newListOrderResult, false),
+		"GetOrder":              kitex.NewMethodInfo(getOrderHandler, newGetOrderArgs, newGetOrderResult, false),
+		"GetInvoice":            kitex.NewMethodInfo(getInvoiceHandler, newGetInvoiceArgs, newGetInvoiceResult, false),
+		"MarkOrderPaid":         kitex.NewMethodInfo(markOrderPaidHandler, newMarkOrderPaidArgs, newMarkOrderPaidResult, false),
+		"CreateReturn":          kitex.NewMethodInfo(createReturnHandler, newCreateReturnArgs, newCreateReturnResult, false),
+		"ListReturns":           kitex.NewMethodInfo(listReturnsHandler, newListReturnsArgs, newListReturnsResult, false),
+		"ExportUserData":        kitex.NewMethodInfo(exportUserDataHandler, newExportUserDataArgs, newExportUserDataResult, false),
+		"EraseUserData":         kitex.NewMethodInfo(eraseUserDataHandler, newEraseUserDataArgs, newEraseUserDataResult, false),
+		"AdminListReturns":      kitex.NewMethodInfo(adminListReturnsHandler, newAdminListReturnsArgs, newAdminListReturnsResult, false),
+		"ApproveReturn":         kitex.NewMethodInfo(approveReturnHandler, newApproveReturnArgs, newApproveReturnResult, false),
+		"RejectReturn":          kitex.NewMethodInfo(rejectReturnHandler, newRejectReturnArgs, newRejectReturnResult, false),
+		"ConfirmReturnReceived": kitex.NewMethodInfo(confirmReturnReceivedHandler, newConfirmReturnReceivedArgs, newConfirmReturnReceivedResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "order",
@@ -346,6 +356,312 @@ func (p *ListOrderResult) GetResult() interface{} {
 	return p.Success
 }
 
+func getOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.GetOrderReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).GetOrder(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GetOrderArgs:
+		success, err := handler.(order.OrderService).GetOrder(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetOrderResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGetOrderArgs() interface{} {
+	return &GetOrderArgs{}
+}
+
+func newGetOrderResult() interface{} {
+	return &GetOrderResult{}
+}
+
+type GetOrderArgs struct {
+	Req *order.GetOrderReq
+}
+
+func (p *GetOrderArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.GetOrderReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetOrderArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetOrderArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetOrderArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetOrderArgs) Unmarshal(in []byte) error {
+	msg := new(order.GetOrderReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetOrderArgs_Req_DEFAULT *order.GetOrderReq
+
+func (p *GetOrderArgs) GetReq() *order.GetOrderReq {
+	if !p.IsSetReq() {
+		return GetOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetOrderArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetOrderResult struct {
+	Success *order.GetOrderResp
+}
+
+var GetOrderResult_Success_DEFAULT *order.GetOrderResp
+
+func (p *GetOrderResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.GetOrderResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetOrderResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetOrderResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetOrderResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetOrderResult) Unmarshal(in []byte) error {
+	msg := new(order.GetOrderResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetOrderResult) GetSuccess() *order.GetOrderResp {
+	if !p.IsSetSuccess() {
+		return GetOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.GetOrderResp)
+}
+
+func (p *GetOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetOrderResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getInvoiceHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.GetInvoiceReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).GetInvoice(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GetInvoiceArgs:
+		success, err := handler.(order.OrderService).GetInvoice(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetInvoiceResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGetInvoiceArgs() interface{} {
+	return &GetInvoiceArgs{}
+}
+
+func newGetInvoiceResult() interface{} {
+	return &GetInvoiceResult{}
+}
+
+type GetInvoiceArgs struct {
+	Req *order.GetInvoiceReq
+}
+
+func (p *GetInvoiceArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.GetInvoiceReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetInvoiceArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetInvoiceArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetInvoiceArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetInvoiceArgs) Unmarshal(in []byte) error {
+	msg := new(order.GetInvoiceReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetInvoiceArgs_Req_DEFAULT *order.GetInvoiceReq
+
+func (p *GetInvoiceArgs) GetReq() *order.GetInvoiceReq {
+	if !p.IsSetReq() {
+		return GetInvoiceArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetInvoiceArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetInvoiceArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetInvoiceResult struct {
+	Success *order.GetInvoiceResp
+}
+
+var GetInvoiceResult_Success_DEFAULT *order.GetInvoiceResp
+
+func (p *GetInvoiceResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.GetInvoiceResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetInvoiceResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetInvoiceResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetInvoiceResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetInvoiceResult) Unmarshal(in []byte) error {
+	msg := new(order.GetInvoiceResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetInvoiceResult) GetSuccess() *order.GetInvoiceResp {
+	if !p.IsSetSuccess() {
+		return GetInvoiceResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetInvoiceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.GetInvoiceResp)
+}
+
+func (p *GetInvoiceResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetInvoiceResult) GetResult() interface{} {
+	return p.Success
+}
+
 func markOrderPaidHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
@@ -499,13 +815,1237 @@ func (p *MarkOrderPaidResult) GetResult() interface{} {
 	return p.Success
 }
 
-type kClient struct {
-	c client.Client
+func createReturnHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.CreateReturnReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).CreateReturn(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *CreateReturnArgs:
+		success, err := handler.(order.OrderService).CreateReturn(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateReturnResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newCreateReturnArgs() interface{} {
+	return &CreateReturnArgs{}
 }
 
-func newServiceClient(c client.Client) *kClient {
-	return &kClient{
-		c: c,
+func newCreateReturnResult() interface{} {
+	return &CreateReturnResult{}
+}
+
+type CreateReturnArgs struct {
+	Req *order.CreateReturnReq
+}
+
+func (p *CreateReturnArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.CreateReturnReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CreateReturnArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CreateReturnArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CreateReturnArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateReturnArgs) Unmarshal(in []byte) error {
+	msg := new(order.CreateReturnReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateReturnArgs_Req_DEFAULT *order.CreateReturnReq
+
+func (p *CreateReturnArgs) GetReq() *order.CreateReturnReq {
+	if !p.IsSetReq() {
+		return CreateReturnArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateReturnArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateReturnArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateReturnResult struct {
+	Success *order.CreateReturnResp
+}
+
+var CreateReturnResult_Success_DEFAULT *order.CreateReturnResp
+
+func (p *CreateReturnResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.CreateReturnResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CreateReturnResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CreateReturnResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CreateReturnResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateReturnResult) Unmarshal(in []byte) error {
+	msg := new(order.CreateReturnResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateReturnResult) GetSuccess() *order.CreateReturnResp {
+	if !p.IsSetSuccess() {
+		return CreateReturnResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateReturnResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.CreateReturnResp)
+}
+
+func (p *CreateReturnResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateReturnResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listReturnsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.ListReturnsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).ListReturns(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ListReturnsArgs:
+		success, err := handler.(order.OrderService).ListReturns(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListReturnsResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newListReturnsArgs() interface{} {
+	return &ListReturnsArgs{}
+}
+
+func newListReturnsResult() interface{} {
+	return &ListReturnsResult{}
+}
+
+type ListReturnsArgs struct {
+	Req *order.ListReturnsReq
+}
+
+func (p *ListReturnsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.ListReturnsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListReturnsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListReturnsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListReturnsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListReturnsArgs) Unmarshal(in []byte) error {
+	msg := new(order.ListReturnsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListReturnsArgs_Req_DEFAULT *order.ListReturnsReq
+
+func (p *ListReturnsArgs) GetReq() *order.ListReturnsReq {
+	if !p.IsSetReq() {
+		return ListReturnsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListReturnsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListReturnsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListReturnsResult struct {
+	Success *order.ListReturnsResp
+}
+
+var ListReturnsResult_Success_DEFAULT *order.ListReturnsResp
+
+func (p *ListReturnsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.ListReturnsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListReturnsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListReturnsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListReturnsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListReturnsResult) Unmarshal(in []byte) error {
+	msg := new(order.ListReturnsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListReturnsResult) GetSuccess() *order.ListReturnsResp {
+	if !p.IsSetSuccess() {
+		return ListReturnsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListReturnsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.ListReturnsResp)
+}
+
+func (p *ListReturnsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListReturnsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func exportUserDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.ExportUserDataReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).ExportUserData(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ExportUserDataArgs:
+		success, err := handler.(order.OrderService).ExportUserData(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ExportUserDataResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newExportUserDataArgs() interface{} {
+	return &ExportUserDataArgs{}
+}
+
+func newExportUserDataResult() interface{} {
+	return &ExportUserDataResult{}
+}
+
+type ExportUserDataArgs struct {
+	Req *order.ExportUserDataReq
+}
+
+func (p *ExportUserDataArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.ExportUserDataReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ExportUserDataArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ExportUserDataArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ExportUserDataArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ExportUserDataArgs) Unmarshal(in []byte) error {
+	msg := new(order.ExportUserDataReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ExportUserDataArgs_Req_DEFAULT *order.ExportUserDataReq
+
+func (p *ExportUserDataArgs) GetReq() *order.ExportUserDataReq {
+	if !p.IsSetReq() {
+		return ExportUserDataArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ExportUserDataArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ExportUserDataArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ExportUserDataResult struct {
+	Success *order.ExportUserDataResp
+}
+
+var ExportUserDataResult_Success_DEFAULT *order.ExportUserDataResp
+
+func (p *ExportUserDataResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.ExportUserDataResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ExportUserDataResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ExportUserDataResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ExportUserDataResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ExportUserDataResult) Unmarshal(in []byte) error {
+	msg := new(order.ExportUserDataResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ExportUserDataResult) GetSuccess() *order.ExportUserDataResp {
+	if !p.IsSetSuccess() {
+		return ExportUserDataResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ExportUserDataResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.ExportUserDataResp)
+}
+
+func (p *ExportUserDataResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ExportUserDataResult) GetResult() interface{} {
+	return p.Success
+}
+
+func eraseUserDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.EraseUserDataReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).EraseUserData(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *EraseUserDataArgs:
+		success, err := handler.(order.OrderService).EraseUserData(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*EraseUserDataResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newEraseUserDataArgs() interface{} {
+	return &EraseUserDataArgs{}
+}
+
+func newEraseUserDataResult() interface{} {
+	return &EraseUserDataResult{}
+}
+
+type EraseUserDataArgs struct {
+	Req *order.EraseUserDataReq
+}
+
+func (p *EraseUserDataArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.EraseUserDataReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *EraseUserDataArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *EraseUserDataArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *EraseUserDataArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *EraseUserDataArgs) Unmarshal(in []byte) error {
+	msg := new(order.EraseUserDataReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var EraseUserDataArgs_Req_DEFAULT *order.EraseUserDataReq
+
+func (p *EraseUserDataArgs) GetReq() *order.EraseUserDataReq {
+	if !p.IsSetReq() {
+		return EraseUserDataArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *EraseUserDataArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *EraseUserDataArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type EraseUserDataResult struct {
+	Success *order.EraseUserDataResp
+}
+
+var EraseUserDataResult_Success_DEFAULT *order.EraseUserDataResp
+
+func (p *EraseUserDataResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.EraseUserDataResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *EraseUserDataResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *EraseUserDataResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *EraseUserDataResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *EraseUserDataResult) Unmarshal(in []byte) error {
+	msg := new(order.EraseUserDataResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *EraseUserDataResult) GetSuccess() *order.EraseUserDataResp {
+	if !p.IsSetSuccess() {
+		return EraseUserDataResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *EraseUserDataResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.EraseUserDataResp)
+}
+
+func (p *EraseUserDataResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *EraseUserDataResult) GetResult() interface{} {
+	return p.Success
+}
+
+func adminListReturnsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.AdminListReturnsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).AdminListReturns(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *AdminListReturnsArgs:
+		success, err := handler.(order.OrderService).AdminListReturns(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*AdminListReturnsResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newAdminListReturnsArgs() interface{} {
+	return &AdminListReturnsArgs{}
+}
+
+func newAdminListReturnsResult() interface{} {
+	return &AdminListReturnsResult{}
+}
+
+type AdminListReturnsArgs struct {
+	Req *order.AdminListReturnsReq
+}
+
+func (p *AdminListReturnsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.AdminListReturnsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *AdminListReturnsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *AdminListReturnsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *AdminListReturnsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *AdminListReturnsArgs) Unmarshal(in []byte) error {
+	msg := new(order.AdminListReturnsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var AdminListReturnsArgs_Req_DEFAULT *order.AdminListReturnsReq
+
+func (p *AdminListReturnsArgs) GetReq() *order.AdminListReturnsReq {
+	if !p.IsSetReq() {
+		return AdminListReturnsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *AdminListReturnsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminListReturnsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type AdminListReturnsResult struct {
+	Success *order.AdminListReturnsResp
+}
+
+var AdminListReturnsResult_Success_DEFAULT *order.AdminListReturnsResp
+
+func (p *AdminListReturnsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.AdminListReturnsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *AdminListReturnsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *AdminListReturnsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *AdminListReturnsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *AdminListReturnsResult) Unmarshal(in []byte) error {
+	msg := new(order.AdminListReturnsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *AdminListReturnsResult) GetSuccess() *order.AdminListReturnsResp {
+	if !p.IsSetSuccess() {
+		return AdminListReturnsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *AdminListReturnsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.AdminListReturnsResp)
+}
+
+func (p *AdminListReturnsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminListReturnsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func approveReturnHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.ApproveReturnReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).ApproveReturn(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ApproveReturnArgs:
+		success, err := handler.(order.OrderService).ApproveReturn(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ApproveReturnResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newApproveReturnArgs() interface{} {
+	return &ApproveReturnArgs{}
+}
+
+func newApproveReturnResult() interface{} {
+	return &ApproveReturnResult{}
+}
+
+type ApproveReturnArgs struct {
+	Req *order.ApproveReturnReq
+}
+
+func (p *ApproveReturnArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.ApproveReturnReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ApproveReturnArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ApproveReturnArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ApproveReturnArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ApproveReturnArgs) Unmarshal(in []byte) error {
+	msg := new(order.ApproveReturnReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ApproveReturnArgs_Req_DEFAULT *order.ApproveReturnReq
+
+func (p *ApproveReturnArgs) GetReq() *order.ApproveReturnReq {
+	if !p.IsSetReq() {
+		return ApproveReturnArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ApproveReturnArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApproveReturnArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ApproveReturnResult struct {
+	Success *order.ApproveReturnResp
+}
+
+var ApproveReturnResult_Success_DEFAULT *order.ApproveReturnResp
+
+func (p *ApproveReturnResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.ApproveReturnResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ApproveReturnResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ApproveReturnResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ApproveReturnResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ApproveReturnResult) Unmarshal(in []byte) error {
+	msg := new(order.ApproveReturnResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ApproveReturnResult) GetSuccess() *order.ApproveReturnResp {
+	if !p.IsSetSuccess() {
+		return ApproveReturnResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ApproveReturnResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.ApproveReturnResp)
+}
+
+func (p *ApproveReturnResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApproveReturnResult) GetResult() interface{} {
+	return p.Success
+}
+
+func rejectReturnHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.RejectReturnReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).RejectReturn(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *RejectReturnArgs:
+		success, err := handler.(order.OrderService).RejectReturn(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RejectReturnResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newRejectReturnArgs() interface{} {
+	return &RejectReturnArgs{}
+}
+
+func newRejectReturnResult() interface{} {
+	return &RejectReturnResult{}
+}
+
+type RejectReturnArgs struct {
+	Req *order.RejectReturnReq
+}
+
+func (p *RejectReturnArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.RejectReturnReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RejectReturnArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RejectReturnArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RejectReturnArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RejectReturnArgs) Unmarshal(in []byte) error {
+	msg := new(order.RejectReturnReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RejectReturnArgs_Req_DEFAULT *order.RejectReturnReq
+
+func (p *RejectReturnArgs) GetReq() *order.RejectReturnReq {
+	if !p.IsSetReq() {
+		return RejectReturnArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RejectReturnArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RejectReturnArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RejectReturnResult struct {
+	Success *order.RejectReturnResp
+}
+
+var RejectReturnResult_Success_DEFAULT *order.RejectReturnResp
+
+func (p *RejectReturnResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.RejectReturnResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RejectReturnResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RejectReturnResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RejectReturnResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RejectReturnResult) Unmarshal(in []byte) error {
+	msg := new(order.RejectReturnResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RejectReturnResult) GetSuccess() *order.RejectReturnResp {
+	if !p.IsSetSuccess() {
+		return RejectReturnResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RejectReturnResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.RejectReturnResp)
+}
+
+func (p *RejectReturnResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RejectReturnResult) GetResult() interface{} {
+	return p.Success
+}
+
+func confirmReturnReceivedHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.ConfirmReturnReceivedReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).ConfirmReturnReceived(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ConfirmReturnReceivedArgs:
+		success, err := handler.(order.OrderService).ConfirmReturnReceived(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ConfirmReturnReceivedResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newConfirmReturnReceivedArgs() interface{} {
+	return &ConfirmReturnReceivedArgs{}
+}
+
+func newConfirmReturnReceivedResult() interface{} {
+	return &ConfirmReturnReceivedResult{}
+}
+
+type ConfirmReturnReceivedArgs struct {
+	Req *order.ConfirmReturnReceivedReq
+}
+
+func (p *ConfirmReturnReceivedArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.ConfirmReturnReceivedReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ConfirmReturnReceivedArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ConfirmReturnReceivedArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ConfirmReturnReceivedArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ConfirmReturnReceivedArgs) Unmarshal(in []byte) error {
+	msg := new(order.ConfirmReturnReceivedReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ConfirmReturnReceivedArgs_Req_DEFAULT *order.ConfirmReturnReceivedReq
+
+func (p *ConfirmReturnReceivedArgs) GetReq() *order.ConfirmReturnReceivedReq {
+	if !p.IsSetReq() {
+		return ConfirmReturnReceivedArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ConfirmReturnReceivedArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ConfirmReturnReceivedArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ConfirmReturnReceivedResult struct {
+	Success *order.ConfirmReturnReceivedResp
+}
+
+var ConfirmReturnReceivedResult_Success_DEFAULT *order.ConfirmReturnReceivedResp
+
+func (p *ConfirmReturnReceivedResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.ConfirmReturnReceivedResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ConfirmReturnReceivedResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ConfirmReturnReceivedResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ConfirmReturnReceivedResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ConfirmReturnReceivedResult) Unmarshal(in []byte) error {
+	msg := new(order.ConfirmReturnReceivedResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ConfirmReturnReceivedResult) GetSuccess() *order.ConfirmReturnReceivedResp {
+	if !p.IsSetSuccess() {
+		return ConfirmReturnReceivedResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ConfirmReturnReceivedResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.ConfirmReturnReceivedResp)
+}
+
+func (p *ConfirmReturnReceivedResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ConfirmReturnReceivedResult) GetResult() interface{} {
+	return p.Success
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
 	}
 }
 
@@ -529,6 +2069,26 @@ func (p *kClient) ListOrder(ctx context.Context, Req *order.ListOrderReq) (r *or
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) GetOrder(ctx context.Context, Req *order.GetOrderReq) (r *order.GetOrderResp, err error) {
+	var _args GetOrderArgs
+	_args.Req = Req
+	var _result GetOrderResult
+	if err = p.c.Call(ctx, "GetOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetInvoice(ctx context.Context, Req *order.GetInvoiceReq) (r *order.GetInvoiceResp, err error) {
+	var _args GetInvoiceArgs
+	_args.Req = Req
+	var _result GetInvoiceResult
+	if err = p.c.Call(ctx, "GetInvoice", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) MarkOrderPaid(ctx context.Context, Req *order.MarkOrderPaidReq) (r *order.MarkOrderPaidResp, err error) {
 	var _args MarkOrderPaidArgs
 	_args.Req = Req
@@ -538,3 +2098,83 @@ func (p *kClient) MarkOrderPaid(ctx context.Context, Req *order.MarkOrderPaidReq
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) CreateReturn(ctx context.Context, Req *order.CreateReturnReq) (r *order.CreateReturnResp, err error) {
+	var _args CreateReturnArgs
+	_args.Req = Req
+	var _result CreateReturnResult
+	if err = p.c.Call(ctx, "CreateReturn", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListReturns(ctx context.Context, Req *order.ListReturnsReq) (r *order.ListReturnsResp, err error) {
+	var _args ListReturnsArgs
+	_args.Req = Req
+	var _result ListReturnsResult
+	if err = p.c.Call(ctx, "ListReturns", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ExportUserData(ctx context.Context, Req *order.ExportUserDataReq) (r *order.ExportUserDataResp, err error) {
+	var _args ExportUserDataArgs
+	_args.Req = Req
+	var _result ExportUserDataResult
+	if err = p.c.Call(ctx, "ExportUserData", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EraseUserData(ctx context.Context, Req *order.EraseUserDataReq) (r *order.EraseUserDataResp, err error) {
+	var _args EraseUserDataArgs
+	_args.Req = Req
+	var _result EraseUserDataResult
+	if err = p.c.Call(ctx, "EraseUserData", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AdminListReturns(ctx context.Context, Req *order.AdminListReturnsReq) (r *order.AdminListReturnsResp, err error) {
+	var _args AdminListReturnsArgs
+	_args.Req = Req
+	var _result AdminListReturnsResult
+	if err = p.c.Call(ctx, "AdminListReturns", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ApproveReturn(ctx context.Context, Req *order.ApproveReturnReq) (r *order.ApproveReturnResp, err error) {
+	var _args ApproveReturnArgs
+	_args.Req = Req
+	var _result ApproveReturnResult
+	if err = p.c.Call(ctx, "ApproveReturn", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RejectReturn(ctx context.Context, Req *order.RejectReturnReq) (r *order.RejectReturnResp, err error) {
+	var _args RejectReturnArgs
+	_args.Req = Req
+	var _result RejectReturnResult
+	if err = p.c.Call(ctx, "RejectReturn", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ConfirmReturnReceived(ctx context.Context, Req *order.ConfirmReturnReceivedReq) (r *order.ConfirmReturnReceivedResp, err error) {
+	var _args ConfirmReturnReceivedArgs
+	_args.Req = Req
+	var _result ConfirmReturnReceivedResult
+	if err = p.c.Call(ctx, "ConfirmReturnReceived", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -89,6 +89,11 @@ func (x *ChargeReq) FastRead(buf []byte, _type int8, number int32) (offset int,
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -113,144 +118,603 @@ func (x *ChargeReq) fastReadField2(buf []byte, _type int8) (offset int, err erro
 	if err != nil {
 		return offset, err
 	}
-	x.CreditCard = &v
-	return offset, nil
+	x.CreditCard = &v
+	return offset, nil
+}
+
+func (x *ChargeReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ChargeReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *ChargeReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.CardToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ChargeResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ChargeResp[number], err)
+}
+
+func (x *ChargeResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RefundReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RefundReq[number], err)
+}
+
+func (x *RefundReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *RefundReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RefundReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RefundReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Amount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *RefundReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RefundReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.RefundKey, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RefundResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RefundResp[number], err)
+}
+
+func (x *RefundResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.RefundId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RefundResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.RefundedTotal, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *TokenizeCardReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_TokenizeCardReq[number], err)
+}
+
+func (x *TokenizeCardReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *TokenizeCardReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v CreditCardInfo
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.CreditCard = &v
+	return offset, nil
+}
+
+func (x *TokenizeCardResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_TokenizeCardResp[number], err)
+}
+
+func (x *TokenizeCardResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.CardToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ExportUserDataReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ExportUserDataReq[number], err)
+}
+
+func (x *ExportUserDataReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *ExportUserDataResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ExportUserDataResp[number], err)
+}
+
+func (x *ExportUserDataResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Data, offset, err = fastpb.ReadBytes(buf, _type)
+	return offset, err
+}
+
+func (x *EraseUserDataReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_EraseUserDataReq[number], err)
+}
+
+func (x *EraseUserDataReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *EraseUserDataResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+}
+
+func (x *CreditCardInfo) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *CreditCardInfo) fastWriteField1(buf []byte) (offset int) {
+	if x.CreditCardNumber == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetCreditCardNumber())
+	return offset
+}
+
+func (x *CreditCardInfo) fastWriteField2(buf []byte) (offset int) {
+	if x.CreditCardCvv == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetCreditCardCvv())
+	return offset
+}
+
+func (x *CreditCardInfo) fastWriteField3(buf []byte) (offset int) {
+	if x.CreditCardExpirationYear == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, x.GetCreditCardExpirationYear())
+	return offset
+}
+
+func (x *CreditCardInfo) fastWriteField4(buf []byte) (offset int) {
+	if x.CreditCardExpirationMonth == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 4, x.GetCreditCardExpirationMonth())
+	return offset
+}
+
+func (x *ChargeReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *ChargeReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 1, x.GetAmount())
+	return offset
+}
+
+func (x *ChargeReq) fastWriteField2(buf []byte) (offset int) {
+	if x.CreditCard == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetCreditCard())
+	return offset
+}
+
+func (x *ChargeReq) fastWriteField3(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetOrderId())
+	return offset
+}
+
+func (x *ChargeReq) fastWriteField4(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 4, x.GetUserId())
+	return offset
+}
+
+func (x *ChargeReq) fastWriteField5(buf []byte) (offset int) {
+	if x.CardToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetCardToken())
+	return offset
+}
+
+func (x *ChargeResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ChargeResp) fastWriteField1(buf []byte) (offset int) {
+	if x.TransactionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetTransactionId())
+	return offset
+}
+
+func (x *RefundReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	return offset
+}
+
+func (x *RefundReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *RefundReq) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	return offset
+}
+
+func (x *RefundReq) fastWriteField3(buf []byte) (offset int) {
+	if x.TransactionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetTransactionId())
+	return offset
+}
+
+func (x *RefundReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 4, x.GetAmount())
+	return offset
+}
+
+func (x *RefundReq) fastWriteField5(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetReason())
+	return offset
 }
 
-func (x *ChargeReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
-	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
-	return offset, err
+func (x *RefundReq) fastWriteField6(buf []byte) (offset int) {
+	if x.RefundKey == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetRefundKey())
+	return offset
 }
 
-func (x *ChargeReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
-	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
-	return offset, err
+func (x *RefundResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
 }
 
-func (x *ChargeResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
-	switch number {
-	case 1:
-		offset, err = x.fastReadField1(buf, _type)
-		if err != nil {
-			goto ReadFieldError
-		}
-	default:
-		offset, err = fastpb.Skip(buf, _type, number)
-		if err != nil {
-			goto SkipFieldError
-		}
+func (x *RefundResp) fastWriteField1(buf []byte) (offset int) {
+	if x.RefundId == "" {
+		return offset
 	}
-	return offset, nil
-SkipFieldError:
-	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
-ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ChargeResp[number], err)
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetRefundId())
+	return offset
 }
 
-func (x *ChargeResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
-	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
-	return offset, err
+func (x *RefundResp) fastWriteField2(buf []byte) (offset int) {
+	if x.RefundedTotal == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 2, x.GetRefundedTotal())
+	return offset
 }
 
-func (x *CreditCardInfo) FastWrite(buf []byte) (offset int) {
+func (x *TokenizeCardReq) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
 	}
 	offset += x.fastWriteField1(buf[offset:])
 	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
 	return offset
 }
 
-func (x *CreditCardInfo) fastWriteField1(buf []byte) (offset int) {
-	if x.CreditCardNumber == "" {
+func (x *TokenizeCardReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetCreditCardNumber())
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
 	return offset
 }
 
-func (x *CreditCardInfo) fastWriteField2(buf []byte) (offset int) {
-	if x.CreditCardCvv == 0 {
+func (x *TokenizeCardReq) fastWriteField2(buf []byte) (offset int) {
+	if x.CreditCard == nil {
 		return offset
 	}
-	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetCreditCardCvv())
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetCreditCard())
 	return offset
 }
 
-func (x *CreditCardInfo) fastWriteField3(buf []byte) (offset int) {
-	if x.CreditCardExpirationYear == 0 {
+func (x *TokenizeCardResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
 		return offset
 	}
-	offset += fastpb.WriteInt32(buf[offset:], 3, x.GetCreditCardExpirationYear())
+	offset += x.fastWriteField1(buf[offset:])
 	return offset
 }
 
-func (x *CreditCardInfo) fastWriteField4(buf []byte) (offset int) {
-	if x.CreditCardExpirationMonth == 0 {
+func (x *TokenizeCardResp) fastWriteField1(buf []byte) (offset int) {
+	if x.CardToken == "" {
 		return offset
 	}
-	offset += fastpb.WriteInt32(buf[offset:], 4, x.GetCreditCardExpirationMonth())
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetCardToken())
 	return offset
 }
 
-func (x *ChargeReq) FastWrite(buf []byte) (offset int) {
+func (x *ExportUserDataReq) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
 	}
 	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
 	return offset
 }
 
-func (x *ChargeReq) fastWriteField1(buf []byte) (offset int) {
-	if x.Amount == 0 {
+func (x *ExportUserDataReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
 		return offset
 	}
-	offset += fastpb.WriteFloat(buf[offset:], 1, x.GetAmount())
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
 	return offset
 }
 
-func (x *ChargeReq) fastWriteField2(buf []byte) (offset int) {
-	if x.CreditCard == nil {
+func (x *ExportUserDataResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
 		return offset
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetCreditCard())
+	offset += x.fastWriteField1(buf[offset:])
 	return offset
 }
 
-func (x *ChargeReq) fastWriteField3(buf []byte) (offset int) {
-	if x.OrderId == "" {
+func (x *ExportUserDataResp) fastWriteField1(buf []byte) (offset int) {
+	if len(x.Data) == 0 {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.GetOrderId())
+	offset += fastpb.WriteBytes(buf[offset:], 1, x.GetData())
 	return offset
 }
 
-func (x *ChargeReq) fastWriteField4(buf []byte) (offset int) {
-	if x.UserId == 0 {
+func (x *EraseUserDataReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
 		return offset
 	}
-	offset += fastpb.WriteUint32(buf[offset:], 4, x.GetUserId())
+	offset += x.fastWriteField1(buf[offset:])
 	return offset
 }
 
-func (x *ChargeResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
+func (x *EraseUserDataReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
 		return offset
 	}
-	offset += x.fastWriteField1(buf[offset:])
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
 	return offset
 }
 
-func (x *ChargeResp) fastWriteField1(buf []byte) (offset int) {
-	if x.TransactionId == "" {
+func (x *EraseUserDataResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetTransactionId())
 	return offset
 }
 
@@ -305,6 +769,7 @@ func (x *ChargeReq) Size() (n int) {
 	n += x.sizeField2()
 	n += x.sizeField3()
 	n += x.sizeField4()
+	n += x.sizeField5()
 	return n
 }
 
@@ -340,6 +805,14 @@ func (x *ChargeReq) sizeField4() (n int) {
 	return n
 }
 
+func (x *ChargeReq) sizeField5() (n int) {
+	if x.CardToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetCardToken())
+	return n
+}
+
 func (x *ChargeResp) Size() (n int) {
 	if x == nil {
 		return n
@@ -356,6 +829,188 @@ func (x *ChargeResp) sizeField1() (n int) {
 	return n
 }
 
+func (x *RefundReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	return n
+}
+
+func (x *RefundReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *RefundReq) sizeField2() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetOrderId())
+	return n
+}
+
+func (x *RefundReq) sizeField3() (n int) {
+	if x.TransactionId == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetTransactionId())
+	return n
+}
+
+func (x *RefundReq) sizeField4() (n int) {
+	if x.Amount == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(4, x.GetAmount())
+	return n
+}
+
+func (x *RefundReq) sizeField5() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetReason())
+	return n
+}
+
+func (x *RefundReq) sizeField6() (n int) {
+	if x.RefundKey == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetRefundKey())
+	return n
+}
+
+func (x *RefundResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *RefundResp) sizeField1() (n int) {
+	if x.RefundId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetRefundId())
+	return n
+}
+
+func (x *RefundResp) sizeField2() (n int) {
+	if x.RefundedTotal == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(2, x.GetRefundedTotal())
+	return n
+}
+
+func (x *TokenizeCardReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *TokenizeCardReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *TokenizeCardReq) sizeField2() (n int) {
+	if x.CreditCard == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetCreditCard())
+	return n
+}
+
+func (x *TokenizeCardResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *TokenizeCardResp) sizeField1() (n int) {
+	if x.CardToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetCardToken())
+	return n
+}
+
+func (x *ExportUserDataReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ExportUserDataReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *ExportUserDataResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ExportUserDataResp) sizeField1() (n int) {
+	if len(x.Data) == 0 {
+		return n
+	}
+	n += fastpb.SizeBytes(1, x.GetData())
+	return n
+}
+
+func (x *EraseUserDataReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *EraseUserDataReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *EraseUserDataResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	return n
+}
+
 var fieldIDToName_CreditCardInfo = map[int32]string{
 	1: "CreditCardNumber",
 	2: "CreditCardCvv",
@@ -368,8 +1023,46 @@ var fieldIDToName_ChargeReq = map[int32]string{
 	2: "CreditCard",
 	3: "OrderId",
 	4: "UserId",
+	5: "CardToken",
 }
 
 var fieldIDToName_ChargeResp = map[int32]string{
 	1: "TransactionId",
 }
+
+var fieldIDToName_RefundReq = map[int32]string{
+	1: "UserId",
+	2: "OrderId",
+	3: "TransactionId",
+	4: "Amount",
+	5: "Reason",
+	6: "RefundKey",
+}
+
+var fieldIDToName_RefundResp = map[int32]string{
+	1: "RefundId",
+	2: "RefundedTotal",
+}
+
+var fieldIDToName_TokenizeCardReq = map[int32]string{
+	1: "UserId",
+	2: "CreditCard",
+}
+
+var fieldIDToName_TokenizeCardResp = map[int32]string{
+	1: "CardToken",
+}
+
+var fieldIDToName_ExportUserDataReq = map[int32]string{
+	1: "UserId",
+}
+
+var fieldIDToName_ExportUserDataResp = map[int32]string{
+	1: "Data",
+}
+
+var fieldIDToName_EraseUserDataReq = map[int32]string{
+	1: "UserId",
+}
+
+var fieldIDToName_EraseUserDataResp = map[int32]string{}
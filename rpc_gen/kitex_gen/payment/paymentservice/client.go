@@ -12,6 +12,10 @@ import (
 // Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
 type Client interface {
 	Charge(ctx context.Context, Req *payment.ChargeReq, callOptions ...callopt.Option) (r *payment.ChargeResp, err error)
+	Refund(ctx context.Context, Req *payment.RefundReq, callOptions ...callopt.Option) (r *payment.RefundResp, err error)
+	TokenizeCard(ctx context.Context, Req *payment.TokenizeCardReq, callOptions ...callopt.Option) (r *payment.TokenizeCardResp, err error)
+	ExportUserData(ctx context.Context, Req *payment.ExportUserDataReq, callOptions ...callopt.Option) (r *payment.ExportUserDataResp, err error)
+	EraseUserData(ctx context.Context, Req *payment.EraseUserDataReq, callOptions ...callopt.Option) (r *payment.EraseUserDataResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -47,3 +51,23 @@ func (p *kPaymentServiceClient) Charge(ctx context.Context, Req *payment.ChargeR
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.Charge(ctx, Req)
 }
+
+func (p *kPaymentServiceClient) Refund(ctx context.Context, Req *payment.RefundReq, callOptions ...callopt.Option) (r *payment.RefundResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Refund(ctx, Req)
+}
+
+func (p *kPaymentServiceClient) TokenizeCard(ctx context.Context, Req *payment.TokenizeCardReq, callOptions ...callopt.Option) (r *payment.TokenizeCardResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TokenizeCard(ctx, Req)
+}
+
+func (p *kPaymentServiceClient) ExportUserData(ctx context.Context, Req *payment.ExportUserDataReq, callOptions ...callopt.Option) (r *payment.ExportUserDataResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportUserData(ctx, Req)
+}
+
+func (p *kPaymentServiceClient) EraseUserData(ctx context.Context, Req *payment.EraseUserDataReq, callOptions ...callopt.Option) (r *payment.EraseUserDataResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EraseUserData(ctx, Req)
+}
@@ -21,7 +21,11 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "PaymentService"
 	handlerType := (*payment.PaymentService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"Charge": kitex.NewMethodInfo(chargeHandler, newChargeArgs, newChargeResult, false),
+		"Charge":         kitex.NewMethodInfo(chargeHandler, newChargeArgs, newChargeResult, false),
+		"Refund":         kitex.NewMethodInfo(refundHandler, newRefundArgs, newRefundResult, false),
+		"TokenizeCard":   kitex.NewMethodInfo(tokenizeCardHandler, newTokenizeCardArgs, newTokenizeCardResult, false),
+		"ExportUserData": kitex.NewMethodInfo(exportUserDataHandler, newExportUserDataArgs, newExportUserDataResult, false),
+		"EraseUserData":  kitex.NewMethodInfo(eraseUserDataHandler, newEraseUserDataArgs, newEraseUserDataResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "payment",
@@ -191,6 +195,618 @@ func (p *ChargeResult) GetResult() interface{} {
 	return p.Success
 }
 
+func refundHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(payment.RefundReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(payment.PaymentService).Refund(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *RefundArgs:
+		success, err := handler.(payment.PaymentService).Refund(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RefundResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newRefundArgs() interface{} {
+	return &RefundArgs{}
+}
+
+func newRefundResult() interface{} {
+	return &RefundResult{}
+}
+
+type RefundArgs struct {
+	Req *payment.RefundReq
+}
+
+func (p *RefundArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(payment.RefundReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RefundArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RefundArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RefundArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RefundArgs) Unmarshal(in []byte) error {
+	msg := new(payment.RefundReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RefundArgs_Req_DEFAULT *payment.RefundReq
+
+func (p *RefundArgs) GetReq() *payment.RefundReq {
+	if !p.IsSetReq() {
+		return RefundArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RefundArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RefundArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RefundResult struct {
+	Success *payment.RefundResp
+}
+
+var RefundResult_Success_DEFAULT *payment.RefundResp
+
+func (p *RefundResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(payment.RefundResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RefundResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RefundResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RefundResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RefundResult) Unmarshal(in []byte) error {
+	msg := new(payment.RefundResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RefundResult) GetSuccess() *payment.RefundResp {
+	if !p.IsSetSuccess() {
+		return RefundResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RefundResult) SetSuccess(x interface{}) {
+	p.Success = x.(*payment.RefundResp)
+}
+
+func (p *RefundResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RefundResult) GetResult() interface{} {
+	return p.Success
+}
+
+func tokenizeCardHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(payment.TokenizeCardReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(payment.PaymentService).TokenizeCard(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *TokenizeCardArgs:
+		success, err := handler.(payment.PaymentService).TokenizeCard(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*TokenizeCardResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newTokenizeCardArgs() interface{} {
+	return &TokenizeCardArgs{}
+}
+
+func newTokenizeCardResult() interface{} {
+	return &TokenizeCardResult{}
+}
+
+type TokenizeCardArgs struct {
+	Req *payment.TokenizeCardReq
+}
+
+func (p *TokenizeCardArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(payment.TokenizeCardReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *TokenizeCardArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *TokenizeCardArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *TokenizeCardArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *TokenizeCardArgs) Unmarshal(in []byte) error {
+	msg := new(payment.TokenizeCardReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var TokenizeCardArgs_Req_DEFAULT *payment.TokenizeCardReq
+
+func (p *TokenizeCardArgs) GetReq() *payment.TokenizeCardReq {
+	if !p.IsSetReq() {
+		return TokenizeCardArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *TokenizeCardArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TokenizeCardArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type TokenizeCardResult struct {
+	Success *payment.TokenizeCardResp
+}
+
+var TokenizeCardResult_Success_DEFAULT *payment.TokenizeCardResp
+
+func (p *TokenizeCardResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(payment.TokenizeCardResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *TokenizeCardResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *TokenizeCardResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *TokenizeCardResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *TokenizeCardResult) Unmarshal(in []byte) error {
+	msg := new(payment.TokenizeCardResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *TokenizeCardResult) GetSuccess() *payment.TokenizeCardResp {
+	if !p.IsSetSuccess() {
+		return TokenizeCardResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *TokenizeCardResult) SetSuccess(x interface{}) {
+	p.Success = x.(*payment.TokenizeCardResp)
+}
+
+func (p *TokenizeCardResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TokenizeCardResult) GetResult() interface{} {
+	return p.Success
+}
+
+func exportUserDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(payment.ExportUserDataReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(payment.PaymentService).ExportUserData(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ExportUserDataArgs:
+		success, err := handler.(payment.PaymentService).ExportUserData(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ExportUserDataResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newExportUserDataArgs() interface{} {
+	return &ExportUserDataArgs{}
+}
+
+func newExportUserDataResult() interface{} {
+	return &ExportUserDataResult{}
+}
+
+type ExportUserDataArgs struct {
+	Req *payment.ExportUserDataReq
+}
+
+func (p *ExportUserDataArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(payment.ExportUserDataReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ExportUserDataArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ExportUserDataArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ExportUserDataArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ExportUserDataArgs) Unmarshal(in []byte) error {
+	msg := new(payment.ExportUserDataReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ExportUserDataArgs_Req_DEFAULT *payment.ExportUserDataReq
+
+func (p *ExportUserDataArgs) GetReq() *payment.ExportUserDataReq {
+	if !p.IsSetReq() {
+		return ExportUserDataArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ExportUserDataArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ExportUserDataArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ExportUserDataResult struct {
+	Success *payment.ExportUserDataResp
+}
+
+var ExportUserDataResult_Success_DEFAULT *payment.ExportUserDataResp
+
+func (p *ExportUserDataResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(payment.ExportUserDataResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ExportUserDataResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ExportUserDataResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ExportUserDataResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ExportUserDataResult) Unmarshal(in []byte) error {
+	msg := new(payment.ExportUserDataResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ExportUserDataResult) GetSuccess() *payment.ExportUserDataResp {
+	if !p.IsSetSuccess() {
+		return ExportUserDataResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ExportUserDataResult) SetSuccess(x interface{}) {
+	p.Success = x.(*payment.ExportUserDataResp)
+}
+
+func (p *ExportUserDataResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ExportUserDataResult) GetResult() interface{} {
+	return p.Success
+}
+
+func eraseUserDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(payment.EraseUserDataReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(payment.PaymentService).EraseUserData(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *EraseUserDataArgs:
+		success, err := handler.(payment.PaymentService).EraseUserData(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*EraseUserDataResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newEraseUserDataArgs() interface{} {
+	return &EraseUserDataArgs{}
+}
+
+func newEraseUserDataResult() interface{} {
+	return &EraseUserDataResult{}
+}
+
+type EraseUserDataArgs struct {
+	Req *payment.EraseUserDataReq
+}
+
+func (p *EraseUserDataArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(payment.EraseUserDataReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *EraseUserDataArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *EraseUserDataArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *EraseUserDataArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *EraseUserDataArgs) Unmarshal(in []byte) error {
+	msg := new(payment.EraseUserDataReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var EraseUserDataArgs_Req_DEFAULT *payment.EraseUserDataReq
+
+func (p *EraseUserDataArgs) GetReq() *payment.EraseUserDataReq {
+	if !p.IsSetReq() {
+		return EraseUserDataArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *EraseUserDataArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *EraseUserDataArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type EraseUserDataResult struct {
+	Success *payment.EraseUserDataResp
+}
+
+var EraseUserDataResult_Success_DEFAULT *payment.EraseUserDataResp
+
+func (p *EraseUserDataResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(payment.EraseUserDataResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *EraseUserDataResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *EraseUserDataResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *EraseUserDataResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *EraseUserDataResult) Unmarshal(in []byte) error {
+	msg := new(payment.EraseUserDataResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *EraseUserDataResult) GetSuccess() *payment.EraseUserDataResp {
+	if !p.IsSetSuccess() {
+		return EraseUserDataResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *EraseUserDataResult) SetSuccess(x interface{}) {
+	p.Success = x.(*payment.EraseUserDataResp)
+}
+
+func (p *EraseUserDataResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *EraseUserDataResult) GetResult() interface{} {
+	return p.Success
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -210,3 +826,43 @@ func (p *kClient) Charge(ctx context.Context, Req *payment.ChargeReq) (r *paymen
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) Refund(ctx context.Context, Req *payment.RefundReq) (r *payment.RefundResp, err error) {
+	var _args RefundArgs
+	_args.Req = Req
+	var _result RefundResult
+	if err = p.c.Call(ctx, "Refund", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TokenizeCard(ctx context.Context, Req *payment.TokenizeCardReq) (r *payment.TokenizeCardResp, err error) {
+	var _args TokenizeCardArgs
+	_args.Req = Req
+	var _result TokenizeCardResult
+	if err = p.c.Call(ctx, "TokenizeCard", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ExportUserData(ctx context.Context, Req *payment.ExportUserDataReq) (r *payment.ExportUserDataResp, err error) {
+	var _args ExportUserDataArgs
+	_args.Req = Req
+	var _result ExportUserDataResult
+	if err = p.c.Call(ctx, "ExportUserData", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EraseUserData(ctx context.Context, Req *payment.EraseUserDataReq) (r *payment.EraseUserDataResp, err error) {
+	var _args EraseUserDataArgs
+	_args.Req = Req
+	var _result EraseUserDataResult
+	if err = p.c.Call(ctx, "EraseUserData", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -89,6 +89,16 @@ func (x *Product) FastRead(buf []byte, _type int8, number int32) (offset int, er
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -137,6 +147,16 @@ func (x *Product) fastReadField6(buf []byte, _type int8) (offset int, err error)
 	return offset, err
 }
 
+func (x *Product) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Weight, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Product) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.TaxCategory, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
 func (x *ListProductsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -277,6 +297,326 @@ func (x *SearchProductsResp) fastReadField1(buf []byte, _type int8) (offset int,
 	return offset, nil
 }
 
+func (x *SuggestProductsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SuggestProductsReq[number], err)
+}
+
+func (x *SuggestProductsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Prefix, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *SuggestProductsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Limit, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *Suggestion) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Suggestion[number], err)
+}
+
+func (x *Suggestion) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Text, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Suggestion) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Kind, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Suggestion) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *SuggestProductsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SuggestProductsResp[number], err)
+}
+
+func (x *SuggestProductsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Suggestion
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Suggestions = append(x.Suggestions, &v)
+	return offset, nil
+}
+
+func (x *PriceHistoryEntry) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_PriceHistoryEntry[number], err)
+}
+
+func (x *PriceHistoryEntry) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Price, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *PriceHistoryEntry) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.ChangedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *PriceHistoryEntry) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetPriceHistoryReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetPriceHistoryReq[number], err)
+}
+
+func (x *GetPriceHistoryReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *GetPriceHistoryReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Limit, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *GetPriceHistoryResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetPriceHistoryResp[number], err)
+}
+
+func (x *GetPriceHistoryResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v PriceHistoryEntry
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Entries = append(x.Entries, &v)
+	return offset, nil
+}
+
+func (x *GetPriceHistoryResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.RegularPrice, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *SchedulePriceChangeReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SchedulePriceChangeReq[number], err)
+}
+
+func (x *SchedulePriceChangeReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *SchedulePriceChangeReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *SchedulePriceChangeReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Price, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *SchedulePriceChangeReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.StartAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *SchedulePriceChangeReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.EndAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *SchedulePriceChangeResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SchedulePriceChangeResp[number], err)
+}
+
+func (x *SchedulePriceChangeResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.RuleId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
 func (x *ListProductsReq) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
@@ -321,6 +661,8 @@ func (x *Product) FastWrite(buf []byte) (offset int) {
 	offset += x.fastWriteField4(buf[offset:])
 	offset += x.fastWriteField5(buf[offset:])
 	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
 	return offset
 }
 
@@ -328,119 +670,368 @@ func (x *Product) fastWriteField1(buf []byte) (offset int) {
 	if x.Id == 0 {
 		return offset
 	}
-	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetId())
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *Product) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
+	return offset
+}
+
+func (x *Product) fastWriteField3(buf []byte) (offset int) {
+	if x.Description == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetDescription())
+	return offset
+}
+
+func (x *Product) fastWriteField4(buf []byte) (offset int) {
+	if x.Picture == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetPicture())
+	return offset
+}
+
+func (x *Product) fastWriteField5(buf []byte) (offset int) {
+	if x.Price == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 5, x.GetPrice())
+	return offset
+}
+
+func (x *Product) fastWriteField6(buf []byte) (offset int) {
+	if len(x.Categories) == 0 {
+		return offset
+	}
+	for i := range x.GetCategories() {
+		offset += fastpb.WriteString(buf[offset:], 6, x.GetCategories()[i])
+	}
+	return offset
+}
+
+func (x *Product) fastWriteField7(buf []byte) (offset int) {
+	if x.Weight == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 7, x.GetWeight())
+	return offset
+}
+
+func (x *Product) fastWriteField8(buf []byte) (offset int) {
+	if x.TaxCategory == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetTaxCategory())
+	return offset
+}
+
+func (x *ListProductsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListProductsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Products == nil {
+		return offset
+	}
+	for i := range x.GetProducts() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetProducts()[i])
+	}
+	return offset
+}
+
+func (x *GetProductReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *GetProductReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *GetProductResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *GetProductResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Product == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetProduct())
+	return offset
+}
+
+func (x *SearchProductsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *SearchProductsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Query == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetQuery())
+	return offset
+}
+
+func (x *SearchProductsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *SearchProductsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Results == nil {
+		return offset
+	}
+	for i := range x.GetResults() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetResults()[i])
+	}
+	return offset
+}
+
+func (x *SuggestProductsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *SuggestProductsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Prefix == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetPrefix())
+	return offset
+}
+
+func (x *SuggestProductsReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Limit == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetLimit())
+	return offset
+}
+
+func (x *Suggestion) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *Suggestion) fastWriteField1(buf []byte) (offset int) {
+	if x.Text == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetText())
+	return offset
+}
+
+func (x *Suggestion) fastWriteField2(buf []byte) (offset int) {
+	if x.Kind == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetKind())
+	return offset
+}
+
+func (x *Suggestion) fastWriteField3(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 3, x.GetProductId())
+	return offset
+}
+
+func (x *SuggestProductsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *SuggestProductsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Suggestions == nil {
+		return offset
+	}
+	for i := range x.GetSuggestions() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetSuggestions()[i])
+	}
+	return offset
+}
+
+func (x *PriceHistoryEntry) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *PriceHistoryEntry) fastWriteField1(buf []byte) (offset int) {
+	if x.Price == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 1, x.GetPrice())
 	return offset
 }
 
-func (x *Product) fastWriteField2(buf []byte) (offset int) {
-	if x.Name == "" {
+func (x *PriceHistoryEntry) fastWriteField2(buf []byte) (offset int) {
+	if x.ChangedAt == 0 {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetChangedAt())
 	return offset
 }
 
-func (x *Product) fastWriteField3(buf []byte) (offset int) {
-	if x.Description == "" {
+func (x *PriceHistoryEntry) fastWriteField3(buf []byte) (offset int) {
+	if x.Reason == "" {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.GetDescription())
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetReason())
 	return offset
 }
 
-func (x *Product) fastWriteField4(buf []byte) (offset int) {
-	if x.Picture == "" {
+func (x *GetPriceHistoryReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.GetPicture())
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
 	return offset
 }
 
-func (x *Product) fastWriteField5(buf []byte) (offset int) {
-	if x.Price == 0 {
+func (x *GetPriceHistoryReq) fastWriteField1(buf []byte) (offset int) {
+	if x.ProductId == 0 {
 		return offset
 	}
-	offset += fastpb.WriteFloat(buf[offset:], 5, x.GetPrice())
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetProductId())
 	return offset
 }
 
-func (x *Product) fastWriteField6(buf []byte) (offset int) {
-	if len(x.Categories) == 0 {
+func (x *GetPriceHistoryReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Limit == 0 {
 		return offset
 	}
-	for i := range x.GetCategories() {
-		offset += fastpb.WriteString(buf[offset:], 6, x.GetCategories()[i])
-	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetLimit())
 	return offset
 }
 
-func (x *ListProductsResp) FastWrite(buf []byte) (offset int) {
+func (x *GetPriceHistoryResp) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
 	}
 	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
 	return offset
 }
 
-func (x *ListProductsResp) fastWriteField1(buf []byte) (offset int) {
-	if x.Products == nil {
+func (x *GetPriceHistoryResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Entries == nil {
 		return offset
 	}
-	for i := range x.GetProducts() {
-		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetProducts()[i])
+	for i := range x.GetEntries() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetEntries()[i])
 	}
 	return offset
 }
 
-func (x *GetProductReq) FastWrite(buf []byte) (offset int) {
+func (x *GetPriceHistoryResp) fastWriteField2(buf []byte) (offset int) {
+	if x.RegularPrice == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 2, x.GetRegularPrice())
+	return offset
+}
+
+func (x *SchedulePriceChangeReq) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
 	}
 	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
 	return offset
 }
 
-func (x *GetProductReq) fastWriteField1(buf []byte) (offset int) {
-	if x.Id == 0 {
+func (x *SchedulePriceChangeReq) fastWriteField1(buf []byte) (offset int) {
+	if x.ProductId == 0 {
 		return offset
 	}
-	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetId())
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetProductId())
 	return offset
 }
 
-func (x *GetProductResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
+func (x *SchedulePriceChangeReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
 		return offset
 	}
-	offset += x.fastWriteField1(buf[offset:])
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
 	return offset
 }
 
-func (x *GetProductResp) fastWriteField1(buf []byte) (offset int) {
-	if x.Product == nil {
+func (x *SchedulePriceChangeReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Price == 0 {
 		return offset
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetProduct())
+	offset += fastpb.WriteFloat(buf[offset:], 3, x.GetPrice())
 	return offset
 }
 
-func (x *SearchProductsReq) FastWrite(buf []byte) (offset int) {
-	if x == nil {
+func (x *SchedulePriceChangeReq) fastWriteField4(buf []byte) (offset int) {
+	if x.StartAt == 0 {
 		return offset
 	}
-	offset += x.fastWriteField1(buf[offset:])
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.GetStartAt())
 	return offset
 }
 
-func (x *SearchProductsReq) fastWriteField1(buf []byte) (offset int) {
-	if x.Query == "" {
+func (x *SchedulePriceChangeReq) fastWriteField5(buf []byte) (offset int) {
+	if x.EndAt == 0 {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetQuery())
+	offset += fastpb.WriteInt64(buf[offset:], 5, x.GetEndAt())
 	return offset
 }
 
-func (x *SearchProductsResp) FastWrite(buf []byte) (offset int) {
+func (x *SchedulePriceChangeResp) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
 	}
@@ -448,13 +1039,11 @@ func (x *SearchProductsResp) FastWrite(buf []byte) (offset int) {
 	return offset
 }
 
-func (x *SearchProductsResp) fastWriteField1(buf []byte) (offset int) {
-	if x.Results == nil {
+func (x *SchedulePriceChangeResp) fastWriteField1(buf []byte) (offset int) {
+	if x.RuleId == 0 {
 		return offset
 	}
-	for i := range x.GetResults() {
-		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetResults()[i])
-	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetRuleId())
 	return offset
 }
 
@@ -502,6 +1091,8 @@ func (x *Product) Size() (n int) {
 	n += x.sizeField4()
 	n += x.sizeField5()
 	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
 	return n
 }
 
@@ -555,6 +1146,22 @@ func (x *Product) sizeField6() (n int) {
 	return n
 }
 
+func (x *Product) sizeField7() (n int) {
+	if x.Weight == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(7, x.GetWeight())
+	return n
+}
+
+func (x *Product) sizeField8() (n int) {
+	if x.TaxCategory == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetTaxCategory())
+	return n
+}
+
 func (x *ListProductsResp) Size() (n int) {
 	if x == nil {
 		return n
@@ -639,6 +1246,237 @@ func (x *SearchProductsResp) sizeField1() (n int) {
 	return n
 }
 
+func (x *SuggestProductsReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *SuggestProductsReq) sizeField1() (n int) {
+	if x.Prefix == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetPrefix())
+	return n
+}
+
+func (x *SuggestProductsReq) sizeField2() (n int) {
+	if x.Limit == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetLimit())
+	return n
+}
+
+func (x *Suggestion) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *Suggestion) sizeField1() (n int) {
+	if x.Text == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetText())
+	return n
+}
+
+func (x *Suggestion) sizeField2() (n int) {
+	if x.Kind == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetKind())
+	return n
+}
+
+func (x *Suggestion) sizeField3() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(3, x.GetProductId())
+	return n
+}
+
+func (x *SuggestProductsResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *SuggestProductsResp) sizeField1() (n int) {
+	if x.Suggestions == nil {
+		return n
+	}
+	for i := range x.GetSuggestions() {
+		n += fastpb.SizeMessage(1, x.GetSuggestions()[i])
+	}
+	return n
+}
+
+func (x *PriceHistoryEntry) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *PriceHistoryEntry) sizeField1() (n int) {
+	if x.Price == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(1, x.GetPrice())
+	return n
+}
+
+func (x *PriceHistoryEntry) sizeField2() (n int) {
+	if x.ChangedAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetChangedAt())
+	return n
+}
+
+func (x *PriceHistoryEntry) sizeField3() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetReason())
+	return n
+}
+
+func (x *GetPriceHistoryReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *GetPriceHistoryReq) sizeField1() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetProductId())
+	return n
+}
+
+func (x *GetPriceHistoryReq) sizeField2() (n int) {
+	if x.Limit == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetLimit())
+	return n
+}
+
+func (x *GetPriceHistoryResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *GetPriceHistoryResp) sizeField1() (n int) {
+	if x.Entries == nil {
+		return n
+	}
+	for i := range x.GetEntries() {
+		n += fastpb.SizeMessage(1, x.GetEntries()[i])
+	}
+	return n
+}
+
+func (x *GetPriceHistoryResp) sizeField2() (n int) {
+	if x.RegularPrice == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(2, x.GetRegularPrice())
+	return n
+}
+
+func (x *SchedulePriceChangeReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *SchedulePriceChangeReq) sizeField1() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetProductId())
+	return n
+}
+
+func (x *SchedulePriceChangeReq) sizeField2() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetName())
+	return n
+}
+
+func (x *SchedulePriceChangeReq) sizeField3() (n int) {
+	if x.Price == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(3, x.GetPrice())
+	return n
+}
+
+func (x *SchedulePriceChangeReq) sizeField4() (n int) {
+	if x.StartAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(4, x.GetStartAt())
+	return n
+}
+
+func (x *SchedulePriceChangeReq) sizeField5() (n int) {
+	if x.EndAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(5, x.GetEndAt())
+	return n
+}
+
+func (x *SchedulePriceChangeResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *SchedulePriceChangeResp) sizeField1() (n int) {
+	if x.RuleId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetRuleId())
+	return n
+}
+
 var fieldIDToName_ListProductsReq = map[int32]string{
 	1: "Page",
 	2: "PageSize",
@@ -652,6 +1490,8 @@ var fieldIDToName_Product = map[int32]string{
 	4: "Picture",
 	5: "Price",
 	6: "Categories",
+	7: "Weight",
+	8: "TaxCategory",
 }
 
 var fieldIDToName_ListProductsResp = map[int32]string{
@@ -673,3 +1513,46 @@ var fieldIDToName_SearchProductsReq = map[int32]string{
 var fieldIDToName_SearchProductsResp = map[int32]string{
 	1: "Results",
 }
+
+var fieldIDToName_SuggestProductsReq = map[int32]string{
+	1: "Prefix",
+	2: "Limit",
+}
+
+var fieldIDToName_Suggestion = map[int32]string{
+	1: "Text",
+	2: "Kind",
+	3: "ProductId",
+}
+
+var fieldIDToName_SuggestProductsResp = map[int32]string{
+	1: "Suggestions",
+}
+
+var fieldIDToName_PriceHistoryEntry = map[int32]string{
+	1: "Price",
+	2: "ChangedAt",
+	3: "Reason",
+}
+
+var fieldIDToName_GetPriceHistoryReq = map[int32]string{
+	1: "ProductId",
+	2: "Limit",
+}
+
+var fieldIDToName_GetPriceHistoryResp = map[int32]string{
+	1: "Entries",
+	2: "RegularPrice",
+}
+
+var fieldIDToName_SchedulePriceChangeReq = map[int32]string{
+	1: "ProductId",
+	2: "Name",
+	3: "Price",
+	4: "StartAt",
+	5: "EndAt",
+}
+
+var fieldIDToName_SchedulePriceChangeResp = map[int32]string{
+	1: "RuleId",
+}
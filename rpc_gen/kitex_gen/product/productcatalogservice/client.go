@@ -14,6 +14,9 @@ type Client interface {
 	ListProducts(ctx context.Context, Req *product.ListProductsReq, callOptions ...callopt.Option) (r *product.ListProductsResp, err error)
 	GetProduct(ctx context.Context, Req *product.GetProductReq, callOptions ...callopt.Option) (r *product.GetProductResp, err error)
 	SearchProducts(ctx context.Context, Req *product.SearchProductsReq, callOptions ...callopt.Option) (r *product.SearchProductsResp, err error)
+	SuggestProducts(ctx context.Context, Req *product.SuggestProductsReq, callOptions ...callopt.Option) (r *product.SuggestProductsResp, err error)
+	GetPriceHistory(ctx context.Context, Req *product.GetPriceHistoryReq, callOptions ...callopt.Option) (r *product.GetPriceHistoryResp, err error)
+	SchedulePriceChange(ctx context.Context, Req *product.SchedulePriceChangeReq, callOptions ...callopt.Option) (r *product.SchedulePriceChangeResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -59,3 +62,18 @@ func (p *kProductCatalogServiceClient) SearchProducts(ctx context.Context, Req *
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.SearchProducts(ctx, Req)
 }
+
+func (p *kProductCatalogServiceClient) SuggestProducts(ctx context.Context, Req *product.SuggestProductsReq, callOptions ...callopt.Option) (r *product.SuggestProductsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SuggestProducts(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) GetPriceHistory(ctx context.Context, Req *product.GetPriceHistoryReq, callOptions ...callopt.Option) (r *product.GetPriceHistoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPriceHistory(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) SchedulePriceChange(ctx context.Context, Req *product.SchedulePriceChangeReq, callOptions ...callopt.Option) (r *product.SchedulePriceChangeResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SchedulePriceChange(ctx, Req)
+}
@@ -21,9 +21,12 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "ProductCatalogService"
 	handlerType := (*product.ProductCatalogService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"ListProducts":   kitex.NewMethodInfo(listProductsHandler, newListProductsArgs, newListProductsResult, false),
-		"GetProduct":     kitex.NewMethodInfo(getProductHandler, newGetProductArgs, newGetProductResult, false),
-		"SearchProducts": kitex.NewMethodInfo(searchProductsHandler, newSearchProductsArgs, newSearchProductsResult, false),
+		"ListProducts":        kitex.NewMethodInfo(listProductsHandler, newListProductsArgs, newListProductsResult, false),
+		"GetProduct":          kitex.NewMethodInfo(getProductHandler, newGetProductArgs, newGetProductResult, false),
+		"SearchProducts":      kitex.NewMethodInfo(searchProductsHandler, newSearchProductsArgs, newSearchProductsResult, false),
+		"SuggestProducts":     kitex.NewMethodInfo(suggestProductsHandler, newSuggestProductsArgs, newSuggestProductsResult, false),
+		"GetPriceHistory":     kitex.NewMethodInfo(getPriceHistoryHandler, newGetPriceHistoryArgs, newGetPriceHistoryResult, false),
+		"SchedulePriceChange": kitex.NewMethodInfo(schedulePriceChangeHandler, newSchedulePriceChangeArgs, newSchedulePriceChangeResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "product",
@@ -499,6 +502,465 @@ func (p *SearchProductsResult) GetResult() interface{} {
 	return p.Success
 }
 
+func suggestProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.SuggestProductsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).SuggestProducts(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *SuggestProductsArgs:
+		success, err := handler.(product.ProductCatalogService).SuggestProducts(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SuggestProductsResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newSuggestProductsArgs() interface{} {
+	return &SuggestProductsArgs{}
+}
+
+func newSuggestProductsResult() interface{} {
+	return &SuggestProductsResult{}
+}
+
+type SuggestProductsArgs struct {
+	Req *product.SuggestProductsReq
+}
+
+func (p *SuggestProductsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.SuggestProductsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *SuggestProductsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *SuggestProductsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *SuggestProductsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SuggestProductsArgs) Unmarshal(in []byte) error {
+	msg := new(product.SuggestProductsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SuggestProductsArgs_Req_DEFAULT *product.SuggestProductsReq
+
+func (p *SuggestProductsArgs) GetReq() *product.SuggestProductsReq {
+	if !p.IsSetReq() {
+		return SuggestProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SuggestProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SuggestProductsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SuggestProductsResult struct {
+	Success *product.SuggestProductsResp
+}
+
+var SuggestProductsResult_Success_DEFAULT *product.SuggestProductsResp
+
+func (p *SuggestProductsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.SuggestProductsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *SuggestProductsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *SuggestProductsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *SuggestProductsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SuggestProductsResult) Unmarshal(in []byte) error {
+	msg := new(product.SuggestProductsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SuggestProductsResult) GetSuccess() *product.SuggestProductsResp {
+	if !p.IsSetSuccess() {
+		return SuggestProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SuggestProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.SuggestProductsResp)
+}
+
+func (p *SuggestProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SuggestProductsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getPriceHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.GetPriceHistoryReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).GetPriceHistory(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GetPriceHistoryArgs:
+		success, err := handler.(product.ProductCatalogService).GetPriceHistory(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetPriceHistoryResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGetPriceHistoryArgs() interface{} {
+	return &GetPriceHistoryArgs{}
+}
+
+func newGetPriceHistoryResult() interface{} {
+	return &GetPriceHistoryResult{}
+}
+
+type GetPriceHistoryArgs struct {
+	Req *product.GetPriceHistoryReq
+}
+
+func (p *GetPriceHistoryArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.GetPriceHistoryReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetPriceHistoryArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetPriceHistoryArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetPriceHistoryArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetPriceHistoryArgs) Unmarshal(in []byte) error {
+	msg := new(product.GetPriceHistoryReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetPriceHistoryArgs_Req_DEFAULT *product.GetPriceHistoryReq
+
+func (p *GetPriceHistoryArgs) GetReq() *product.GetPriceHistoryReq {
+	if !p.IsSetReq() {
+		return GetPriceHistoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetPriceHistoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetPriceHistoryArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetPriceHistoryResult struct {
+	Success *product.GetPriceHistoryResp
+}
+
+var GetPriceHistoryResult_Success_DEFAULT *product.GetPriceHistoryResp
+
+func (p *GetPriceHistoryResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.GetPriceHistoryResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetPriceHistoryResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetPriceHistoryResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetPriceHistoryResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetPriceHistoryResult) Unmarshal(in []byte) error {
+	msg := new(product.GetPriceHistoryResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetPriceHistoryResult) GetSuccess() *product.GetPriceHistoryResp {
+	if !p.IsSetSuccess() {
+		return GetPriceHistoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetPriceHistoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.GetPriceHistoryResp)
+}
+
+func (p *GetPriceHistoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetPriceHistoryResult) GetResult() interface{} {
+	return p.Success
+}
+
+func schedulePriceChangeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.SchedulePriceChangeReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).SchedulePriceChange(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *SchedulePriceChangeArgs:
+		success, err := handler.(product.ProductCatalogService).SchedulePriceChange(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SchedulePriceChangeResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newSchedulePriceChangeArgs() interface{} {
+	return &SchedulePriceChangeArgs{}
+}
+
+func newSchedulePriceChangeResult() interface{} {
+	return &SchedulePriceChangeResult{}
+}
+
+type SchedulePriceChangeArgs struct {
+	Req *product.SchedulePriceChangeReq
+}
+
+func (p *SchedulePriceChangeArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.SchedulePriceChangeReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *SchedulePriceChangeArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *SchedulePriceChangeArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *SchedulePriceChangeArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SchedulePriceChangeArgs) Unmarshal(in []byte) error {
+	msg := new(product.SchedulePriceChangeReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SchedulePriceChangeArgs_Req_DEFAULT *product.SchedulePriceChangeReq
+
+func (p *SchedulePriceChangeArgs) GetReq() *product.SchedulePriceChangeReq {
+	if !p.IsSetReq() {
+		return SchedulePriceChangeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SchedulePriceChangeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SchedulePriceChangeArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SchedulePriceChangeResult struct {
+	Success *product.SchedulePriceChangeResp
+}
+
+var SchedulePriceChangeResult_Success_DEFAULT *product.SchedulePriceChangeResp
+
+func (p *SchedulePriceChangeResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.SchedulePriceChangeResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *SchedulePriceChangeResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *SchedulePriceChangeResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *SchedulePriceChangeResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SchedulePriceChangeResult) Unmarshal(in []byte) error {
+	msg := new(product.SchedulePriceChangeResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SchedulePriceChangeResult) GetSuccess() *product.SchedulePriceChangeResp {
+	if !p.IsSetSuccess() {
+		return SchedulePriceChangeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SchedulePriceChangeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.SchedulePriceChangeResp)
+}
+
+func (p *SchedulePriceChangeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SchedulePriceChangeResult) GetResult() interface{} {
+	return p.Success
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -538,3 +1000,33 @@ func (p *kClient) SearchProducts(ctx context.Context, Req *product.SearchProduct
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) SuggestProducts(ctx context.Context, Req *product.SuggestProductsReq) (r *product.SuggestProductsResp, err error) {
+	var _args SuggestProductsArgs
+	_args.Req = Req
+	var _result SuggestProductsResult
+	if err = p.c.Call(ctx, "SuggestProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetPriceHistory(ctx context.Context, Req *product.GetPriceHistoryReq) (r *product.GetPriceHistoryResp, err error) {
+	var _args GetPriceHistoryArgs
+	_args.Req = Req
+	var _result GetPriceHistoryResult
+	if err = p.c.Call(ctx, "GetPriceHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SchedulePriceChange(ctx context.Context, Req *product.SchedulePriceChangeReq) (r *product.SchedulePriceChangeResp, err error) {
+	var _args SchedulePriceChangeArgs
+	_args.Req = Req
+	var _result SchedulePriceChangeResult
+	if err = p.c.Call(ctx, "SchedulePriceChange", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -0,0 +1,1893 @@
+// Code generated by Fastpb v0.0.2. DO NOT EDIT.
+
+package shipping
+
+import (
+	fmt "fmt"
+	fastpb "github.com/cloudwego/fastpb"
+)
+
+var (
+	_ = fmt.Errorf
+	_ = fastpb.Skip
+)
+
+func (x *Address) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Address[number], err)
+}
+
+func (x *Address) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.StreetAddress, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Address) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.City, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Address) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.State, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Address) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Country, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Address) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.ZipCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Quote) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Quote[number], err)
+}
+
+func (x *Quote) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Method, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Quote) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Quote) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Cost, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Quote) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.MinDays, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *Quote) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.MaxDays, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *GetQuoteReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetQuoteReq[number], err)
+}
+
+func (x *GetQuoteReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Address = &v
+	return offset, nil
+}
+
+func (x *GetQuoteReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Weight, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *GetQuoteResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetQuoteResp[number], err)
+}
+
+func (x *GetQuoteResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Zone, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetQuoteResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v Quote
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Quotes = append(x.Quotes, &v)
+	return offset, nil
+}
+
+func (x *TrackingEvent) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_TrackingEvent[number], err)
+}
+
+func (x *TrackingEvent) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Status, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *TrackingEvent) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Description, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *TrackingEvent) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.CreatedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Shipment) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Shipment[number], err)
+}
+
+func (x *Shipment) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Shipment) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.TrackingNumber, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Shipment) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Carrier, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Shipment) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Method, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Shipment) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Cost, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Shipment) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.Status, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Shipment) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	var v TrackingEvent
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Events = append(x.Events, &v)
+	return offset, nil
+}
+
+func (x *Shipment) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.ReturnId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateShipmentReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateShipmentReq[number], err)
+}
+
+func (x *CreateShipmentReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *CreateShipmentReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateShipmentReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Method, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateShipmentReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Address = &v
+	return offset, nil
+}
+
+func (x *CreateShipmentReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Weight, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *CreateShipmentResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateShipmentResp[number], err)
+}
+
+func (x *CreateShipmentResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Shipment
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Shipment = &v
+	return offset, nil
+}
+
+func (x *ListShipmentsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListShipmentsReq[number], err)
+}
+
+func (x *ListShipmentsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *ListShipmentsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.OrderIds = append(x.OrderIds, v)
+	return offset, err
+}
+
+func (x *ListShipmentsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListShipmentsResp[number], err)
+}
+
+func (x *ListShipmentsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Shipment
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Shipments = append(x.Shipments, &v)
+	return offset, nil
+}
+
+func (x *CreateReturnLabelReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateReturnLabelReq[number], err)
+}
+
+func (x *CreateReturnLabelReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *CreateReturnLabelReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateReturnLabelReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.ReturnId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateReturnLabelReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Address = &v
+	return offset, nil
+}
+
+func (x *CreateReturnLabelReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Weight, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *CreateReturnLabelResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateReturnLabelResp[number], err)
+}
+
+func (x *CreateReturnLabelResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Shipment
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Shipment = &v
+	return offset, nil
+}
+
+func (x *CreateReturnLabelResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.ShipToName, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateReturnLabelResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.ShipTo = &v
+	return offset, nil
+}
+
+func (x *ExportUserDataReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ExportUserDataReq[number], err)
+}
+
+func (x *ExportUserDataReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *ExportUserDataResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ExportUserDataResp[number], err)
+}
+
+func (x *ExportUserDataResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Data, offset, err = fastpb.ReadBytes(buf, _type)
+	return offset, err
+}
+
+func (x *EraseUserDataReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_EraseUserDataReq[number], err)
+}
+
+func (x *EraseUserDataReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *EraseUserDataResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+}
+
+func (x *Address) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *Address) fastWriteField1(buf []byte) (offset int) {
+	if x.StreetAddress == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetStreetAddress())
+	return offset
+}
+
+func (x *Address) fastWriteField2(buf []byte) (offset int) {
+	if x.City == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCity())
+	return offset
+}
+
+func (x *Address) fastWriteField3(buf []byte) (offset int) {
+	if x.State == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetState())
+	return offset
+}
+
+func (x *Address) fastWriteField4(buf []byte) (offset int) {
+	if x.Country == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetCountry())
+	return offset
+}
+
+func (x *Address) fastWriteField5(buf []byte) (offset int) {
+	if x.ZipCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetZipCode())
+	return offset
+}
+
+func (x *Quote) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *Quote) fastWriteField1(buf []byte) (offset int) {
+	if x.Method == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetMethod())
+	return offset
+}
+
+func (x *Quote) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
+	return offset
+}
+
+func (x *Quote) fastWriteField3(buf []byte) (offset int) {
+	if x.Cost == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 3, x.GetCost())
+	return offset
+}
+
+func (x *Quote) fastWriteField4(buf []byte) (offset int) {
+	if x.MinDays == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 4, x.GetMinDays())
+	return offset
+}
+
+func (x *Quote) fastWriteField5(buf []byte) (offset int) {
+	if x.MaxDays == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 5, x.GetMaxDays())
+	return offset
+}
+
+func (x *GetQuoteReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *GetQuoteReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetAddress())
+	return offset
+}
+
+func (x *GetQuoteReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Weight == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 2, x.GetWeight())
+	return offset
+}
+
+func (x *GetQuoteResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *GetQuoteResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Zone == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetZone())
+	return offset
+}
+
+func (x *GetQuoteResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Quotes == nil {
+		return offset
+	}
+	for i := range x.GetQuotes() {
+		offset += fastpb.WriteMessage(buf[offset:], 2, x.GetQuotes()[i])
+	}
+	return offset
+}
+
+func (x *TrackingEvent) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *TrackingEvent) fastWriteField1(buf []byte) (offset int) {
+	if x.Status == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetStatus())
+	return offset
+}
+
+func (x *TrackingEvent) fastWriteField2(buf []byte) (offset int) {
+	if x.Description == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetDescription())
+	return offset
+}
+
+func (x *TrackingEvent) fastWriteField3(buf []byte) (offset int) {
+	if x.CreatedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetCreatedAt())
+	return offset
+}
+
+func (x *Shipment) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	return offset
+}
+
+func (x *Shipment) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *Shipment) fastWriteField2(buf []byte) (offset int) {
+	if x.TrackingNumber == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetTrackingNumber())
+	return offset
+}
+
+func (x *Shipment) fastWriteField3(buf []byte) (offset int) {
+	if x.Carrier == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetCarrier())
+	return offset
+}
+
+func (x *Shipment) fastWriteField4(buf []byte) (offset int) {
+	if x.Method == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetMethod())
+	return offset
+}
+
+func (x *Shipment) fastWriteField5(buf []byte) (offset int) {
+	if x.Cost == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 5, x.GetCost())
+	return offset
+}
+
+func (x *Shipment) fastWriteField6(buf []byte) (offset int) {
+	if x.Status == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetStatus())
+	return offset
+}
+
+func (x *Shipment) fastWriteField7(buf []byte) (offset int) {
+	if x.Events == nil {
+		return offset
+	}
+	for i := range x.GetEvents() {
+		offset += fastpb.WriteMessage(buf[offset:], 7, x.GetEvents()[i])
+	}
+	return offset
+}
+
+func (x *Shipment) fastWriteField8(buf []byte) (offset int) {
+	if x.ReturnId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetReturnId())
+	return offset
+}
+
+func (x *CreateShipmentReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *CreateShipmentReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *CreateShipmentReq) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	return offset
+}
+
+func (x *CreateShipmentReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Method == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetMethod())
+	return offset
+}
+
+func (x *CreateShipmentReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 4, x.GetAddress())
+	return offset
+}
+
+func (x *CreateShipmentReq) fastWriteField5(buf []byte) (offset int) {
+	if x.Weight == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 5, x.GetWeight())
+	return offset
+}
+
+func (x *CreateShipmentResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *CreateShipmentResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Shipment == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetShipment())
+	return offset
+}
+
+func (x *ListShipmentsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ListShipmentsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *ListShipmentsReq) fastWriteField2(buf []byte) (offset int) {
+	if len(x.OrderIds) == 0 {
+		return offset
+	}
+	for i := range x.GetOrderIds() {
+		offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderIds()[i])
+	}
+	return offset
+}
+
+func (x *ListShipmentsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListShipmentsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Shipments == nil {
+		return offset
+	}
+	for i := range x.GetShipments() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetShipments()[i])
+	}
+	return offset
+}
+
+func (x *CreateReturnLabelReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *CreateReturnLabelReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *CreateReturnLabelReq) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	return offset
+}
+
+func (x *CreateReturnLabelReq) fastWriteField3(buf []byte) (offset int) {
+	if x.ReturnId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetReturnId())
+	return offset
+}
+
+func (x *CreateReturnLabelReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 4, x.GetAddress())
+	return offset
+}
+
+func (x *CreateReturnLabelReq) fastWriteField5(buf []byte) (offset int) {
+	if x.Weight == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 5, x.GetWeight())
+	return offset
+}
+
+func (x *CreateReturnLabelResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *CreateReturnLabelResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Shipment == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetShipment())
+	return offset
+}
+
+func (x *CreateReturnLabelResp) fastWriteField2(buf []byte) (offset int) {
+	if x.ShipToName == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetShipToName())
+	return offset
+}
+
+func (x *CreateReturnLabelResp) fastWriteField3(buf []byte) (offset int) {
+	if x.ShipTo == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 3, x.GetShipTo())
+	return offset
+}
+
+func (x *ExportUserDataReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ExportUserDataReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *ExportUserDataResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ExportUserDataResp) fastWriteField1(buf []byte) (offset int) {
+	if len(x.Data) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteBytes(buf[offset:], 1, x.GetData())
+	return offset
+}
+
+func (x *EraseUserDataReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *EraseUserDataReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *EraseUserDataResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	return offset
+}
+
+func (x *Address) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *Address) sizeField1() (n int) {
+	if x.StreetAddress == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetStreetAddress())
+	return n
+}
+
+func (x *Address) sizeField2() (n int) {
+	if x.City == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCity())
+	return n
+}
+
+func (x *Address) sizeField3() (n int) {
+	if x.State == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetState())
+	return n
+}
+
+func (x *Address) sizeField4() (n int) {
+	if x.Country == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetCountry())
+	return n
+}
+
+func (x *Address) sizeField5() (n int) {
+	if x.ZipCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetZipCode())
+	return n
+}
+
+func (x *Quote) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *Quote) sizeField1() (n int) {
+	if x.Method == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetMethod())
+	return n
+}
+
+func (x *Quote) sizeField2() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetName())
+	return n
+}
+
+func (x *Quote) sizeField3() (n int) {
+	if x.Cost == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(3, x.GetCost())
+	return n
+}
+
+func (x *Quote) sizeField4() (n int) {
+	if x.MinDays == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(4, x.GetMinDays())
+	return n
+}
+
+func (x *Quote) sizeField5() (n int) {
+	if x.MaxDays == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(5, x.GetMaxDays())
+	return n
+}
+
+func (x *GetQuoteReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *GetQuoteReq) sizeField1() (n int) {
+	if x.Address == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetAddress())
+	return n
+}
+
+func (x *GetQuoteReq) sizeField2() (n int) {
+	if x.Weight == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(2, x.GetWeight())
+	return n
+}
+
+func (x *GetQuoteResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *GetQuoteResp) sizeField1() (n int) {
+	if x.Zone == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetZone())
+	return n
+}
+
+func (x *GetQuoteResp) sizeField2() (n int) {
+	if x.Quotes == nil {
+		return n
+	}
+	for i := range x.GetQuotes() {
+		n += fastpb.SizeMessage(2, x.GetQuotes()[i])
+	}
+	return n
+}
+
+func (x *TrackingEvent) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *TrackingEvent) sizeField1() (n int) {
+	if x.Status == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetStatus())
+	return n
+}
+
+func (x *TrackingEvent) sizeField2() (n int) {
+	if x.Description == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetDescription())
+	return n
+}
+
+func (x *TrackingEvent) sizeField3() (n int) {
+	if x.CreatedAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetCreatedAt())
+	return n
+}
+
+func (x *Shipment) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	return n
+}
+
+func (x *Shipment) sizeField1() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetOrderId())
+	return n
+}
+
+func (x *Shipment) sizeField2() (n int) {
+	if x.TrackingNumber == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetTrackingNumber())
+	return n
+}
+
+func (x *Shipment) sizeField3() (n int) {
+	if x.Carrier == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetCarrier())
+	return n
+}
+
+func (x *Shipment) sizeField4() (n int) {
+	if x.Method == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetMethod())
+	return n
+}
+
+func (x *Shipment) sizeField5() (n int) {
+	if x.Cost == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(5, x.GetCost())
+	return n
+}
+
+func (x *Shipment) sizeField6() (n int) {
+	if x.Status == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetStatus())
+	return n
+}
+
+func (x *Shipment) sizeField7() (n int) {
+	if x.Events == nil {
+		return n
+	}
+	for i := range x.GetEvents() {
+		n += fastpb.SizeMessage(7, x.GetEvents()[i])
+	}
+	return n
+}
+
+func (x *Shipment) sizeField8() (n int) {
+	if x.ReturnId == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetReturnId())
+	return n
+}
+
+func (x *CreateShipmentReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *CreateShipmentReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *CreateShipmentReq) sizeField2() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetOrderId())
+	return n
+}
+
+func (x *CreateShipmentReq) sizeField3() (n int) {
+	if x.Method == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetMethod())
+	return n
+}
+
+func (x *CreateShipmentReq) sizeField4() (n int) {
+	if x.Address == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(4, x.GetAddress())
+	return n
+}
+
+func (x *CreateShipmentReq) sizeField5() (n int) {
+	if x.Weight == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(5, x.GetWeight())
+	return n
+}
+
+func (x *CreateShipmentResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *CreateShipmentResp) sizeField1() (n int) {
+	if x.Shipment == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetShipment())
+	return n
+}
+
+func (x *ListShipmentsReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ListShipmentsReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *ListShipmentsReq) sizeField2() (n int) {
+	if len(x.OrderIds) == 0 {
+		return n
+	}
+	for i := range x.GetOrderIds() {
+		n += fastpb.SizeString(2, x.GetOrderIds()[i])
+	}
+	return n
+}
+
+func (x *ListShipmentsResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ListShipmentsResp) sizeField1() (n int) {
+	if x.Shipments == nil {
+		return n
+	}
+	for i := range x.GetShipments() {
+		n += fastpb.SizeMessage(1, x.GetShipments()[i])
+	}
+	return n
+}
+
+func (x *CreateReturnLabelReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *CreateReturnLabelReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *CreateReturnLabelReq) sizeField2() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetOrderId())
+	return n
+}
+
+func (x *CreateReturnLabelReq) sizeField3() (n int) {
+	if x.ReturnId == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetReturnId())
+	return n
+}
+
+func (x *CreateReturnLabelReq) sizeField4() (n int) {
+	if x.Address == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(4, x.GetAddress())
+	return n
+}
+
+func (x *CreateReturnLabelReq) sizeField5() (n int) {
+	if x.Weight == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(5, x.GetWeight())
+	return n
+}
+
+func (x *CreateReturnLabelResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *CreateReturnLabelResp) sizeField1() (n int) {
+	if x.Shipment == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetShipment())
+	return n
+}
+
+func (x *CreateReturnLabelResp) sizeField2() (n int) {
+	if x.ShipToName == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetShipToName())
+	return n
+}
+
+func (x *CreateReturnLabelResp) sizeField3() (n int) {
+	if x.ShipTo == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(3, x.GetShipTo())
+	return n
+}
+
+func (x *ExportUserDataReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ExportUserDataReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *ExportUserDataResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ExportUserDataResp) sizeField1() (n int) {
+	if len(x.Data) == 0 {
+		return n
+	}
+	n += fastpb.SizeBytes(1, x.GetData())
+	return n
+}
+
+func (x *EraseUserDataReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *EraseUserDataReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *EraseUserDataResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	return n
+}
+
+var fieldIDToName_Address = map[int32]string{
+	1: "StreetAddress",
+	2: "City",
+	3: "State",
+	4: "Country",
+	5: "ZipCode",
+}
+
+var fieldIDToName_Quote = map[int32]string{
+	1: "Method",
+	2: "Name",
+	3: "Cost",
+	4: "MinDays",
+	5: "MaxDays",
+}
+
+var fieldIDToName_GetQuoteReq = map[int32]string{
+	1: "Address",
+	2: "Weight",
+}
+
+var fieldIDToName_GetQuoteResp = map[int32]string{
+	1: "Zone",
+	2: "Quotes",
+}
+
+var fieldIDToName_TrackingEvent = map[int32]string{
+	1: "Status",
+	2: "Description",
+	3: "CreatedAt",
+}
+
+var fieldIDToName_Shipment = map[int32]string{
+	1: "OrderId",
+	2: "TrackingNumber",
+	3: "Carrier",
+	4: "Method",
+	5: "Cost",
+	6: "Status",
+	7: "Events",
+	8: "ReturnId",
+}
+
+var fieldIDToName_CreateShipmentReq = map[int32]string{
+	1: "UserId",
+	2: "OrderId",
+	3: "Method",
+	4: "Address",
+	5: "Weight",
+}
+
+var fieldIDToName_CreateShipmentResp = map[int32]string{
+	1: "Shipment",
+}
+
+var fieldIDToName_ListShipmentsReq = map[int32]string{
+	1: "UserId",
+	2: "OrderIds",
+}
+
+var fieldIDToName_ListShipmentsResp = map[int32]string{
+	1: "Shipments",
+}
+
+var fieldIDToName_CreateReturnLabelReq = map[int32]string{
+	1: "UserId",
+	2: "OrderId",
+	3: "ReturnId",
+	4: "Address",
+	5: "Weight",
+}
+
+var fieldIDToName_CreateReturnLabelResp = map[int32]string{
+	1: "Shipment",
+	2: "ShipToName",
+	3: "ShipTo",
+}
+
+var fieldIDToName_ExportUserDataReq = map[int32]string{
+	1: "UserId",
+}
+
+var fieldIDToName_ExportUserDataResp = map[int32]string{
+	1: "Data",
+}
+
+var fieldIDToName_EraseUserDataReq = map[int32]string{
+	1: "UserId",
+}
+
+var fieldIDToName_EraseUserDataResp = map[int32]string{}
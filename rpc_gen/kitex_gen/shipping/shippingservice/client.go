@@ -0,0 +1,79 @@
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
+
+package shippingservice
+
+import (
+	"context"
+	shipping "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+	client "github.com/cloudwego/kitex/client"
+	callopt "github.com/cloudwego/kitex/client/callopt"
+)
+
+// Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
+type Client interface {
+	GetQuote(ctx context.Context, Req *shipping.GetQuoteReq, callOptions ...callopt.Option) (r *shipping.GetQuoteResp, err error)
+	CreateShipment(ctx context.Context, Req *shipping.CreateShipmentReq, callOptions ...callopt.Option) (r *shipping.CreateShipmentResp, err error)
+	ListShipments(ctx context.Context, Req *shipping.ListShipmentsReq, callOptions ...callopt.Option) (r *shipping.ListShipmentsResp, err error)
+	CreateReturnLabel(ctx context.Context, Req *shipping.CreateReturnLabelReq, callOptions ...callopt.Option) (r *shipping.CreateReturnLabelResp, err error)
+	ExportUserData(ctx context.Context, Req *shipping.ExportUserDataReq, callOptions ...callopt.Option) (r *shipping.ExportUserDataResp, err error)
+	EraseUserData(ctx context.Context, Req *shipping.EraseUserDataReq, callOptions ...callopt.Option) (r *shipping.EraseUserDataResp, err error)
+}
+
+// NewClient creates a client for the service defined in IDL.
+func NewClient(destService string, opts ...client.Option) (Client, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+
+	options = append(options, opts...)
+
+	kc, err := client.NewClient(serviceInfo(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kShippingServiceClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewClient creates a client for the service defined in IDL. It panics if any error occurs.
+func MustNewClient(destService string, opts ...client.Option) Client {
+	kc, err := NewClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kShippingServiceClient struct {
+	*kClient
+}
+
+func (p *kShippingServiceClient) GetQuote(ctx context.Context, Req *shipping.GetQuoteReq, callOptions ...callopt.Option) (r *shipping.GetQuoteResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetQuote(ctx, Req)
+}
+
+func (p *kShippingServiceClient) CreateShipment(ctx context.Context, Req *shipping.CreateShipmentReq, callOptions ...callopt.Option) (r *shipping.CreateShipmentResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateShipment(ctx, Req)
+}
+
+func (p *kShippingServiceClient) ListShipments(ctx context.Context, Req *shipping.ListShipmentsReq, callOptions ...callopt.Option) (r *shipping.ListShipmentsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListShipments(ctx, Req)
+}
+
+func (p *kShippingServiceClient) CreateReturnLabel(ctx context.Context, Req *shipping.CreateReturnLabelReq, callOptions ...callopt.Option) (r *shipping.CreateReturnLabelResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateReturnLabel(ctx, Req)
+}
+
+func (p *kShippingServiceClient) ExportUserData(ctx context.Context, Req *shipping.ExportUserDataReq, callOptions ...callopt.Option) (r *shipping.ExportUserDataResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportUserData(ctx, Req)
+}
+
+func (p *kShippingServiceClient) EraseUserData(ctx context.Context, Req *shipping.EraseUserDataReq, callOptions ...callopt.Option) (r *shipping.EraseUserDataResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EraseUserData(ctx, Req)
+}
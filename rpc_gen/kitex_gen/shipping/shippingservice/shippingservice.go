@@ -0,0 +1,1032 @@
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
+
+package shippingservice
+
+import (
+	"context"
+	shipping "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+	streaming "github.com/cloudwego/kitex/pkg/streaming"
+	proto "google.golang.org/protobuf/proto"
+)
+
+func serviceInfo() *kitex.ServiceInfo {
+	return shippingServiceServiceInfo
+}
+
+var shippingServiceServiceInfo = NewServiceInfo()
+
+func NewServiceInfo() *kitex.ServiceInfo {
+	serviceName := "ShippingService"
+	handlerType := (*shipping.ShippingService)(nil)
+	methods := map[string]kitex.MethodInfo{
+		"GetQuote":          kitex.NewMethodInfo(getQuoteHandler, newGetQuoteArgs, newGetQuoteResult, false),
+		"CreateShipment":    kitex.NewMethodInfo(createShipmentHandler, newCreateShipmentArgs, newCreateShipmentResult, false),
+		"ListShipments":     kitex.NewMethodInfo(listShipmentsHandler, newListShipmentsArgs, newListShipmentsResult, false),
+		"CreateReturnLabel": kitex.NewMethodInfo(createReturnLabelHandler, newCreateReturnLabelArgs, newCreateReturnLabelResult, false),
+		"ExportUserData":    kitex.NewMethodInfo(exportUserDataHandler, newExportUserDataArgs, newExportUserDataResult, false),
+		"EraseUserData":     kitex.NewMethodInfo(eraseUserDataHandler, newEraseUserDataArgs, newEraseUserDataResult, false),
+	}
+	extra := map[string]interface{}{
+		"PackageName":     "shipping",
+		"ServiceFilePath": ``,
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         methods,
+		PayloadCodec:    kitex.Protobuf,
+		KiteXGenVersion: "v0.8.0",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func getQuoteHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(shipping.GetQuoteReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(shipping.ShippingService).GetQuote(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GetQuoteArgs:
+		success, err := handler.(shipping.ShippingService).GetQuote(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetQuoteResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGetQuoteArgs() interface{} {
+	return &GetQuoteArgs{}
+}
+
+func newGetQuoteResult() interface{} {
+	return &GetQuoteResult{}
+}
+
+type GetQuoteArgs struct {
+	Req *shipping.GetQuoteReq
+}
+
+func (p *GetQuoteArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(shipping.GetQuoteReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetQuoteArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetQuoteArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetQuoteArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetQuoteArgs) Unmarshal(in []byte) error {
+	msg := new(shipping.GetQuoteReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetQuoteArgs_Req_DEFAULT *shipping.GetQuoteReq
+
+func (p *GetQuoteArgs) GetReq() *shipping.GetQuoteReq {
+	if !p.IsSetReq() {
+		return GetQuoteArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetQuoteArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetQuoteArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetQuoteResult struct {
+	Success *shipping.GetQuoteResp
+}
+
+var GetQuoteResult_Success_DEFAULT *shipping.GetQuoteResp
+
+func (p *GetQuoteResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(shipping.GetQuoteResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetQuoteResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetQuoteResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetQuoteResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetQuoteResult) Unmarshal(in []byte) error {
+	msg := new(shipping.GetQuoteResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetQuoteResult) GetSuccess() *shipping.GetQuoteResp {
+	if !p.IsSetSuccess() {
+		return GetQuoteResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetQuoteResult) SetSuccess(x interface{}) {
+	p.Success = x.(*shipping.GetQuoteResp)
+}
+
+func (p *GetQuoteResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetQuoteResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createShipmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(shipping.CreateShipmentReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(shipping.ShippingService).CreateShipment(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *CreateShipmentArgs:
+		success, err := handler.(shipping.ShippingService).CreateShipment(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateShipmentResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newCreateShipmentArgs() interface{} {
+	return &CreateShipmentArgs{}
+}
+
+func newCreateShipmentResult() interface{} {
+	return &CreateShipmentResult{}
+}
+
+type CreateShipmentArgs struct {
+	Req *shipping.CreateShipmentReq
+}
+
+func (p *CreateShipmentArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(shipping.CreateShipmentReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CreateShipmentArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CreateShipmentArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CreateShipmentArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateShipmentArgs) Unmarshal(in []byte) error {
+	msg := new(shipping.CreateShipmentReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateShipmentArgs_Req_DEFAULT *shipping.CreateShipmentReq
+
+func (p *CreateShipmentArgs) GetReq() *shipping.CreateShipmentReq {
+	if !p.IsSetReq() {
+		return CreateShipmentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateShipmentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateShipmentArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateShipmentResult struct {
+	Success *shipping.CreateShipmentResp
+}
+
+var CreateShipmentResult_Success_DEFAULT *shipping.CreateShipmentResp
+
+func (p *CreateShipmentResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(shipping.CreateShipmentResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CreateShipmentResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CreateShipmentResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CreateShipmentResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateShipmentResult) Unmarshal(in []byte) error {
+	msg := new(shipping.CreateShipmentResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateShipmentResult) GetSuccess() *shipping.CreateShipmentResp {
+	if !p.IsSetSuccess() {
+		return CreateShipmentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateShipmentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*shipping.CreateShipmentResp)
+}
+
+func (p *CreateShipmentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateShipmentResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listShipmentsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(shipping.ListShipmentsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(shipping.ShippingService).ListShipments(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ListShipmentsArgs:
+		success, err := handler.(shipping.ShippingService).ListShipments(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListShipmentsResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newListShipmentsArgs() interface{} {
+	return &ListShipmentsArgs{}
+}
+
+func newListShipmentsResult() interface{} {
+	return &ListShipmentsResult{}
+}
+
+type ListShipmentsArgs struct {
+	Req *shipping.ListShipmentsReq
+}
+
+func (p *ListShipmentsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(shipping.ListShipmentsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListShipmentsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListShipmentsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListShipmentsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListShipmentsArgs) Unmarshal(in []byte) error {
+	msg := new(shipping.ListShipmentsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListShipmentsArgs_Req_DEFAULT *shipping.ListShipmentsReq
+
+func (p *ListShipmentsArgs) GetReq() *shipping.ListShipmentsReq {
+	if !p.IsSetReq() {
+		return ListShipmentsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListShipmentsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListShipmentsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListShipmentsResult struct {
+	Success *shipping.ListShipmentsResp
+}
+
+var ListShipmentsResult_Success_DEFAULT *shipping.ListShipmentsResp
+
+func (p *ListShipmentsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(shipping.ListShipmentsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListShipmentsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListShipmentsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListShipmentsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListShipmentsResult) Unmarshal(in []byte) error {
+	msg := new(shipping.ListShipmentsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListShipmentsResult) GetSuccess() *shipping.ListShipmentsResp {
+	if !p.IsSetSuccess() {
+		return ListShipmentsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListShipmentsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*shipping.ListShipmentsResp)
+}
+
+func (p *ListShipmentsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListShipmentsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createReturnLabelHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(shipping.CreateReturnLabelReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(shipping.ShippingService).CreateReturnLabel(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *CreateReturnLabelArgs:
+		success, err := handler.(shipping.ShippingService).CreateReturnLabel(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateReturnLabelResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newCreateReturnLabelArgs() interface{} {
+	return &CreateReturnLabelArgs{}
+}
+
+func newCreateReturnLabelResult() interface{} {
+	return &CreateReturnLabelResult{}
+}
+
+type CreateReturnLabelArgs struct {
+	Req *shipping.CreateReturnLabelReq
+}
+
+func (p *CreateReturnLabelArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(shipping.CreateReturnLabelReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CreateReturnLabelArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CreateReturnLabelArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CreateReturnLabelArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateReturnLabelArgs) Unmarshal(in []byte) error {
+	msg := new(shipping.CreateReturnLabelReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateReturnLabelArgs_Req_DEFAULT *shipping.CreateReturnLabelReq
+
+func (p *CreateReturnLabelArgs) GetReq() *shipping.CreateReturnLabelReq {
+	if !p.IsSetReq() {
+		return CreateReturnLabelArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateReturnLabelArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateReturnLabelArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateReturnLabelResult struct {
+	Success *shipping.CreateReturnLabelResp
+}
+
+var CreateReturnLabelResult_Success_DEFAULT *shipping.CreateReturnLabelResp
+
+func (p *CreateReturnLabelResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(shipping.CreateReturnLabelResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CreateReturnLabelResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CreateReturnLabelResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CreateReturnLabelResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateReturnLabelResult) Unmarshal(in []byte) error {
+	msg := new(shipping.CreateReturnLabelResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateReturnLabelResult) GetSuccess() *shipping.CreateReturnLabelResp {
+	if !p.IsSetSuccess() {
+		return CreateReturnLabelResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateReturnLabelResult) SetSuccess(x interface{}) {
+	p.Success = x.(*shipping.CreateReturnLabelResp)
+}
+
+func (p *CreateReturnLabelResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateReturnLabelResult) GetResult() interface{} {
+	return p.Success
+}
+
+func exportUserDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(shipping.ExportUserDataReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(shipping.ShippingService).ExportUserData(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ExportUserDataArgs:
+		success, err := handler.(shipping.ShippingService).ExportUserData(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ExportUserDataResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newExportUserDataArgs() interface{} {
+	return &ExportUserDataArgs{}
+}
+
+func newExportUserDataResult() interface{} {
+	return &ExportUserDataResult{}
+}
+
+type ExportUserDataArgs struct {
+	Req *shipping.ExportUserDataReq
+}
+
+func (p *ExportUserDataArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(shipping.ExportUserDataReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ExportUserDataArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ExportUserDataArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ExportUserDataArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ExportUserDataArgs) Unmarshal(in []byte) error {
+	msg := new(shipping.ExportUserDataReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ExportUserDataArgs_Req_DEFAULT *shipping.ExportUserDataReq
+
+func (p *ExportUserDataArgs) GetReq() *shipping.ExportUserDataReq {
+	if !p.IsSetReq() {
+		return ExportUserDataArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ExportUserDataArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ExportUserDataArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ExportUserDataResult struct {
+	Success *shipping.ExportUserDataResp
+}
+
+var ExportUserDataResult_Success_DEFAULT *shipping.ExportUserDataResp
+
+func (p *ExportUserDataResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(shipping.ExportUserDataResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ExportUserDataResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ExportUserDataResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ExportUserDataResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ExportUserDataResult) Unmarshal(in []byte) error {
+	msg := new(shipping.ExportUserDataResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ExportUserDataResult) GetSuccess() *shipping.ExportUserDataResp {
+	if !p.IsSetSuccess() {
+		return ExportUserDataResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ExportUserDataResult) SetSuccess(x interface{}) {
+	p.Success = x.(*shipping.ExportUserDataResp)
+}
+
+func (p *ExportUserDataResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ExportUserDataResult) GetResult() interface{} {
+	return p.Success
+}
+
+func eraseUserDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(shipping.EraseUserDataReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(shipping.ShippingService).EraseUserData(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *EraseUserDataArgs:
+		success, err := handler.(shipping.ShippingService).EraseUserData(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*EraseUserDataResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newEraseUserDataArgs() interface{} {
+	return &EraseUserDataArgs{}
+}
+
+func newEraseUserDataResult() interface{} {
+	return &EraseUserDataResult{}
+}
+
+type EraseUserDataArgs struct {
+	Req *shipping.EraseUserDataReq
+}
+
+func (p *EraseUserDataArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(shipping.EraseUserDataReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *EraseUserDataArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *EraseUserDataArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *EraseUserDataArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *EraseUserDataArgs) Unmarshal(in []byte) error {
+	msg := new(shipping.EraseUserDataReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var EraseUserDataArgs_Req_DEFAULT *shipping.EraseUserDataReq
+
+func (p *EraseUserDataArgs) GetReq() *shipping.EraseUserDataReq {
+	if !p.IsSetReq() {
+		return EraseUserDataArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *EraseUserDataArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *EraseUserDataArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type EraseUserDataResult struct {
+	Success *shipping.EraseUserDataResp
+}
+
+var EraseUserDataResult_Success_DEFAULT *shipping.EraseUserDataResp
+
+func (p *EraseUserDataResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(shipping.EraseUserDataResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *EraseUserDataResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *EraseUserDataResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *EraseUserDataResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *EraseUserDataResult) Unmarshal(in []byte) error {
+	msg := new(shipping.EraseUserDataResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *EraseUserDataResult) GetSuccess() *shipping.EraseUserDataResp {
+	if !p.IsSetSuccess() {
+		return EraseUserDataResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *EraseUserDataResult) SetSuccess(x interface{}) {
+	p.Success = x.(*shipping.EraseUserDataResp)
+}
+
+func (p *EraseUserDataResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *EraseUserDataResult) GetResult() interface{} {
+	return p.Success
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) GetQuote(ctx context.Context, Req *shipping.GetQuoteReq) (r *shipping.GetQuoteResp, err error) {
+	var _args GetQuoteArgs
+	_args.Req = Req
+	var _result GetQuoteResult
+	if err = p.c.Call(ctx, "GetQuote", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateShipment(ctx context.Context, Req *shipping.CreateShipmentReq) (r *shipping.CreateShipmentResp, err error) {
+	var _args CreateShipmentArgs
+	_args.Req = Req
+	var _result CreateShipmentResult
+	if err = p.c.Call(ctx, "CreateShipment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListShipments(ctx context.Context, Req *shipping.ListShipmentsReq) (r *shipping.ListShipmentsResp, err error) {
+	var _args ListShipmentsArgs
+	_args.Req = Req
+	var _result ListShipmentsResult
+	if err = p.c.Call(ctx, "ListShipments", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateReturnLabel(ctx context.Context, Req *shipping.CreateReturnLabelReq) (r *shipping.CreateReturnLabelResp, err error) {
+	var _args CreateReturnLabelArgs
+	_args.Req = Req
+	var _result CreateReturnLabelResult
+	if err = p.c.Call(ctx, "CreateReturnLabel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ExportUserData(ctx context.Context, Req *shipping.ExportUserDataReq) (r *shipping.ExportUserDataResp, err error) {
+	var _args ExportUserDataArgs
+	_args.Req = Req
+	var _result ExportUserDataResult
+	if err = p.c.Call(ctx, "ExportUserData", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EraseUserData(ctx context.Context, Req *shipping.EraseUserDataReq) (r *shipping.EraseUserDataResp, err error) {
+	var _args EraseUserDataArgs
+	_args.Req = Req
+	var _result EraseUserDataResult
+	if err = p.c.Call(ctx, "EraseUserData", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
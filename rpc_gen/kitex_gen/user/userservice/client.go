@@ -1,4 +1,4 @@
-// Code generated by Kitex v0.9.1. DO NOT EDIT.
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
 
 package userservice
 
@@ -13,6 +13,41 @@ import (
 type Client interface {
 	Register(ctx context.Context, Req *user.RegisterReq, callOptions ...callopt.Option) (r *user.RegisterResp, err error)
 	Login(ctx context.Context, Req *user.LoginReq, callOptions ...callopt.Option) (r *user.LoginResp, err error)
+	GetNotificationPreferences(ctx context.Context, Req *user.GetNotificationPreferencesReq, callOptions ...callopt.Option) (r *user.GetNotificationPreferencesResp, err error)
+	UpdateNotificationPreferences(ctx context.Context, Req *user.UpdateNotificationPreferencesReq, callOptions ...callopt.Option) (r *user.UpdateNotificationPreferencesResp, err error)
+	Unsubscribe(ctx context.Context, Req *user.UnsubscribeReq, callOptions ...callopt.Option) (r *user.UnsubscribeResp, err error)
+	GetJwks(ctx context.Context, Req *user.GetJwksReq, callOptions ...callopt.Option) (r *user.GetJwksResp, err error)
+	RefreshToken(ctx context.Context, Req *user.RefreshTokenReq, callOptions ...callopt.Option) (r *user.RefreshTokenResp, err error)
+	RevokeToken(ctx context.Context, Req *user.RevokeTokenReq, callOptions ...callopt.Option) (r *user.RevokeTokenResp, err error)
+	ListSessions(ctx context.Context, Req *user.ListSessionsReq, callOptions ...callopt.Option) (r *user.ListSessionsResp, err error)
+	SendVerificationEmail(ctx context.Context, Req *user.SendVerificationEmailReq, callOptions ...callopt.Option) (r *user.SendVerificationEmailResp, err error)
+	VerifyEmail(ctx context.Context, Req *user.VerifyEmailReq, callOptions ...callopt.Option) (r *user.VerifyEmailResp, err error)
+	RequestPasswordReset(ctx context.Context, Req *user.RequestPasswordResetReq, callOptions ...callopt.Option) (r *user.RequestPasswordResetResp, err error)
+	ResetPassword(ctx context.Context, Req *user.ResetPasswordReq, callOptions ...callopt.Option) (r *user.ResetPasswordResp, err error)
+	UnlockAccount(ctx context.Context, Req *user.UnlockAccountReq, callOptions ...callopt.Option) (r *user.UnlockAccountResp, err error)
+	GrantRole(ctx context.Context, Req *user.GrantRoleReq, callOptions ...callopt.Option) (r *user.GrantRoleResp, err error)
+	RevokeRole(ctx context.Context, Req *user.RevokeRoleReq, callOptions ...callopt.Option) (r *user.RevokeRoleResp, err error)
+	ListRoleAudit(ctx context.Context, Req *user.ListRoleAuditReq, callOptions ...callopt.Option) (r *user.ListRoleAuditResp, err error)
+	EnrollTotp(ctx context.Context, Req *user.EnrollTotpReq, callOptions ...callopt.Option) (r *user.EnrollTotpResp, err error)
+	ConfirmTotp(ctx context.Context, Req *user.ConfirmTotpReq, callOptions ...callopt.Option) (r *user.ConfirmTotpResp, err error)
+	DisableTotp(ctx context.Context, Req *user.DisableTotpReq, callOptions ...callopt.Option) (r *user.DisableTotpResp, err error)
+	GetTotpStatus(ctx context.Context, Req *user.GetTotpStatusReq, callOptions ...callopt.Option) (r *user.GetTotpStatusResp, err error)
+	RegenerateRecoveryCodes(ctx context.Context, Req *user.RegenerateRecoveryCodesReq, callOptions ...callopt.Option) (r *user.RegenerateRecoveryCodesResp, err error)
+	VerifyTwoFactor(ctx context.Context, Req *user.VerifyTwoFactorReq, callOptions ...callopt.Option) (r *user.LoginResp, err error)
+	ListOidcProviders(ctx context.Context, Req *user.ListOidcProvidersReq, callOptions ...callopt.Option) (r *user.ListOidcProvidersResp, err error)
+	StartOidcLogin(ctx context.Context, Req *user.StartOidcLoginReq, callOptions ...callopt.Option) (r *user.StartOidcLoginResp, err error)
+	FinishOidcLogin(ctx context.Context, Req *user.FinishOidcLoginReq, callOptions ...callopt.Option) (r *user.LoginResp, err error)
+	GetProfile(ctx context.Context, Req *user.GetProfileReq, callOptions ...callopt.Option) (r *user.GetProfileResp, err error)
+	UpdateProfile(ctx context.Context, Req *user.UpdateProfileReq, callOptions ...callopt.Option) (r *user.UpdateProfileResp, err error)
+	ListAddresses(ctx context.Context, Req *user.ListAddressesReq, callOptions ...callopt.Option) (r *user.ListAddressesResp, err error)
+	CreateAddress(ctx context.Context, Req *user.CreateAddressReq, callOptions ...callopt.Option) (r *user.CreateAddressResp, err error)
+	UpdateAddress(ctx context.Context, Req *user.UpdateAddressReq, callOptions ...callopt.Option) (r *user.UpdateAddressResp, err error)
+	DeleteAddress(ctx context.Context, Req *user.DeleteAddressReq, callOptions ...callopt.Option) (r *user.DeleteAddressResp, err error)
+	SetDefaultAddress(ctx context.Context, Req *user.SetDefaultAddressReq, callOptions ...callopt.Option) (r *user.SetDefaultAddressResp, err error)
+	RequestDataExport(ctx context.Context, Req *user.RequestDataExportReq, callOptions ...callopt.Option) (r *user.RequestDataExportResp, err error)
+	DeleteAccount(ctx context.Context, Req *user.DeleteAccountReq, callOptions ...callopt.Option) (r *user.DeleteAccountResp, err error)
+	ConfirmAccountDeletion(ctx context.Context, Req *user.ConfirmAccountDeletionReq, callOptions ...callopt.Option) (r *user.ConfirmAccountDeletionResp, err error)
+	GetAccountDeletion(ctx context.Context, Req *user.GetAccountDeletionReq, callOptions ...callopt.Option) (r *user.GetAccountDeletionResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -53,3 +88,178 @@ func (p *kUserServiceClient) Login(ctx context.Context, Req *user.LoginReq, call
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.Login(ctx, Req)
 }
+
+func (p *kUserServiceClient) GetNotificationPreferences(ctx context.Context, Req *user.GetNotificationPreferencesReq, callOptions ...callopt.Option) (r *user.GetNotificationPreferencesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetNotificationPreferences(ctx, Req)
+}
+
+func (p *kUserServiceClient) UpdateNotificationPreferences(ctx context.Context, Req *user.UpdateNotificationPreferencesReq, callOptions ...callopt.Option) (r *user.UpdateNotificationPreferencesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateNotificationPreferences(ctx, Req)
+}
+
+func (p *kUserServiceClient) Unsubscribe(ctx context.Context, Req *user.UnsubscribeReq, callOptions ...callopt.Option) (r *user.UnsubscribeResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Unsubscribe(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetJwks(ctx context.Context, Req *user.GetJwksReq, callOptions ...callopt.Option) (r *user.GetJwksResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetJwks(ctx, Req)
+}
+
+func (p *kUserServiceClient) RefreshToken(ctx context.Context, Req *user.RefreshTokenReq, callOptions ...callopt.Option) (r *user.RefreshTokenResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RefreshToken(ctx, Req)
+}
+
+func (p *kUserServiceClient) RevokeToken(ctx context.Context, Req *user.RevokeTokenReq, callOptions ...callopt.Option) (r *user.RevokeTokenResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RevokeToken(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListSessions(ctx context.Context, Req *user.ListSessionsReq, callOptions ...callopt.Option) (r *user.ListSessionsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListSessions(ctx, Req)
+}
+
+func (p *kUserServiceClient) SendVerificationEmail(ctx context.Context, Req *user.SendVerificationEmailReq, callOptions ...callopt.Option) (r *user.SendVerificationEmailResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SendVerificationEmail(ctx, Req)
+}
+
+func (p *kUserServiceClient) VerifyEmail(ctx context.Context, Req *user.VerifyEmailReq, callOptions ...callopt.Option) (r *user.VerifyEmailResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.VerifyEmail(ctx, Req)
+}
+
+func (p *kUserServiceClient) RequestPasswordReset(ctx context.Context, Req *user.RequestPasswordResetReq, callOptions ...callopt.Option) (r *user.RequestPasswordResetResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RequestPasswordReset(ctx, Req)
+}
+
+func (p *kUserServiceClient) ResetPassword(ctx context.Context, Req *user.ResetPasswordReq, callOptions ...callopt.Option) (r *user.ResetPasswordResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ResetPassword(ctx, Req)
+}
+
+func (p *kUserServiceClient) UnlockAccount(ctx context.Context, Req *user.UnlockAccountReq, callOptions ...callopt.Option) (r *user.UnlockAccountResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UnlockAccount(ctx, Req)
+}
+
+func (p *kUserServiceClient) GrantRole(ctx context.Context, Req *user.GrantRoleReq, callOptions ...callopt.Option) (r *user.GrantRoleResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GrantRole(ctx, Req)
+}
+
+func (p *kUserServiceClient) RevokeRole(ctx context.Context, Req *user.RevokeRoleReq, callOptions ...callopt.Option) (r *user.RevokeRoleResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RevokeRole(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListRoleAudit(ctx context.Context, Req *user.ListRoleAuditReq, callOptions ...callopt.Option) (r *user.ListRoleAuditResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListRoleAudit(ctx, Req)
+}
+
+func (p *kUserServiceClient) EnrollTotp(ctx context.Context, Req *user.EnrollTotpReq, callOptions ...callopt.Option) (r *user.EnrollTotpResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EnrollTotp(ctx, Req)
+}
+
+func (p *kUserServiceClient) ConfirmTotp(ctx context.Context, Req *user.ConfirmTotpReq, callOptions ...callopt.Option) (r *user.ConfirmTotpResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ConfirmTotp(ctx, Req)
+}
+
+func (p *kUserServiceClient) DisableTotp(ctx context.Context, Req *user.DisableTotpReq, callOptions ...callopt.Option) (r *user.DisableTotpResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DisableTotp(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetTotpStatus(ctx context.Context, Req *user.GetTotpStatusReq, callOptions ...callopt.Option) (r *user.GetTotpStatusResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetTotpStatus(ctx, Req)
+}
+
+func (p *kUserServiceClient) RegenerateRecoveryCodes(ctx context.Context, Req *user.RegenerateRecoveryCodesReq, callOptions ...callopt.Option) (r *user.RegenerateRecoveryCodesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RegenerateRecoveryCodes(ctx, Req)
+}
+
+func (p *kUserServiceClient) VerifyTwoFactor(ctx context.Context, Req *user.VerifyTwoFactorReq, callOptions ...callopt.Option) (r *user.LoginResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.VerifyTwoFactor(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListOidcProviders(ctx context.Context, Req *user.ListOidcProvidersReq, callOptions ...callopt.Option) (r *user.ListOidcProvidersResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListOidcProviders(ctx, Req)
+}
+
+func (p *kUserServiceClient) StartOidcLogin(ctx context.Context, Req *user.StartOidcLoginReq, callOptions ...callopt.Option) (r *user.StartOidcLoginResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.StartOidcLogin(ctx, Req)
+}
+
+func (p *kUserServiceClient) FinishOidcLogin(ctx context.Context, Req *user.FinishOidcLoginReq, callOptions ...callopt.Option) (r *user.LoginResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.FinishOidcLogin(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetProfile(ctx context.Context, Req *user.GetProfileReq, callOptions ...callopt.Option) (r *user.GetProfileResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetProfile(ctx, Req)
+}
+
+func (p *kUserServiceClient) UpdateProfile(ctx context.Context, Req *user.UpdateProfileReq, callOptions ...callopt.Option) (r *user.UpdateProfileResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateProfile(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListAddresses(ctx context.Context, Req *user.ListAddressesReq, callOptions ...callopt.Option) (r *user.ListAddressesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAddresses(ctx, Req)
+}
+
+func (p *kUserServiceClient) CreateAddress(ctx context.Context, Req *user.CreateAddressReq, callOptions ...callopt.Option) (r *user.CreateAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) UpdateAddress(ctx context.Context, Req *user.UpdateAddressReq, callOptions ...callopt.Option) (r *user.UpdateAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) DeleteAddress(ctx context.Context, Req *user.DeleteAddressReq, callOptions ...callopt.Option) (r *user.DeleteAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) SetDefaultAddress(ctx context.Context, Req *user.SetDefaultAddressReq, callOptions ...callopt.Option) (r *user.SetDefaultAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetDefaultAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) RequestDataExport(ctx context.Context, Req *user.RequestDataExportReq, callOptions ...callopt.Option) (r *user.RequestDataExportResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RequestDataExport(ctx, Req)
+}
+
+func (p *kUserServiceClient) DeleteAccount(ctx context.Context, Req *user.DeleteAccountReq, callOptions ...callopt.Option) (r *user.DeleteAccountResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteAccount(ctx, Req)
+}
+
+func (p *kUserServiceClient) ConfirmAccountDeletion(ctx context.Context, Req *user.ConfirmAccountDeletionReq, callOptions ...callopt.Option) (r *user.ConfirmAccountDeletionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ConfirmAccountDeletion(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetAccountDeletion(ctx context.Context, Req *user.GetAccountDeletionReq, callOptions ...callopt.Option) (r *user.GetAccountDeletionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetAccountDeletion(ctx, Req)
+}
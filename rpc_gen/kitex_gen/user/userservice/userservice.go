@@ -1,10 +1,9 @@
-// Code generated by Kitex v0.9.1. DO NOT EDIT.
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
 
 package userservice
 
 import (
 	"context"
-	"errors"
 	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
 	client "github.com/cloudwego/kitex/client"
 	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
@@ -12,84 +11,64 @@ import (
 	proto "google.golang.org/protobuf/proto"
 )
 
-var errInvalidMessageType = errors.New("invalid message type for service method handler")
-
-var serviceMethods = map[string]kitex.MethodInfo{
-	"Register": kitex.NewMethodInfo(
-		registerHandler,
-		newRegisterArgs,
-		newRegisterResult,
-		false,
-		kitex.WithStreamingMode(kitex.StreamingUnary),
-	),
-	"Login": kitex.NewMethodInfo(
-		loginHandler,
-		newLoginArgs,
-		newLoginResult,
-		false,
-		kitex.WithStreamingMode(kitex.StreamingUnary),
-	),
-}
-
-var (
-	userServiceServiceInfo                = NewServiceInfo()
-	userServiceServiceInfoForClient       = NewServiceInfoForClient()
-	userServiceServiceInfoForStreamClient = NewServiceInfoForStreamClient()
-)
-
-// for server
 func serviceInfo() *kitex.ServiceInfo {
 	return userServiceServiceInfo
 }
 
-// for client
-func serviceInfoForStreamClient() *kitex.ServiceInfo {
-	return userServiceServiceInfoForStreamClient
-}
-
-// for stream client
-func serviceInfoForClient() *kitex.ServiceInfo {
-	return userServiceServiceInfoForClient
-}
+var userServiceServiceInfo = NewServiceInfo()
 
-// NewServiceInfo creates a new ServiceInfo containing all methods
 func NewServiceInfo() *kitex.ServiceInfo {
-	return newServiceInfo(false, true, true)
-}
-
-// NewServiceInfo creates a new ServiceInfo containing non-streaming methods
-func NewServiceInfoForClient() *kitex.ServiceInfo {
-	return newServiceInfo(false, false, true)
-}
-func NewServiceInfoForStreamClient() *kitex.ServiceInfo {
-	return newServiceInfo(true, true, false)
-}
-
-func newServiceInfo(hasStreaming bool, keepStreamingMethods bool, keepNonStreamingMethods bool) *kitex.ServiceInfo {
 	serviceName := "UserService"
 	handlerType := (*user.UserService)(nil)
-	methods := map[string]kitex.MethodInfo{}
-	for name, m := range serviceMethods {
-		if m.IsStreaming() && !keepStreamingMethods {
-			continue
-		}
-		if !m.IsStreaming() && !keepNonStreamingMethods {
-			continue
-		}
-		methods[name] = m
+	methods := map[string]kitex.MethodInfo{
+		"Register":                      kitex.NewMethodInfo(registerHandler, newRegisterArgs, newRegisterResult, false),
+		"Login":                         kitex.NewMethodInfo(loginHandler, newLoginArgs, newLoginResult, false),
+		"GetNotificationPreferences":    kitex.NewMethodInfo(getNotificationPreferencesHandler, newGetNotificationPreferencesArgs, newGetNotificationPreferencesResult, false),
+		"UpdateNotificationPreferences": kitex.NewMethodInfo(updateNotificationPreferencesHandler, newUpdateNotificationPreferencesArgs, newUpdateNotificationPreferencesResult, false),
+		"Unsubscribe":                   kitex.NewMethodInfo(unsubscribeHandler, newUnsubscribeArgs, newUnsubscribeResult, false),
+		"GetJwks":                       kitex.NewMethodInfo(getJwksHandler, newGetJwksArgs, newGetJwksResult, false),
+		"RefreshToken":                  kitex.NewMethodInfo(refreshTokenHandler, newRefreshTokenArgs, newRefreshTokenResult, false),
+		"RevokeToken":                   kitex.NewMethodInfo(revokeTokenHandler, newRevokeTokenArgs, newRevokeTokenResult, false),
+		"ListSessions":                  kitex.NewMethodInfo(listSessionsHandler, newListSessionsArgs, newListSessionsResult, false),
+		"SendVerificationEmail":         kitex.NewMethodInfo(sendVerificationEmailHandler, newSendVerificationEmailArgs, newSendVerificationEmailResult, false),
+		"VerifyEmail":                   kitex.NewMethodInfo(verifyEmailHandler, newVerifyEmailArgs, newVerifyEmailResult, false),
+		"RequestPasswordReset":          kitex.NewMethodInfo(requestPasswordResetHandler, newRequestPasswordResetArgs, newRequestPasswordResetResult, false),
+		"ResetPassword":                 kitex.NewMethodInfo(resetPasswordHandler, newResetPasswordArgs, newResetPasswordResult, false),
+		"UnlockAccount":                 kitex.NewMethodInfo(unlockAccountHandler, newUnlockAccountArgs, newUnlockAccountResult, false),
+		"GrantRole":                     kitex.NewMethodInfo(grantRoleHandler, newGrantRoleArgs, newGrantRoleResult, false),
+		"RevokeRole":                    kitex.NewMethodInfo(revokeRoleHandler, newRevokeRoleArgs, newRevokeRoleResult, false),
+		"ListRoleAudit":                 kitex.NewMethodInfo(listRoleAuditHandler, newListRoleAuditArgs, newListRoleAuditResult, false),
+		"EnrollTotp":                    kitex.NewMethodInfo(enrollTotpHandler, newEnrollTotpArgs, newEnrollTotpResult, false),
+		"ConfirmTotp":                   kitex.NewMethodInfo(confirmTotpHandler, newConfirmTotpArgs, newConfirmTotpResult, false),
+		"DisableTotp":                   kitex.NewMethodInfo(disableTotpHandler, newDisableTotpArgs, newDisableTotpResult, false),
+		"GetTotpStatus":                 kitex.NewMethodInfo(getTotpStatusHandler, newGetTotpStatusArgs, newGetTotpStatusResult, false),
+		"RegenerateRecoveryCodes":       kitex.NewMethodInfo(regenerateRecoveryCodesHandler, newRegenerateRecoveryCodesArgs, newRegenerateRecoveryCodesResult, false),
+		"VerifyTwoFactor":               kitex.NewMethodInfo(verifyTwoFactorHandler, newVerifyTwoFactorArgs, newVerifyTwoFactorResult, false),
+		"ListOidcProviders":             kitex.NewMethodInfo(listOidcProvidersHandler, newListOidcProvidersArgs, newListOidcProvidersResult, false),
+		"StartOidcLogin":                kitex.NewMethodInfo(startOidcLoginHandler, newStartOidcLoginArgs, newStartOidcLoginResult, false),
+		"FinishOidcLogin":               kitex.NewMethodInfo(finishOidcLoginHandler, newFinishOidcLoginArgs, newFinishOidcLoginResult, false),
+		"GetProfile":                    kitex.NewMethodInfo(getProfileHandler, newGetProfileArgs, newGetProfileResult, false),
+		"UpdateProfile":                 kitex.NewMethodInfo(updateProfileHandler, newUpdateProfileArgs, newUpdateProfileResult, false),
+		"ListAddresses":                 kitex.NewMethodInfo(listAddressesHandler, newListAddressesArgs, newListAddressesResult, false),
+		"CreateAddress":                 kitex.NewMethodInfo(createAddressHandler, newCreateAddressArgs, newCreateAddressResult, false),
+		"UpdateAddress":                 kitex.NewMethodInfo(updateAddressHandler, newUpdateAddressArgs, newUpdateAddressResult, false),
+		"DeleteAddress":                 kitex.NewMethodInfo(deleteAddressHandler, newDeleteAddressArgs, newDeleteAddressResult, false),
+		"SetDefaultAddress":             kitex.NewMethodInfo(setDefaultAddressHandler, newSetDefaultAddressArgs, newSetDefaultAddressResult, false),
+		"RequestDataExport":             kitex.NewMethodInfo(requestDataExportHandler, newRequestDataExportArgs, newRequestDataExportResult, false),
+		"DeleteAccount":                 kitex.NewMethodInfo(deleteAccountHandler, newDeleteAccountArgs, newDeleteAccountResult, false),
+		"ConfirmAccountDeletion":        kitex.NewMethodInfo(confirmAccountDeletionHandler, newConfirmAccountDeletionArgs, newConfirmAccountDeletionResult, false),
+		"GetAccountDeletion":            kitex.NewMethodInfo(getAccountDeletionHandler, newGetAccountDeletionArgs, newGetAccountDeletionResult, false),
 	}
 	extra := map[string]interface{}{
-		"PackageName": "user",
-	}
-	if hasStreaming {
-		extra["streaming"] = hasStreaming
+		"PackageName":     "user",
+		"ServiceFilePath": ``,
 	}
 	svcInfo := &kitex.ServiceInfo{
 		ServiceName:     serviceName,
 		HandlerType:     handlerType,
 		Methods:         methods,
 		PayloadCodec:    kitex.Protobuf,
-		KiteXGenVersion: "v0.9.1",
+		KiteXGenVersion: "v0.8.0",
 		Extra:           extra,
 	}
 	return svcInfo
@@ -107,7 +86,9 @@ func registerHandler(ctx context.Context, handler interface{}, arg, result inter
 		if err != nil {
 			return err
 		}
-		return st.SendMsg(resp)
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
 	case *RegisterArgs:
 		success, err := handler.(user.UserService).Register(ctx, s.Req)
 		if err != nil {
@@ -115,10 +96,8 @@ func registerHandler(ctx context.Context, handler interface{}, arg, result inter
 		}
 		realResult := result.(*RegisterResult)
 		realResult.Success = success
-		return nil
-	default:
-		return errInvalidMessageType
 	}
+	return nil
 }
 func newRegisterArgs() interface{} {
 	return &RegisterArgs{}
@@ -260,7 +239,9 @@ func loginHandler(ctx context.Context, handler interface{}, arg, result interfac
 		if err != nil {
 			return err
 		}
-		return st.SendMsg(resp)
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
 	case *LoginArgs:
 		success, err := handler.(user.UserService).Login(ctx, s.Req)
 		if err != nil {
@@ -268,10 +249,8 @@ func loginHandler(ctx context.Context, handler interface{}, arg, result interfac
 		}
 		realResult := result.(*LoginResult)
 		realResult.Success = success
-		return nil
-	default:
-		return errInvalidMessageType
 	}
+	return nil
 }
 func newLoginArgs() interface{} {
 	return &LoginArgs{}
@@ -401,31 +380,5736 @@ func (p *LoginResult) GetResult() interface{} {
 	return p.Success
 }
 
-type kClient struct {
-	c client.Client
+func getNotificationPreferencesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.GetNotificationPreferencesReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).GetNotificationPreferences(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GetNotificationPreferencesArgs:
+		success, err := handler.(user.UserService).GetNotificationPreferences(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetNotificationPreferencesResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGetNotificationPreferencesArgs() interface{} {
+	return &GetNotificationPreferencesArgs{}
 }
 
-func newServiceClient(c client.Client) *kClient {
-	return &kClient{
-		c: c,
+func newGetNotificationPreferencesResult() interface{} {
+	return &GetNotificationPreferencesResult{}
+}
+
+type GetNotificationPreferencesArgs struct {
+	Req *user.GetNotificationPreferencesReq
+}
+
+func (p *GetNotificationPreferencesArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.GetNotificationPreferencesReq)
 	}
+	return p.Req.FastRead(buf, _type, number)
 }
 
-func (p *kClient) Register(ctx context.Context, Req *user.RegisterReq) (r *user.RegisterResp, err error) {
-	var _args RegisterArgs
-	_args.Req = Req
-	var _result RegisterResult
-	if err = p.c.Call(ctx, "Register", &_args, &_result); err != nil {
-		return
+func (p *GetNotificationPreferencesArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
 	}
-	return _result.GetSuccess(), nil
+	return p.Req.FastWrite(buf)
 }
 
-func (p *kClient) Login(ctx context.Context, Req *user.LoginReq) (r *user.LoginResp, err error) {
-	var _args LoginArgs
-	_args.Req = Req
-	var _result LoginResult
-	if err = p.c.Call(ctx, "Login", &_args, &_result); err != nil {
+func (p *GetNotificationPreferencesArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetNotificationPreferencesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetNotificationPreferencesArgs) Unmarshal(in []byte) error {
+	msg := new(user.GetNotificationPreferencesReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetNotificationPreferencesArgs_Req_DEFAULT *user.GetNotificationPreferencesReq
+
+func (p *GetNotificationPreferencesArgs) GetReq() *user.GetNotificationPreferencesReq {
+	if !p.IsSetReq() {
+		return GetNotificationPreferencesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetNotificationPreferencesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetNotificationPreferencesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetNotificationPreferencesResult struct {
+	Success *user.GetNotificationPreferencesResp
+}
+
+var GetNotificationPreferencesResult_Success_DEFAULT *user.GetNotificationPreferencesResp
+
+func (p *GetNotificationPreferencesResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.GetNotificationPreferencesResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetNotificationPreferencesResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetNotificationPreferencesResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetNotificationPreferencesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetNotificationPreferencesResult) Unmarshal(in []byte) error {
+	msg := new(user.GetNotificationPreferencesResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetNotificationPreferencesResult) GetSuccess() *user.GetNotificationPreferencesResp {
+	if !p.IsSetSuccess() {
+		return GetNotificationPreferencesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetNotificationPreferencesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.GetNotificationPreferencesResp)
+}
+
+func (p *GetNotificationPreferencesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetNotificationPreferencesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateNotificationPreferencesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.UpdateNotificationPreferencesReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).UpdateNotificationPreferences(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *UpdateNotificationPreferencesArgs:
+		success, err := handler.(user.UserService).UpdateNotificationPreferences(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateNotificationPreferencesResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newUpdateNotificationPreferencesArgs() interface{} {
+	return &UpdateNotificationPreferencesArgs{}
+}
+
+func newUpdateNotificationPreferencesResult() interface{} {
+	return &UpdateNotificationPreferencesResult{}
+}
+
+type UpdateNotificationPreferencesArgs struct {
+	Req *user.UpdateNotificationPreferencesReq
+}
+
+func (p *UpdateNotificationPreferencesArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.UpdateNotificationPreferencesReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateNotificationPreferencesArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateNotificationPreferencesArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateNotificationPreferencesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateNotificationPreferencesArgs) Unmarshal(in []byte) error {
+	msg := new(user.UpdateNotificationPreferencesReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateNotificationPreferencesArgs_Req_DEFAULT *user.UpdateNotificationPreferencesReq
+
+func (p *UpdateNotificationPreferencesArgs) GetReq() *user.UpdateNotificationPreferencesReq {
+	if !p.IsSetReq() {
+		return UpdateNotificationPreferencesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateNotificationPreferencesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateNotificationPreferencesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateNotificationPreferencesResult struct {
+	Success *user.UpdateNotificationPreferencesResp
+}
+
+var UpdateNotificationPreferencesResult_Success_DEFAULT *user.UpdateNotificationPreferencesResp
+
+func (p *UpdateNotificationPreferencesResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.UpdateNotificationPreferencesResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateNotificationPreferencesResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateNotificationPreferencesResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateNotificationPreferencesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateNotificationPreferencesResult) Unmarshal(in []byte) error {
+	msg := new(user.UpdateNotificationPreferencesResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateNotificationPreferencesResult) GetSuccess() *user.UpdateNotificationPreferencesResp {
+	if !p.IsSetSuccess() {
+		return UpdateNotificationPreferencesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateNotificationPreferencesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.UpdateNotificationPreferencesResp)
+}
+
+func (p *UpdateNotificationPreferencesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateNotificationPreferencesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func unsubscribeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.UnsubscribeReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).Unsubscribe(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *UnsubscribeArgs:
+		success, err := handler.(user.UserService).Unsubscribe(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UnsubscribeResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newUnsubscribeArgs() interface{} {
+	return &UnsubscribeArgs{}
+}
+
+func newUnsubscribeResult() interface{} {
+	return &UnsubscribeResult{}
+}
+
+type UnsubscribeArgs struct {
+	Req *user.UnsubscribeReq
+}
+
+func (p *UnsubscribeArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.UnsubscribeReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UnsubscribeArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UnsubscribeArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UnsubscribeArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UnsubscribeArgs) Unmarshal(in []byte) error {
+	msg := new(user.UnsubscribeReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UnsubscribeArgs_Req_DEFAULT *user.UnsubscribeReq
+
+func (p *UnsubscribeArgs) GetReq() *user.UnsubscribeReq {
+	if !p.IsSetReq() {
+		return UnsubscribeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UnsubscribeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UnsubscribeArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UnsubscribeResult struct {
+	Success *user.UnsubscribeResp
+}
+
+var UnsubscribeResult_Success_DEFAULT *user.UnsubscribeResp
+
+func (p *UnsubscribeResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.UnsubscribeResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UnsubscribeResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UnsubscribeResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UnsubscribeResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UnsubscribeResult) Unmarshal(in []byte) error {
+	msg := new(user.UnsubscribeResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UnsubscribeResult) GetSuccess() *user.UnsubscribeResp {
+	if !p.IsSetSuccess() {
+		return UnsubscribeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UnsubscribeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.UnsubscribeResp)
+}
+
+func (p *UnsubscribeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UnsubscribeResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getJwksHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.GetJwksReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).GetJwks(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GetJwksArgs:
+		success, err := handler.(user.UserService).GetJwks(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetJwksResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGetJwksArgs() interface{} {
+	return &GetJwksArgs{}
+}
+
+func newGetJwksResult() interface{} {
+	return &GetJwksResult{}
+}
+
+type GetJwksArgs struct {
+	Req *user.GetJwksReq
+}
+
+func (p *GetJwksArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.GetJwksReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetJwksArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetJwksArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetJwksArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetJwksArgs) Unmarshal(in []byte) error {
+	msg := new(user.GetJwksReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetJwksArgs_Req_DEFAULT *user.GetJwksReq
+
+func (p *GetJwksArgs) GetReq() *user.GetJwksReq {
+	if !p.IsSetReq() {
+		return GetJwksArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetJwksArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetJwksArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetJwksResult struct {
+	Success *user.GetJwksResp
+}
+
+var GetJwksResult_Success_DEFAULT *user.GetJwksResp
+
+func (p *GetJwksResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.GetJwksResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetJwksResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetJwksResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetJwksResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetJwksResult) Unmarshal(in []byte) error {
+	msg := new(user.GetJwksResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetJwksResult) GetSuccess() *user.GetJwksResp {
+	if !p.IsSetSuccess() {
+		return GetJwksResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetJwksResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.GetJwksResp)
+}
+
+func (p *GetJwksResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetJwksResult) GetResult() interface{} {
+	return p.Success
+}
+
+func refreshTokenHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RefreshTokenReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RefreshToken(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *RefreshTokenArgs:
+		success, err := handler.(user.UserService).RefreshToken(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RefreshTokenResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newRefreshTokenArgs() interface{} {
+	return &RefreshTokenArgs{}
+}
+
+func newRefreshTokenResult() interface{} {
+	return &RefreshTokenResult{}
+}
+
+type RefreshTokenArgs struct {
+	Req *user.RefreshTokenReq
+}
+
+func (p *RefreshTokenArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RefreshTokenReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RefreshTokenArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RefreshTokenArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RefreshTokenArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RefreshTokenArgs) Unmarshal(in []byte) error {
+	msg := new(user.RefreshTokenReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RefreshTokenArgs_Req_DEFAULT *user.RefreshTokenReq
+
+func (p *RefreshTokenArgs) GetReq() *user.RefreshTokenReq {
+	if !p.IsSetReq() {
+		return RefreshTokenArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RefreshTokenArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RefreshTokenArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RefreshTokenResult struct {
+	Success *user.RefreshTokenResp
+}
+
+var RefreshTokenResult_Success_DEFAULT *user.RefreshTokenResp
+
+func (p *RefreshTokenResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RefreshTokenResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RefreshTokenResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RefreshTokenResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RefreshTokenResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RefreshTokenResult) Unmarshal(in []byte) error {
+	msg := new(user.RefreshTokenResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RefreshTokenResult) GetSuccess() *user.RefreshTokenResp {
+	if !p.IsSetSuccess() {
+		return RefreshTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RefreshTokenResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RefreshTokenResp)
+}
+
+func (p *RefreshTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RefreshTokenResult) GetResult() interface{} {
+	return p.Success
+}
+
+func revokeTokenHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RevokeTokenReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RevokeToken(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *RevokeTokenArgs:
+		success, err := handler.(user.UserService).RevokeToken(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RevokeTokenResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newRevokeTokenArgs() interface{} {
+	return &RevokeTokenArgs{}
+}
+
+func newRevokeTokenResult() interface{} {
+	return &RevokeTokenResult{}
+}
+
+type RevokeTokenArgs struct {
+	Req *user.RevokeTokenReq
+}
+
+func (p *RevokeTokenArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RevokeTokenReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RevokeTokenArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RevokeTokenArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RevokeTokenArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RevokeTokenArgs) Unmarshal(in []byte) error {
+	msg := new(user.RevokeTokenReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RevokeTokenArgs_Req_DEFAULT *user.RevokeTokenReq
+
+func (p *RevokeTokenArgs) GetReq() *user.RevokeTokenReq {
+	if !p.IsSetReq() {
+		return RevokeTokenArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RevokeTokenArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RevokeTokenArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RevokeTokenResult struct {
+	Success *user.RevokeTokenResp
+}
+
+var RevokeTokenResult_Success_DEFAULT *user.RevokeTokenResp
+
+func (p *RevokeTokenResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RevokeTokenResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RevokeTokenResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RevokeTokenResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RevokeTokenResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RevokeTokenResult) Unmarshal(in []byte) error {
+	msg := new(user.RevokeTokenResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RevokeTokenResult) GetSuccess() *user.RevokeTokenResp {
+	if !p.IsSetSuccess() {
+		return RevokeTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RevokeTokenResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RevokeTokenResp)
+}
+
+func (p *RevokeTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RevokeTokenResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listSessionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListSessionsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListSessions(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ListSessionsArgs:
+		success, err := handler.(user.UserService).ListSessions(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListSessionsResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newListSessionsArgs() interface{} {
+	return &ListSessionsArgs{}
+}
+
+func newListSessionsResult() interface{} {
+	return &ListSessionsResult{}
+}
+
+type ListSessionsArgs struct {
+	Req *user.ListSessionsReq
+}
+
+func (p *ListSessionsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ListSessionsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListSessionsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListSessionsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListSessionsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListSessionsArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListSessionsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListSessionsArgs_Req_DEFAULT *user.ListSessionsReq
+
+func (p *ListSessionsArgs) GetReq() *user.ListSessionsReq {
+	if !p.IsSetReq() {
+		return ListSessionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListSessionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListSessionsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListSessionsResult struct {
+	Success *user.ListSessionsResp
+}
+
+var ListSessionsResult_Success_DEFAULT *user.ListSessionsResp
+
+func (p *ListSessionsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ListSessionsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListSessionsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListSessionsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListSessionsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListSessionsResult) Unmarshal(in []byte) error {
+	msg := new(user.ListSessionsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListSessionsResult) GetSuccess() *user.ListSessionsResp {
+	if !p.IsSetSuccess() {
+		return ListSessionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListSessionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListSessionsResp)
+}
+
+func (p *ListSessionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListSessionsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func sendVerificationEmailHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.SendVerificationEmailReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).SendVerificationEmail(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *SendVerificationEmailArgs:
+		success, err := handler.(user.UserService).SendVerificationEmail(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SendVerificationEmailResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newSendVerificationEmailArgs() interface{} {
+	return &SendVerificationEmailArgs{}
+}
+
+func newSendVerificationEmailResult() interface{} {
+	return &SendVerificationEmailResult{}
+}
+
+type SendVerificationEmailArgs struct {
+	Req *user.SendVerificationEmailReq
+}
+
+func (p *SendVerificationEmailArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.SendVerificationEmailReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *SendVerificationEmailArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *SendVerificationEmailArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *SendVerificationEmailArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SendVerificationEmailArgs) Unmarshal(in []byte) error {
+	msg := new(user.SendVerificationEmailReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SendVerificationEmailArgs_Req_DEFAULT *user.SendVerificationEmailReq
+
+func (p *SendVerificationEmailArgs) GetReq() *user.SendVerificationEmailReq {
+	if !p.IsSetReq() {
+		return SendVerificationEmailArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SendVerificationEmailArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SendVerificationEmailArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SendVerificationEmailResult struct {
+	Success *user.SendVerificationEmailResp
+}
+
+var SendVerificationEmailResult_Success_DEFAULT *user.SendVerificationEmailResp
+
+func (p *SendVerificationEmailResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.SendVerificationEmailResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *SendVerificationEmailResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *SendVerificationEmailResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *SendVerificationEmailResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SendVerificationEmailResult) Unmarshal(in []byte) error {
+	msg := new(user.SendVerificationEmailResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SendVerificationEmailResult) GetSuccess() *user.SendVerificationEmailResp {
+	if !p.IsSetSuccess() {
+		return SendVerificationEmailResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SendVerificationEmailResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.SendVerificationEmailResp)
+}
+
+func (p *SendVerificationEmailResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SendVerificationEmailResult) GetResult() interface{} {
+	return p.Success
+}
+
+func verifyEmailHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.VerifyEmailReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).VerifyEmail(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *VerifyEmailArgs:
+		success, err := handler.(user.UserService).VerifyEmail(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*VerifyEmailResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newVerifyEmailArgs() interface{} {
+	return &VerifyEmailArgs{}
+}
+
+func newVerifyEmailResult() interface{} {
+	return &VerifyEmailResult{}
+}
+
+type VerifyEmailArgs struct {
+	Req *user.VerifyEmailReq
+}
+
+func (p *VerifyEmailArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.VerifyEmailReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *VerifyEmailArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *VerifyEmailArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *VerifyEmailArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *VerifyEmailArgs) Unmarshal(in []byte) error {
+	msg := new(user.VerifyEmailReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var VerifyEmailArgs_Req_DEFAULT *user.VerifyEmailReq
+
+func (p *VerifyEmailArgs) GetReq() *user.VerifyEmailReq {
+	if !p.IsSetReq() {
+		return VerifyEmailArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *VerifyEmailArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VerifyEmailArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type VerifyEmailResult struct {
+	Success *user.VerifyEmailResp
+}
+
+var VerifyEmailResult_Success_DEFAULT *user.VerifyEmailResp
+
+func (p *VerifyEmailResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.VerifyEmailResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *VerifyEmailResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *VerifyEmailResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *VerifyEmailResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *VerifyEmailResult) Unmarshal(in []byte) error {
+	msg := new(user.VerifyEmailResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *VerifyEmailResult) GetSuccess() *user.VerifyEmailResp {
+	if !p.IsSetSuccess() {
+		return VerifyEmailResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *VerifyEmailResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.VerifyEmailResp)
+}
+
+func (p *VerifyEmailResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerifyEmailResult) GetResult() interface{} {
+	return p.Success
+}
+
+func requestPasswordResetHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RequestPasswordResetReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RequestPasswordReset(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *RequestPasswordResetArgs:
+		success, err := handler.(user.UserService).RequestPasswordReset(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RequestPasswordResetResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newRequestPasswordResetArgs() interface{} {
+	return &RequestPasswordResetArgs{}
+}
+
+func newRequestPasswordResetResult() interface{} {
+	return &RequestPasswordResetResult{}
+}
+
+type RequestPasswordResetArgs struct {
+	Req *user.RequestPasswordResetReq
+}
+
+func (p *RequestPasswordResetArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RequestPasswordResetReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RequestPasswordResetArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RequestPasswordResetArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RequestPasswordResetArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RequestPasswordResetArgs) Unmarshal(in []byte) error {
+	msg := new(user.RequestPasswordResetReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RequestPasswordResetArgs_Req_DEFAULT *user.RequestPasswordResetReq
+
+func (p *RequestPasswordResetArgs) GetReq() *user.RequestPasswordResetReq {
+	if !p.IsSetReq() {
+		return RequestPasswordResetArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RequestPasswordResetArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RequestPasswordResetArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RequestPasswordResetResult struct {
+	Success *user.RequestPasswordResetResp
+}
+
+var RequestPasswordResetResult_Success_DEFAULT *user.RequestPasswordResetResp
+
+func (p *RequestPasswordResetResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RequestPasswordResetResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RequestPasswordResetResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RequestPasswordResetResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RequestPasswordResetResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RequestPasswordResetResult) Unmarshal(in []byte) error {
+	msg := new(user.RequestPasswordResetResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RequestPasswordResetResult) GetSuccess() *user.RequestPasswordResetResp {
+	if !p.IsSetSuccess() {
+		return RequestPasswordResetResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RequestPasswordResetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RequestPasswordResetResp)
+}
+
+func (p *RequestPasswordResetResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RequestPasswordResetResult) GetResult() interface{} {
+	return p.Success
+}
+
+func resetPasswordHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ResetPasswordReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ResetPassword(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ResetPasswordArgs:
+		success, err := handler.(user.UserService).ResetPassword(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ResetPasswordResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newResetPasswordArgs() interface{} {
+	return &ResetPasswordArgs{}
+}
+
+func newResetPasswordResult() interface{} {
+	return &ResetPasswordResult{}
+}
+
+type ResetPasswordArgs struct {
+	Req *user.ResetPasswordReq
+}
+
+func (p *ResetPasswordArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ResetPasswordReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ResetPasswordArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ResetPasswordArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ResetPasswordArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ResetPasswordArgs) Unmarshal(in []byte) error {
+	msg := new(user.ResetPasswordReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ResetPasswordArgs_Req_DEFAULT *user.ResetPasswordReq
+
+func (p *ResetPasswordArgs) GetReq() *user.ResetPasswordReq {
+	if !p.IsSetReq() {
+		return ResetPasswordArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ResetPasswordArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ResetPasswordArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ResetPasswordResult struct {
+	Success *user.ResetPasswordResp
+}
+
+var ResetPasswordResult_Success_DEFAULT *user.ResetPasswordResp
+
+func (p *ResetPasswordResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ResetPasswordResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ResetPasswordResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ResetPasswordResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ResetPasswordResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ResetPasswordResult) Unmarshal(in []byte) error {
+	msg := new(user.ResetPasswordResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ResetPasswordResult) GetSuccess() *user.ResetPasswordResp {
+	if !p.IsSetSuccess() {
+		return ResetPasswordResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ResetPasswordResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ResetPasswordResp)
+}
+
+func (p *ResetPasswordResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ResetPasswordResult) GetResult() interface{} {
+	return p.Success
+}
+
+func unlockAccountHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.UnlockAccountReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).UnlockAccount(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *UnlockAccountArgs:
+		success, err := handler.(user.UserService).UnlockAccount(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UnlockAccountResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newUnlockAccountArgs() interface{} {
+	return &UnlockAccountArgs{}
+}
+
+func newUnlockAccountResult() interface{} {
+	return &UnlockAccountResult{}
+}
+
+type UnlockAccountArgs struct {
+	Req *user.UnlockAccountReq
+}
+
+func (p *UnlockAccountArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.UnlockAccountReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UnlockAccountArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UnlockAccountArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UnlockAccountArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UnlockAccountArgs) Unmarshal(in []byte) error {
+	msg := new(user.UnlockAccountReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UnlockAccountArgs_Req_DEFAULT *user.UnlockAccountReq
+
+func (p *UnlockAccountArgs) GetReq() *user.UnlockAccountReq {
+	if !p.IsSetReq() {
+		return UnlockAccountArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UnlockAccountArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UnlockAccountArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UnlockAccountResult struct {
+	Success *user.UnlockAccountResp
+}
+
+var UnlockAccountResult_Success_DEFAULT *user.UnlockAccountResp
+
+func (p *UnlockAccountResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.UnlockAccountResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UnlockAccountResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UnlockAccountResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UnlockAccountResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UnlockAccountResult) Unmarshal(in []byte) error {
+	msg := new(user.UnlockAccountResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UnlockAccountResult) GetSuccess() *user.UnlockAccountResp {
+	if !p.IsSetSuccess() {
+		return UnlockAccountResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UnlockAccountResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.UnlockAccountResp)
+}
+
+func (p *UnlockAccountResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UnlockAccountResult) GetResult() interface{} {
+	return p.Success
+}
+
+func grantRoleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.GrantRoleReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).GrantRole(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GrantRoleArgs:
+		success, err := handler.(user.UserService).GrantRole(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GrantRoleResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGrantRoleArgs() interface{} {
+	return &GrantRoleArgs{}
+}
+
+func newGrantRoleResult() interface{} {
+	return &GrantRoleResult{}
+}
+
+type GrantRoleArgs struct {
+	Req *user.GrantRoleReq
+}
+
+func (p *GrantRoleArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.GrantRoleReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GrantRoleArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GrantRoleArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GrantRoleArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GrantRoleArgs) Unmarshal(in []byte) error {
+	msg := new(user.GrantRoleReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GrantRoleArgs_Req_DEFAULT *user.GrantRoleReq
+
+func (p *GrantRoleArgs) GetReq() *user.GrantRoleReq {
+	if !p.IsSetReq() {
+		return GrantRoleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GrantRoleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GrantRoleArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GrantRoleResult struct {
+	Success *user.GrantRoleResp
+}
+
+var GrantRoleResult_Success_DEFAULT *user.GrantRoleResp
+
+func (p *GrantRoleResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.GrantRoleResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GrantRoleResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GrantRoleResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GrantRoleResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GrantRoleResult) Unmarshal(in []byte) error {
+	msg := new(user.GrantRoleResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GrantRoleResult) GetSuccess() *user.GrantRoleResp {
+	if !p.IsSetSuccess() {
+		return GrantRoleResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GrantRoleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.GrantRoleResp)
+}
+
+func (p *GrantRoleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GrantRoleResult) GetResult() interface{} {
+	return p.Success
+}
+
+func revokeRoleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RevokeRoleReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RevokeRole(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *RevokeRoleArgs:
+		success, err := handler.(user.UserService).RevokeRole(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RevokeRoleResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newRevokeRoleArgs() interface{} {
+	return &RevokeRoleArgs{}
+}
+
+func newRevokeRoleResult() interface{} {
+	return &RevokeRoleResult{}
+}
+
+type RevokeRoleArgs struct {
+	Req *user.RevokeRoleReq
+}
+
+func (p *RevokeRoleArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RevokeRoleReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RevokeRoleArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RevokeRoleArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RevokeRoleArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RevokeRoleArgs) Unmarshal(in []byte) error {
+	msg := new(user.RevokeRoleReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RevokeRoleArgs_Req_DEFAULT *user.RevokeRoleReq
+
+func (p *RevokeRoleArgs) GetReq() *user.RevokeRoleReq {
+	if !p.IsSetReq() {
+		return RevokeRoleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RevokeRoleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RevokeRoleArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RevokeRoleResult struct {
+	Success *user.RevokeRoleResp
+}
+
+var RevokeRoleResult_Success_DEFAULT *user.RevokeRoleResp
+
+func (p *RevokeRoleResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RevokeRoleResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RevokeRoleResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RevokeRoleResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RevokeRoleResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RevokeRoleResult) Unmarshal(in []byte) error {
+	msg := new(user.RevokeRoleResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RevokeRoleResult) GetSuccess() *user.RevokeRoleResp {
+	if !p.IsSetSuccess() {
+		return RevokeRoleResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RevokeRoleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RevokeRoleResp)
+}
+
+func (p *RevokeRoleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RevokeRoleResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listRoleAuditHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListRoleAuditReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListRoleAudit(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ListRoleAuditArgs:
+		success, err := handler.(user.UserService).ListRoleAudit(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListRoleAuditResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newListRoleAuditArgs() interface{} {
+	return &ListRoleAuditArgs{}
+}
+
+func newListRoleAuditResult() interface{} {
+	return &ListRoleAuditResult{}
+}
+
+type ListRoleAuditArgs struct {
+	Req *user.ListRoleAuditReq
+}
+
+func (p *ListRoleAuditArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ListRoleAuditReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListRoleAuditArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListRoleAuditArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListRoleAuditArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListRoleAuditArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListRoleAuditReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListRoleAuditArgs_Req_DEFAULT *user.ListRoleAuditReq
+
+func (p *ListRoleAuditArgs) GetReq() *user.ListRoleAuditReq {
+	if !p.IsSetReq() {
+		return ListRoleAuditArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListRoleAuditArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListRoleAuditArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListRoleAuditResult struct {
+	Success *user.ListRoleAuditResp
+}
+
+var ListRoleAuditResult_Success_DEFAULT *user.ListRoleAuditResp
+
+func (p *ListRoleAuditResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ListRoleAuditResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListRoleAuditResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListRoleAuditResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListRoleAuditResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListRoleAuditResult) Unmarshal(in []byte) error {
+	msg := new(user.ListRoleAuditResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListRoleAuditResult) GetSuccess() *user.ListRoleAuditResp {
+	if !p.IsSetSuccess() {
+		return ListRoleAuditResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListRoleAuditResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListRoleAuditResp)
+}
+
+func (p *ListRoleAuditResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListRoleAuditResult) GetResult() interface{} {
+	return p.Success
+}
+
+func enrollTotpHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.EnrollTotpReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).EnrollTotp(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *EnrollTotpArgs:
+		success, err := handler.(user.UserService).EnrollTotp(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*EnrollTotpResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newEnrollTotpArgs() interface{} {
+	return &EnrollTotpArgs{}
+}
+
+func newEnrollTotpResult() interface{} {
+	return &EnrollTotpResult{}
+}
+
+type EnrollTotpArgs struct {
+	Req *user.EnrollTotpReq
+}
+
+func (p *EnrollTotpArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.EnrollTotpReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *EnrollTotpArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *EnrollTotpArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *EnrollTotpArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *EnrollTotpArgs) Unmarshal(in []byte) error {
+	msg := new(user.EnrollTotpReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var EnrollTotpArgs_Req_DEFAULT *user.EnrollTotpReq
+
+func (p *EnrollTotpArgs) GetReq() *user.EnrollTotpReq {
+	if !p.IsSetReq() {
+		return EnrollTotpArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *EnrollTotpArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *EnrollTotpArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type EnrollTotpResult struct {
+	Success *user.EnrollTotpResp
+}
+
+var EnrollTotpResult_Success_DEFAULT *user.EnrollTotpResp
+
+func (p *EnrollTotpResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.EnrollTotpResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *EnrollTotpResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *EnrollTotpResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *EnrollTotpResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *EnrollTotpResult) Unmarshal(in []byte) error {
+	msg := new(user.EnrollTotpResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *EnrollTotpResult) GetSuccess() *user.EnrollTotpResp {
+	if !p.IsSetSuccess() {
+		return EnrollTotpResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *EnrollTotpResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.EnrollTotpResp)
+}
+
+func (p *EnrollTotpResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *EnrollTotpResult) GetResult() interface{} {
+	return p.Success
+}
+
+func confirmTotpHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ConfirmTotpReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ConfirmTotp(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ConfirmTotpArgs:
+		success, err := handler.(user.UserService).ConfirmTotp(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ConfirmTotpResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newConfirmTotpArgs() interface{} {
+	return &ConfirmTotpArgs{}
+}
+
+func newConfirmTotpResult() interface{} {
+	return &ConfirmTotpResult{}
+}
+
+type ConfirmTotpArgs struct {
+	Req *user.ConfirmTotpReq
+}
+
+func (p *ConfirmTotpArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ConfirmTotpReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ConfirmTotpArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ConfirmTotpArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ConfirmTotpArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ConfirmTotpArgs) Unmarshal(in []byte) error {
+	msg := new(user.ConfirmTotpReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ConfirmTotpArgs_Req_DEFAULT *user.ConfirmTotpReq
+
+func (p *ConfirmTotpArgs) GetReq() *user.ConfirmTotpReq {
+	if !p.IsSetReq() {
+		return ConfirmTotpArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ConfirmTotpArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ConfirmTotpArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ConfirmTotpResult struct {
+	Success *user.ConfirmTotpResp
+}
+
+var ConfirmTotpResult_Success_DEFAULT *user.ConfirmTotpResp
+
+func (p *ConfirmTotpResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ConfirmTotpResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ConfirmTotpResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ConfirmTotpResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ConfirmTotpResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ConfirmTotpResult) Unmarshal(in []byte) error {
+	msg := new(user.ConfirmTotpResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ConfirmTotpResult) GetSuccess() *user.ConfirmTotpResp {
+	if !p.IsSetSuccess() {
+		return ConfirmTotpResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ConfirmTotpResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ConfirmTotpResp)
+}
+
+func (p *ConfirmTotpResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ConfirmTotpResult) GetResult() interface{} {
+	return p.Success
+}
+
+func disableTotpHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.DisableTotpReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).DisableTotp(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *DisableTotpArgs:
+		success, err := handler.(user.UserService).DisableTotp(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DisableTotpResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newDisableTotpArgs() interface{} {
+	return &DisableTotpArgs{}
+}
+
+func newDisableTotpResult() interface{} {
+	return &DisableTotpResult{}
+}
+
+type DisableTotpArgs struct {
+	Req *user.DisableTotpReq
+}
+
+func (p *DisableTotpArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.DisableTotpReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DisableTotpArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DisableTotpArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DisableTotpArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DisableTotpArgs) Unmarshal(in []byte) error {
+	msg := new(user.DisableTotpReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DisableTotpArgs_Req_DEFAULT *user.DisableTotpReq
+
+func (p *DisableTotpArgs) GetReq() *user.DisableTotpReq {
+	if !p.IsSetReq() {
+		return DisableTotpArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DisableTotpArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DisableTotpArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DisableTotpResult struct {
+	Success *user.DisableTotpResp
+}
+
+var DisableTotpResult_Success_DEFAULT *user.DisableTotpResp
+
+func (p *DisableTotpResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.DisableTotpResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DisableTotpResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DisableTotpResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DisableTotpResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DisableTotpResult) Unmarshal(in []byte) error {
+	msg := new(user.DisableTotpResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DisableTotpResult) GetSuccess() *user.DisableTotpResp {
+	if !p.IsSetSuccess() {
+		return DisableTotpResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DisableTotpResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.DisableTotpResp)
+}
+
+func (p *DisableTotpResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DisableTotpResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getTotpStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.GetTotpStatusReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).GetTotpStatus(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GetTotpStatusArgs:
+		success, err := handler.(user.UserService).GetTotpStatus(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetTotpStatusResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGetTotpStatusArgs() interface{} {
+	return &GetTotpStatusArgs{}
+}
+
+func newGetTotpStatusResult() interface{} {
+	return &GetTotpStatusResult{}
+}
+
+type GetTotpStatusArgs struct {
+	Req *user.GetTotpStatusReq
+}
+
+func (p *GetTotpStatusArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.GetTotpStatusReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetTotpStatusArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetTotpStatusArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetTotpStatusArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetTotpStatusArgs) Unmarshal(in []byte) error {
+	msg := new(user.GetTotpStatusReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetTotpStatusArgs_Req_DEFAULT *user.GetTotpStatusReq
+
+func (p *GetTotpStatusArgs) GetReq() *user.GetTotpStatusReq {
+	if !p.IsSetReq() {
+		return GetTotpStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetTotpStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetTotpStatusArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetTotpStatusResult struct {
+	Success *user.GetTotpStatusResp
+}
+
+var GetTotpStatusResult_Success_DEFAULT *user.GetTotpStatusResp
+
+func (p *GetTotpStatusResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.GetTotpStatusResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetTotpStatusResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetTotpStatusResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetTotpStatusResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetTotpStatusResult) Unmarshal(in []byte) error {
+	msg := new(user.GetTotpStatusResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetTotpStatusResult) GetSuccess() *user.GetTotpStatusResp {
+	if !p.IsSetSuccess() {
+		return GetTotpStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetTotpStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.GetTotpStatusResp)
+}
+
+func (p *GetTotpStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetTotpStatusResult) GetResult() interface{} {
+	return p.Success
+}
+
+func regenerateRecoveryCodesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RegenerateRecoveryCodesReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RegenerateRecoveryCodes(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *RegenerateRecoveryCodesArgs:
+		success, err := handler.(user.UserService).RegenerateRecoveryCodes(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RegenerateRecoveryCodesResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newRegenerateRecoveryCodesArgs() interface{} {
+	return &RegenerateRecoveryCodesArgs{}
+}
+
+func newRegenerateRecoveryCodesResult() interface{} {
+	return &RegenerateRecoveryCodesResult{}
+}
+
+type RegenerateRecoveryCodesArgs struct {
+	Req *user.RegenerateRecoveryCodesReq
+}
+
+func (p *RegenerateRecoveryCodesArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RegenerateRecoveryCodesReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RegenerateRecoveryCodesArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RegenerateRecoveryCodesArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RegenerateRecoveryCodesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RegenerateRecoveryCodesArgs) Unmarshal(in []byte) error {
+	msg := new(user.RegenerateRecoveryCodesReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RegenerateRecoveryCodesArgs_Req_DEFAULT *user.RegenerateRecoveryCodesReq
+
+func (p *RegenerateRecoveryCodesArgs) GetReq() *user.RegenerateRecoveryCodesReq {
+	if !p.IsSetReq() {
+		return RegenerateRecoveryCodesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RegenerateRecoveryCodesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RegenerateRecoveryCodesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RegenerateRecoveryCodesResult struct {
+	Success *user.RegenerateRecoveryCodesResp
+}
+
+var RegenerateRecoveryCodesResult_Success_DEFAULT *user.RegenerateRecoveryCodesResp
+
+func (p *RegenerateRecoveryCodesResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RegenerateRecoveryCodesResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RegenerateRecoveryCodesResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RegenerateRecoveryCodesResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RegenerateRecoveryCodesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RegenerateRecoveryCodesResult) Unmarshal(in []byte) error {
+	msg := new(user.RegenerateRecoveryCodesResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RegenerateRecoveryCodesResult) GetSuccess() *user.RegenerateRecoveryCodesResp {
+	if !p.IsSetSuccess() {
+		return RegenerateRecoveryCodesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RegenerateRecoveryCodesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RegenerateRecoveryCodesResp)
+}
+
+func (p *RegenerateRecoveryCodesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RegenerateRecoveryCodesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func verifyTwoFactorHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.VerifyTwoFactorReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).VerifyTwoFactor(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *VerifyTwoFactorArgs:
+		success, err := handler.(user.UserService).VerifyTwoFactor(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*VerifyTwoFactorResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newVerifyTwoFactorArgs() interface{} {
+	return &VerifyTwoFactorArgs{}
+}
+
+func newVerifyTwoFactorResult() interface{} {
+	return &VerifyTwoFactorResult{}
+}
+
+type VerifyTwoFactorArgs struct {
+	Req *user.VerifyTwoFactorReq
+}
+
+func (p *VerifyTwoFactorArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.VerifyTwoFactorReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *VerifyTwoFactorArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *VerifyTwoFactorArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *VerifyTwoFactorArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *VerifyTwoFactorArgs) Unmarshal(in []byte) error {
+	msg := new(user.VerifyTwoFactorReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var VerifyTwoFactorArgs_Req_DEFAULT *user.VerifyTwoFactorReq
+
+func (p *VerifyTwoFactorArgs) GetReq() *user.VerifyTwoFactorReq {
+	if !p.IsSetReq() {
+		return VerifyTwoFactorArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *VerifyTwoFactorArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VerifyTwoFactorArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type VerifyTwoFactorResult struct {
+	Success *user.LoginResp
+}
+
+var VerifyTwoFactorResult_Success_DEFAULT *user.LoginResp
+
+func (p *VerifyTwoFactorResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.LoginResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *VerifyTwoFactorResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *VerifyTwoFactorResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *VerifyTwoFactorResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *VerifyTwoFactorResult) Unmarshal(in []byte) error {
+	msg := new(user.LoginResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *VerifyTwoFactorResult) GetSuccess() *user.LoginResp {
+	if !p.IsSetSuccess() {
+		return VerifyTwoFactorResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *VerifyTwoFactorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.LoginResp)
+}
+
+func (p *VerifyTwoFactorResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerifyTwoFactorResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listOidcProvidersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListOidcProvidersReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListOidcProviders(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ListOidcProvidersArgs:
+		success, err := handler.(user.UserService).ListOidcProviders(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListOidcProvidersResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newListOidcProvidersArgs() interface{} {
+	return &ListOidcProvidersArgs{}
+}
+
+func newListOidcProvidersResult() interface{} {
+	return &ListOidcProvidersResult{}
+}
+
+type ListOidcProvidersArgs struct {
+	Req *user.ListOidcProvidersReq
+}
+
+func (p *ListOidcProvidersArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ListOidcProvidersReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListOidcProvidersArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListOidcProvidersArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListOidcProvidersArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListOidcProvidersArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListOidcProvidersReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListOidcProvidersArgs_Req_DEFAULT *user.ListOidcProvidersReq
+
+func (p *ListOidcProvidersArgs) GetReq() *user.ListOidcProvidersReq {
+	if !p.IsSetReq() {
+		return ListOidcProvidersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListOidcProvidersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListOidcProvidersArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListOidcProvidersResult struct {
+	Success *user.ListOidcProvidersResp
+}
+
+var ListOidcProvidersResult_Success_DEFAULT *user.ListOidcProvidersResp
+
+func (p *ListOidcProvidersResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ListOidcProvidersResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListOidcProvidersResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListOidcProvidersResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListOidcProvidersResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListOidcProvidersResult) Unmarshal(in []byte) error {
+	msg := new(user.ListOidcProvidersResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListOidcProvidersResult) GetSuccess() *user.ListOidcProvidersResp {
+	if !p.IsSetSuccess() {
+		return ListOidcProvidersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListOidcProvidersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListOidcProvidersResp)
+}
+
+func (p *ListOidcProvidersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListOidcProvidersResult) GetResult() interface{} {
+	return p.Success
+}
+
+func startOidcLoginHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.StartOidcLoginReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).StartOidcLogin(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *StartOidcLoginArgs:
+		success, err := handler.(user.UserService).StartOidcLogin(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*StartOidcLoginResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newStartOidcLoginArgs() interface{} {
+	return &StartOidcLoginArgs{}
+}
+
+func newStartOidcLoginResult() interface{} {
+	return &StartOidcLoginResult{}
+}
+
+type StartOidcLoginArgs struct {
+	Req *user.StartOidcLoginReq
+}
+
+func (p *StartOidcLoginArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.StartOidcLoginReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *StartOidcLoginArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *StartOidcLoginArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *StartOidcLoginArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *StartOidcLoginArgs) Unmarshal(in []byte) error {
+	msg := new(user.StartOidcLoginReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var StartOidcLoginArgs_Req_DEFAULT *user.StartOidcLoginReq
+
+func (p *StartOidcLoginArgs) GetReq() *user.StartOidcLoginReq {
+	if !p.IsSetReq() {
+		return StartOidcLoginArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *StartOidcLoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *StartOidcLoginArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type StartOidcLoginResult struct {
+	Success *user.StartOidcLoginResp
+}
+
+var StartOidcLoginResult_Success_DEFAULT *user.StartOidcLoginResp
+
+func (p *StartOidcLoginResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.StartOidcLoginResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *StartOidcLoginResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *StartOidcLoginResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *StartOidcLoginResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *StartOidcLoginResult) Unmarshal(in []byte) error {
+	msg := new(user.StartOidcLoginResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *StartOidcLoginResult) GetSuccess() *user.StartOidcLoginResp {
+	if !p.IsSetSuccess() {
+		return StartOidcLoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *StartOidcLoginResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.StartOidcLoginResp)
+}
+
+func (p *StartOidcLoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *StartOidcLoginResult) GetResult() interface{} {
+	return p.Success
+}
+
+func finishOidcLoginHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.FinishOidcLoginReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).FinishOidcLogin(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *FinishOidcLoginArgs:
+		success, err := handler.(user.UserService).FinishOidcLogin(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*FinishOidcLoginResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newFinishOidcLoginArgs() interface{} {
+	return &FinishOidcLoginArgs{}
+}
+
+func newFinishOidcLoginResult() interface{} {
+	return &FinishOidcLoginResult{}
+}
+
+type FinishOidcLoginArgs struct {
+	Req *user.FinishOidcLoginReq
+}
+
+func (p *FinishOidcLoginArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.FinishOidcLoginReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *FinishOidcLoginArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *FinishOidcLoginArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *FinishOidcLoginArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *FinishOidcLoginArgs) Unmarshal(in []byte) error {
+	msg := new(user.FinishOidcLoginReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var FinishOidcLoginArgs_Req_DEFAULT *user.FinishOidcLoginReq
+
+func (p *FinishOidcLoginArgs) GetReq() *user.FinishOidcLoginReq {
+	if !p.IsSetReq() {
+		return FinishOidcLoginArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *FinishOidcLoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FinishOidcLoginArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type FinishOidcLoginResult struct {
+	Success *user.LoginResp
+}
+
+var FinishOidcLoginResult_Success_DEFAULT *user.LoginResp
+
+func (p *FinishOidcLoginResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.LoginResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *FinishOidcLoginResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *FinishOidcLoginResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *FinishOidcLoginResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *FinishOidcLoginResult) Unmarshal(in []byte) error {
+	msg := new(user.LoginResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *FinishOidcLoginResult) GetSuccess() *user.LoginResp {
+	if !p.IsSetSuccess() {
+		return FinishOidcLoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *FinishOidcLoginResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.LoginResp)
+}
+
+func (p *FinishOidcLoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FinishOidcLoginResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getProfileHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.GetProfileReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).GetProfile(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GetProfileArgs:
+		success, err := handler.(user.UserService).GetProfile(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetProfileResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGetProfileArgs() interface{} {
+	return &GetProfileArgs{}
+}
+
+func newGetProfileResult() interface{} {
+	return &GetProfileResult{}
+}
+
+type GetProfileArgs struct {
+	Req *user.GetProfileReq
+}
+
+func (p *GetProfileArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.GetProfileReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetProfileArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetProfileArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetProfileArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetProfileArgs) Unmarshal(in []byte) error {
+	msg := new(user.GetProfileReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetProfileArgs_Req_DEFAULT *user.GetProfileReq
+
+func (p *GetProfileArgs) GetReq() *user.GetProfileReq {
+	if !p.IsSetReq() {
+		return GetProfileArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetProfileArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetProfileArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetProfileResult struct {
+	Success *user.GetProfileResp
+}
+
+var GetProfileResult_Success_DEFAULT *user.GetProfileResp
+
+func (p *GetProfileResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.GetProfileResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetProfileResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetProfileResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetProfileResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetProfileResult) Unmarshal(in []byte) error {
+	msg := new(user.GetProfileResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetProfileResult) GetSuccess() *user.GetProfileResp {
+	if !p.IsSetSuccess() {
+		return GetProfileResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetProfileResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.GetProfileResp)
+}
+
+func (p *GetProfileResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetProfileResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateProfileHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.UpdateProfileReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).UpdateProfile(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *UpdateProfileArgs:
+		success, err := handler.(user.UserService).UpdateProfile(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateProfileResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newUpdateProfileArgs() interface{} {
+	return &UpdateProfileArgs{}
+}
+
+func newUpdateProfileResult() interface{} {
+	return &UpdateProfileResult{}
+}
+
+type UpdateProfileArgs struct {
+	Req *user.UpdateProfileReq
+}
+
+func (p *UpdateProfileArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.UpdateProfileReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateProfileArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateProfileArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateProfileArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateProfileArgs) Unmarshal(in []byte) error {
+	msg := new(user.UpdateProfileReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateProfileArgs_Req_DEFAULT *user.UpdateProfileReq
+
+func (p *UpdateProfileArgs) GetReq() *user.UpdateProfileReq {
+	if !p.IsSetReq() {
+		return UpdateProfileArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateProfileArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateProfileArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateProfileResult struct {
+	Success *user.UpdateProfileResp
+}
+
+var UpdateProfileResult_Success_DEFAULT *user.UpdateProfileResp
+
+func (p *UpdateProfileResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.UpdateProfileResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateProfileResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateProfileResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateProfileResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateProfileResult) Unmarshal(in []byte) error {
+	msg := new(user.UpdateProfileResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateProfileResult) GetSuccess() *user.UpdateProfileResp {
+	if !p.IsSetSuccess() {
+		return UpdateProfileResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateProfileResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.UpdateProfileResp)
+}
+
+func (p *UpdateProfileResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateProfileResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listAddressesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListAddressesReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListAddresses(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ListAddressesArgs:
+		success, err := handler.(user.UserService).ListAddresses(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListAddressesResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newListAddressesArgs() interface{} {
+	return &ListAddressesArgs{}
+}
+
+func newListAddressesResult() interface{} {
+	return &ListAddressesResult{}
+}
+
+type ListAddressesArgs struct {
+	Req *user.ListAddressesReq
+}
+
+func (p *ListAddressesArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ListAddressesReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListAddressesArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListAddressesArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListAddressesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListAddressesArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListAddressesReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListAddressesArgs_Req_DEFAULT *user.ListAddressesReq
+
+func (p *ListAddressesArgs) GetReq() *user.ListAddressesReq {
+	if !p.IsSetReq() {
+		return ListAddressesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListAddressesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListAddressesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListAddressesResult struct {
+	Success *user.ListAddressesResp
+}
+
+var ListAddressesResult_Success_DEFAULT *user.ListAddressesResp
+
+func (p *ListAddressesResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ListAddressesResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListAddressesResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListAddressesResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListAddressesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListAddressesResult) Unmarshal(in []byte) error {
+	msg := new(user.ListAddressesResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListAddressesResult) GetSuccess() *user.ListAddressesResp {
+	if !p.IsSetSuccess() {
+		return ListAddressesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListAddressesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListAddressesResp)
+}
+
+func (p *ListAddressesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListAddressesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.CreateAddressReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).CreateAddress(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *CreateAddressArgs:
+		success, err := handler.(user.UserService).CreateAddress(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateAddressResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newCreateAddressArgs() interface{} {
+	return &CreateAddressArgs{}
+}
+
+func newCreateAddressResult() interface{} {
+	return &CreateAddressResult{}
+}
+
+type CreateAddressArgs struct {
+	Req *user.CreateAddressReq
+}
+
+func (p *CreateAddressArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.CreateAddressReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CreateAddressArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CreateAddressArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CreateAddressArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateAddressArgs) Unmarshal(in []byte) error {
+	msg := new(user.CreateAddressReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateAddressArgs_Req_DEFAULT *user.CreateAddressReq
+
+func (p *CreateAddressArgs) GetReq() *user.CreateAddressReq {
+	if !p.IsSetReq() {
+		return CreateAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateAddressArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateAddressResult struct {
+	Success *user.CreateAddressResp
+}
+
+var CreateAddressResult_Success_DEFAULT *user.CreateAddressResp
+
+func (p *CreateAddressResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.CreateAddressResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CreateAddressResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CreateAddressResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CreateAddressResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateAddressResult) Unmarshal(in []byte) error {
+	msg := new(user.CreateAddressResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateAddressResult) GetSuccess() *user.CreateAddressResp {
+	if !p.IsSetSuccess() {
+		return CreateAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.CreateAddressResp)
+}
+
+func (p *CreateAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateAddressResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.UpdateAddressReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).UpdateAddress(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *UpdateAddressArgs:
+		success, err := handler.(user.UserService).UpdateAddress(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateAddressResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newUpdateAddressArgs() interface{} {
+	return &UpdateAddressArgs{}
+}
+
+func newUpdateAddressResult() interface{} {
+	return &UpdateAddressResult{}
+}
+
+type UpdateAddressArgs struct {
+	Req *user.UpdateAddressReq
+}
+
+func (p *UpdateAddressArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.UpdateAddressReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateAddressArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateAddressArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateAddressArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateAddressArgs) Unmarshal(in []byte) error {
+	msg := new(user.UpdateAddressReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateAddressArgs_Req_DEFAULT *user.UpdateAddressReq
+
+func (p *UpdateAddressArgs) GetReq() *user.UpdateAddressReq {
+	if !p.IsSetReq() {
+		return UpdateAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateAddressArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateAddressResult struct {
+	Success *user.UpdateAddressResp
+}
+
+var UpdateAddressResult_Success_DEFAULT *user.UpdateAddressResp
+
+func (p *UpdateAddressResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.UpdateAddressResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateAddressResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateAddressResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateAddressResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateAddressResult) Unmarshal(in []byte) error {
+	msg := new(user.UpdateAddressResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateAddressResult) GetSuccess() *user.UpdateAddressResp {
+	if !p.IsSetSuccess() {
+		return UpdateAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.UpdateAddressResp)
+}
+
+func (p *UpdateAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateAddressResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.DeleteAddressReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).DeleteAddress(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *DeleteAddressArgs:
+		success, err := handler.(user.UserService).DeleteAddress(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteAddressResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newDeleteAddressArgs() interface{} {
+	return &DeleteAddressArgs{}
+}
+
+func newDeleteAddressResult() interface{} {
+	return &DeleteAddressResult{}
+}
+
+type DeleteAddressArgs struct {
+	Req *user.DeleteAddressReq
+}
+
+func (p *DeleteAddressArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.DeleteAddressReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DeleteAddressArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DeleteAddressArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DeleteAddressArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteAddressArgs) Unmarshal(in []byte) error {
+	msg := new(user.DeleteAddressReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteAddressArgs_Req_DEFAULT *user.DeleteAddressReq
+
+func (p *DeleteAddressArgs) GetReq() *user.DeleteAddressReq {
+	if !p.IsSetReq() {
+		return DeleteAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteAddressArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteAddressResult struct {
+	Success *user.DeleteAddressResp
+}
+
+var DeleteAddressResult_Success_DEFAULT *user.DeleteAddressResp
+
+func (p *DeleteAddressResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.DeleteAddressResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DeleteAddressResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DeleteAddressResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DeleteAddressResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteAddressResult) Unmarshal(in []byte) error {
+	msg := new(user.DeleteAddressResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteAddressResult) GetSuccess() *user.DeleteAddressResp {
+	if !p.IsSetSuccess() {
+		return DeleteAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.DeleteAddressResp)
+}
+
+func (p *DeleteAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteAddressResult) GetResult() interface{} {
+	return p.Success
+}
+
+func setDefaultAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.SetDefaultAddressReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).SetDefaultAddress(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *SetDefaultAddressArgs:
+		success, err := handler.(user.UserService).SetDefaultAddress(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SetDefaultAddressResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newSetDefaultAddressArgs() interface{} {
+	return &SetDefaultAddressArgs{}
+}
+
+func newSetDefaultAddressResult() interface{} {
+	return &SetDefaultAddressResult{}
+}
+
+type SetDefaultAddressArgs struct {
+	Req *user.SetDefaultAddressReq
+}
+
+func (p *SetDefaultAddressArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.SetDefaultAddressReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *SetDefaultAddressArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *SetDefaultAddressArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *SetDefaultAddressArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SetDefaultAddressArgs) Unmarshal(in []byte) error {
+	msg := new(user.SetDefaultAddressReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SetDefaultAddressArgs_Req_DEFAULT *user.SetDefaultAddressReq
+
+func (p *SetDefaultAddressArgs) GetReq() *user.SetDefaultAddressReq {
+	if !p.IsSetReq() {
+		return SetDefaultAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SetDefaultAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SetDefaultAddressArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SetDefaultAddressResult struct {
+	Success *user.SetDefaultAddressResp
+}
+
+var SetDefaultAddressResult_Success_DEFAULT *user.SetDefaultAddressResp
+
+func (p *SetDefaultAddressResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.SetDefaultAddressResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *SetDefaultAddressResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *SetDefaultAddressResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *SetDefaultAddressResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SetDefaultAddressResult) Unmarshal(in []byte) error {
+	msg := new(user.SetDefaultAddressResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SetDefaultAddressResult) GetSuccess() *user.SetDefaultAddressResp {
+	if !p.IsSetSuccess() {
+		return SetDefaultAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SetDefaultAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.SetDefaultAddressResp)
+}
+
+func (p *SetDefaultAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SetDefaultAddressResult) GetResult() interface{} {
+	return p.Success
+}
+
+func requestDataExportHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RequestDataExportReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RequestDataExport(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *RequestDataExportArgs:
+		success, err := handler.(user.UserService).RequestDataExport(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RequestDataExportResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newRequestDataExportArgs() interface{} {
+	return &RequestDataExportArgs{}
+}
+
+func newRequestDataExportResult() interface{} {
+	return &RequestDataExportResult{}
+}
+
+type RequestDataExportArgs struct {
+	Req *user.RequestDataExportReq
+}
+
+func (p *RequestDataExportArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RequestDataExportReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RequestDataExportArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RequestDataExportArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RequestDataExportArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RequestDataExportArgs) Unmarshal(in []byte) error {
+	msg := new(user.RequestDataExportReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RequestDataExportArgs_Req_DEFAULT *user.RequestDataExportReq
+
+func (p *RequestDataExportArgs) GetReq() *user.RequestDataExportReq {
+	if !p.IsSetReq() {
+		return RequestDataExportArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RequestDataExportArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RequestDataExportArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RequestDataExportResult struct {
+	Success *user.RequestDataExportResp
+}
+
+var RequestDataExportResult_Success_DEFAULT *user.RequestDataExportResp
+
+func (p *RequestDataExportResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RequestDataExportResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RequestDataExportResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RequestDataExportResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RequestDataExportResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RequestDataExportResult) Unmarshal(in []byte) error {
+	msg := new(user.RequestDataExportResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RequestDataExportResult) GetSuccess() *user.RequestDataExportResp {
+	if !p.IsSetSuccess() {
+		return RequestDataExportResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RequestDataExportResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RequestDataExportResp)
+}
+
+func (p *RequestDataExportResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RequestDataExportResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteAccountHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.DeleteAccountReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).DeleteAccount(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *DeleteAccountArgs:
+		success, err := handler.(user.UserService).DeleteAccount(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteAccountResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newDeleteAccountArgs() interface{} {
+	return &DeleteAccountArgs{}
+}
+
+func newDeleteAccountResult() interface{} {
+	return &DeleteAccountResult{}
+}
+
+type DeleteAccountArgs struct {
+	Req *user.DeleteAccountReq
+}
+
+func (p *DeleteAccountArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.DeleteAccountReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DeleteAccountArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DeleteAccountArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DeleteAccountArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteAccountArgs) Unmarshal(in []byte) error {
+	msg := new(user.DeleteAccountReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteAccountArgs_Req_DEFAULT *user.DeleteAccountReq
+
+func (p *DeleteAccountArgs) GetReq() *user.DeleteAccountReq {
+	if !p.IsSetReq() {
+		return DeleteAccountArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteAccountArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteAccountArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteAccountResult struct {
+	Success *user.DeleteAccountResp
+}
+
+var DeleteAccountResult_Success_DEFAULT *user.DeleteAccountResp
+
+func (p *DeleteAccountResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.DeleteAccountResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DeleteAccountResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DeleteAccountResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DeleteAccountResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteAccountResult) Unmarshal(in []byte) error {
+	msg := new(user.DeleteAccountResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteAccountResult) GetSuccess() *user.DeleteAccountResp {
+	if !p.IsSetSuccess() {
+		return DeleteAccountResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteAccountResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.DeleteAccountResp)
+}
+
+func (p *DeleteAccountResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteAccountResult) GetResult() interface{} {
+	return p.Success
+}
+
+func confirmAccountDeletionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ConfirmAccountDeletionReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ConfirmAccountDeletion(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ConfirmAccountDeletionArgs:
+		success, err := handler.(user.UserService).ConfirmAccountDeletion(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ConfirmAccountDeletionResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newConfirmAccountDeletionArgs() interface{} {
+	return &ConfirmAccountDeletionArgs{}
+}
+
+func newConfirmAccountDeletionResult() interface{} {
+	return &ConfirmAccountDeletionResult{}
+}
+
+type ConfirmAccountDeletionArgs struct {
+	Req *user.ConfirmAccountDeletionReq
+}
+
+func (p *ConfirmAccountDeletionArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ConfirmAccountDeletionReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ConfirmAccountDeletionArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ConfirmAccountDeletionArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ConfirmAccountDeletionArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ConfirmAccountDeletionArgs) Unmarshal(in []byte) error {
+	msg := new(user.ConfirmAccountDeletionReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ConfirmAccountDeletionArgs_Req_DEFAULT *user.ConfirmAccountDeletionReq
+
+func (p *ConfirmAccountDeletionArgs) GetReq() *user.ConfirmAccountDeletionReq {
+	if !p.IsSetReq() {
+		return ConfirmAccountDeletionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ConfirmAccountDeletionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ConfirmAccountDeletionArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ConfirmAccountDeletionResult struct {
+	Success *user.ConfirmAccountDeletionResp
+}
+
+var ConfirmAccountDeletionResult_Success_DEFAULT *user.ConfirmAccountDeletionResp
+
+func (p *ConfirmAccountDeletionResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ConfirmAccountDeletionResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ConfirmAccountDeletionResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ConfirmAccountDeletionResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ConfirmAccountDeletionResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ConfirmAccountDeletionResult) Unmarshal(in []byte) error {
+	msg := new(user.ConfirmAccountDeletionResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ConfirmAccountDeletionResult) GetSuccess() *user.ConfirmAccountDeletionResp {
+	if !p.IsSetSuccess() {
+		return ConfirmAccountDeletionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ConfirmAccountDeletionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ConfirmAccountDeletionResp)
+}
+
+func (p *ConfirmAccountDeletionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ConfirmAccountDeletionResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getAccountDeletionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.GetAccountDeletionReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).GetAccountDeletion(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GetAccountDeletionArgs:
+		success, err := handler.(user.UserService).GetAccountDeletion(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetAccountDeletionResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGetAccountDeletionArgs() interface{} {
+	return &GetAccountDeletionArgs{}
+}
+
+func newGetAccountDeletionResult() interface{} {
+	return &GetAccountDeletionResult{}
+}
+
+type GetAccountDeletionArgs struct {
+	Req *user.GetAccountDeletionReq
+}
+
+func (p *GetAccountDeletionArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.GetAccountDeletionReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetAccountDeletionArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetAccountDeletionArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetAccountDeletionArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetAccountDeletionArgs) Unmarshal(in []byte) error {
+	msg := new(user.GetAccountDeletionReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetAccountDeletionArgs_Req_DEFAULT *user.GetAccountDeletionReq
+
+func (p *GetAccountDeletionArgs) GetReq() *user.GetAccountDeletionReq {
+	if !p.IsSetReq() {
+		return GetAccountDeletionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetAccountDeletionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetAccountDeletionArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetAccountDeletionResult struct {
+	Success *user.GetAccountDeletionResp
+}
+
+var GetAccountDeletionResult_Success_DEFAULT *user.GetAccountDeletionResp
+
+func (p *GetAccountDeletionResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.GetAccountDeletionResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetAccountDeletionResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetAccountDeletionResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetAccountDeletionResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetAccountDeletionResult) Unmarshal(in []byte) error {
+	msg := new(user.GetAccountDeletionResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetAccountDeletionResult) GetSuccess() *user.GetAccountDeletionResp {
+	if !p.IsSetSuccess() {
+		return GetAccountDeletionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetAccountDeletionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.GetAccountDeletionResp)
+}
+
+func (p *GetAccountDeletionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetAccountDeletionResult) GetResult() interface{} {
+	return p.Success
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) Register(ctx context.Context, Req *user.RegisterReq) (r *user.RegisterResp, err error) {
+	var _args RegisterArgs
+	_args.Req = Req
+	var _result RegisterResult
+	if err = p.c.Call(ctx, "Register", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Login(ctx context.Context, Req *user.LoginReq) (r *user.LoginResp, err error) {
+	var _args LoginArgs
+	_args.Req = Req
+	var _result LoginResult
+	if err = p.c.Call(ctx, "Login", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetNotificationPreferences(ctx context.Context, Req *user.GetNotificationPreferencesReq) (r *user.GetNotificationPreferencesResp, err error) {
+	var _args GetNotificationPreferencesArgs
+	_args.Req = Req
+	var _result GetNotificationPreferencesResult
+	if err = p.c.Call(ctx, "GetNotificationPreferences", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateNotificationPreferences(ctx context.Context, Req *user.UpdateNotificationPreferencesReq) (r *user.UpdateNotificationPreferencesResp, err error) {
+	var _args UpdateNotificationPreferencesArgs
+	_args.Req = Req
+	var _result UpdateNotificationPreferencesResult
+	if err = p.c.Call(ctx, "UpdateNotificationPreferences", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Unsubscribe(ctx context.Context, Req *user.UnsubscribeReq) (r *user.UnsubscribeResp, err error) {
+	var _args UnsubscribeArgs
+	_args.Req = Req
+	var _result UnsubscribeResult
+	if err = p.c.Call(ctx, "Unsubscribe", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetJwks(ctx context.Context, Req *user.GetJwksReq) (r *user.GetJwksResp, err error) {
+	var _args GetJwksArgs
+	_args.Req = Req
+	var _result GetJwksResult
+	if err = p.c.Call(ctx, "GetJwks", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RefreshToken(ctx context.Context, Req *user.RefreshTokenReq) (r *user.RefreshTokenResp, err error) {
+	var _args RefreshTokenArgs
+	_args.Req = Req
+	var _result RefreshTokenResult
+	if err = p.c.Call(ctx, "RefreshToken", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RevokeToken(ctx context.Context, Req *user.RevokeTokenReq) (r *user.RevokeTokenResp, err error) {
+	var _args RevokeTokenArgs
+	_args.Req = Req
+	var _result RevokeTokenResult
+	if err = p.c.Call(ctx, "RevokeToken", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListSessions(ctx context.Context, Req *user.ListSessionsReq) (r *user.ListSessionsResp, err error) {
+	var _args ListSessionsArgs
+	_args.Req = Req
+	var _result ListSessionsResult
+	if err = p.c.Call(ctx, "ListSessions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SendVerificationEmail(ctx context.Context, Req *user.SendVerificationEmailReq) (r *user.SendVerificationEmailResp, err error) {
+	var _args SendVerificationEmailArgs
+	_args.Req = Req
+	var _result SendVerificationEmailResult
+	if err = p.c.Call(ctx, "SendVerificationEmail", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) VerifyEmail(ctx context.Context, Req *user.VerifyEmailReq) (r *user.VerifyEmailResp, err error) {
+	var _args VerifyEmailArgs
+	_args.Req = Req
+	var _result VerifyEmailResult
+	if err = p.c.Call(ctx, "VerifyEmail", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RequestPasswordReset(ctx context.Context, Req *user.RequestPasswordResetReq) (r *user.RequestPasswordResetResp, err error) {
+	var _args RequestPasswordResetArgs
+	_args.Req = Req
+	var _result RequestPasswordResetResult
+	if err = p.c.Call(ctx, "RequestPasswordReset", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ResetPassword(ctx context.Context, Req *user.ResetPasswordReq) (r *user.ResetPasswordResp, err error) {
+	var _args ResetPasswordArgs
+	_args.Req = Req
+	var _result ResetPasswordResult
+	if err = p.c.Call(ctx, "ResetPassword", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UnlockAccount(ctx context.Context, Req *user.UnlockAccountReq) (r *user.UnlockAccountResp, err error) {
+	var _args UnlockAccountArgs
+	_args.Req = Req
+	var _result UnlockAccountResult
+	if err = p.c.Call(ctx, "UnlockAccount", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GrantRole(ctx context.Context, Req *user.GrantRoleReq) (r *user.GrantRoleResp, err error) {
+	var _args GrantRoleArgs
+	_args.Req = Req
+	var _result GrantRoleResult
+	if err = p.c.Call(ctx, "GrantRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RevokeRole(ctx context.Context, Req *user.RevokeRoleReq) (r *user.RevokeRoleResp, err error) {
+	var _args RevokeRoleArgs
+	_args.Req = Req
+	var _result RevokeRoleResult
+	if err = p.c.Call(ctx, "RevokeRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListRoleAudit(ctx context.Context, Req *user.ListRoleAuditReq) (r *user.ListRoleAuditResp, err error) {
+	var _args ListRoleAuditArgs
+	_args.Req = Req
+	var _result ListRoleAuditResult
+	if err = p.c.Call(ctx, "ListRoleAudit", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EnrollTotp(ctx context.Context, Req *user.EnrollTotpReq) (r *user.EnrollTotpResp, err error) {
+	var _args EnrollTotpArgs
+	_args.Req = Req
+	var _result EnrollTotpResult
+	if err = p.c.Call(ctx, "EnrollTotp", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ConfirmTotp(ctx context.Context, Req *user.ConfirmTotpReq) (r *user.ConfirmTotpResp, err error) {
+	var _args ConfirmTotpArgs
+	_args.Req = Req
+	var _result ConfirmTotpResult
+	if err = p.c.Call(ctx, "ConfirmTotp", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DisableTotp(ctx context.Context, Req *user.DisableTotpReq) (r *user.DisableTotpResp, err error) {
+	var _args DisableTotpArgs
+	_args.Req = Req
+	var _result DisableTotpResult
+	if err = p.c.Call(ctx, "DisableTotp", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetTotpStatus(ctx context.Context, Req *user.GetTotpStatusReq) (r *user.GetTotpStatusResp, err error) {
+	var _args GetTotpStatusArgs
+	_args.Req = Req
+	var _result GetTotpStatusResult
+	if err = p.c.Call(ctx, "GetTotpStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RegenerateRecoveryCodes(ctx context.Context, Req *user.RegenerateRecoveryCodesReq) (r *user.RegenerateRecoveryCodesResp, err error) {
+	var _args RegenerateRecoveryCodesArgs
+	_args.Req = Req
+	var _result RegenerateRecoveryCodesResult
+	if err = p.c.Call(ctx, "RegenerateRecoveryCodes", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) VerifyTwoFactor(ctx context.Context, Req *user.VerifyTwoFactorReq) (r *user.LoginResp, err error) {
+	var _args VerifyTwoFactorArgs
+	_args.Req = Req
+	var _result VerifyTwoFactorResult
+	if err = p.c.Call(ctx, "VerifyTwoFactor", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListOidcProviders(ctx context.Context, Req *user.ListOidcProvidersReq) (r *user.ListOidcProvidersResp, err error) {
+	var _args ListOidcProvidersArgs
+	_args.Req = Req
+	var _result ListOidcProvidersResult
+	if err = p.c.Call(ctx, "ListOidcProviders", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) StartOidcLogin(ctx context.Context, Req *user.StartOidcLoginReq) (r *user.StartOidcLoginResp, err error) {
+	var _args StartOidcLoginArgs
+	_args.Req = Req
+	var _result StartOidcLoginResult
+	if err = p.c.Call(ctx, "StartOidcLogin", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) FinishOidcLogin(ctx context.Context, Req *user.FinishOidcLoginReq) (r *user.LoginResp, err error) {
+	var _args FinishOidcLoginArgs
+	_args.Req = Req
+	var _result FinishOidcLoginResult
+	if err = p.c.Call(ctx, "FinishOidcLogin", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetProfile(ctx context.Context, Req *user.GetProfileReq) (r *user.GetProfileResp, err error) {
+	var _args GetProfileArgs
+	_args.Req = Req
+	var _result GetProfileResult
+	if err = p.c.Call(ctx, "GetProfile", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateProfile(ctx context.Context, Req *user.UpdateProfileReq) (r *user.UpdateProfileResp, err error) {
+	var _args UpdateProfileArgs
+	_args.Req = Req
+	var _result UpdateProfileResult
+	if err = p.c.Call(ctx, "UpdateProfile", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListAddresses(ctx context.Context, Req *user.ListAddressesReq) (r *user.ListAddressesResp, err error) {
+	var _args ListAddressesArgs
+	_args.Req = Req
+	var _result ListAddressesResult
+	if err = p.c.Call(ctx, "ListAddresses", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateAddress(ctx context.Context, Req *user.CreateAddressReq) (r *user.CreateAddressResp, err error) {
+	var _args CreateAddressArgs
+	_args.Req = Req
+	var _result CreateAddressResult
+	if err = p.c.Call(ctx, "CreateAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateAddress(ctx context.Context, Req *user.UpdateAddressReq) (r *user.UpdateAddressResp, err error) {
+	var _args UpdateAddressArgs
+	_args.Req = Req
+	var _result UpdateAddressResult
+	if err = p.c.Call(ctx, "UpdateAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteAddress(ctx context.Context, Req *user.DeleteAddressReq) (r *user.DeleteAddressResp, err error) {
+	var _args DeleteAddressArgs
+	_args.Req = Req
+	var _result DeleteAddressResult
+	if err = p.c.Call(ctx, "DeleteAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetDefaultAddress(ctx context.Context, Req *user.SetDefaultAddressReq) (r *user.SetDefaultAddressResp, err error) {
+	var _args SetDefaultAddressArgs
+	_args.Req = Req
+	var _result SetDefaultAddressResult
+	if err = p.c.Call(ctx, "SetDefaultAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RequestDataExport(ctx context.Context, Req *user.RequestDataExportReq) (r *user.RequestDataExportResp, err error) {
+	var _args RequestDataExportArgs
+	_args.Req = Req
+	var _result RequestDataExportResult
+	if err = p.c.Call(ctx, "RequestDataExport", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteAccount(ctx context.Context, Req *user.DeleteAccountReq) (r *user.DeleteAccountResp, err error) {
+	var _args DeleteAccountArgs
+	_args.Req = Req
+	var _result DeleteAccountResult
+	if err = p.c.Call(ctx, "DeleteAccount", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ConfirmAccountDeletion(ctx context.Context, Req *user.ConfirmAccountDeletionReq) (r *user.ConfirmAccountDeletionResp, err error) {
+	var _args ConfirmAccountDeletionArgs
+	_args.Req = Req
+	var _result ConfirmAccountDeletionResult
+	if err = p.c.Call(ctx, "ConfirmAccountDeletion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetAccountDeletion(ctx context.Context, Req *user.GetAccountDeletionReq) (r *user.GetAccountDeletionResp, err error) {
+	var _args GetAccountDeletionArgs
+	_args.Req = Req
+	var _result GetAccountDeletionResult
+	if err = p.c.Call(ctx, "GetAccountDeletion", &_args, &_result); err != nil {
 		return
 	}
 	return _result.GetSuccess(), nil
@@ -15,6 +15,8 @@ type RPCClient interface {
 	AddItem(ctx context.Context, Req *cart.AddItemReq, callOptions ...callopt.Option) (r *cart.AddItemResp, err error)
 	GetCart(ctx context.Context, Req *cart.GetCartReq, callOptions ...callopt.Option) (r *cart.GetCartResp, err error)
 	EmptyCart(ctx context.Context, Req *cart.EmptyCartReq, callOptions ...callopt.Option) (r *cart.EmptyCartResp, err error)
+	ExportUserData(ctx context.Context, Req *cart.ExportUserDataReq, callOptions ...callopt.Option) (r *cart.ExportUserDataResp, err error)
+	EraseUserData(ctx context.Context, Req *cart.EraseUserDataReq, callOptions ...callopt.Option) (r *cart.EraseUserDataResp, err error)
 }
 
 func NewRPCClient(dstService string, opts ...client.Option) (RPCClient, error) {
@@ -54,3 +56,11 @@ func (c *clientImpl) GetCart(ctx context.Context, Req *cart.GetCartReq, callOpti
 func (c *clientImpl) EmptyCart(ctx context.Context, Req *cart.EmptyCartReq, callOptions ...callopt.Option) (r *cart.EmptyCartResp, err error) {
 	return c.kitexClient.EmptyCart(ctx, Req, callOptions...)
 }
+
+func (c *clientImpl) ExportUserData(ctx context.Context, Req *cart.ExportUserDataReq, callOptions ...callopt.Option) (r *cart.ExportUserDataResp, err error) {
+	return c.kitexClient.ExportUserData(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) EraseUserData(ctx context.Context, Req *cart.EraseUserDataReq, callOptions ...callopt.Option) (r *cart.EraseUserDataResp, err error) {
+	return c.kitexClient.EraseUserData(ctx, Req, callOptions...)
+}
@@ -33,3 +33,21 @@ func EmptyCart(ctx context.Context, req *cart.EmptyCartReq, callOptions ...callo
 	}
 	return resp, nil
 }
+
+func ExportUserData(ctx context.Context, req *cart.ExportUserDataReq, callOptions ...callopt.Option) (resp *cart.ExportUserDataResp, err error) {
+	resp, err = defaultClient.ExportUserData(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ExportUserData call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func EraseUserData(ctx context.Context, req *cart.EraseUserDataReq, callOptions ...callopt.Option) (resp *cart.EraseUserDataResp, err error) {
+	resp, err = defaultClient.EraseUserData(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "EraseUserData call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
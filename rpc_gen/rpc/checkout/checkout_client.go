@@ -13,6 +13,11 @@ type RPCClient interface {
 	KitexClient() checkoutservice.Client
 	Service() string
 	Checkout(ctx context.Context, Req *checkout.CheckoutReq, callOptions ...callopt.Option) (r *checkout.CheckoutResp, err error)
+	FlashSaleCheckout(ctx context.Context, Req *checkout.FlashSaleCheckoutReq, callOptions ...callopt.Option) (r *checkout.FlashSaleCheckoutResp, err error)
+	GetFlashSaleStatus(ctx context.Context, Req *checkout.GetFlashSaleStatusReq, callOptions ...callopt.Option) (r *checkout.GetFlashSaleStatusResp, err error)
+	SubmitCheckout(ctx context.Context, Req *checkout.CheckoutReq, callOptions ...callopt.Option) (r *checkout.SubmitCheckoutResp, err error)
+	GetCheckoutStatus(ctx context.Context, Req *checkout.GetCheckoutStatusReq, callOptions ...callopt.Option) (r *checkout.GetCheckoutStatusResp, err error)
+	Quote(ctx context.Context, Req *checkout.QuoteReq, callOptions ...callopt.Option) (r *checkout.QuoteResp, err error)
 }
 
 func NewRPCClient(dstService string, opts ...client.Option) (RPCClient, error) {
@@ -44,3 +49,23 @@ func (c *clientImpl) KitexClient() checkoutservice.Client {
 func (c *clientImpl) Checkout(ctx context.Context, Req *checkout.CheckoutReq, callOptions ...callopt.Option) (r *checkout.CheckoutResp, err error) {
 	return c.kitexClient.Checkout(ctx, Req, callOptions...)
 }
+
+func (c *clientImpl) FlashSaleCheckout(ctx context.Context, Req *checkout.FlashSaleCheckoutReq, callOptions ...callopt.Option) (r *checkout.FlashSaleCheckoutResp, err error) {
+	return c.kitexClient.FlashSaleCheckout(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) GetFlashSaleStatus(ctx context.Context, Req *checkout.GetFlashSaleStatusReq, callOptions ...callopt.Option) (r *checkout.GetFlashSaleStatusResp, err error) {
+	return c.kitexClient.GetFlashSaleStatus(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) SubmitCheckout(ctx context.Context, Req *checkout.CheckoutReq, callOptions ...callopt.Option) (r *checkout.SubmitCheckoutResp, err error) {
+	return c.kitexClient.SubmitCheckout(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) GetCheckoutStatus(ctx context.Context, Req *checkout.GetCheckoutStatusReq, callOptions ...callopt.Option) (r *checkout.GetCheckoutStatusResp, err error) {
+	return c.kitexClient.GetCheckoutStatus(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) Quote(ctx context.Context, Req *checkout.QuoteReq, callOptions ...callopt.Option) (r *checkout.QuoteResp, err error) {
+	return c.kitexClient.Quote(ctx, Req, callOptions...)
+}
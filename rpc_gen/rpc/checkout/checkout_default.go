@@ -15,3 +15,48 @@ func Checkout(ctx context.Context, req *checkout.CheckoutReq, callOptions ...cal
 	}
 	return resp, nil
 }
+
+func FlashSaleCheckout(ctx context.Context, req *checkout.FlashSaleCheckoutReq, callOptions ...callopt.Option) (resp *checkout.FlashSaleCheckoutResp, err error) {
+	resp, err = defaultClient.FlashSaleCheckout(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "FlashSaleCheckout call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func GetFlashSaleStatus(ctx context.Context, req *checkout.GetFlashSaleStatusReq, callOptions ...callopt.Option) (resp *checkout.GetFlashSaleStatusResp, err error) {
+	resp, err = defaultClient.GetFlashSaleStatus(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetFlashSaleStatus call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func SubmitCheckout(ctx context.Context, req *checkout.CheckoutReq, callOptions ...callopt.Option) (resp *checkout.SubmitCheckoutResp, err error) {
+	resp, err = defaultClient.SubmitCheckout(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "SubmitCheckout call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func GetCheckoutStatus(ctx context.Context, req *checkout.GetCheckoutStatusReq, callOptions ...callopt.Option) (resp *checkout.GetCheckoutStatusResp, err error) {
+	resp, err = defaultClient.GetCheckoutStatus(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetCheckoutStatus call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func Quote(ctx context.Context, req *checkout.QuoteReq, callOptions ...callopt.Option) (resp *checkout.QuoteResp, err error) {
+	resp, err = defaultClient.Quote(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "Quote call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
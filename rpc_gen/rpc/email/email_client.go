@@ -13,6 +13,10 @@ type RPCClient interface {
 	KitexClient() emailservice.Client
 	Service() string
 	Send(ctx context.Context, Req *email.EmailReq, callOptions ...callopt.Option) (r *email.EmailResp, err error)
+	ListDeadLetters(ctx context.Context, Req *email.ListDeadLettersReq, callOptions ...callopt.Option) (r *email.ListDeadLettersResp, err error)
+	ReplayDeadLetters(ctx context.Context, Req *email.ReplayDeadLettersReq, callOptions ...callopt.Option) (r *email.ReplayDeadLettersResp, err error)
+	ExportUserData(ctx context.Context, Req *email.ExportUserDataReq, callOptions ...callopt.Option) (r *email.ExportUserDataResp, err error)
+	EraseUserData(ctx context.Context, Req *email.EraseUserDataReq, callOptions ...callopt.Option) (r *email.EraseUserDataResp, err error)
 }
 
 func NewRPCClient(dstService string, opts ...client.Option) (RPCClient, error) {
@@ -44,3 +48,19 @@ func (c *clientImpl) KitexClient() emailservice.Client {
 func (c *clientImpl) Send(ctx context.Context, Req *email.EmailReq, callOptions ...callopt.Option) (r *email.EmailResp, err error) {
 	return c.kitexClient.Send(ctx, Req, callOptions...)
 }
+
+func (c *clientImpl) ListDeadLetters(ctx context.Context, Req *email.ListDeadLettersReq, callOptions ...callopt.Option) (r *email.ListDeadLettersResp, err error) {
+	return c.kitexClient.ListDeadLetters(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ReplayDeadLetters(ctx context.Context, Req *email.ReplayDeadLettersReq, callOptions ...callopt.Option) (r *email.ReplayDeadLettersResp, err error) {
+	return c.kitexClient.ReplayDeadLetters(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ExportUserData(ctx context.Context, Req *email.ExportUserDataReq, callOptions ...callopt.Option) (r *email.ExportUserDataResp, err error) {
+	return c.kitexClient.ExportUserData(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) EraseUserData(ctx context.Context, Req *email.EraseUserDataReq, callOptions ...callopt.Option) (r *email.EraseUserDataResp, err error) {
+	return c.kitexClient.EraseUserData(ctx, Req, callOptions...)
+}
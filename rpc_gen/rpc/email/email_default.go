@@ -15,3 +15,39 @@ func Send(ctx context.Context, req *email.EmailReq, callOptions ...callopt.Optio
 	}
 	return resp, nil
 }
+
+func ListDeadLetters(ctx context.Context, req *email.ListDeadLettersReq, callOptions ...callopt.Option) (resp *email.ListDeadLettersResp, err error) {
+	resp, err = defaultClient.ListDeadLetters(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ListDeadLetters call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ReplayDeadLetters(ctx context.Context, req *email.ReplayDeadLettersReq, callOptions ...callopt.Option) (resp *email.ReplayDeadLettersResp, err error) {
+	resp, err = defaultClient.ReplayDeadLetters(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ReplayDeadLetters call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ExportUserData(ctx context.Context, req *email.ExportUserDataReq, callOptions ...callopt.Option) (resp *email.ExportUserDataResp, err error) {
+	resp, err = defaultClient.ExportUserData(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ExportUserData call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func EraseUserData(ctx context.Context, req *email.EraseUserDataReq, callOptions ...callopt.Option) (resp *email.EraseUserDataResp, err error) {
+	resp, err = defaultClient.EraseUserData(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "EraseUserData call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
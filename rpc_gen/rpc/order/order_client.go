@@ -16,6 +16,16 @@ type RPCClient interface {
 	PlaceOrder(ctx context.Context, Req *order.PlaceOrderReq, callOptions ...callopt.Option) (r *order.PlaceOrderResp, err error)
 	ListOrder(ctx context.Context, Req *order.ListOrderReq, callOptions ...callopt.Option) (r *order.ListOrderResp, err error)
 	MarkOrderPaid(ctx context.Context, Req *order.MarkOrderPaidReq, callOptions ...callopt.Option) (r *order.MarkOrderPaidResp, err error)
+	GetOrder(ctx context.Context, Req *order.GetOrderReq, callOptions ...callopt.Option) (r *order.GetOrderResp, err error)
+	GetInvoice(ctx context.Context, Req *order.GetInvoiceReq, callOptions ...callopt.Option) (r *order.GetInvoiceResp, err error)
+	CreateReturn(ctx context.Context, Req *order.CreateReturnReq, callOptions ...callopt.Option) (r *order.CreateReturnResp, err error)
+	ListReturns(ctx context.Context, Req *order.ListReturnsReq, callOptions ...callopt.Option) (r *order.ListReturnsResp, err error)
+	AdminListReturns(ctx context.Context, Req *order.AdminListReturnsReq, callOptions ...callopt.Option) (r *order.AdminListReturnsResp, err error)
+	ApproveReturn(ctx context.Context, Req *order.ApproveReturnReq, callOptions ...callopt.Option) (r *order.ApproveReturnResp, err error)
+	RejectReturn(ctx context.Context, Req *order.RejectReturnReq, callOptions ...callopt.Option) (r *order.RejectReturnResp, err error)
+	ConfirmReturnReceived(ctx context.Context, Req *order.ConfirmReturnReceivedReq, callOptions ...callopt.Option) (r *order.ConfirmReturnReceivedResp, err error)
+	ExportUserData(ctx context.Context, Req *order.ExportUserDataReq, callOptions ...callopt.Option) (r *order.ExportUserDataResp, err error)
+	EraseUserData(ctx context.Context, Req *order.EraseUserDataReq, callOptions ...callopt.Option) (r *order.EraseUserDataResp, err error)
 }
 
 func NewRPCClient(dstService string, opts ...client.Option) (RPCClient, error) {
@@ -55,3 +65,43 @@ func (c *clientImpl) ListOrder(ctx context.Context, Req *order.ListOrderReq, cal
 func (c *clientImpl) MarkOrderPaid(ctx context.Context, Req *order.MarkOrderPaidReq, callOptions ...callopt.Option) (r *order.MarkOrderPaidResp, err error) {
 	return c.kitexClient.MarkOrderPaid(ctx, Req, callOptions...)
 }
+
+func (c *clientImpl) GetOrder(ctx context.Context, Req *order.GetOrderReq, callOptions ...callopt.Option) (r *order.GetOrderResp, err error) {
+	return c.kitexClient.GetOrder(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) GetInvoice(ctx context.Context, Req *order.GetInvoiceReq, callOptions ...callopt.Option) (r *order.GetInvoiceResp, err error) {
+	return c.kitexClient.GetInvoice(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) CreateReturn(ctx context.Context, Req *order.CreateReturnReq, callOptions ...callopt.Option) (r *order.CreateReturnResp, err error) {
+	return c.kitexClient.CreateReturn(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ListReturns(ctx context.Context, Req *order.ListReturnsReq, callOptions ...callopt.Option) (r *order.ListReturnsResp, err error) {
+	return c.kitexClient.ListReturns(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) AdminListReturns(ctx context.Context, Req *order.AdminListReturnsReq, callOptions ...callopt.Option) (r *order.AdminListReturnsResp, err error) {
+	return c.kitexClient.AdminListReturns(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ApproveReturn(ctx context.Context, Req *order.ApproveReturnReq, callOptions ...callopt.Option) (r *order.ApproveReturnResp, err error) {
+	return c.kitexClient.ApproveReturn(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) RejectReturn(ctx context.Context, Req *order.RejectReturnReq, callOptions ...callopt.Option) (r *order.RejectReturnResp, err error) {
+	return c.kitexClient.RejectReturn(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ConfirmReturnReceived(ctx context.Context, Req *order.ConfirmReturnReceivedReq, callOptions ...callopt.Option) (r *order.ConfirmReturnReceivedResp, err error) {
+	return c.kitexClient.ConfirmReturnReceived(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ExportUserData(ctx context.Context, Req *order.ExportUserDataReq, callOptions ...callopt.Option) (r *order.ExportUserDataResp, err error) {
+	return c.kitexClient.ExportUserData(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) EraseUserData(ctx context.Context, Req *order.EraseUserDataReq, callOptions ...callopt.Option) (r *order.EraseUserDataResp, err error) {
+	return c.kitexClient.EraseUserData(ctx, Req, callOptions...)
+}
@@ -34,3 +34,93 @@ func MarkOrderPaid(ctx context.Context, req *order.MarkOrderPaidReq, callOptions
 	}
 	return resp, nil
 }
+
+func GetOrder(ctx context.Context, req *order.GetOrderReq, callOptions ...callopt.Option) (resp *order.GetOrderResp, err error) {
+	resp, err = defaultClient.GetOrder(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetOrder call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func GetInvoice(ctx context.Context, req *order.GetInvoiceReq, callOptions ...callopt.Option) (resp *order.GetInvoiceResp, err error) {
+	resp, err = defaultClient.GetInvoice(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetInvoice call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func CreateReturn(ctx context.Context, req *order.CreateReturnReq, callOptions ...callopt.Option) (resp *order.CreateReturnResp, err error) {
+	resp, err = defaultClient.CreateReturn(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "CreateReturn call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ListReturns(ctx context.Context, req *order.ListReturnsReq, callOptions ...callopt.Option) (resp *order.ListReturnsResp, err error) {
+	resp, err = defaultClient.ListReturns(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ListReturns call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func AdminListReturns(ctx context.Context, req *order.AdminListReturnsReq, callOptions ...callopt.Option) (resp *order.AdminListReturnsResp, err error) {
+	resp, err = defaultClient.AdminListReturns(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "AdminListReturns call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ApproveReturn(ctx context.Context, req *order.ApproveReturnReq, callOptions ...callopt.Option) (resp *order.ApproveReturnResp, err error) {
+	resp, err = defaultClient.ApproveReturn(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ApproveReturn call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func RejectReturn(ctx context.Context, req *order.RejectReturnReq, callOptions ...callopt.Option) (resp *order.RejectReturnResp, err error) {
+	resp, err = defaultClient.RejectReturn(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "RejectReturn call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ConfirmReturnReceived(ctx context.Context, req *order.ConfirmReturnReceivedReq, callOptions ...callopt.Option) (resp *order.ConfirmReturnReceivedResp, err error) {
+	resp, err = defaultClient.ConfirmReturnReceived(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ConfirmReturnReceived call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ExportUserData(ctx context.Context, req *order.ExportUserDataReq, callOptions ...callopt.Option) (resp *order.ExportUserDataResp, err error) {
+	resp, err = defaultClient.ExportUserData(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ExportUserData call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func EraseUserData(ctx context.Context, req *order.EraseUserDataReq, callOptions ...callopt.Option) (resp *order.EraseUserDataResp, err error) {
+	resp, err = defaultClient.EraseUserData(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "EraseUserData call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
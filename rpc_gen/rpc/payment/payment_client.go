@@ -13,6 +13,10 @@ type RPCClient interface {
 	KitexClient() paymentservice.Client
 	Service() string
 	Charge(ctx context.Context, Req *payment.ChargeReq, callOptions ...callopt.Option) (r *payment.ChargeResp, err error)
+	Refund(ctx context.Context, Req *payment.RefundReq, callOptions ...callopt.Option) (r *payment.RefundResp, err error)
+	ExportUserData(ctx context.Context, Req *payment.ExportUserDataReq, callOptions ...callopt.Option) (r *payment.ExportUserDataResp, err error)
+	EraseUserData(ctx context.Context, Req *payment.EraseUserDataReq, callOptions ...callopt.Option) (r *payment.EraseUserDataResp, err error)
+	TokenizeCard(ctx context.Context, Req *payment.TokenizeCardReq, callOptions ...callopt.Option) (r *payment.TokenizeCardResp, err error)
 }
 
 func NewRPCClient(dstService string, opts ...client.Option) (RPCClient, error) {
@@ -44,3 +48,19 @@ func (c *clientImpl) KitexClient() paymentservice.Client {
 func (c *clientImpl) Charge(ctx context.Context, Req *payment.ChargeReq, callOptions ...callopt.Option) (r *payment.ChargeResp, err error) {
 	return c.kitexClient.Charge(ctx, Req, callOptions...)
 }
+
+func (c *clientImpl) Refund(ctx context.Context, Req *payment.RefundReq, callOptions ...callopt.Option) (r *payment.RefundResp, err error) {
+	return c.kitexClient.Refund(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ExportUserData(ctx context.Context, Req *payment.ExportUserDataReq, callOptions ...callopt.Option) (r *payment.ExportUserDataResp, err error) {
+	return c.kitexClient.ExportUserData(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) EraseUserData(ctx context.Context, Req *payment.EraseUserDataReq, callOptions ...callopt.Option) (r *payment.EraseUserDataResp, err error) {
+	return c.kitexClient.EraseUserData(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) TokenizeCard(ctx context.Context, Req *payment.TokenizeCardReq, callOptions ...callopt.Option) (r *payment.TokenizeCardResp, err error) {
+	return c.kitexClient.TokenizeCard(ctx, Req, callOptions...)
+}
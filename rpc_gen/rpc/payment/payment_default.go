@@ -15,3 +15,39 @@ func Charge(ctx context.Context, req *payment.ChargeReq, callOptions ...callopt.
 	}
 	return resp, nil
 }
+
+func Refund(ctx context.Context, req *payment.RefundReq, callOptions ...callopt.Option) (resp *payment.RefundResp, err error) {
+	resp, err = defaultClient.Refund(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "Refund call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ExportUserData(ctx context.Context, req *payment.ExportUserDataReq, callOptions ...callopt.Option) (resp *payment.ExportUserDataResp, err error) {
+	resp, err = defaultClient.ExportUserData(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ExportUserData call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func EraseUserData(ctx context.Context, req *payment.EraseUserDataReq, callOptions ...callopt.Option) (resp *payment.EraseUserDataResp, err error) {
+	resp, err = defaultClient.EraseUserData(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "EraseUserData call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func TokenizeCard(ctx context.Context, req *payment.TokenizeCardReq, callOptions ...callopt.Option) (resp *payment.TokenizeCardResp, err error) {
+	resp, err = defaultClient.TokenizeCard(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "TokenizeCard call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
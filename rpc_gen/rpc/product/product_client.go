@@ -15,6 +15,9 @@ type RPCClient interface {
 	ListProducts(ctx context.Context, Req *product.ListProductsReq, callOptions ...callopt.Option) (r *product.ListProductsResp, err error)
 	GetProduct(ctx context.Context, Req *product.GetProductReq, callOptions ...callopt.Option) (r *product.GetProductResp, err error)
 	SearchProducts(ctx context.Context, Req *product.SearchProductsReq, callOptions ...callopt.Option) (r *product.SearchProductsResp, err error)
+	SuggestProducts(ctx context.Context, Req *product.SuggestProductsReq, callOptions ...callopt.Option) (r *product.SuggestProductsResp, err error)
+	GetPriceHistory(ctx context.Context, Req *product.GetPriceHistoryReq, callOptions ...callopt.Option) (r *product.GetPriceHistoryResp, err error)
+	SchedulePriceChange(ctx context.Context, Req *product.SchedulePriceChangeReq, callOptions ...callopt.Option) (r *product.SchedulePriceChangeResp, err error)
 }
 
 func NewRPCClient(dstService string, opts ...client.Option) (RPCClient, error) {
@@ -54,3 +57,15 @@ func (c *clientImpl) GetProduct(ctx context.Context, Req *product.GetProductReq,
 func (c *clientImpl) SearchProducts(ctx context.Context, Req *product.SearchProductsReq, callOptions ...callopt.Option) (r *product.SearchProductsResp, err error) {
 	return c.kitexClient.SearchProducts(ctx, Req, callOptions...)
 }
+
+func (c *clientImpl) SuggestProducts(ctx context.Context, Req *product.SuggestProductsReq, callOptions ...callopt.Option) (r *product.SuggestProductsResp, err error) {
+	return c.kitexClient.SuggestProducts(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) GetPriceHistory(ctx context.Context, Req *product.GetPriceHistoryReq, callOptions ...callopt.Option) (r *product.GetPriceHistoryResp, err error) {
+	return c.kitexClient.GetPriceHistory(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) SchedulePriceChange(ctx context.Context, Req *product.SchedulePriceChangeReq, callOptions ...callopt.Option) (r *product.SchedulePriceChangeResp, err error) {
+	return c.kitexClient.SchedulePriceChange(ctx, Req, callOptions...)
+}
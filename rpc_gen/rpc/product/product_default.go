@@ -33,3 +33,30 @@ func SearchProducts(ctx context.Context, req *product.SearchProductsReq, callOpt
 	}
 	return resp, nil
 }
+
+func SuggestProducts(ctx context.Context, req *product.SuggestProductsReq, callOptions ...callopt.Option) (resp *product.SuggestProductsResp, err error) {
+	resp, err = defaultClient.SuggestProducts(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "SuggestProducts call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func GetPriceHistory(ctx context.Context, req *product.GetPriceHistoryReq, callOptions ...callopt.Option) (resp *product.GetPriceHistoryResp, err error) {
+	resp, err = defaultClient.GetPriceHistory(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetPriceHistory call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func SchedulePriceChange(ctx context.Context, req *product.SchedulePriceChangeReq, callOptions ...callopt.Option) (resp *product.SchedulePriceChangeResp, err error) {
+	resp, err = defaultClient.SchedulePriceChange(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "SchedulePriceChange call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,71 @@
+package shipping
+
+import (
+	"context"
+	shipping "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping/shippingservice"
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/client/callopt"
+)
+
+type RPCClient interface {
+	KitexClient() shippingservice.Client
+	Service() string
+	GetQuote(ctx context.Context, Req *shipping.GetQuoteReq, callOptions ...callopt.Option) (r *shipping.GetQuoteResp, err error)
+	CreateShipment(ctx context.Context, Req *shipping.CreateShipmentReq, callOptions ...callopt.Option) (r *shipping.CreateShipmentResp, err error)
+	ListShipments(ctx context.Context, Req *shipping.ListShipmentsReq, callOptions ...callopt.Option) (r *shipping.ListShipmentsResp, err error)
+	CreateReturnLabel(ctx context.Context, Req *shipping.CreateReturnLabelReq, callOptions ...callopt.Option) (r *shipping.CreateReturnLabelResp, err error)
+	ExportUserData(ctx context.Context, Req *shipping.ExportUserDataReq, callOptions ...callopt.Option) (r *shipping.ExportUserDataResp, err error)
+	EraseUserData(ctx context.Context, Req *shipping.EraseUserDataReq, callOptions ...callopt.Option) (r *shipping.EraseUserDataResp, err error)
+}
+
+func NewRPCClient(dstService string, opts ...client.Option) (RPCClient, error) {
+	kitexClient, err := shippingservice.NewClient(dstService, opts...)
+	if err != nil {
+		return nil, err
+	}
+	cli := &clientImpl{
+		service:     dstService,
+		kitexClient: kitexClient,
+	}
+
+	return cli, nil
+}
+
+type clientImpl struct {
+	service     string
+	kitexClient shippingservice.Client
+}
+
+func (c *clientImpl) Service() string {
+	return c.service
+}
+
+func (c *clientImpl) KitexClient() shippingservice.Client {
+	return c.kitexClient
+}
+
+func (c *clientImpl) GetQuote(ctx context.Context, Req *shipping.GetQuoteReq, callOptions ...callopt.Option) (r *shipping.GetQuoteResp, err error) {
+	return c.kitexClient.GetQuote(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) CreateShipment(ctx context.Context, Req *shipping.CreateShipmentReq, callOptions ...callopt.Option) (r *shipping.CreateShipmentResp, err error) {
+	return c.kitexClient.CreateShipment(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ListShipments(ctx context.Context, Req *shipping.ListShipmentsReq, callOptions ...callopt.Option) (r *shipping.ListShipmentsResp, err error) {
+	return c.kitexClient.ListShipments(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) CreateReturnLabel(ctx context.Context, Req *shipping.CreateReturnLabelReq, callOptions ...callopt.Option) (r *shipping.CreateReturnLabelResp, err error) {
+	return c.kitexClient.CreateReturnLabel(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ExportUserData(ctx context.Context, Req *shipping.ExportUserDataReq, callOptions ...callopt.Option) (r *shipping.ExportUserDataResp, err error) {
+	return c.kitexClient.ExportUserData(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) EraseUserData(ctx context.Context, Req *shipping.EraseUserDataReq, callOptions ...callopt.Option) (r *shipping.EraseUserDataResp, err error) {
+	return c.kitexClient.EraseUserData(ctx, Req, callOptions...)
+}
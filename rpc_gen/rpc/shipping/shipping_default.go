@@ -0,0 +1,62 @@
+package shipping
+
+import (
+	"context"
+	shipping "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/shipping"
+	"github.com/cloudwego/kitex/client/callopt"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+func GetQuote(ctx context.Context, req *shipping.GetQuoteReq, callOptions ...callopt.Option) (resp *shipping.GetQuoteResp, err error) {
+	resp, err = defaultClient.GetQuote(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetQuote call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func CreateShipment(ctx context.Context, req *shipping.CreateShipmentReq, callOptions ...callopt.Option) (resp *shipping.CreateShipmentResp, err error) {
+	resp, err = defaultClient.CreateShipment(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "CreateShipment call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ListShipments(ctx context.Context, req *shipping.ListShipmentsReq, callOptions ...callopt.Option) (resp *shipping.ListShipmentsResp, err error) {
+	resp, err = defaultClient.ListShipments(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ListShipments call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func CreateReturnLabel(ctx context.Context, req *shipping.CreateReturnLabelReq, callOptions ...callopt.Option) (resp *shipping.CreateReturnLabelResp, err error) {
+	resp, err = defaultClient.CreateReturnLabel(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "CreateReturnLabel call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ExportUserData(ctx context.Context, req *shipping.ExportUserDataReq, callOptions ...callopt.Option) (resp *shipping.ExportUserDataResp, err error) {
+	resp, err = defaultClient.ExportUserData(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ExportUserData call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func EraseUserData(ctx context.Context, req *shipping.EraseUserDataReq, callOptions ...callopt.Option) (resp *shipping.EraseUserDataResp, err error) {
+	resp, err = defaultClient.EraseUserData(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "EraseUserData call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
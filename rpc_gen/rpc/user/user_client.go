@@ -14,6 +14,41 @@ type RPCClient interface {
 	Service() string
 	Register(ctx context.Context, Req *user.RegisterReq, callOptions ...callopt.Option) (r *user.RegisterResp, err error)
 	Login(ctx context.Context, Req *user.LoginReq, callOptions ...callopt.Option) (r *user.LoginResp, err error)
+	GetNotificationPreferences(ctx context.Context, Req *user.GetNotificationPreferencesReq, callOptions ...callopt.Option) (r *user.GetNotificationPreferencesResp, err error)
+	UpdateNotificationPreferences(ctx context.Context, Req *user.UpdateNotificationPreferencesReq, callOptions ...callopt.Option) (r *user.UpdateNotificationPreferencesResp, err error)
+	Unsubscribe(ctx context.Context, Req *user.UnsubscribeReq, callOptions ...callopt.Option) (r *user.UnsubscribeResp, err error)
+	GetJwks(ctx context.Context, Req *user.GetJwksReq, callOptions ...callopt.Option) (r *user.GetJwksResp, err error)
+	RefreshToken(ctx context.Context, Req *user.RefreshTokenReq, callOptions ...callopt.Option) (r *user.RefreshTokenResp, err error)
+	RevokeToken(ctx context.Context, Req *user.RevokeTokenReq, callOptions ...callopt.Option) (r *user.RevokeTokenResp, err error)
+	ListSessions(ctx context.Context, Req *user.ListSessionsReq, callOptions ...callopt.Option) (r *user.ListSessionsResp, err error)
+	GrantRole(ctx context.Context, Req *user.GrantRoleReq, callOptions ...callopt.Option) (r *user.GrantRoleResp, err error)
+	RevokeRole(ctx context.Context, Req *user.RevokeRoleReq, callOptions ...callopt.Option) (r *user.RevokeRoleResp, err error)
+	ListRoleAudit(ctx context.Context, Req *user.ListRoleAuditReq, callOptions ...callopt.Option) (r *user.ListRoleAuditResp, err error)
+	SendVerificationEmail(ctx context.Context, Req *user.SendVerificationEmailReq, callOptions ...callopt.Option) (r *user.SendVerificationEmailResp, err error)
+	VerifyEmail(ctx context.Context, Req *user.VerifyEmailReq, callOptions ...callopt.Option) (r *user.VerifyEmailResp, err error)
+	RequestPasswordReset(ctx context.Context, Req *user.RequestPasswordResetReq, callOptions ...callopt.Option) (r *user.RequestPasswordResetResp, err error)
+	ResetPassword(ctx context.Context, Req *user.ResetPasswordReq, callOptions ...callopt.Option) (r *user.ResetPasswordResp, err error)
+	UnlockAccount(ctx context.Context, Req *user.UnlockAccountReq, callOptions ...callopt.Option) (r *user.UnlockAccountResp, err error)
+	EnrollTotp(ctx context.Context, Req *user.EnrollTotpReq, callOptions ...callopt.Option) (r *user.EnrollTotpResp, err error)
+	ConfirmTotp(ctx context.Context, Req *user.ConfirmTotpReq, callOptions ...callopt.Option) (r *user.ConfirmTotpResp, err error)
+	DisableTotp(ctx context.Context, Req *user.DisableTotpReq, callOptions ...callopt.Option) (r *user.DisableTotpResp, err error)
+	GetTotpStatus(ctx context.Context, Req *user.GetTotpStatusReq, callOptions ...callopt.Option) (r *user.GetTotpStatusResp, err error)
+	RegenerateRecoveryCodes(ctx context.Context, Req *user.RegenerateRecoveryCodesReq, callOptions ...callopt.Option) (r *user.RegenerateRecoveryCodesResp, err error)
+	VerifyTwoFactor(ctx context.Context, Req *user.VerifyTwoFactorReq, callOptions ...callopt.Option) (r *user.LoginResp, err error)
+	ListOidcProviders(ctx context.Context, Req *user.ListOidcProvidersReq, callOptions ...callopt.Option) (r *user.ListOidcProvidersResp, err error)
+	StartOidcLogin(ctx context.Context, Req *user.StartOidcLoginReq, callOptions ...callopt.Option) (r *user.StartOidcLoginResp, err error)
+	FinishOidcLogin(ctx context.Context, Req *user.FinishOidcLoginReq, callOptions ...callopt.Option) (r *user.LoginResp, err error)
+	GetProfile(ctx context.Context, Req *user.GetProfileReq, callOptions ...callopt.Option) (r *user.GetProfileResp, err error)
+	UpdateProfile(ctx context.Context, Req *user.UpdateProfileReq, callOptions ...callopt.Option) (r *user.UpdateProfileResp, err error)
+	ListAddresses(ctx context.Context, Req *user.ListAddressesReq, callOptions ...callopt.Option) (r *user.ListAddressesResp, err error)
+	CreateAddress(ctx context.Context, Req *user.CreateAddressReq, callOptions ...callopt.Option) (r *user.CreateAddressResp, err error)
+	UpdateAddress(ctx context.Context, Req *user.UpdateAddressReq, callOptions ...callopt.Option) (r *user.UpdateAddressResp, err error)
+	DeleteAddress(ctx context.Context, Req *user.DeleteAddressReq, callOptions ...callopt.Option) (r *user.DeleteAddressResp, err error)
+	SetDefaultAddress(ctx context.Context, Req *user.SetDefaultAddressReq, callOptions ...callopt.Option) (r *user.SetDefaultAddressResp, err error)
+	RequestDataExport(ctx context.Context, Req *user.RequestDataExportReq, callOptions ...callopt.Option) (r *user.RequestDataExportResp, err error)
+	DeleteAccount(ctx context.Context, Req *user.DeleteAccountReq, callOptions ...callopt.Option) (r *user.DeleteAccountResp, err error)
+	ConfirmAccountDeletion(ctx context.Context, Req *user.ConfirmAccountDeletionReq, callOptions ...callopt.Option) (r *user.ConfirmAccountDeletionResp, err error)
+	GetAccountDeletion(ctx context.Context, Req *user.GetAccountDeletionReq, callOptions ...callopt.Option) (r *user.GetAccountDeletionResp, err error)
 }
 
 func NewRPCClient(dstService string, opts ...client.Option) (RPCClient, error) {
@@ -49,3 +84,143 @@ func (c *clientImpl) Register(ctx context.Context, Req *user.RegisterReq, callOp
 func (c *clientImpl) Login(ctx context.Context, Req *user.LoginReq, callOptions ...callopt.Option) (r *user.LoginResp, err error) {
 	return c.kitexClient.Login(ctx, Req, callOptions...)
 }
+
+func (c *clientImpl) GetNotificationPreferences(ctx context.Context, Req *user.GetNotificationPreferencesReq, callOptions ...callopt.Option) (r *user.GetNotificationPreferencesResp, err error) {
+	return c.kitexClient.GetNotificationPreferences(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) UpdateNotificationPreferences(ctx context.Context, Req *user.UpdateNotificationPreferencesReq, callOptions ...callopt.Option) (r *user.UpdateNotificationPreferencesResp, err error) {
+	return c.kitexClient.UpdateNotificationPreferences(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) Unsubscribe(ctx context.Context, Req *user.UnsubscribeReq, callOptions ...callopt.Option) (r *user.UnsubscribeResp, err error) {
+	return c.kitexClient.Unsubscribe(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) GetJwks(ctx context.Context, Req *user.GetJwksReq, callOptions ...callopt.Option) (r *user.GetJwksResp, err error) {
+	return c.kitexClient.GetJwks(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) RefreshToken(ctx context.Context, Req *user.RefreshTokenReq, callOptions ...callopt.Option) (r *user.RefreshTokenResp, err error) {
+	return c.kitexClient.RefreshToken(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) RevokeToken(ctx context.Context, Req *user.RevokeTokenReq, callOptions ...callopt.Option) (r *user.RevokeTokenResp, err error) {
+	return c.kitexClient.RevokeToken(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ListSessions(ctx context.Context, Req *user.ListSessionsReq, callOptions ...callopt.Option) (r *user.ListSessionsResp, err error) {
+	return c.kitexClient.ListSessions(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) GrantRole(ctx context.Context, Req *user.GrantRoleReq, callOptions ...callopt.Option) (r *user.GrantRoleResp, err error) {
+	return c.kitexClient.GrantRole(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) RevokeRole(ctx context.Context, Req *user.RevokeRoleReq, callOptions ...callopt.Option) (r *user.RevokeRoleResp, err error) {
+	return c.kitexClient.RevokeRole(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ListRoleAudit(ctx context.Context, Req *user.ListRoleAuditReq, callOptions ...callopt.Option) (r *user.ListRoleAuditResp, err error) {
+	return c.kitexClient.ListRoleAudit(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) SendVerificationEmail(ctx context.Context, Req *user.SendVerificationEmailReq, callOptions ...callopt.Option) (r *user.SendVerificationEmailResp, err error) {
+	return c.kitexClient.SendVerificationEmail(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) VerifyEmail(ctx context.Context, Req *user.VerifyEmailReq, callOptions ...callopt.Option) (r *user.VerifyEmailResp, err error) {
+	return c.kitexClient.VerifyEmail(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) RequestPasswordReset(ctx context.Context, Req *user.RequestPasswordResetReq, callOptions ...callopt.Option) (r *user.RequestPasswordResetResp, err error) {
+	return c.kitexClient.RequestPasswordReset(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ResetPassword(ctx context.Context, Req *user.ResetPasswordReq, callOptions ...callopt.Option) (r *user.ResetPasswordResp, err error) {
+	return c.kitexClient.ResetPassword(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) UnlockAccount(ctx context.Context, Req *user.UnlockAccountReq, callOptions ...callopt.Option) (r *user.UnlockAccountResp, err error) {
+	return c.kitexClient.UnlockAccount(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) EnrollTotp(ctx context.Context, Req *user.EnrollTotpReq, callOptions ...callopt.Option) (r *user.EnrollTotpResp, err error) {
+	return c.kitexClient.EnrollTotp(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ConfirmTotp(ctx context.Context, Req *user.ConfirmTotpReq, callOptions ...callopt.Option) (r *user.ConfirmTotpResp, err error) {
+	return c.kitexClient.ConfirmTotp(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) DisableTotp(ctx context.Context, Req *user.DisableTotpReq, callOptions ...callopt.Option) (r *user.DisableTotpResp, err error) {
+	return c.kitexClient.DisableTotp(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) GetTotpStatus(ctx context.Context, Req *user.GetTotpStatusReq, callOptions ...callopt.Option) (r *user.GetTotpStatusResp, err error) {
+	return c.kitexClient.GetTotpStatus(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) RegenerateRecoveryCodes(ctx context.Context, Req *user.RegenerateRecoveryCodesReq, callOptions ...callopt.Option) (r *user.RegenerateRecoveryCodesResp, err error) {
+	return c.kitexClient.RegenerateRecoveryCodes(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) VerifyTwoFactor(ctx context.Context, Req *user.VerifyTwoFactorReq, callOptions ...callopt.Option) (r *user.LoginResp, err error) {
+	return c.kitexClient.VerifyTwoFactor(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ListOidcProviders(ctx context.Context, Req *user.ListOidcProvidersReq, callOptions ...callopt.Option) (r *user.ListOidcProvidersResp, err error) {
+	return c.kitexClient.ListOidcProviders(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) StartOidcLogin(ctx context.Context, Req *user.StartOidcLoginReq, callOptions ...callopt.Option) (r *user.StartOidcLoginResp, err error) {
+	return c.kitexClient.StartOidcLogin(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) FinishOidcLogin(ctx context.Context, Req *user.FinishOidcLoginReq, callOptions ...callopt.Option) (r *user.LoginResp, err error) {
+	return c.kitexClient.FinishOidcLogin(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) GetProfile(ctx context.Context, Req *user.GetProfileReq, callOptions ...callopt.Option) (r *user.GetProfileResp, err error) {
+	return c.kitexClient.GetProfile(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) UpdateProfile(ctx context.Context, Req *user.UpdateProfileReq, callOptions ...callopt.Option) (r *user.UpdateProfileResp, err error) {
+	return c.kitexClient.UpdateProfile(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ListAddresses(ctx context.Context, Req *user.ListAddressesReq, callOptions ...callopt.Option) (r *user.ListAddressesResp, err error) {
+	return c.kitexClient.ListAddresses(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) CreateAddress(ctx context.Context, Req *user.CreateAddressReq, callOptions ...callopt.Option) (r *user.CreateAddressResp, err error) {
+	return c.kitexClient.CreateAddress(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) UpdateAddress(ctx context.Context, Req *user.UpdateAddressReq, callOptions ...callopt.Option) (r *user.UpdateAddressResp, err error) {
+	return c.kitexClient.UpdateAddress(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) DeleteAddress(ctx context.Context, Req *user.DeleteAddressReq, callOptions ...callopt.Option) (r *user.DeleteAddressResp, err error) {
+	return c.kitexClient.DeleteAddress(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) SetDefaultAddress(ctx context.Context, Req *user.SetDefaultAddressReq, callOptions ...callopt.Option) (r *user.SetDefaultAddressResp, err error) {
+	return c.kitexClient.SetDefaultAddress(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) RequestDataExport(ctx context.Context, Req *user.RequestDataExportReq, callOptions ...callopt.Option) (r *user.RequestDataExportResp, err error) {
+	return c.kitexClient.RequestDataExport(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) DeleteAccount(ctx context.Context, Req *user.DeleteAccountReq, callOptions ...callopt.Option) (r *user.DeleteAccountResp, err error) {
+	return c.kitexClient.DeleteAccount(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ConfirmAccountDeletion(ctx context.Context, Req *user.ConfirmAccountDeletionReq, callOptions ...callopt.Option) (r *user.ConfirmAccountDeletionResp, err error) {
+	return c.kitexClient.ConfirmAccountDeletion(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) GetAccountDeletion(ctx context.Context, Req *user.GetAccountDeletionReq, callOptions ...callopt.Option) (r *user.GetAccountDeletionResp, err error) {
+	return c.kitexClient.GetAccountDeletion(ctx, Req, callOptions...)
+}
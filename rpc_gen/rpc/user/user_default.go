@@ -24,3 +24,318 @@ func Login(ctx context.Context, req *user.LoginReq, callOptions ...callopt.Optio
 	}
 	return resp, nil
 }
+
+func GetNotificationPreferences(ctx context.Context, req *user.GetNotificationPreferencesReq, callOptions ...callopt.Option) (resp *user.GetNotificationPreferencesResp, err error) {
+	resp, err = defaultClient.GetNotificationPreferences(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetNotificationPreferences call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func UpdateNotificationPreferences(ctx context.Context, req *user.UpdateNotificationPreferencesReq, callOptions ...callopt.Option) (resp *user.UpdateNotificationPreferencesResp, err error) {
+	resp, err = defaultClient.UpdateNotificationPreferences(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "UpdateNotificationPreferences call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func Unsubscribe(ctx context.Context, req *user.UnsubscribeReq, callOptions ...callopt.Option) (resp *user.UnsubscribeResp, err error) {
+	resp, err = defaultClient.Unsubscribe(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "Unsubscribe call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func GetJwks(ctx context.Context, req *user.GetJwksReq, callOptions ...callopt.Option) (resp *user.GetJwksResp, err error) {
+	resp, err = defaultClient.GetJwks(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetJwks call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func RefreshToken(ctx context.Context, req *user.RefreshTokenReq, callOptions ...callopt.Option) (resp *user.RefreshTokenResp, err error) {
+	resp, err = defaultClient.RefreshToken(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "RefreshToken call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func RevokeToken(ctx context.Context, req *user.RevokeTokenReq, callOptions ...callopt.Option) (resp *user.RevokeTokenResp, err error) {
+	resp, err = defaultClient.RevokeToken(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "RevokeToken call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ListSessions(ctx context.Context, req *user.ListSessionsReq, callOptions ...callopt.Option) (resp *user.ListSessionsResp, err error) {
+	resp, err = defaultClient.ListSessions(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ListSessions call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func GrantRole(ctx context.Context, req *user.GrantRoleReq, callOptions ...callopt.Option) (resp *user.GrantRoleResp, err error) {
+	resp, err = defaultClient.GrantRole(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GrantRole call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func RevokeRole(ctx context.Context, req *user.RevokeRoleReq, callOptions ...callopt.Option) (resp *user.RevokeRoleResp, err error) {
+	resp, err = defaultClient.RevokeRole(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "RevokeRole call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ListRoleAudit(ctx context.Context, req *user.ListRoleAuditReq, callOptions ...callopt.Option) (resp *user.ListRoleAuditResp, err error) {
+	resp, err = defaultClient.ListRoleAudit(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ListRoleAudit call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func SendVerificationEmail(ctx context.Context, req *user.SendVerificationEmailReq, callOptions ...callopt.Option) (resp *user.SendVerificationEmailResp, err error) {
+	resp, err = defaultClient.SendVerificationEmail(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "SendVerificationEmail call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func VerifyEmail(ctx context.Context, req *user.VerifyEmailReq, callOptions ...callopt.Option) (resp *user.VerifyEmailResp, err error) {
+	resp, err = defaultClient.VerifyEmail(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "VerifyEmail call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func RequestPasswordReset(ctx context.Context, req *user.RequestPasswordResetReq, callOptions ...callopt.Option) (resp *user.RequestPasswordResetResp, err error) {
+	resp, err = defaultClient.RequestPasswordReset(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "RequestPasswordReset call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ResetPassword(ctx context.Context, req *user.ResetPasswordReq, callOptions ...callopt.Option) (resp *user.ResetPasswordResp, err error) {
+	resp, err = defaultClient.ResetPassword(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ResetPassword call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func UnlockAccount(ctx context.Context, req *user.UnlockAccountReq, callOptions ...callopt.Option) (resp *user.UnlockAccountResp, err error) {
+	resp, err = defaultClient.UnlockAccount(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "UnlockAccount call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func EnrollTotp(ctx context.Context, req *user.EnrollTotpReq, callOptions ...callopt.Option) (resp *user.EnrollTotpResp, err error) {
+	resp, err = defaultClient.EnrollTotp(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "EnrollTotp call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ConfirmTotp(ctx context.Context, req *user.ConfirmTotpReq, callOptions ...callopt.Option) (resp *user.ConfirmTotpResp, err error) {
+	resp, err = defaultClient.ConfirmTotp(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ConfirmTotp call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func DisableTotp(ctx context.Context, req *user.DisableTotpReq, callOptions ...callopt.Option) (resp *user.DisableTotpResp, err error) {
+	resp, err = defaultClient.DisableTotp(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "DisableTotp call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func GetTotpStatus(ctx context.Context, req *user.GetTotpStatusReq, callOptions ...callopt.Option) (resp *user.GetTotpStatusResp, err error) {
+	resp, err = defaultClient.GetTotpStatus(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetTotpStatus call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func RegenerateRecoveryCodes(ctx context.Context, req *user.RegenerateRecoveryCodesReq, callOptions ...callopt.Option) (resp *user.RegenerateRecoveryCodesResp, err error) {
+	resp, err = defaultClient.RegenerateRecoveryCodes(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "RegenerateRecoveryCodes call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func VerifyTwoFactor(ctx context.Context, req *user.VerifyTwoFactorReq, callOptions ...callopt.Option) (resp *user.LoginResp, err error) {
+	resp, err = defaultClient.VerifyTwoFactor(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "VerifyTwoFactor call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ListOidcProviders(ctx context.Context, req *user.ListOidcProvidersReq, callOptions ...callopt.Option) (resp *user.ListOidcProvidersResp, err error) {
+	resp, err = defaultClient.ListOidcProviders(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ListOidcProviders call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func StartOidcLogin(ctx context.Context, req *user.StartOidcLoginReq, callOptions ...callopt.Option) (resp *user.StartOidcLoginResp, err error) {
+	resp, err = defaultClient.StartOidcLogin(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "StartOidcLogin call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func FinishOidcLogin(ctx context.Context, req *user.FinishOidcLoginReq, callOptions ...callopt.Option) (resp *user.LoginResp, err error) {
+	resp, err = defaultClient.FinishOidcLogin(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "FinishOidcLogin call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func GetProfile(ctx context.Context, req *user.GetProfileReq, callOptions ...callopt.Option) (resp *user.GetProfileResp, err error) {
+	resp, err = defaultClient.GetProfile(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetProfile call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func UpdateProfile(ctx context.Context, req *user.UpdateProfileReq, callOptions ...callopt.Option) (resp *user.UpdateProfileResp, err error) {
+	resp, err = defaultClient.UpdateProfile(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "UpdateProfile call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ListAddresses(ctx context.Context, req *user.ListAddressesReq, callOptions ...callopt.Option) (resp *user.ListAddressesResp, err error) {
+	resp, err = defaultClient.ListAddresses(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ListAddresses call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func CreateAddress(ctx context.Context, req *user.CreateAddressReq, callOptions ...callopt.Option) (resp *user.CreateAddressResp, err error) {
+	resp, err = defaultClient.CreateAddress(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "CreateAddress call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func UpdateAddress(ctx context.Context, req *user.UpdateAddressReq, callOptions ...callopt.Option) (resp *user.UpdateAddressResp, err error) {
+	resp, err = defaultClient.UpdateAddress(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "UpdateAddress call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func DeleteAddress(ctx context.Context, req *user.DeleteAddressReq, callOptions ...callopt.Option) (resp *user.DeleteAddressResp, err error) {
+	resp, err = defaultClient.DeleteAddress(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "DeleteAddress call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func SetDefaultAddress(ctx context.Context, req *user.SetDefaultAddressReq, callOptions ...callopt.Option) (resp *user.SetDefaultAddressResp, err error) {
+	resp, err = defaultClient.SetDefaultAddress(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "SetDefaultAddress call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func RequestDataExport(ctx context.Context, req *user.RequestDataExportReq, callOptions ...callopt.Option) (resp *user.RequestDataExportResp, err error) {
+	resp, err = defaultClient.RequestDataExport(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "RequestDataExport call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func DeleteAccount(ctx context.Context, req *user.DeleteAccountReq, callOptions ...callopt.Option) (resp *user.DeleteAccountResp, err error) {
+	resp, err = defaultClient.DeleteAccount(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "DeleteAccount call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ConfirmAccountDeletion(ctx context.Context, req *user.ConfirmAccountDeletionReq, callOptions ...callopt.Option) (resp *user.ConfirmAccountDeletionResp, err error) {
+	resp, err = defaultClient.ConfirmAccountDeletion(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ConfirmAccountDeletion call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func GetAccountDeletion(ctx context.Context, req *user.GetAccountDeletionReq, callOptions ...callopt.Option) (resp *user.GetAccountDeletionResp, err error) {
+	resp, err = defaultClient.GetAccountDeletion(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetAccountDeletion call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}